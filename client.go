@@ -3,6 +3,7 @@ package ovirtclient
 import (
 	"math/rand"
 	"net/http"
+	"sync"
 
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
@@ -11,29 +12,50 @@ import (
 //
 //goland:noinspection GoDeprecation
 type Client interface {
-	// GetURL returns the oVirt engine base URL.
+	// GetURL returns the oVirt engine base URL. If failover URLs are configured, this is the URL currently in use.
 	GetURL() string
 
+	ResourceClients
+
 	DiskClient
 	DiskAttachmentClient
 	VMClient
+	VMBackupClient
 	NICClient
 	VNICProfileClient
 	NetworkClient
+	NetworkProviderClient
+	HostProviderClient
+	ImageProviderClient
 	DatacenterClient
 	ClusterClient
 	StorageDomainClient
 	HostClient
+	HostNICClient
 	TemplateClient
 	TemplateDiskClient
 	TestConnectionClient
 	TagClient
+	QuotaClient
+	PermissionClient
+	UserClient
+	GroupClient
+	RoleClient
+	InstanceTypeClient
+	OperatingSystemClient
+	ErrataClient
+	EventClient
+	JobClient
+	EngineClient
+	CleanupClient
+	StatsClient
 }
 
 // ClientWithLegacySupport is an extension of Client that also offers the ability to retrieve the underlying
 // SDK connection or a configured HTTP client.
 type ClientWithLegacySupport interface {
-	// GetSDKClient returns a configured oVirt SDK client for the use cases that are not covered by goVirt.
+	// GetSDKClient returns a configured oVirt SDK client for the use cases that are not covered by goVirt. The
+	// returned connection is not replaced when the session expires, so it should not be held for a long time.
 	GetSDKClient() *ovirtsdk4.Connection
 
 	// GetHTTPClient returns a configured HTTP client for the oVirt engine. This can be used to send manual
@@ -44,15 +66,54 @@ type ClientWithLegacySupport interface {
 }
 
 type oVirtClient struct {
-	conn            *ovirtsdk4.Connection
-	httpClient      http.Client
-	logger          Logger
-	url             string
+	// conn is the underlying SDK connection. It is replaced when the session expires, use connection() to access it.
+	conn *ovirtsdk4.Connection
+	// connLock guards conn.
+	connLock sync.RWMutex
+	// connect creates a new SDK connection to the current URL when the session expires or the client fails over to
+	// a different engine. It must be called with connLock held. If nil, the client does not log in again.
+	connect    func() (*ovirtsdk4.Connection, error)
+	httpClient http.Client
+	logger     Logger
+	// url is the engine URL currently in use. It is guarded by connLock.
+	url string
+	// urls contains the primary engine URL followed by the failover URLs, if any.
+	urls []string
+	// urlIndex is the index of url in urls. It is guarded by connLock.
+	urlIndex        int
 	nonSecureRandom *rand.Rand
+	// correlationID is the default correlation ID attached to create, update and delete calls.
+	correlationID string
+	// metrics is the optional collector receiving measurements about API calls.
+	metrics MetricsCollector
+	// stats records the statistics returned by Stats. It is always set by New.
+	stats *statsCollector
+	// tracer is the optional tracer creating spans around API calls.
+	tracer Tracer
+	// rateLimiter is the optional limiter for the rate of API calls.
+	rateLimiter *rateLimiter
+	// dryRun causes calls changing the oVirt Engine to be logged and fail with an EDryRun error instead of being sent.
+	dryRun bool
+	// readOnly causes calls changing the oVirt Engine to be rejected with an EReadOnly error.
+	readOnly bool
+	// username is the user the client is logged in as. It is recorded in the audit records.
+	username string
+	// auditSink is the optional sink recording the calls changing the oVirt Engine.
+	auditSink AuditSink
+	// vmNameValidator checks the names of created and renamed VMs. If nil, DefaultVMNameValidator is used.
+	vmNameValidator VMNameValidator
+	// engineVersion is the cached version of the oVirt Engine, nil until it is first fetched. It is cleared when the
+	// client logs in again or fails over, since the new connection may lead to an engine running a different version.
+	engineVersion *engineVersion
+	// engineVersionConn is the connection engineVersion was fetched through. The cached version is only used while
+	// this connection is current.
+	engineVersionConn *ovirtsdk4.Connection
+	// engineVersionLock guards engineVersion and engineVersionConn.
+	engineVersionLock sync.Mutex
 }
 
 func (o *oVirtClient) GetSDKClient() *ovirtsdk4.Connection {
-	return o.conn
+	return o.connection()
 }
 
 func (o *oVirtClient) GetHTTPClient() http.Client {
@@ -60,5 +121,7 @@ func (o *oVirtClient) GetHTTPClient() http.Client {
 }
 
 func (o *oVirtClient) GetURL() string {
+	o.connLock.RLock()
+	defer o.connLock.RUnlock()
 	return o.url
 }
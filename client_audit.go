@@ -0,0 +1,154 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+)
+
+// redactedParameter replaces the values of parameters containing secrets in the audit records.
+const redactedParameter = "<redacted>"
+
+// secretParameterNames are the parts of parameter names indicating that the value is a secret, which must not be
+// recorded.
+var secretParameterNames = []string{"password", "passphrase", "secret", "token", "private_key"}
+
+// AuditSink records the calls the client makes that change the state of the oVirt Engine, such as creating or removing
+// a VM. It can be used to keep a compliance trail of which operator changed what in environments where multiple
+// operators share an engine. A sink can be passed to the client using ExtraSettingsV12.
+//
+// The sink is called from multiple goroutines concurrently and must therefore be thread-safe.
+type AuditSink interface {
+	// Record is called once after each create, update or delete call has finished, including all its retries. Calls
+	// rejected in read-only mode and calls skipped in dry-run mode are recorded as well.
+	Record(record AuditRecord)
+}
+
+// AuditRecord describes a single call that changes the state of the oVirt Engine.
+type AuditRecord interface {
+	// Time returns the time the call was started.
+	Time() time.Time
+	// Duration returns the time the call took, including all retries.
+	Duration() time.Duration
+	// User returns the name of the user the client is logged in as, for example admin@internal.
+	User() string
+	// Operation returns the name of the client function making the call, for example "RemoveVM".
+	Operation() string
+	// Action returns a human-readable description of the call including the IDs of the objects involved, for example
+	// "removing VM 1234".
+	Action() string
+	// ResourceType returns the type of the object the call works on, for example ResourceTypeVM for RemoveVM. For
+	// calls creating an object below another object, such as CreateNIC, this is the type of the parent object.
+	ResourceType() ResourceType
+	// ResourceID returns the ID of the object the call works on. For calls creating an object below another object
+	// this is the ID of the parent object, for example the VM for CreateNIC. It is empty for calls creating top-level
+	// objects, such as CreateVM.
+	ResourceID() string
+	// Parameters returns the other arguments of the call by name, for example "tag_id" for AddTagToVM or "name" for
+	// CreateVM. The optional parameter objects of the calls are not included. The values of passwords and other
+	// secrets are replaced by "<redacted>".
+	Parameters() map[string]string
+	// CorrelationID returns the correlation ID sent with the call, if any. It can be used to find the jobs and events
+	// of the call on the engine.
+	CorrelationID() string
+	// DryRun returns true if the call was skipped because the client is in dry-run mode.
+	DryRun() bool
+	// Err returns the error the call returned, or nil if the call was successful.
+	Err() error
+}
+
+type auditRecord struct {
+	time          time.Time
+	duration      time.Duration
+	user          string
+	operation     string
+	action        string
+	resourceType  ResourceType
+	resourceID    string
+	parameters    map[string]string
+	correlationID string
+	dryRun        bool
+	err           error
+}
+
+func (a *auditRecord) Time() time.Time {
+	return a.time
+}
+
+func (a *auditRecord) Duration() time.Duration {
+	return a.duration
+}
+
+func (a *auditRecord) User() string {
+	return a.user
+}
+
+func (a *auditRecord) Operation() string {
+	return a.operation
+}
+
+func (a *auditRecord) Action() string {
+	return a.action
+}
+
+func (a *auditRecord) ResourceType() ResourceType {
+	return a.resourceType
+}
+
+func (a *auditRecord) ResourceID() string {
+	return a.resourceID
+}
+
+func (a *auditRecord) Parameters() map[string]string {
+	return a.parameters
+}
+
+func (a *auditRecord) CorrelationID() string {
+	return a.correlationID
+}
+
+func (a *auditRecord) DryRun() bool {
+	return a.dryRun
+}
+
+func (a *auditRecord) Err() error {
+	return a.err
+}
+
+// startAuditRecord creates the audit record for a call. It must be called directly from oVirtClient.mutate so
+// callerOperation can determine the name of the operation.
+func (o *oVirtClient) startAuditRecord(resource callResource, action string, retries []RetryStrategy) *auditRecord {
+	return &auditRecord{
+		time:          time.Now(),
+		user:          o.username,
+		operation:     callerOperation(),
+		action:        action,
+		resourceType:  resource.resourceType,
+		resourceID:    resource.id,
+		parameters:    redactParameters(resource.parameters),
+		correlationID: o.correlationIDFor(retries),
+		dryRun:        o.dryRun && !o.readOnly,
+	}
+}
+
+// finishAuditRecord completes the record with the result of the call and passes it to the audit sink.
+func (o *oVirtClient) finishAuditRecord(record *auditRecord, err error) {
+	record.duration = time.Since(record.time)
+	record.err = err
+	o.auditSink.Record(record)
+}
+
+// redactParameters returns a copy of the parameters of a call with the values of secrets, such as passwords, replaced.
+func redactParameters(parameters map[string]string) map[string]string {
+	result := make(map[string]string, len(parameters))
+	for name, value := range parameters {
+		result[name] = value
+		lowerName := strings.ToLower(name)
+		for _, secretName := range secretParameterNames {
+			if strings.Contains(lowerName, secretName) {
+				result[name] = redactedParameter
+				break
+			}
+		}
+	}
+	return result
+}
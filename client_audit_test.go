@@ -0,0 +1,68 @@
+// This file contains tests for the internal audit functionality. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"sync"
+	"testing"
+)
+
+type testAuditSink struct {
+	lock    *sync.Mutex
+	records []AuditRecord
+}
+
+func (s *testAuditSink) Record(record AuditRecord) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.records = append(s.records, record)
+}
+
+func TestAuditSinkRecordsMutations(t *testing.T) {
+	t.Parallel()
+	sink := &testAuditSink{lock: &sync.Mutex{}}
+	o := &oVirtClient{
+		logger:    &noopLogger{},
+		username:  "admin@internal",
+		auditSink: sink,
+	}
+	retries := []RetryStrategy{ExponentialBackoff(1), MaxTries(1), CorrelationID("audit-test")}
+	resource := resourceOf(ResourceTypeVM, "vm1").with("tag_id", "tag1").with("password", "secret")
+	if err := o.retry(resource, "testing audit read", retries, func() error { return nil }); err != nil {
+		t.Fatalf("read call failed (%v)", err)
+	}
+	err := o.mutate(resource, "testing audit", retries, func() error {
+		return newError(EConflict, "test failure")
+	})
+	if !HasErrorCode(err, EConflict) {
+		t.Fatalf("mutation did not return the expected error (%v)", err)
+	}
+	o.readOnly = true
+	if err := o.mutate(resource, "testing audit in read-only mode", retries, func() error { return nil }); err == nil {
+		t.Fatalf("mutation in read-only mode did not fail")
+	}
+
+	if len(sink.records) != 2 {
+		t.Fatalf("incorrect number of audit records (expected: 2, got: %d)", len(sink.records))
+	}
+	record := sink.records[0]
+	if record.Operation() != "TestAuditSinkRecordsMutations" || record.Action() != "testing audit" {
+		t.Fatalf("incorrect operation or action recorded (%s, %s)", record.Operation(), record.Action())
+	}
+	if record.User() != "admin@internal" || record.CorrelationID() != "audit-test" || record.DryRun() {
+		t.Fatalf("incorrect call details recorded (%s, %s, %t)", record.User(), record.CorrelationID(), record.DryRun())
+	}
+	if record.ResourceType() != ResourceTypeVM || record.ResourceID() != "vm1" {
+		t.Fatalf("incorrect resource recorded (%s, %s)", record.ResourceType(), record.ResourceID())
+	}
+	if parameters := record.Parameters(); parameters["tag_id"] != "tag1" || parameters["password"] != redactedParameter {
+		t.Fatalf("incorrect parameters recorded (%v)", parameters)
+	}
+	if !HasErrorCode(record.Err(), EConflict) {
+		t.Fatalf("incorrect error recorded (%v)", record.Err())
+	}
+	if !HasErrorCode(sink.records[1].Err(), EReadOnly) {
+		t.Fatalf("incorrect error recorded for read-only mode (%v)", sink.records[1].Err())
+	}
+}
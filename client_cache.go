@@ -0,0 +1,120 @@
+package ovirtclient
+
+import (
+	"sync"
+	"time"
+)
+
+// CachingClient is a Client that caches clusters, templates, VNIC profiles and tags. See NewCachingClient for details.
+type CachingClient interface {
+	Client
+
+	// InvalidateCache removes all entries from the cache, forcing the next calls to fetch the resources from the
+	// oVirt Engine.
+	InvalidateCache()
+}
+
+// NewCachingClient wraps the client in a read-through cache for resources that rarely change: clusters, templates,
+// VNIC profiles and tags. The results of the list and get calls for these resources are kept for the duration of
+// ttl, which reduces the load on the oVirt Engine for controllers that reconcile frequently. Errors are not cached.
+//
+// Creating, updating or removing templates, VNIC profiles or tags, as well as upgrading the compatibility version of
+// a cluster, through the caching client invalidates the cached entries for that resource type. The same applies to
+// cleaning up, importing, registering and tagging templates, and to importing Glance images as templates. Changes
+// made in any other way, such as by calling Remove() on a returned object or by a different client, only become
+// visible after the TTL expires or InvalidateCache is called.
+//
+// All other calls are passed to the wrapped client without caching.
+func NewCachingClient(client Client, ttl time.Duration) CachingClient {
+	return &cachingClient{
+		Client:  client,
+		ttl:     ttl,
+		lock:    &sync.Mutex{},
+		entries: map[cacheKind]map[string]cacheEntry{},
+	}
+}
+
+type cacheKind string
+
+const (
+	cacheKindCluster       cacheKind = "cluster"
+	cacheKindTemplate      cacheKind = "template"
+	cacheKindBlankTemplate cacheKind = "blanktemplate"
+	cacheKindVNICProfile   cacheKind = "vnicprofile"
+	cacheKindTag           cacheKind = "tag"
+)
+
+// cacheKeyList is the key the result of the list call is stored under. Get calls are stored under the ID of the
+// resource, which can never be empty. The result of GetBlankTemplate is stored under this key in the
+// cacheKindBlankTemplate kind.
+const cacheKeyList = ""
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type cachingClient struct {
+	Client
+
+	ttl     time.Duration
+	lock    *sync.Mutex
+	entries map[cacheKind]map[string]cacheEntry
+}
+
+func (c *cachingClient) InvalidateCache() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = map[cacheKind]map[string]cacheEntry{}
+}
+
+// invalidate removes all cached entries of a resource type.
+func (c *cachingClient) invalidate(kind cacheKind) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, kind)
+}
+
+// cached returns the cached value for the key if it is present and not expired. Otherwise, it calls the fetch
+// function and stores its result if it succeeds. The lock is not held while fetching, so concurrent calls for the
+// same key may each fetch the value.
+func (c *cachingClient) cached(kind cacheKind, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.lock.Lock()
+	if entry, ok := c.entries[kind][key]; ok && time.Now().Before(entry.expires) {
+		c.lock.Unlock()
+		return entry.value, nil
+	}
+	c.lock.Unlock()
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[kind]; !ok {
+		c.entries[kind] = map[string]cacheEntry{}
+	}
+	c.entries[kind][key] = cacheEntry{
+		value:   value,
+		expires: time.Now().Add(c.ttl),
+	}
+	return value, nil
+}
+
+func (c *cachingClient) VNICProfiles() VNICProfileClient {
+	return c
+}
+
+func (c *cachingClient) Clusters() ClusterClient {
+	return c
+}
+
+func (c *cachingClient) Templates() TemplateClient {
+	return c
+}
+
+func (c *cachingClient) Tags() TagClient {
+	return c
+}
@@ -0,0 +1,40 @@
+package ovirtclient
+
+func (c *cachingClient) ListClusters(retries ...RetryStrategy) ([]Cluster, error) {
+	result, err := c.cached(cacheKindCluster, cacheKeyList, func() (interface{}, error) {
+		return c.Client.ListClusters(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]Cluster{}, result.([]Cluster)...), nil
+}
+
+func (c *cachingClient) GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error) {
+	result, err := c.cached(cacheKindCluster, string(id), func() (interface{}, error) {
+		return c.Client.GetCluster(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Cluster), nil
+}
+
+func (c *cachingClient) UpgradeClusterCompatibility(
+	id ClusterID,
+	major uint,
+	minor uint,
+	retries ...RetryStrategy,
+) (ClusterUpgradeResult, error) {
+	defer c.invalidate(cacheKindCluster)
+	return c.Client.UpgradeClusterCompatibility(id, major, minor, retries...)
+}
+
+func (c *cachingClient) UpdateClusterMemoryPolicy(
+	id ClusterID,
+	params UpdateClusterMemoryPolicyParameters,
+	retries ...RetryStrategy,
+) (Cluster, error) {
+	defer c.invalidate(cacheKindCluster)
+	return c.Client.UpdateClusterMemoryPolicy(id, params, retries...)
+}
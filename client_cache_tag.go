@@ -0,0 +1,45 @@
+package ovirtclient
+
+func (c *cachingClient) ListTags(retries ...RetryStrategy) ([]Tag, error) {
+	result, err := c.cached(cacheKindTag, cacheKeyList, func() (interface{}, error) {
+		return c.Client.ListTags(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]Tag{}, result.([]Tag)...), nil
+}
+
+func (c *cachingClient) GetTag(id TagID, retries ...RetryStrategy) (Tag, error) {
+	result, err := c.cached(cacheKindTag, string(id), func() (interface{}, error) {
+		return c.Client.GetTag(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Tag), nil
+}
+
+func (c *cachingClient) GetTags(ids []TagID, retries ...RetryStrategy) ([]Tag, error) {
+	return getTags(c, ids, retries)
+}
+
+func (c *cachingClient) CreateTag(
+	name string,
+	description string,
+	parentID TagID,
+	retries ...RetryStrategy,
+) (Tag, error) {
+	defer c.invalidate(cacheKindTag)
+	return c.Client.CreateTag(name, description, parentID, retries...)
+}
+
+func (c *cachingClient) UpdateTag(id TagID, params UpdateTagParameters, retries ...RetryStrategy) (Tag, error) {
+	defer c.invalidate(cacheKindTag)
+	return c.Client.UpdateTag(id, params, retries...)
+}
+
+func (c *cachingClient) RemoveTag(tagID TagID, retries ...RetryStrategy) error {
+	defer c.invalidate(cacheKindTag)
+	return c.Client.RemoveTag(tagID, retries...)
+}
@@ -0,0 +1,94 @@
+package ovirtclient
+
+func (c *cachingClient) ListTemplates(retries ...RetryStrategy) ([]Template, error) {
+	result, err := c.cached(cacheKindTemplate, cacheKeyList, func() (interface{}, error) {
+		return c.Client.ListTemplates(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]Template{}, result.([]Template)...), nil
+}
+
+func (c *cachingClient) GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error) {
+	result, err := c.cached(cacheKindTemplate, string(id), func() (interface{}, error) {
+		return c.Client.GetTemplate(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Template), nil
+}
+
+func (c *cachingClient) GetBlankTemplate(retries ...RetryStrategy) (Template, error) {
+	result, err := c.cached(cacheKindBlankTemplate, cacheKeyList, func() (interface{}, error) {
+		return c.Client.GetBlankTemplate(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(Template), nil
+}
+
+func (c *cachingClient) CreateTemplate(
+	vmID VMID,
+	name string,
+	params OptionalTemplateCreateParameters,
+	retries ...RetryStrategy) (Template, error) {
+	defer c.invalidateTemplates()
+	return c.Client.CreateTemplate(vmID, name, params, retries...)
+}
+
+func (c *cachingClient) RemoveTemplate(templateID TemplateID, retries ...RetryStrategy) error {
+	defer c.invalidateTemplates()
+	return c.Client.RemoveTemplate(templateID, retries...)
+}
+
+func (c *cachingClient) CleanupTemplates(params CleanupParameters, retries ...RetryStrategy) ([]TemplateID, error) {
+	defer c.invalidateTemplates()
+	return c.Client.CleanupTemplates(params, retries...)
+}
+
+func (c *cachingClient) AddTagToTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) error {
+	defer c.invalidateTemplates()
+	return c.Client.AddTagToTemplate(id, tagID, retries...)
+}
+
+func (c *cachingClient) ImportTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) error {
+	defer c.invalidateTemplates()
+	return c.Client.ImportTemplateFromStorageDomain(storageDomainID, templateID, clusterID, params, retries...)
+}
+
+func (c *cachingClient) RegisterTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) error {
+	defer c.invalidateTemplates()
+	return c.Client.RegisterTemplateFromStorageDomain(storageDomainID, templateID, clusterID, retries...)
+}
+
+func (c *cachingClient) ImportGlanceImage(
+	providerID string,
+	imageID string,
+	storageDomainID string,
+	asTemplate bool,
+	retries ...RetryStrategy,
+) error {
+	if asTemplate {
+		defer c.invalidateTemplates()
+	}
+	return c.Client.ImportGlanceImage(providerID, imageID, storageDomainID, asTemplate, retries...)
+}
+
+func (c *cachingClient) invalidateTemplates() {
+	c.invalidate(cacheKindTemplate)
+	c.invalidate(cacheKindBlankTemplate)
+}
@@ -0,0 +1,315 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestCachingClientCachesClusters(t *testing.T) {
+	t.Parallel()
+	counter := &countingClient{Client: ovirtclient.NewMock()}
+	client := ovirtclient.NewCachingClient(counter, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListClusters(); err != nil {
+			t.Fatalf("failed to list clusters (%v)", err)
+		}
+	}
+	if counter.listClusters != 1 {
+		t.Fatalf("the clusters were fetched %d times instead of once", counter.listClusters)
+	}
+
+	client.InvalidateCache()
+	if _, err := client.ListClusters(); err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	if counter.listClusters != 2 {
+		t.Fatalf("the clusters were not fetched again after invalidating the cache")
+	}
+}
+
+func TestCachingClientExpiresEntries(t *testing.T) {
+	t.Parallel()
+	counter := &countingClient{Client: ovirtclient.NewMock()}
+	client := ovirtclient.NewCachingClient(counter, 10*time.Millisecond)
+
+	if _, err := client.ListClusters(); err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.ListClusters(); err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	if counter.listClusters != 2 {
+		t.Fatalf("the clusters were not fetched again after the TTL expired")
+	}
+}
+
+func TestCachingClientInvalidatesOnChange(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewCachingClient(ovirtclient.NewMock(), time.Hour)
+
+	tags, err := client.ListTags()
+	if err != nil {
+		t.Fatalf("failed to list tags (%v)", err)
+	}
+	tag, err := client.CreateTag("cache-test", "", "")
+	if err != nil {
+		t.Fatalf("failed to create tag (%v)", err)
+	}
+	newTags, err := client.ListTags()
+	if err != nil {
+		t.Fatalf("failed to list tags (%v)", err)
+	}
+	if len(newTags) != len(tags)+1 {
+		t.Fatalf("the cached tag list was not invalidated after creating a tag")
+	}
+
+	if _, err := client.GetTag(tag.ID()); err != nil {
+		t.Fatalf("failed to get tag (%v)", err)
+	}
+	if err := client.RemoveTag(tag.ID()); err != nil {
+		t.Fatalf("failed to remove tag (%v)", err)
+	}
+	if _, err := client.GetTag(tag.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("the cached tag was returned after removing it (%v)", err)
+	}
+}
+
+type countingClient struct {
+	ovirtclient.Client
+
+	listClusters int
+}
+
+func (c *countingClient) ListClusters(retries ...ovirtclient.RetryStrategy) ([]ovirtclient.Cluster, error) {
+	c.listClusters++
+	return c.Client.ListClusters(retries...)
+}
+
+func TestCachingClientInvalidatesOnTemplateCleanup(t *testing.T) {
+	t.Parallel()
+	mock := ovirtclient.NewMock()
+	client := ovirtclient.NewCachingClient(mock, time.Hour)
+	tpl := createCacheTestTemplate(t, mock, "cache-cleanup-1")
+
+	if _, err := client.GetTemplate(tpl.ID()); err != nil {
+		t.Fatalf("failed to get template (%v)", err)
+	}
+	assertCachedTemplateListed(t, client, tpl.ID(), true)
+	if _, err := client.CleanupTemplates(
+		ovirtclient.CleanupParams().MustWithNamePrefix("cache-cleanup-"),
+	); err != nil {
+		t.Fatalf("failed to clean up templates (%v)", err)
+	}
+	if _, err := client.GetTemplate(tpl.ID()); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("the cached template was returned after cleaning it up (%v)", err)
+	}
+	assertCachedTemplateListed(t, client, tpl.ID(), false)
+}
+
+func TestCachingClientInvalidatesOnTemplateImport(t *testing.T) {
+	t.Parallel()
+	mock := ovirtclient.NewMock()
+	client := ovirtclient.NewCachingClient(mock, time.Hour)
+	tpl := createCacheTestTemplate(t, mock, "cache-import")
+	exportStorageDomainID := exportCacheTestTemplate(t, mock, tpl)
+
+	assertCachedTemplateListed(t, client, tpl.ID(), false)
+	if err := client.ImportTemplateFromStorageDomain(
+		exportStorageDomainID,
+		tpl.ID(),
+		cacheTestClusterID(t, mock),
+		nil,
+	); err != nil {
+		t.Fatalf("failed to import template (%v)", err)
+	}
+	assertCachedTemplateListed(t, client, tpl.ID(), true)
+}
+
+func TestCachingClientInvalidatesOnTemplateRegistration(t *testing.T) {
+	t.Parallel()
+	mock := ovirtclient.NewMock()
+	// The mock only has unregistered templates on data storage domains when loaded from a saved state, so the
+	// registration is simulated by an import from the export storage domain.
+	client := ovirtclient.NewCachingClient(&registeringClient{Client: mock}, time.Hour)
+	tpl := createCacheTestTemplate(t, mock, "cache-register")
+	exportStorageDomainID := exportCacheTestTemplate(t, mock, tpl)
+
+	assertCachedTemplateListed(t, client, tpl.ID(), false)
+	if err := client.RegisterTemplateFromStorageDomain(
+		exportStorageDomainID,
+		tpl.ID(),
+		cacheTestClusterID(t, mock),
+	); err != nil {
+		t.Fatalf("failed to register template (%v)", err)
+	}
+	assertCachedTemplateListed(t, client, tpl.ID(), true)
+}
+
+func TestCachingClientInvalidatesOnGlanceImageImport(t *testing.T) {
+	t.Parallel()
+	mock := ovirtclient.NewMock()
+	client := ovirtclient.NewCachingClient(mock, time.Hour)
+	image := findGlanceImage(t, mock)
+
+	templates, err := client.ListTemplates()
+	if err != nil {
+		t.Fatalf("failed to list templates (%v)", err)
+	}
+	if err := client.ImportGlanceImage(
+		image.ProviderID(),
+		image.ID(),
+		cacheTestDataStorageDomainID(t, mock),
+		true,
+	); err != nil {
+		t.Fatalf("failed to import Glance image as template (%v)", err)
+	}
+	newTemplates, err := client.ListTemplates()
+	if err != nil {
+		t.Fatalf("failed to list templates (%v)", err)
+	}
+	if len(newTemplates) != len(templates)+1 {
+		t.Fatalf("the cached template list was not invalidated after importing a Glance image as template")
+	}
+}
+
+func TestCachingClientInvalidatesOnTemplateTag(t *testing.T) {
+	t.Parallel()
+	mock := ovirtclient.NewMock()
+	// The mock returns the template it stores, which would make the new tag visible through the cached object.
+	client := ovirtclient.NewCachingClient(&copyingClient{Client: mock}, time.Hour)
+	tpl := createCacheTestTemplate(t, mock, "cache-tag")
+	tag, err := mock.CreateTag("cache-template-tag", "", "")
+	if err != nil {
+		t.Fatalf("failed to create tag (%v)", err)
+	}
+
+	if _, err := client.GetTemplate(tpl.ID()); err != nil {
+		t.Fatalf("failed to get template (%v)", err)
+	}
+	if err := client.AddTagToTemplate(tpl.ID(), tag.ID()); err != nil {
+		t.Fatalf("failed to add tag to template (%v)", err)
+	}
+	cachedTemplate, err := client.GetTemplate(tpl.ID())
+	if err != nil {
+		t.Fatalf("failed to get template (%v)", err)
+	}
+	tagIDs := cachedTemplate.TagIDs()
+	if len(tagIDs) != 1 || tagIDs[0] != tag.ID() {
+		t.Fatalf("the cached template was not invalidated after adding a tag (tags: %v)", tagIDs)
+	}
+}
+
+// copyingClient returns copies of the templates, like a client talking to an engine does.
+type copyingClient struct {
+	ovirtclient.Client
+}
+
+func (c *copyingClient) GetTemplate(
+	id ovirtclient.TemplateID,
+	retries ...ovirtclient.RetryStrategy,
+) (ovirtclient.Template, error) {
+	tpl, err := c.Client.GetTemplate(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Clone(), nil
+}
+
+// registeringClient imports templates from an export storage domain when asked to register them.
+type registeringClient struct {
+	ovirtclient.Client
+}
+
+func (r *registeringClient) RegisterTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID ovirtclient.TemplateID,
+	clusterID ovirtclient.ClusterID,
+	retries ...ovirtclient.RetryStrategy,
+) error {
+	return r.Client.ImportTemplateFromStorageDomain(storageDomainID, templateID, clusterID, nil, retries...)
+}
+
+func createCacheTestTemplate(t *testing.T, client ovirtclient.Client, name string) ovirtclient.Template {
+	vm, err := client.CreateVM(cacheTestClusterID(t, client), ovirtclient.DefaultBlankTemplateID, name, nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	tpl, err := client.CreateTemplate(vm.ID(), name, nil)
+	if err != nil {
+		t.Fatalf("failed to create template (%v)", err)
+	}
+	return tpl
+}
+
+// exportCacheTestTemplate exports the template to the export storage domain and removes it from the engine. It
+// returns the ID of the export storage domain.
+func exportCacheTestTemplate(t *testing.T, client ovirtclient.Client, tpl ovirtclient.Template) string {
+	storageDomainID := cacheTestStorageDomainID(t, client, ovirtclient.StorageDomainFunctionExport)
+	if err := client.ExportTemplate(tpl.ID(), storageDomainID); err != nil {
+		t.Fatalf("failed to export template (%v)", err)
+	}
+	if err := client.RemoveTemplate(tpl.ID()); err != nil {
+		t.Fatalf("failed to remove template (%v)", err)
+	}
+	return storageDomainID
+}
+
+func cacheTestClusterID(t *testing.T, client ovirtclient.Client) ovirtclient.ClusterID {
+	clusters, err := client.ListClusters()
+	if err != nil || len(clusters) == 0 {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	return clusters[0].ID()
+}
+
+func cacheTestDataStorageDomainID(t *testing.T, client ovirtclient.Client) string {
+	return cacheTestStorageDomainID(t, client, ovirtclient.StorageDomainFunctionData)
+}
+
+// cacheTestStorageDomainID returns the ID of the first storage domain with the specified function that is attached to
+// a datacenter.
+func cacheTestStorageDomainID(
+	t *testing.T,
+	client ovirtclient.Client,
+	function ovirtclient.StorageDomainFunction,
+) string {
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	for _, storageDomain := range storageDomains {
+		if storageDomain.Function() == function && len(storageDomain.DatacenterIDs()) > 0 {
+			return storageDomain.ID()
+		}
+	}
+	t.Fatalf("no attached %s storage domain found", function)
+	return ""
+}
+
+func assertCachedTemplateListed(
+	t *testing.T,
+	client ovirtclient.Client,
+	templateID ovirtclient.TemplateID,
+	expected bool,
+) {
+	t.Helper()
+	templates, err := client.ListTemplates()
+	if err != nil {
+		t.Fatalf("failed to list templates (%v)", err)
+	}
+	for _, tpl := range templates {
+		if tpl.ID() == templateID {
+			if !expected {
+				t.Fatalf("the cached template list still contains template %s", templateID)
+			}
+			return
+		}
+	}
+	if expected {
+		t.Fatalf("the cached template list does not contain template %s", templateID)
+	}
+}
@@ -0,0 +1,45 @@
+package ovirtclient
+
+func (c *cachingClient) ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error) {
+	result, err := c.cached(cacheKindVNICProfile, cacheKeyList, func() (interface{}, error) {
+		return c.Client.ListVNICProfiles(retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]VNICProfile{}, result.([]VNICProfile)...), nil
+}
+
+func (c *cachingClient) GetVNICProfile(id string, retries ...RetryStrategy) (VNICProfile, error) {
+	result, err := c.cached(cacheKindVNICProfile, id, func() (interface{}, error) {
+		return c.Client.GetVNICProfile(id, retries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(VNICProfile), nil
+}
+
+func (c *cachingClient) CreateVNICProfile(
+	name string,
+	networkID string,
+	params OptionalVNICProfileParameters,
+	retries ...RetryStrategy,
+) (VNICProfile, error) {
+	defer c.invalidate(cacheKindVNICProfile)
+	return c.Client.CreateVNICProfile(name, networkID, params, retries...)
+}
+
+func (c *cachingClient) UpdateVNICProfile(
+	id string,
+	params UpdateVNICProfileParameters,
+	retries ...RetryStrategy,
+) (VNICProfile, error) {
+	defer c.invalidate(cacheKindVNICProfile)
+	return c.Client.UpdateVNICProfile(id, params, retries...)
+}
+
+func (c *cachingClient) RemoveVNICProfile(id string, retries ...RetryStrategy) error {
+	defer c.invalidate(cacheKindVNICProfile)
+	return c.Client.RemoveVNICProfile(id, retries...)
+}
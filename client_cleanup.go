@@ -0,0 +1,337 @@
+package ovirtclient
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// CleanupClient contains helpers to sweep resources left behind, for example by CI runs that were aborted before
+// they could clean up after themselves.
+type CleanupClient interface {
+	// CleanupVMs removes all VMs matching params. VMs that are not down are powered off first. It returns the IDs of
+	// the removed VMs. If some VMs could not be removed, the removed IDs are returned together with an error.
+	CleanupVMs(params CleanupParameters, retries ...RetryStrategy) ([]VMID, error)
+	// CleanupDisks removes all disks matching params. Only the name prefix is supported for disks, as disks cannot
+	// be tagged in oVirt and the engine does not report the creation time for them. Passing a tag or a minimum age
+	// results in an EBadArgument error. Disks that are still attached to a VM cannot be removed.
+	CleanupDisks(params CleanupParameters, retries ...RetryStrategy) ([]DiskID, error)
+	// CleanupTemplates removes all templates matching params, except the blank template.
+	CleanupTemplates(params CleanupParameters, retries ...RetryStrategy) ([]TemplateID, error)
+}
+
+// CleanupParameters select the resources removed by the CleanupClient functions. All parameters are used together as
+// an AND filter. Either a name prefix or a tag must be set to avoid removing all resources by accident.
+type CleanupParameters interface {
+	// NamePrefix returns the prefix the names of the removed resources must start with, or nil if the name should
+	// not be checked.
+	NamePrefix() *string
+	// TagID returns the ID of the tag the removed resources must have, or nil if tags should not be checked.
+	TagID() *TagID
+	// OlderThan returns the minimum age of the removed resources, or nil if the age should not be checked. Resources
+	// the engine reports no creation time for are never considered old enough.
+	OlderThan() *time.Duration
+}
+
+// BuildableCleanupParameters is a buildable version of CleanupParameters.
+type BuildableCleanupParameters interface {
+	CleanupParameters
+
+	// WithNamePrefix sets the prefix the names of the removed resources must start with. It must not be empty.
+	WithNamePrefix(prefix string) (BuildableCleanupParameters, error)
+	// MustWithNamePrefix is identical to WithNamePrefix, but panics instead of returning an error.
+	MustWithNamePrefix(prefix string) BuildableCleanupParameters
+	// WithTagID sets the tag the removed resources must have.
+	WithTagID(tagID TagID) (BuildableCleanupParameters, error)
+	// MustWithTagID is identical to WithTagID, but panics instead of returning an error.
+	MustWithTagID(tagID TagID) BuildableCleanupParameters
+	// WithOlderThan sets the minimum age of the removed resources. It must be positive.
+	WithOlderThan(age time.Duration) (BuildableCleanupParameters, error)
+	// MustWithOlderThan is identical to WithOlderThan, but panics instead of returning an error.
+	MustWithOlderThan(age time.Duration) BuildableCleanupParameters
+}
+
+// CleanupParams creates a buildable set of CleanupParameters for use with the CleanupClient functions.
+func CleanupParams() BuildableCleanupParameters {
+	return &cleanupParams{}
+}
+
+type cleanupParams struct {
+	namePrefix *string
+	tagID      *TagID
+	olderThan  *time.Duration
+}
+
+func (c *cleanupParams) NamePrefix() *string {
+	return c.namePrefix
+}
+
+func (c *cleanupParams) TagID() *TagID {
+	return c.tagID
+}
+
+func (c *cleanupParams) OlderThan() *time.Duration {
+	return c.olderThan
+}
+
+func (c *cleanupParams) WithNamePrefix(prefix string) (BuildableCleanupParameters, error) {
+	if prefix == "" {
+		return nil, newError(EBadArgument, "the name prefix for cleanup must not be empty")
+	}
+	c.namePrefix = &prefix
+	return c, nil
+}
+
+func (c *cleanupParams) MustWithNamePrefix(prefix string) BuildableCleanupParameters {
+	builder, err := c.WithNamePrefix(prefix)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cleanupParams) WithTagID(tagID TagID) (BuildableCleanupParameters, error) {
+	if tagID == "" {
+		return nil, newError(EBadArgument, "the tag ID for cleanup must not be empty")
+	}
+	c.tagID = &tagID
+	return c, nil
+}
+
+func (c *cleanupParams) MustWithTagID(tagID TagID) BuildableCleanupParameters {
+	builder, err := c.WithTagID(tagID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cleanupParams) WithOlderThan(age time.Duration) (BuildableCleanupParameters, error) {
+	if age <= 0 {
+		return nil, newError(EBadArgument, "the minimum age for cleanup must be positive")
+	}
+	c.olderThan = &age
+	return c, nil
+}
+
+func (c *cleanupParams) MustWithOlderThan(age time.Duration) BuildableCleanupParameters {
+	builder, err := c.WithOlderThan(age)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) CleanupVMs(params CleanupParameters, retries ...RetryStrategy) ([]VMID, error) {
+	return cleanupVMs(o, params, retries)
+}
+
+func (o *oVirtClient) CleanupDisks(params CleanupParameters, retries ...RetryStrategy) ([]DiskID, error) {
+	return cleanupDisks(o, params, retries)
+}
+
+func (o *oVirtClient) CleanupTemplates(params CleanupParameters, retries ...RetryStrategy) ([]TemplateID, error) {
+	return cleanupTemplates(o, params, retries)
+}
+
+// validateCleanupParameters checks that the params select at least some resources by name or tag, and that only
+// the filters supported for the resource kind are set.
+func validateCleanupParameters(params CleanupParameters, kind string, supportsTag bool, supportsAge bool) error {
+	if params == nil || (params.NamePrefix() == nil && params.TagID() == nil) {
+		return newError(EBadArgument, "a name prefix or a tag must be specified to clean up %ss", kind)
+	}
+	if params.TagID() != nil && !supportsTag {
+		return newError(EBadArgument, "cleaning up %ss by tag is not supported", kind)
+	}
+	if params.OlderThan() != nil && !supportsAge {
+		return newError(EBadArgument, "cleaning up %ss by age is not supported", kind)
+	}
+	return nil
+}
+
+// cleanupMatches returns true if a resource with the specified name, tags and creation time matches params.
+func cleanupMatches(params CleanupParameters, name string, tagIDs []TagID, creationTime time.Time) bool {
+	if prefix := params.NamePrefix(); prefix != nil && !strings.HasPrefix(name, *prefix) {
+		return false
+	}
+	if tagID := params.TagID(); tagID != nil {
+		found := false
+		for _, id := range tagIDs {
+			if id == *tagID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if olderThan := params.OlderThan(); olderThan != nil {
+		if creationTime.IsZero() || time.Since(creationTime) < *olderThan {
+			return false
+		}
+	}
+	return true
+}
+
+// cleanupVMs implements CleanupVMs. It is shared between the real and the mock client.
+func cleanupVMs(client Client, params CleanupParameters, retries []RetryStrategy) ([]VMID, error) {
+	if err := validateCleanupParameters(params, "VM", true, true); err != nil {
+		return nil, err
+	}
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list VMs for cleanup")
+	}
+	var ids []VMID
+	for _, vm := range vms {
+		if cleanupMatches(params, vm.Name(), vm.TagIDs(), vm.CreationTime()) {
+			ids = append(ids, vm.ID())
+		}
+	}
+	results := runBulk(ids, nil, func(id VMID) error {
+		vm, err := client.GetVM(id, retries...)
+		if err != nil {
+			return err
+		}
+		if vm.Status() != VMStatusDown {
+			if err := client.StopVM(id, true, retries...); err != nil {
+				return err
+			}
+			if _, err := client.WaitForVMStatus(id, VMStatusDown, retries...); err != nil {
+				return err
+			}
+		}
+		return client.RemoveVM(id, retries...)
+	})
+	removed := []VMID{}
+	failed := map[string]error{}
+	for id, err := range results {
+		if err != nil && !HasErrorCode(err, ENotFound) {
+			failed[string(id)] = err
+			continue
+		}
+		removed = append(removed, id)
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i] < removed[j] })
+	return removed, cleanupError("VM", len(ids), failed)
+}
+
+// cleanupDisks implements CleanupDisks. It is shared between the real and the mock client.
+func cleanupDisks(client Client, params CleanupParameters, retries []RetryStrategy) ([]DiskID, error) {
+	if err := validateCleanupParameters(params, "disk", false, false); err != nil {
+		return nil, err
+	}
+	disks, err := client.ListDisks(retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to list disks for cleanup")
+	}
+	removed := []DiskID{}
+	failed := map[string]error{}
+	total := 0
+	for _, disk := range disks {
+		if !cleanupMatches(params, disk.Alias(), nil, time.Time{}) {
+			continue
+		}
+		total++
+		if err := client.RemoveDisk(disk.ID(), retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			failed[string(disk.ID())] = err
+			continue
+		}
+		removed = append(removed, disk.ID())
+	}
+	return removed, cleanupError("disk", total, failed)
+}
+
+// cleanupTemplates implements CleanupTemplates. It is shared between the real and the mock client.
+func cleanupTemplates(client Client, params CleanupParameters, retries []RetryStrategy) ([]TemplateID, error) {
+	if err := validateCleanupParameters(params, "template", true, true); err != nil {
+		return nil, err
+	}
+	templates, err := listCleanupTemplates(client, params, retries)
+	if err != nil {
+		return nil, err
+	}
+	removed := []TemplateID{}
+	failed := map[string]error{}
+	total := 0
+	for _, tpl := range templates {
+		if tpl.ID() == DefaultBlankTemplateID {
+			continue
+		}
+		if !cleanupMatches(params, tpl.Name(), tpl.TagIDs(), tpl.CreationTime()) {
+			continue
+		}
+		total++
+		if err := client.RemoveTemplate(tpl.ID(), retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			failed[string(tpl.ID())] = err
+			continue
+		}
+		removed = append(removed, tpl.ID())
+	}
+	return removed, cleanupError("template", total, failed)
+}
+
+// listCleanupTemplates returns the templates that are candidates for cleanup. If a tag is set, the templates are
+// searched by the tag name, since the engine does not include the tags of templates when listing them.
+func listCleanupTemplates(client Client, params CleanupParameters, retries []RetryStrategy) ([]Template, error) {
+	tagID := params.TagID()
+	if tagID == nil {
+		templates, err := client.ListTemplates(retries...)
+		if err != nil {
+			return nil, wrap(err, EUnidentified, "failed to list templates for cleanup")
+		}
+		return templates, nil
+	}
+	tag, err := client.GetTag(*tagID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch tag %s for cleanup", *tagID)
+	}
+	templates, err := client.SearchTemplates(TemplateSearchParams().WithTag(tag.Name()), retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to search templates with tag %s for cleanup", tag.Name())
+	}
+	result := make([]Template, len(templates))
+	for i, tpl := range templates {
+		result[i] = taggedTemplate{Template: tpl, tagID: *tagID}
+	}
+	return result, nil
+}
+
+// taggedTemplate adds a tag to the tags reported by a template found by a tag search.
+type taggedTemplate struct {
+	Template
+	tagID TagID
+}
+
+func (t taggedTemplate) TagIDs() []TagID {
+	for _, id := range t.Template.TagIDs() {
+		if id == t.tagID {
+			return t.Template.TagIDs()
+		}
+	}
+	return append(append([]TagID{}, t.Template.TagIDs()...), t.tagID)
+}
+
+// cleanupError returns an error describing the resources that failed to be removed, or nil if all were removed.
+func cleanupError(kind string, total int, failed map[string]error) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(failed))
+	for id := range failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return wrap(
+		failed[ids[0]],
+		EUnidentified,
+		"failed to remove %d of %d %ss (%s), first error for %s %s",
+		len(failed),
+		total,
+		kind,
+		strings.Join(ids, ", "),
+		kind,
+		ids[0],
+	)
+}
@@ -0,0 +1,101 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestCleanupVMsByNamePrefix(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	prefix := fmt.Sprintf("test-%s-", helper.GenerateRandomID(5))
+
+	vm1 := assertCanCreateVM(t, helper, prefix+"1", nil)
+	vm2 := assertCanCreateVM(t, helper, prefix+"2", nil)
+	otherVM := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	removed, err := client.CleanupVMs(ovirtclient.CleanupParams().MustWithNamePrefix(prefix))
+	if err != nil {
+		t.Fatalf("failed to clean up VMs (%v)", err)
+	}
+	if len(removed) != 2 {
+		t.Fatalf("incorrect number of VMs removed (expected: 2, got: %d)", len(removed))
+	}
+	for _, id := range []ovirtclient.VMID{vm1.ID(), vm2.ID()} {
+		if _, err := client.GetVM(id); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("VM %s was not removed by the cleanup (%v)", id, err)
+		}
+	}
+	if _, err := client.GetVM(otherVM.ID()); err != nil {
+		t.Fatalf("the cleanup affected a VM not matching the prefix (%v)", err)
+	}
+}
+
+func TestCleanupVMsOlderThan(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	prefix := fmt.Sprintf("test-%s-", helper.GenerateRandomID(5))
+
+	vm := assertCanCreateVM(t, helper, prefix+"1", nil)
+
+	removed, err := client.CleanupVMs(
+		ovirtclient.CleanupParams().MustWithNamePrefix(prefix).MustWithOlderThan(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("failed to clean up VMs (%v)", err)
+	}
+	if len(removed) != 0 {
+		t.Fatalf("the cleanup removed VMs newer than the minimum age (%v)", removed)
+	}
+	if _, err := client.GetVM(vm.ID()); err != nil {
+		t.Fatalf("the cleanup removed a VM newer than the minimum age (%v)", err)
+	}
+}
+
+func TestCleanupRequiresFilter(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if _, err := client.CleanupVMs(ovirtclient.CleanupParams()); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("cleaning up VMs without a name prefix or tag did not return an EBadArgument error (%v)", err)
+	}
+	if _, err := client.CleanupDisks(
+		ovirtclient.CleanupParams().MustWithNamePrefix("test-").MustWithOlderThan(time.Hour),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("cleaning up disks by age did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestCleanupTemplatesByTag(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	taggedTemplate := assertCanCreateTemplate(t, helper, vm)
+	otherTemplate := assertCanCreateTemplate(t, helper, vm)
+	if err := client.AddTagToTemplate(taggedTemplate.ID(), tag.ID()); err != nil {
+		t.Fatalf("failed to add tag to template (%v)", err)
+	}
+
+	removed, err := client.CleanupTemplates(ovirtclient.CleanupParams().MustWithTagID(tag.ID()))
+	if err != nil {
+		t.Fatalf("failed to clean up templates (%v)", err)
+	}
+	if len(removed) != 1 || removed[0] != taggedTemplate.ID() {
+		t.Fatalf("incorrect templates removed (expected: %s, got: %v)", taggedTemplate.ID(), removed)
+	}
+	if _, err := client.GetTemplate(otherTemplate.ID()); err != nil {
+		t.Fatalf("the cleanup affected a template without the tag (%v)", err)
+	}
+}
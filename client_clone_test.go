@@ -0,0 +1,58 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMClone(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().
+			MustWithHugePages(ovirtclient.VMHugePages2M).
+			MustWithCPUParameters(2, 1, 1),
+	)
+
+	clone := vm.Clone()
+	if clone.ID() != vm.ID() || clone.Name() != vm.Name() || clone.ClusterID() != vm.ClusterID() {
+		t.Fatalf("the cloned VM does not match the original")
+	}
+	if clone.HugePages() == nil || *clone.HugePages() != *vm.HugePages() {
+		t.Fatalf("the cloned VM has incorrect hugepages settings")
+	}
+	if clone.HugePages() == vm.HugePages() {
+		t.Fatalf("the cloned VM shares the hugepages settings with the original")
+	}
+	if clone.CPU().Topo().Cores() != vm.CPU().Topo().Cores() {
+		t.Fatalf("the cloned VM has an incorrect CPU topology")
+	}
+	if clone.CPU().Topo() == vm.CPU().Topo() {
+		t.Fatalf("the cloned VM shares the CPU topology with the original")
+	}
+}
+
+func TestDiskClone(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	clone := disk.Clone()
+	if clone.ID() != disk.ID() || clone.Alias() != disk.Alias() || clone.TotalSize() != disk.TotalSize() {
+		t.Fatalf("the cloned disk does not match the original")
+	}
+	storageDomainIDs := clone.StorageDomainIDs()
+	if len(storageDomainIDs) != len(disk.StorageDomainIDs()) {
+		t.Fatalf("the cloned disk has incorrect storage domain IDs")
+	}
+	storageDomainIDs[0] = "changed"
+	if disk.StorageDomainIDs()[0] == "changed" {
+		t.Fatalf("the cloned disk shares the storage domain IDs with the original")
+	}
+}
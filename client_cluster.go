@@ -1,10 +1,17 @@
 package ovirtclient
 
 import (
+	"fmt"
+	"strings"
+
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
-//go:generate go run scripts/rest.go -i "Cluster" -n "cluster"
+//go:generate go run scripts/rest.go -i "Cluster" -n "cluster" -T "ClusterID"
+
+// ClusterID is an identifier for a cluster. It has a special type so the compiler can catch errors when the cluster
+// ID is erroneously passed elsewhere.
+type ClusterID string
 
 // ClusterClient is a part of the Client that deals with clusters in the oVirt Engine. A cluster is a logical grouping
 // of hosts that share the same storage domains and have the same type of CPU (either Intel or AMD). If the hosts have
@@ -15,15 +22,324 @@ type ClusterClient interface {
 	// ListClusters returns a list of all clusters in the oVirt engine.
 	ListClusters(retries ...RetryStrategy) ([]Cluster, error)
 	// GetCluster returns a specific cluster based on the cluster ID. An error is returned if the cluster doesn't exist.
-	GetCluster(id string, retries ...RetryStrategy) (Cluster, error)
+	GetCluster(id ClusterID, retries ...RetryStrategy) (Cluster, error)
+	// UpgradeClusterCompatibility raises the compatibility version of the cluster to the specified major and minor
+	// version. Running VMs keep the previous compatibility version until they are restarted, so the engine marks
+	// them as having a pending next run configuration. These VMs are returned in the result. Lowering the
+	// compatibility version is not supported and results in an EBadArgument error.
+	UpgradeClusterCompatibility(
+		id ClusterID,
+		major uint,
+		minor uint,
+		retries ...RetryStrategy,
+	) (ClusterUpgradeResult, error)
+	// UpdateClusterMemoryPolicy changes the memory overcommit, ballooning and KSM settings of the cluster. Use
+	// UpdateClusterMemoryPolicyParams to obtain a builder for the parameters.
+	UpdateClusterMemoryPolicy(
+		id ClusterID,
+		params UpdateClusterMemoryPolicyParameters,
+		retries ...RetryStrategy,
+	) (Cluster, error)
 }
 
 // Cluster represents a cluster returned from a ListClusters or GetCluster call.
 type Cluster interface {
 	// ID returns the UUID of the cluster.
-	ID() string
+	ID() ClusterID
 	// Name returns the textual name of the cluster.
 	Name() string
+	// CPUArchitecture returns the CPU architecture of the hosts in the cluster. It returns an empty string if the
+	// cluster has no CPU type set.
+	CPUArchitecture() CPUArchitecture
+	// CPUType returns the CPU type of the cluster, for example "Intel Cascadelake Server Family". All hosts in the
+	// cluster must support this CPU type. It returns an empty string if the cluster has no CPU type set.
+	CPUType() string
+	// CompatibilityVersion returns the compatibility version of the cluster, which determines the features
+	// available to the VMs in the cluster.
+	CompatibilityVersion() ClusterVersion
+	// BIOSType returns the default BIOS type of the VMs in the cluster. VMs created with BIOSTypeClusterDefault use
+	// this BIOS type. It returns an empty string if the engine does not report a BIOS type.
+	BIOSType() BIOSType
+	// MemoryOverCommitPercent returns the percentage of the physical memory of the hosts that the scheduler allows
+	// to be allocated to VMs. A value of 100 means no overcommit, 150 allows allocating 1.5 times the physical memory.
+	MemoryOverCommitPercent() uint
+	// BallooningEnabled returns true if memory ballooning is enabled for the VMs in the cluster, allowing the
+	// engine to reclaim unused memory from VMs with a memory balloon device.
+	BallooningEnabled() bool
+	// KSMEnabled returns true if Kernel Samepage Merging is enabled on the hosts in the cluster.
+	KSMEnabled() bool
+	// KSMMergeAcrossNodes returns true if KSM merges identical memory pages across NUMA nodes. It returns false if
+	// KSM only merges pages within the same NUMA node.
+	KSMMergeAcrossNodes() bool
+
+	// UpgradeCompatibility raises the compatibility version of the cluster. See
+	// ClusterClient.UpgradeClusterCompatibility for details.
+	UpgradeCompatibility(major uint, minor uint, retries ...RetryStrategy) (ClusterUpgradeResult, error)
+	// UpdateMemoryPolicy changes the memory settings of the cluster. See ClusterClient.UpdateClusterMemoryPolicy
+	// for details.
+	UpdateMemoryPolicy(params UpdateClusterMemoryPolicyParameters, retries ...RetryStrategy) (Cluster, error)
+}
+
+// UpdateClusterMemoryPolicyParameters describes the changes to the memory settings of a cluster. All parameters are
+// optional, nil values leave the corresponding setting unchanged.
+type UpdateClusterMemoryPolicyParameters interface {
+	// OverCommitPercent returns the new memory overcommit percentage, or nil to leave it unchanged.
+	OverCommitPercent() *uint
+	// Ballooning returns the new memory ballooning setting, or nil to leave it unchanged.
+	Ballooning() *bool
+	// KSM returns the new Kernel Samepage Merging setting, or nil to leave it unchanged.
+	KSM() *bool
+	// KSMMergeAcrossNodes returns the new KSM NUMA node merging setting, or nil to leave it unchanged.
+	KSMMergeAcrossNodes() *bool
+}
+
+// BuildableUpdateClusterMemoryPolicyParameters is a buildable version of UpdateClusterMemoryPolicyParameters.
+type BuildableUpdateClusterMemoryPolicyParameters interface {
+	UpdateClusterMemoryPolicyParameters
+
+	// WithOverCommitPercent sets the memory overcommit percentage. It returns an error if the percentage is below
+	// 100, since the engine does not allow reserving physical memory this way.
+	WithOverCommitPercent(percent uint) (BuildableUpdateClusterMemoryPolicyParameters, error)
+	// MustWithOverCommitPercent is identical to WithOverCommitPercent, but panics instead of returning an error.
+	MustWithOverCommitPercent(percent uint) BuildableUpdateClusterMemoryPolicyParameters
+	// WithBallooning enables or disables memory ballooning.
+	WithBallooning(enabled bool) (BuildableUpdateClusterMemoryPolicyParameters, error)
+	// MustWithBallooning is identical to WithBallooning, but panics instead of returning an error.
+	MustWithBallooning(enabled bool) BuildableUpdateClusterMemoryPolicyParameters
+	// WithKSM enables or disables Kernel Samepage Merging.
+	WithKSM(enabled bool) (BuildableUpdateClusterMemoryPolicyParameters, error)
+	// MustWithKSM is identical to WithKSM, but panics instead of returning an error.
+	MustWithKSM(enabled bool) BuildableUpdateClusterMemoryPolicyParameters
+	// WithKSMMergeAcrossNodes sets if KSM merges memory pages across NUMA nodes.
+	WithKSMMergeAcrossNodes(merge bool) (BuildableUpdateClusterMemoryPolicyParameters, error)
+	// MustWithKSMMergeAcrossNodes is identical to WithKSMMergeAcrossNodes, but panics instead of returning an error.
+	MustWithKSMMergeAcrossNodes(merge bool) BuildableUpdateClusterMemoryPolicyParameters
+}
+
+// UpdateClusterMemoryPolicyParams creates a builder for the parameters of UpdateClusterMemoryPolicy.
+func UpdateClusterMemoryPolicyParams() BuildableUpdateClusterMemoryPolicyParameters {
+	return &updateClusterMemoryPolicyParams{}
+}
+
+type updateClusterMemoryPolicyParams struct {
+	overCommitPercent   *uint
+	ballooning          *bool
+	ksm                 *bool
+	ksmMergeAcrossNodes *bool
+}
+
+func (u *updateClusterMemoryPolicyParams) OverCommitPercent() *uint {
+	return u.overCommitPercent
+}
+
+func (u *updateClusterMemoryPolicyParams) Ballooning() *bool {
+	return u.ballooning
+}
+
+func (u *updateClusterMemoryPolicyParams) KSM() *bool {
+	return u.ksm
+}
+
+func (u *updateClusterMemoryPolicyParams) KSMMergeAcrossNodes() *bool {
+	return u.ksmMergeAcrossNodes
+}
+
+func (u *updateClusterMemoryPolicyParams) WithOverCommitPercent(percent uint) (
+	BuildableUpdateClusterMemoryPolicyParameters,
+	error,
+) {
+	if percent < 100 {
+		return nil, newError(EBadArgument, "memory overcommit percentage must be at least 100 (%d given)", percent)
+	}
+	u.overCommitPercent = &percent
+	return u, nil
+}
+
+func (u *updateClusterMemoryPolicyParams) MustWithOverCommitPercent(
+	percent uint,
+) BuildableUpdateClusterMemoryPolicyParameters {
+	builder, err := u.WithOverCommitPercent(percent)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterMemoryPolicyParams) WithBallooning(enabled bool) (
+	BuildableUpdateClusterMemoryPolicyParameters,
+	error,
+) {
+	u.ballooning = &enabled
+	return u, nil
+}
+
+func (u *updateClusterMemoryPolicyParams) MustWithBallooning(
+	enabled bool,
+) BuildableUpdateClusterMemoryPolicyParameters {
+	builder, err := u.WithBallooning(enabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterMemoryPolicyParams) WithKSM(enabled bool) (BuildableUpdateClusterMemoryPolicyParameters, error) {
+	u.ksm = &enabled
+	return u, nil
+}
+
+func (u *updateClusterMemoryPolicyParams) MustWithKSM(enabled bool) BuildableUpdateClusterMemoryPolicyParameters {
+	builder, err := u.WithKSM(enabled)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateClusterMemoryPolicyParams) WithKSMMergeAcrossNodes(merge bool) (
+	BuildableUpdateClusterMemoryPolicyParameters,
+	error,
+) {
+	u.ksmMergeAcrossNodes = &merge
+	return u, nil
+}
+
+func (u *updateClusterMemoryPolicyParams) MustWithKSMMergeAcrossNodes(
+	merge bool,
+) BuildableUpdateClusterMemoryPolicyParameters {
+	builder, err := u.WithKSMMergeAcrossNodes(merge)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// ClusterUpgradeResult is the outcome of a cluster compatibility version upgrade.
+type ClusterUpgradeResult interface {
+	// Cluster returns the cluster after the upgrade.
+	Cluster() Cluster
+	// PreviousVersion returns the compatibility version of the cluster before the upgrade.
+	PreviousVersion() ClusterVersion
+	// VMsPendingRestart returns the VMs in the cluster that have pending next run configuration changes. These VMs
+	// must be restarted to use the new compatibility version.
+	VMsPendingRestart() []VM
+}
+
+// ClusterVersion is the compatibility version of a cluster, for example 4.6.
+type ClusterVersion interface {
+	// Major returns the major version number, for example 4 in 4.6.
+	Major() uint
+	// Minor returns the minor version number, for example 6 in 4.6.
+	Minor() uint
+	// AtLeast returns true if the version is equal to or newer than the specified major and minor version number.
+	AtLeast(major uint, minor uint) bool
+	// String returns the version in the major.minor format.
+	String() string
+}
+
+// CPUArchitecture is the CPU architecture of a cluster.
+type CPUArchitecture string
+
+const (
+	// CPUArchitectureX86_64 is the 64 bit x86 architecture of Intel and AMD CPUs.
+	CPUArchitectureX86_64 CPUArchitecture = "x86_64"
+	// CPUArchitecturePPC64 is the 64 bit POWER architecture.
+	CPUArchitecturePPC64 CPUArchitecture = "ppc64"
+	// CPUArchitectureS390X is the 64 bit IBM Z architecture.
+	CPUArchitectureS390X CPUArchitecture = "s390x"
+	// CPUArchitectureUndefined indicates that the architecture is not set.
+	CPUArchitectureUndefined CPUArchitecture = "undefined"
+)
+
+// Validate returns an error if the CPU architecture is not known.
+func (c CPUArchitecture) Validate() error {
+	for _, architecture := range CPUArchitectureValues() {
+		if architecture == c {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid CPU architecture: %s must be one of: %s",
+		c,
+		strings.Join(CPUArchitectureValues().Strings(), ", "),
+	)
+}
+
+// CPUArchitectureList is a list of CPUArchitecture values.
+type CPUArchitectureList []CPUArchitecture
+
+// Strings creates a string list of the values.
+func (l CPUArchitectureList) Strings() []string {
+	result := make([]string, len(l))
+	for i, architecture := range l {
+		result[i] = string(architecture)
+	}
+	return result
+}
+
+// CPUArchitectureValues returns all possible CPUArchitecture values.
+func CPUArchitectureValues() CPUArchitectureList {
+	return []CPUArchitecture{
+		CPUArchitectureX86_64,
+		CPUArchitecturePPC64,
+		CPUArchitectureS390X,
+		CPUArchitectureUndefined,
+	}
+}
+
+// BIOSType is the BIOS type and chipset emulated for a VM.
+type BIOSType string
+
+const (
+	// BIOSTypeClusterDefault uses the default BIOS type of the cluster. This is only valid for VMs.
+	BIOSTypeClusterDefault BIOSType = "cluster_default"
+	// BIOSTypeI440FXSeaBIOS is the legacy i440FX chipset with SeaBIOS.
+	BIOSTypeI440FXSeaBIOS BIOSType = "i440fx_sea_bios"
+	// BIOSTypeQ35SeaBIOS is the Q35 chipset with SeaBIOS.
+	BIOSTypeQ35SeaBIOS BIOSType = "q35_sea_bios"
+	// BIOSTypeQ35OVMF is the Q35 chipset with UEFI firmware.
+	BIOSTypeQ35OVMF BIOSType = "q35_ovmf"
+	// BIOSTypeQ35SecureBoot is the Q35 chipset with UEFI firmware and secure boot enabled.
+	BIOSTypeQ35SecureBoot BIOSType = "q35_secure_boot"
+)
+
+// Validate returns an error if the BIOS type is not known.
+func (b BIOSType) Validate() error {
+	for _, biosType := range BIOSTypeValues() {
+		if biosType == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid BIOS type: %s must be one of: %s",
+		b,
+		strings.Join(BIOSTypeValues().Strings(), ", "),
+	)
+}
+
+// BIOSTypeList is a list of BIOSType values.
+type BIOSTypeList []BIOSType
+
+// Strings creates a string list of the values.
+func (l BIOSTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, biosType := range l {
+		result[i] = string(biosType)
+	}
+	return result
+}
+
+// BIOSTypeValues returns all possible BIOSType values.
+func BIOSTypeValues() BIOSTypeList {
+	return []BIOSType{
+		BIOSTypeClusterDefault,
+		BIOSTypeI440FXSeaBIOS,
+		BIOSTypeQ35SeaBIOS,
+		BIOSTypeQ35OVMF,
+		BIOSTypeQ35SecureBoot,
+	}
 }
 
 func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, error) {
@@ -36,24 +352,160 @@ func convertSDKCluster(sdkCluster *ovirtsdk4.Cluster, client Client) (Cluster, e
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch name for cluster %s", id)
 	}
-	return &cluster{
-		client: client,
-		id:     id,
-		name:   name,
-	}, nil
+	sdkVersion, ok := sdkCluster.Version()
+	if !ok {
+		return nil, newError(EFieldMissing, "failed to fetch compatibility version for cluster %s", id)
+	}
+	major, ok := sdkVersion.Major()
+	if !ok {
+		return nil, newError(EFieldMissing, "failed to fetch major compatibility version for cluster %s", id)
+	}
+	minor, ok := sdkVersion.Minor()
+	if !ok {
+		return nil, newError(EFieldMissing, "failed to fetch minor compatibility version for cluster %s", id)
+	}
+	result := &cluster{
+		client:               client,
+		id:                   ClusterID(id),
+		name:                 name,
+		compatibilityVersion: &clusterVersion{major: uint(major), minor: uint(minor)},
+	}
+	// The CPU is not set on clusters without hosts, and the BIOS type is only reported by newer engines.
+	if sdkCPU, ok := sdkCluster.Cpu(); ok {
+		architecture, _ := sdkCPU.Architecture()
+		result.cpuArchitecture = CPUArchitecture(architecture)
+		result.cpuType, _ = sdkCPU.Type()
+	}
+	if biosType, ok := sdkCluster.BiosType(); ok {
+		result.biosType = BIOSType(biosType)
+	}
+	convertSDKClusterMemoryPolicy(sdkCluster, result)
+	return result, nil
+}
+
+// convertSDKClusterMemoryPolicy reads the memory overcommit, ballooning and KSM settings. Settings the engine does not
+// report keep the engine defaults.
+func convertSDKClusterMemoryPolicy(sdkCluster *ovirtsdk4.Cluster, result *cluster) {
+	result.memoryOverCommitPercent = 100
+	if memoryPolicy, ok := sdkCluster.MemoryPolicy(); ok {
+		if overCommit, ok := memoryPolicy.OverCommit(); ok {
+			if percent, ok := overCommit.Percent(); ok {
+				result.memoryOverCommitPercent = uint(percent)
+			}
+		}
+	}
+	result.ballooningEnabled, _ = sdkCluster.BallooningEnabled()
+	if ksm, ok := sdkCluster.Ksm(); ok {
+		result.ksmEnabled, _ = ksm.Enabled()
+		result.ksmMergeAcrossNodes, _ = ksm.MergeAcrossNodes()
+	}
 }
 
 type cluster struct {
 	client Client
 
-	id   string
-	name string
+	id                   ClusterID
+	name                 string
+	cpuArchitecture      CPUArchitecture
+	cpuType              string
+	compatibilityVersion *clusterVersion
+	biosType             BIOSType
+
+	memoryOverCommitPercent uint
+	ballooningEnabled       bool
+	ksmEnabled              bool
+	ksmMergeAcrossNodes     bool
 }
 
-func (c cluster) ID() string {
+func (c cluster) ID() ClusterID {
 	return c.id
 }
 
 func (c cluster) Name() string {
 	return c.name
 }
+
+func (c cluster) CPUArchitecture() CPUArchitecture {
+	return c.cpuArchitecture
+}
+
+func (c cluster) CPUType() string {
+	return c.cpuType
+}
+
+func (c cluster) CompatibilityVersion() ClusterVersion {
+	return c.compatibilityVersion
+}
+
+func (c cluster) BIOSType() BIOSType {
+	return c.biosType
+}
+
+func (c cluster) MemoryOverCommitPercent() uint {
+	return c.memoryOverCommitPercent
+}
+
+func (c cluster) BallooningEnabled() bool {
+	return c.ballooningEnabled
+}
+
+func (c cluster) KSMEnabled() bool {
+	return c.ksmEnabled
+}
+
+func (c cluster) KSMMergeAcrossNodes() bool {
+	return c.ksmMergeAcrossNodes
+}
+
+func (c cluster) UpdateMemoryPolicy(
+	params UpdateClusterMemoryPolicyParameters,
+	retries ...RetryStrategy,
+) (Cluster, error) {
+	return c.client.UpdateClusterMemoryPolicy(c.id, params, retries...)
+}
+
+func (c cluster) UpgradeCompatibility(major uint, minor uint, retries ...RetryStrategy) (ClusterUpgradeResult, error) {
+	return c.client.UpgradeClusterCompatibility(c.id, major, minor, retries...)
+}
+
+type clusterVersion struct {
+	major uint
+	minor uint
+}
+
+func (v *clusterVersion) Major() uint {
+	return v.major
+}
+
+func (v *clusterVersion) Minor() uint {
+	return v.minor
+}
+
+func (v *clusterVersion) AtLeast(major uint, minor uint) bool {
+	if v.major != major {
+		return v.major > major
+	}
+	return v.minor >= minor
+}
+
+func (v *clusterVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+type clusterUpgradeResult struct {
+	cluster           Cluster
+	previousVersion   ClusterVersion
+	vmsPendingRestart []VM
+}
+
+func (c clusterUpgradeResult) Cluster() Cluster {
+	return c.cluster
+}
+
+func (c clusterUpgradeResult) PreviousVersion() ClusterVersion {
+	return c.previousVersion
+}
+
+func (c clusterUpgradeResult) VMsPendingRestart() []VM {
+	return c.vmsPendingRestart
+}
@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListClusters(retries ...RetryStrategy) (result []Cluster, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Cluster{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeCluster, ""),
 		"listing clusters",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().ClustersService().List().Send()
+			response, e := o.connection().SystemService().ClustersService().List().Send()
 			if e != nil {
 				return e
 			}
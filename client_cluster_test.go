@@ -0,0 +1,140 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestClusterCompatibilitySettings(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	cluster, err := client.GetCluster(helper.GetClusterID())
+	if err != nil {
+		t.Fatalf("failed to get cluster %s (%v)", helper.GetClusterID(), err)
+	}
+	version := cluster.CompatibilityVersion()
+	if !version.AtLeast(4, 0) {
+		t.Fatalf("unexpected compatibility version of cluster %s (%s)", cluster.ID(), version)
+	}
+	if version.String() != fmt.Sprintf("%d.%d", version.Major(), version.Minor()) {
+		t.Fatalf("incorrect compatibility version string (%s)", version.String())
+	}
+	if architecture := cluster.CPUArchitecture(); architecture != "" {
+		if err := architecture.Validate(); err != nil {
+			t.Fatalf("invalid CPU architecture on cluster %s (%v)", cluster.ID(), err)
+		}
+		if cluster.CPUType() == "" {
+			t.Fatalf("cluster %s has a CPU architecture, but no CPU type", cluster.ID())
+		}
+	}
+	if biosType := cluster.BIOSType(); biosType != "" {
+		if err := biosType.Validate(); err != nil {
+			t.Fatalf("invalid BIOS type on cluster %s (%v)", cluster.ID(), err)
+		}
+	}
+}
+
+// TestClusterCompatibilityUpgrade runs against the mock only since the compatibility version of a cluster cannot be
+// lowered again after the test.
+func TestClusterCompatibilityUpgrade(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	cluster := clusters[0]
+	runningVM := createClusterUpgradeTestVM(t, client, cluster.ID(), "running")
+	stoppedVM := createClusterUpgradeTestVM(t, client, cluster.ID(), "stopped")
+	if err := runningVM.Start(); err != nil {
+		t.Fatalf("failed to start VM %s (%v)", runningVM.ID(), err)
+	}
+
+	version := cluster.CompatibilityVersion()
+	result, err := cluster.UpgradeCompatibility(version.Major(), version.Minor()+1)
+	if err != nil {
+		t.Fatalf("failed to upgrade cluster %s (%v)", cluster.ID(), err)
+	}
+	if result.PreviousVersion().String() != version.String() {
+		t.Fatalf("incorrect previous version (%s != %s)", result.PreviousVersion(), version)
+	}
+	if !result.Cluster().CompatibilityVersion().AtLeast(version.Major(), version.Minor()+1) {
+		t.Fatalf("cluster %s was not upgraded (%s)", cluster.ID(), result.Cluster().CompatibilityVersion())
+	}
+	pending := result.VMsPendingRestart()
+	if len(pending) != 1 || pending[0].ID() != runningVM.ID() {
+		t.Fatalf("incorrect VMs pending restart after upgrade (%d VMs)", len(pending))
+	}
+	fetchedVM, err := client.GetVM(stoppedVM.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch VM %s (%v)", stoppedVM.ID(), err)
+	}
+	if fetchedVM.NextRunConfigurationExists() {
+		t.Fatalf("stopped VM %s has a pending next run configuration after upgrade", stoppedVM.ID())
+	}
+
+	_, err = client.UpgradeClusterCompatibility(cluster.ID(), version.Major(), version.Minor())
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("lowering the compatibility version of cluster %s did not fail (%v)", cluster.ID(), err)
+	}
+}
+
+func createClusterUpgradeTestVM(
+	t *testing.T,
+	client ovirtclient.Client,
+	clusterID ovirtclient.ClusterID,
+	name string,
+) ovirtclient.VM {
+	vm, err := client.CreateVM(clusterID, ovirtclient.DefaultBlankTemplateID, name, nil)
+	if err != nil {
+		t.Fatalf("failed to create VM %s (%v)", name, err)
+	}
+	return vm
+}
+
+// TestClusterMemoryPolicyUpdate runs against the mock only since it changes the capacity settings of the cluster.
+func TestClusterMemoryPolicyUpdate(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	cluster := clusters[0]
+	if cluster.MemoryOverCommitPercent() != 100 || !cluster.BallooningEnabled() || !cluster.KSMEnabled() {
+		t.Fatalf("unexpected default memory policy on cluster %s", cluster.ID())
+	}
+
+	if _, err := ovirtclient.UpdateClusterMemoryPolicyParams().WithOverCommitPercent(50); err == nil {
+		t.Fatalf("setting an overcommit percentage below 100 did not fail")
+	}
+	updatedCluster, err := cluster.UpdateMemoryPolicy(
+		ovirtclient.UpdateClusterMemoryPolicyParams().
+			MustWithOverCommitPercent(150).
+			MustWithBallooning(false).
+			MustWithKSMMergeAcrossNodes(false),
+	)
+	if err != nil {
+		t.Fatalf("failed to update memory policy of cluster %s (%v)", cluster.ID(), err)
+	}
+	if updatedCluster.MemoryOverCommitPercent() != 150 {
+		t.Fatalf("incorrect overcommit percentage after update (%d)", updatedCluster.MemoryOverCommitPercent())
+	}
+	if updatedCluster.BallooningEnabled() || updatedCluster.KSMMergeAcrossNodes() {
+		t.Fatalf("ballooning or KSM merging across nodes still enabled after update")
+	}
+	if !updatedCluster.KSMEnabled() {
+		t.Fatalf("KSM was disabled even though it was not part of the update")
+	}
+	fetchedCluster, err := client.GetCluster(cluster.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch cluster %s (%v)", cluster.ID(), err)
+	}
+	if fetchedCluster.MemoryOverCommitPercent() != 150 {
+		t.Fatalf("memory policy update was not stored (%d)", fetchedCluster.MemoryOverCommitPercent())
+	}
+}
@@ -0,0 +1,66 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateClusterMemoryPolicy(
+	id ClusterID,
+	params UpdateClusterMemoryPolicyParameters,
+	retries ...RetryStrategy,
+) (result Cluster, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	clusterBuilder := ovirtsdk.NewClusterBuilder()
+	if percent := params.OverCommitPercent(); percent != nil {
+		clusterBuilder.MemoryPolicy(
+			ovirtsdk.NewMemoryPolicyBuilder().
+				OverCommit(ovirtsdk.NewMemoryOverCommitBuilder().Percent(int64(*percent)).MustBuild()).
+				MustBuild(),
+		)
+	}
+	if ballooning := params.Ballooning(); ballooning != nil {
+		clusterBuilder.BallooningEnabled(*ballooning)
+	}
+	if params.KSM() != nil || params.KSMMergeAcrossNodes() != nil {
+		ksmBuilder := ovirtsdk.NewKsmBuilder()
+		if ksm := params.KSM(); ksm != nil {
+			ksmBuilder.Enabled(*ksm)
+		}
+		if merge := params.KSMMergeAcrossNodes(); merge != nil {
+			ksmBuilder.MergeAcrossNodes(*merge)
+		}
+		clusterBuilder.Ksm(ksmBuilder.MustBuild())
+	}
+	err = o.mutate(
+		resourceOf(ResourceTypeCluster, string(id)),
+		fmt.Sprintf("updating memory policy of cluster %s", id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				ClustersService().
+				ClusterService(string(id)).
+				Update().
+				Cluster(clusterBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkCluster, ok := response.Cluster()
+			if !ok {
+				return newError(EFieldMissing, "missing cluster in update response")
+			}
+			result, e = convertSDKCluster(sdkCluster, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert cluster %s", id)
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,113 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpgradeClusterCompatibility(
+	id ClusterID,
+	major uint,
+	minor uint,
+	retries ...RetryStrategy,
+) (result ClusterUpgradeResult, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	originalCluster, err := o.GetCluster(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	previousVersion := originalCluster.CompatibilityVersion()
+	if err := validateClusterUpgrade(originalCluster, major, minor); err != nil {
+		return nil, err
+	}
+	upgradedCluster := originalCluster
+	if previousVersion.Major() != major || previousVersion.Minor() != minor {
+		correlationID := o.correlationIDFor(retries)
+		err = o.mutate(
+			resourceOf(ResourceTypeCluster, string(id)).with("major", major).with("minor", minor),
+			fmt.Sprintf("upgrading cluster %s to compatibility version %d.%d", id, major, minor),
+			retries,
+			func() error {
+				req := o.connection().SystemService().
+					ClustersService().
+					ClusterService(string(id)).
+					Update().
+					Cluster(
+						ovirtsdk.NewClusterBuilder().
+							Version(ovirtsdk.NewVersionBuilder().Major(int64(major)).Minor(int64(minor)).MustBuild()).
+							MustBuild(),
+					)
+				if correlationID != "" {
+					req.Query("correlation_id", correlationID)
+				}
+				response, e := req.Send()
+				if e != nil {
+					return e
+				}
+				sdkCluster, ok := response.Cluster()
+				if !ok {
+					return newError(ENotFound, "no cluster returned when upgrading cluster ID %s", id)
+				}
+				upgradedCluster, e = convertSDKCluster(sdkCluster, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert cluster %s", id)
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, withCorrelationID(err, correlationID)
+		}
+	}
+	return newClusterUpgradeResult(o, o.logger, upgradedCluster, previousVersion, retries)
+}
+
+// validateClusterUpgrade checks if the cluster can be upgraded to the specified compatibility version. It is shared
+// between the real and the mock client.
+func validateClusterUpgrade(cluster Cluster, major uint, minor uint) error {
+	version := cluster.CompatibilityVersion()
+	if version.AtLeast(major, minor) && (version.Major() != major || version.Minor() != minor) {
+		return newError(
+			EBadArgument,
+			"cannot lower the compatibility version of cluster %s from %s to %d.%d",
+			cluster.ID(),
+			version,
+			major,
+			minor,
+		)
+	}
+	return nil
+}
+
+// newClusterUpgradeResult collects the VMs in the upgraded cluster that must be restarted to use the new
+// compatibility version and logs a notice for each. It is shared between the real and the mock client.
+func newClusterUpgradeResult(
+	client VMClient,
+	logger Logger,
+	cluster Cluster,
+	previousVersion ClusterVersion,
+	retries []RetryStrategy,
+) (ClusterUpgradeResult, error) {
+	vms, err := client.ListVMs(retries...)
+	if err != nil {
+		return nil, err
+	}
+	result := &clusterUpgradeResult{
+		cluster:           cluster,
+		previousVersion:   previousVersion,
+		vmsPendingRestart: []VM{},
+	}
+	for _, vm := range vms {
+		if vm.ClusterID() != cluster.ID() || !vm.NextRunConfigurationExists() {
+			continue
+		}
+		logger.Infof(
+			"VM %s (%s) has pending configuration changes and must be restarted to use compatibility version %s.",
+			vm.Name(),
+			vm.ID(),
+			cluster.CompatibilityVersion(),
+		)
+		result.vmsPendingRestart = append(result.vmsPendingRestart, vm)
+	}
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package ovirtclient
+
+import (
+	"errors"
+)
+
+// CorrelationID returns an option that attaches the specified correlation ID to a create, update or delete call. It is
+// passed along with the retry strategies, for example:
+//
+//	vm, err := client.CreateVM(clusterID, templateID, name, nil, ovirtclient.CorrelationID("my-id"))
+//
+// The engine records the correlation ID on the jobs and audit log events created by the call, so the action can be
+// tracked using WaitForJob. If the call fails, the correlation ID can be extracted from the returned error using
+// CorrelationIDFromError. A correlation ID passed to a call takes precedence over the one configured for the client
+// using ExtraSettingsV2. Read calls ignore the correlation ID.
+func CorrelationID(id string) RetryStrategy {
+	return &retryStrategyContainer{
+		func() RetryInstance {
+			return &correlationIDStrategy{
+				id: id,
+			}
+		},
+		false,
+		false,
+		false,
+	}
+}
+
+type correlationIDStrategy struct {
+	id string
+}
+
+func (c *correlationIDStrategy) Name() string {
+	return "correlation ID"
+}
+
+func (c *correlationIDStrategy) CorrelationID() string {
+	return c.id
+}
+
+func (c *correlationIDStrategy) Continue(_ error, _ string) error {
+	return nil
+}
+
+func (c *correlationIDStrategy) Wait(_ error) interface{} {
+	return nil
+}
+
+func (c *correlationIDStrategy) OnWaitExpired(_ error, _ string) error {
+	return nil
+}
+
+// CorrelationIDFromError returns the correlation ID of the failed call if the error was returned from a call with a
+// correlation ID.
+func CorrelationIDFromError(err error) (string, bool) {
+	var e *engineError
+	for errors.As(err, &e) {
+		if e.correlationID != "" {
+			return e.correlationID, true
+		}
+		err = e.Unwrap()
+	}
+	return "", false
+}
+
+// correlationIDFromRetries returns the correlation ID passed along with the retry strategies, or an empty string if
+// none was passed.
+func correlationIDFromRetries(retries []RetryStrategy) string {
+	for _, r := range retries {
+		if c, ok := r.Get().(*correlationIDStrategy); ok {
+			return c.id
+		}
+	}
+	return ""
+}
+
+// correlationIDFor returns the correlation ID to use for a create, update or delete call.
+func (o *oVirtClient) correlationIDFor(retries []RetryStrategy) string {
+	if id := correlationIDFromRetries(retries); id != "" {
+		return id
+	}
+	return o.correlationID
+}
+
+// withCorrelationID adds the correlation ID to a non-nil error returned from a call.
+func withCorrelationID(err error, correlationID string) error {
+	if err == nil || correlationID == "" {
+		return err
+	}
+	// wrap always returns an *engineError, so the type assertion is safe.
+	e := wrap(err, EUnidentified, "failed call with correlation ID %s", correlationID).(*engineError)
+	e.correlationID = correlationID
+	return e
+}
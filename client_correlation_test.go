@@ -0,0 +1,64 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestCorrelationIDJobTracking(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	correlationID := fmt.Sprintf("test_%s", helper.GenerateRandomID(10))
+
+	vm, err := client.CreateVM(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		nil,
+		ovirtclient.CorrelationID(correlationID),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VM with correlation ID (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+
+	jobs, err := client.WaitForJob(correlationID)
+	if err != nil {
+		t.Fatalf("failed to wait for job with correlation ID %s (%v)", correlationID, err)
+	}
+	if len(jobs) == 0 {
+		t.Fatalf("no jobs returned for correlation ID %s", correlationID)
+	}
+}
+
+func TestCorrelationIDInError(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	correlationID := fmt.Sprintf("test_%s", helper.GenerateRandomID(10))
+
+	err := client.RemoveVM(
+		ovirtclient.VMID(helper.GenerateRandomID(10)),
+		ovirtclient.CorrelationID(correlationID),
+	)
+	if err == nil {
+		t.Fatalf("removing a non-existent VM did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("removing a non-existent VM returned an unexpected error (%v)", err)
+	}
+	id, ok := ovirtclient.CorrelationIDFromError(err)
+	if !ok {
+		t.Fatalf("no correlation ID found in error (%v)", err)
+	}
+	if id != correlationID {
+		t.Fatalf("incorrect correlation ID in error (expected: %s, got: %s)", correlationID, id)
+	}
+}
@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"strings"
+
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
@@ -16,12 +18,223 @@ type DatacenterClient interface {
 	ListDatacenters(retries ...RetryStrategy) ([]Datacenter, error)
 	// ListDatacenterClusters lists all clusters in the specified datacenter.
 	ListDatacenterClusters(id string, retries ...RetryStrategy) ([]Cluster, error)
+	// CreateDatacenter creates a new, empty datacenter with the specified name. The datacenter stays in the
+	// DatacenterStatusUninitialized status until a cluster, a host and a storage domain are added. Use
+	// CreateDatacenterParams to obtain a builder for the optional parameters.
+	CreateDatacenter(name string, params OptionalDatacenterParameters, retries ...RetryStrategy) (Datacenter, error)
+	// RemoveDatacenter removes the datacenter with the specified ID. The engine refuses to remove a datacenter that
+	// still has active storage domains.
+	RemoveDatacenter(id string, retries ...RetryStrategy) error
 }
 
 // DatacenterData is the core of a Datacenter when client functions are not required.
 type DatacenterData interface {
+	// ID returns the identifier of the datacenter.
 	ID() string
+	// Name returns the user-facing name of the datacenter.
 	Name() string
+	// Description returns the description of the datacenter.
+	Description() string
+	// Status returns the status of the datacenter.
+	Status() DatacenterStatus
+	// Local returns true if the datacenter uses local storage of a single host instead of shared storage.
+	Local() bool
+	// StorageFormat returns the storage format version of the storage domains in the datacenter.
+	StorageFormat() StorageFormat
+}
+
+// DatacenterStatus is the status of a datacenter.
+type DatacenterStatus string
+
+const (
+	// DatacenterStatusContend indicates that the hosts of the datacenter are contending for the storage pool
+	// manager role.
+	DatacenterStatusContend DatacenterStatus = "contend"
+	// DatacenterStatusMaintenance indicates that the datacenter is in maintenance.
+	DatacenterStatusMaintenance DatacenterStatus = "maintenance"
+	// DatacenterStatusNotOperational indicates that the datacenter is not operational, for example because its
+	// master storage domain is not reachable.
+	DatacenterStatusNotOperational DatacenterStatus = "not_operational"
+	// DatacenterStatusProblematic indicates that the datacenter has problems, for example because no host is
+	// available as the storage pool manager.
+	DatacenterStatusProblematic DatacenterStatus = "problematic"
+	// DatacenterStatusUninitialized indicates that the datacenter has no storage domain attached yet.
+	DatacenterStatusUninitialized DatacenterStatus = "uninitialized"
+	// DatacenterStatusUp indicates that the datacenter is operating normally.
+	DatacenterStatusUp DatacenterStatus = "up"
+)
+
+// DatacenterStatusList is a list of DatacenterStatus values.
+type DatacenterStatusList []DatacenterStatus
+
+// Strings creates a string list of the values.
+func (l DatacenterStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// DatacenterStatusValues returns all possible DatacenterStatus values.
+func DatacenterStatusValues() DatacenterStatusList {
+	return []DatacenterStatus{
+		DatacenterStatusContend,
+		DatacenterStatusMaintenance,
+		DatacenterStatusNotOperational,
+		DatacenterStatusProblematic,
+		DatacenterStatusUninitialized,
+		DatacenterStatusUp,
+	}
+}
+
+// StorageFormat is the version of the on-disk format of the storage domains in a datacenter. Newer versions support
+// more features, for example V5 supports 4k block size.
+type StorageFormat string
+
+const (
+	// StorageFormatV1 is the storage format version 1.
+	StorageFormatV1 StorageFormat = "v1"
+	// StorageFormatV2 is the storage format version 2.
+	StorageFormatV2 StorageFormat = "v2"
+	// StorageFormatV3 is the storage format version 3.
+	StorageFormatV3 StorageFormat = "v3"
+	// StorageFormatV4 is the storage format version 4.
+	StorageFormatV4 StorageFormat = "v4"
+	// StorageFormatV5 is the storage format version 5, used by oVirt 4.3 and later.
+	StorageFormatV5 StorageFormat = "v5"
+)
+
+// Validate returns an error if the storage format is not known.
+func (s StorageFormat) Validate() error {
+	for _, format := range StorageFormatValues() {
+		if format == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid storage format: %s must be one of: %s",
+		s,
+		strings.Join(StorageFormatValues().Strings(), ", "),
+	)
+}
+
+// StorageFormatList is a list of StorageFormat values.
+type StorageFormatList []StorageFormat
+
+// Strings creates a string list of the values.
+func (l StorageFormatList) Strings() []string {
+	result := make([]string, len(l))
+	for i, format := range l {
+		result[i] = string(format)
+	}
+	return result
+}
+
+// StorageFormatValues returns all possible StorageFormat values.
+func StorageFormatValues() StorageFormatList {
+	return []StorageFormat{
+		StorageFormatV1,
+		StorageFormatV2,
+		StorageFormatV3,
+		StorageFormatV4,
+		StorageFormatV5,
+	}
+}
+
+// OptionalDatacenterParameters is a set of optional parameters for creating a datacenter.
+type OptionalDatacenterParameters interface {
+	// Description returns the description of the datacenter, or nil if no description should be set.
+	Description() *string
+	// Local returns true if the datacenter should use local storage, or nil if the engine default (shared
+	// storage) should be used.
+	Local() *bool
+	// StorageFormat returns the storage format of the datacenter, or nil if the engine default should be used.
+	StorageFormat() *StorageFormat
+}
+
+// BuildableDatacenterParameters is a buildable version of OptionalDatacenterParameters.
+type BuildableDatacenterParameters interface {
+	OptionalDatacenterParameters
+
+	// WithDescription sets the description of the datacenter.
+	WithDescription(description string) (BuildableDatacenterParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableDatacenterParameters
+	// WithLocal sets if the datacenter uses local storage of a single host instead of shared storage.
+	WithLocal(local bool) (BuildableDatacenterParameters, error)
+	// MustWithLocal is identical to WithLocal, but panics instead of returning an error.
+	MustWithLocal(local bool) BuildableDatacenterParameters
+	// WithStorageFormat sets the storage format of the datacenter. It returns an error if the format is invalid.
+	WithStorageFormat(format StorageFormat) (BuildableDatacenterParameters, error)
+	// MustWithStorageFormat is identical to WithStorageFormat, but panics instead of returning an error.
+	MustWithStorageFormat(format StorageFormat) BuildableDatacenterParameters
+}
+
+// CreateDatacenterParams creates a buildable set of optional parameters for CreateDatacenter.
+func CreateDatacenterParams() BuildableDatacenterParameters {
+	return &datacenterParams{}
+}
+
+type datacenterParams struct {
+	description   *string
+	local         *bool
+	storageFormat *StorageFormat
+}
+
+func (d *datacenterParams) Description() *string {
+	return d.description
+}
+
+func (d *datacenterParams) Local() *bool {
+	return d.local
+}
+
+func (d *datacenterParams) StorageFormat() *StorageFormat {
+	return d.storageFormat
+}
+
+func (d *datacenterParams) WithDescription(description string) (BuildableDatacenterParameters, error) {
+	d.description = &description
+	return d, nil
+}
+
+func (d *datacenterParams) MustWithDescription(description string) BuildableDatacenterParameters {
+	builder, err := d.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (d *datacenterParams) WithLocal(local bool) (BuildableDatacenterParameters, error) {
+	d.local = &local
+	return d, nil
+}
+
+func (d *datacenterParams) MustWithLocal(local bool) BuildableDatacenterParameters {
+	builder, err := d.WithLocal(local)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (d *datacenterParams) WithStorageFormat(format StorageFormat) (BuildableDatacenterParameters, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	d.storageFormat = &format
+	return d, nil
+}
+
+func (d *datacenterParams) MustWithStorageFormat(format StorageFormat) BuildableDatacenterParameters {
+	builder, err := d.WithStorageFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 // Datacenter is a logical entity that defines the set of resources used in a specific environment.
@@ -32,7 +245,7 @@ type Datacenter interface {
 	// Clusters lists the clusters for this datacenter. This is a network call and may be slow.
 	Clusters(retries ...RetryStrategy) ([]Cluster, error)
 	// HasCluster returns true if the cluster is in the datacenter. This is a network call and may be slow.
-	HasCluster(clusterID string, retries ...RetryStrategy) (bool, error)
+	HasCluster(clusterID ClusterID, retries ...RetryStrategy) (bool, error)
 }
 
 func convertSDKDatacenter(sdkObject *ovirtsdk4.DataCenter, client *oVirtClient) (Datacenter, error) {
@@ -44,26 +257,42 @@ func convertSDKDatacenter(sdkObject *ovirtsdk4.DataCenter, client *oVirtClient)
 	if !ok {
 		return nil, newFieldNotFound("datacenter", "name")
 	}
+	status, ok := sdkObject.Status()
+	if !ok {
+		return nil, newFieldNotFound("datacenter", "status")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	local, _ := sdkObject.Local()
+	storageFormat, _ := sdkObject.StorageFormat()
 
 	return &datacenter{
-		client: client,
-		id:     id,
-		name:   name,
+		client:        client,
+		id:            id,
+		name:          name,
+		description:   description,
+		status:        DatacenterStatus(status),
+		local:         local,
+		storageFormat: StorageFormat(storageFormat),
 	}, nil
 }
 
 type datacenter struct {
 	client Client
 
-	id   string
-	name string
+	id            string
+	name          string
+	description   string
+	status        DatacenterStatus
+	local         bool
+	storageFormat StorageFormat
 }
 
 func (d datacenter) Clusters(retries ...RetryStrategy) ([]Cluster, error) {
 	return d.client.ListDatacenterClusters(d.id, retries...)
 }
 
-func (d datacenter) HasCluster(clusterID string, retries ...RetryStrategy) (bool, error) {
+func (d datacenter) HasCluster(clusterID ClusterID, retries ...RetryStrategy) (bool, error) {
 	clusters, err := d.client.ListDatacenterClusters(d.id, retries...)
 	if err != nil {
 		return false, err
@@ -83,3 +312,19 @@ func (d datacenter) ID() string {
 func (d datacenter) Name() string {
 	return d.name
 }
+
+func (d datacenter) Description() string {
+	return d.description
+}
+
+func (d datacenter) Status() DatacenterStatus {
+	return d.status
+}
+
+func (d datacenter) Local() bool {
+	return d.local
+}
+
+func (d datacenter) StorageFormat() StorageFormat {
+	return d.storageFormat
+}
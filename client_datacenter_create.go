@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateDatacenter(
+	name string,
+	params OptionalDatacenterParameters,
+	retries ...RetryStrategy,
+) (result Datacenter, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+
+	if err := validateDatacenterCreationParameters(name, params); err != nil {
+		return nil, err
+	}
+
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeDatacenter, "").with("name", name),
+		fmt.Sprintf("creating datacenter %s", name),
+		retries,
+		func() error {
+			datacenterBuilder := ovirtsdk.NewDataCenterBuilder().Name(name)
+			if params != nil {
+				if description := params.Description(); description != nil {
+					datacenterBuilder.Description(*description)
+				}
+				if local := params.Local(); local != nil {
+					datacenterBuilder.Local(*local)
+				}
+				if storageFormat := params.StorageFormat(); storageFormat != nil {
+					datacenterBuilder.StorageFormat(ovirtsdk.StorageFormat(*storageFormat))
+				}
+			}
+			req := o.connection().SystemService().DataCentersService().Add()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.DataCenter(datacenterBuilder.MustBuild()).Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.DataCenter()
+			if !ok {
+				return newFieldNotFound("response from datacenter creation", "datacenter")
+			}
+			result, err = convertSDKDatacenter(sdkObject, o)
+			return err
+		})
+	return result, withCorrelationID(err, correlationID)
+}
+
+func validateDatacenterCreationParameters(name string, params OptionalDatacenterParameters) error {
+	if name == "" {
+		return newError(EBadArgument, "name cannot be empty for datacenter creation")
+	}
+	if params != nil {
+		if storageFormat := params.StorageFormat(); storageFormat != nil {
+			if err := storageFormat.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetDatacenter(id string, retries ...RetryStrategy) (result Datacenter, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDatacenter, id),
 		fmt.Sprintf("getting datacenter %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().DataCentersService().DataCenterService(id).Get().Send()
+			response, err := o.connection().SystemService().DataCentersService().DataCenterService(id).Get().Send()
 			if err != nil {
 				return err
 			}
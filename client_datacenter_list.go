@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListDatacenters(retries ...RetryStrategy) (result []Datacenter, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Datacenter{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDatacenter, ""),
 		"listing datacenters",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().DataCentersService().List().Send()
+			response, e := o.connection().SystemService().DataCentersService().List().Send()
 			if e != nil {
 				return e
 			}
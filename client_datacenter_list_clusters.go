@@ -7,12 +7,12 @@ import (
 func (o *oVirtClient) ListDatacenterClusters(id string, retries ...RetryStrategy) (result []Cluster, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Cluster{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDatacenter, id),
 		fmt.Sprintf("listing datacenters %s clusters", id),
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.
+			response, e := o.connection().
 				SystemService().
 				DataCentersService().
 				DataCenterService(id).
@@ -0,0 +1,24 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveDatacenter(id string, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeDatacenter, id),
+		fmt.Sprintf("removing datacenter %s", id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().DataCentersService().DataCenterService(id).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
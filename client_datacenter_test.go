@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestDatacenterListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	datacenters, err := client.ListDatacenters()
+	if err != nil {
+		t.Fatalf("failed to list datacenters (%v)", err)
+	}
+	foundCluster := false
+	for _, dc := range datacenters {
+		fetchedDC, err := client.GetDatacenter(dc.ID())
+		if err != nil {
+			t.Fatalf("failed to get datacenter %s (%v)", dc.ID(), err)
+		}
+		if fetchedDC.Status() != dc.Status() {
+			t.Fatalf("status mismatch on datacenter %s (%s != %s)", dc.ID(), fetchedDC.Status(), dc.Status())
+		}
+		hasCluster, err := dc.HasCluster(helper.GetClusterID())
+		if err != nil {
+			t.Fatalf("failed to list clusters of datacenter %s (%v)", dc.ID(), err)
+		}
+		foundCluster = foundCluster || hasCluster
+	}
+	if !foundCluster {
+		t.Fatalf("no datacenter contains the test cluster %s", helper.GetClusterID())
+	}
+}
+
+func TestDatacenterCreation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	dc, err := client.CreateDatacenter(
+		name,
+		ovirtclient.CreateDatacenterParams().
+			MustWithDescription("created by the datacenter test").
+			MustWithStorageFormat(ovirtclient.StorageFormatV5),
+	)
+	if err != nil {
+		t.Fatalf("failed to create datacenter %s (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := client.RemoveDatacenter(dc.ID()); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Errorf("failed to remove datacenter %s (%v)", dc.ID(), err)
+		}
+	})
+	if dc.Name() != name || dc.Description() != "created by the datacenter test" {
+		t.Fatalf("incorrect name or description on the created datacenter (%s, %s)", dc.Name(), dc.Description())
+	}
+	if dc.Status() != ovirtclient.DatacenterStatusUninitialized {
+		t.Fatalf("incorrect status of the created datacenter (%s)", dc.Status())
+	}
+	if dc.StorageFormat() != ovirtclient.StorageFormatV5 {
+		t.Fatalf("incorrect storage format of the created datacenter (%s)", dc.StorageFormat())
+	}
+	if err := client.RemoveDatacenter(dc.ID()); err != nil {
+		t.Fatalf("failed to remove datacenter %s (%v)", dc.ID(), err)
+	}
+	if _, err := client.GetDatacenter(dc.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("the removed datacenter %s still exists (%v)", dc.ID(), err)
+	}
+}
@@ -8,7 +8,11 @@ import (
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
-//go:generate go run scripts/rest.go -i "Disk" -n "disk"
+//go:generate go run scripts/rest.go -i "Disk" -n "disk" -T "DiskID"
+
+// DiskID is an identifier for a disk. It has a special type so the compiler can catch errors when the disk ID is
+// erroneously passed elsewhere.
+type DiskID string
 
 // DiskClient is the client interface part that deals with disks.
 type DiskClient interface {
@@ -128,7 +132,7 @@ type DiskClient interface {
 	//         //...
 	//     }
 	StartUploadToDisk(
-		diskID string,
+		diskID DiskID,
 		size uint64,
 		reader readSeekCloser,
 		retries ...RetryStrategy,
@@ -145,7 +149,7 @@ type DiskClient interface {
 	//   uploaded. The reader must support seeking and close.
 	// - retries: a set of optional retry options.
 	UploadToDisk(
-		diskID string,
+		diskID DiskID,
 		size uint64,
 		reader readSeekCloser,
 		retries ...RetryStrategy,
@@ -163,7 +167,7 @@ type DiskClient interface {
 	//
 	// Deprecated: please use StartDownloadDisk instead.
 	StartImageDownload(
-		diskID string,
+		diskID DiskID,
 		format ImageFormat,
 		retries ...RetryStrategy,
 	) (ImageDownload, error)
@@ -178,7 +182,7 @@ type DiskClient interface {
 	//
 	// The caller MUST close the returned reader, otherwise the disk will remain locked in the oVirt engine.
 	StartDownloadDisk(
-		diskID string,
+		diskID DiskID,
 		format ImageFormat,
 		retries ...RetryStrategy,
 	) (ImageDownload, error)
@@ -188,7 +192,7 @@ type DiskClient interface {
 	//
 	// Deprecated: please use DownloadDisk instead.
 	DownloadImage(
-		diskID string,
+		diskID DiskID,
 		format ImageFormat,
 		retries ...RetryStrategy,
 	) (ImageDownloadReader, error)
@@ -196,7 +200,7 @@ type DiskClient interface {
 	// DownloadDisk runs StartDownloadDisk, then waits for the download to be ready before returning the reader.
 	// The caller MUST close the ImageDownloadReader in order to properly unlock the disk in the oVirt engine.
 	DownloadDisk(
-		diskID string,
+		diskID DiskID,
 		format ImageFormat,
 		retries ...RetryStrategy,
 	) (ImageDownloadReader, error)
@@ -229,7 +233,7 @@ type DiskClient interface {
 	// object, which can be used to wait for the update to complete. Use UpdateDiskParams to
 	// obtain a builder for the parameters structure.
 	StartUpdateDisk(
-		id string,
+		id DiskID,
 		params UpdateDiskParameters,
 		retries ...RetryStrategy,
 	) (DiskUpdate, error)
@@ -237,21 +241,36 @@ type DiskClient interface {
 	// UpdateDisk updates the specified disk with the specified parameters. Use UpdateDiskParams to
 	// obtain a builder for the parameters structure.
 	UpdateDisk(
-		id string,
+		id DiskID,
 		params UpdateDiskParameters,
 		retries ...RetryStrategy,
 	) (Disk, error)
 
 	// ListDisks lists all disks.
 	ListDisks(retries ...RetryStrategy) ([]Disk, error)
+	// ListDisksPage returns a single page of disks as specified in params. Use this function instead of ListDisks on
+	// large installations to avoid fetching all disks at once.
+	ListDisksPage(params PageParameters, retries ...RetryStrategy) ([]Disk, error)
 	// GetDisk fetches a disk with a specific ID from the oVirt Engine.
-	GetDisk(diskID string, retries ...RetryStrategy) (Disk, error)
+	GetDisk(diskID DiskID, retries ...RetryStrategy) (Disk, error)
 	// ListDisksByAlias fetches a disks with a specific name from the oVirt Engine.
 	ListDisksByAlias(alias string, retries ...RetryStrategy) ([]Disk, error)
+	// SearchDisks lists the disks matching the criteria specified in params. The filtering is done by the engine,
+	// so large disk lists don't need to be fetched.
+	SearchDisks(params DiskSearchParameters, retries ...RetryStrategy) ([]Disk, error)
 	// RemoveDisk removes a disk with a specific ID.
-	RemoveDisk(diskID string, retries ...RetryStrategy) error
-	// WaitForDiskOK waits for a disk to be in OK status
-	WaitForDiskOK(diskID string, retries ...RetryStrategy) (Disk, error)
+	RemoveDisk(diskID DiskID, retries ...RetryStrategy) error
+	// AddTagToDisk always returns an EUnsupported error. The oVirt Engine can only assign tags to VMs, templates and
+	// hosts, which is also why disks have no TagIDs. To find disks by tag, assign the tag to the VMs the disks are
+	// attached to and search using DiskSearchParams().WithTag().
+	AddTagToDisk(diskID DiskID, tagID TagID, retries ...RetryStrategy) error
+	// WaitForDiskOK waits for a disk to be in OK status, for example after an upload, copy or snapshot operation. It
+	// is identical to calling WaitForDiskStatus with DiskStatusOK.
+	WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error)
+	// WaitForDiskStatus waits for a disk to reach the desired status. While the disk is locked, it keeps polling. If
+	// the disk reaches a different status it will not leave on its own, such as illegal when waiting for OK, it
+	// returns an EUnexpectedDiskStatus error.
+	WaitForDiskStatus(diskID DiskID, status DiskStatus, retries ...RetryStrategy) (Disk, error)
 }
 
 // UpdateDiskParams creates a builder for the params for updating a disk.
@@ -259,6 +278,111 @@ func UpdateDiskParams() BuildableUpdateDiskParameters {
 	return &updateDiskParams{}
 }
 
+// DiskSearchParameters declares the parameters that can be passed to SearchDisks. Each parameter is declared as
+// a pointer, where a nil value means that the parameter is not searched for. All parameters are used together as an
+// AND filter.
+type DiskSearchParameters interface {
+	// Alias will match the alias of the disk exactly.
+	Alias() *string
+	// Tag will match the name of a tag. Disks cannot be tagged in oVirt, so this matches the disks attached to VMs
+	// with the tag.
+	Tag() *string
+	// Statuses will return a list of acceptable statuses for this disk search.
+	Statuses() *DiskStatusList
+	// StorageDomainID will match the disks stored on the specified storage domain.
+	StorageDomainID() *string
+	// Attached will match the disks attached to at least one VM if true, and the floating disks that are not
+	// attached to any VM if false.
+	Attached() *bool
+}
+
+// BuildableDiskSearchParameters is a buildable version of DiskSearchParameters.
+type BuildableDiskSearchParameters interface {
+	DiskSearchParameters
+
+	// WithAlias sets the alias to search for.
+	WithAlias(alias string) BuildableDiskSearchParameters
+	// WithTag sets the tag name to search for.
+	WithTag(tag string) BuildableDiskSearchParameters
+	// WithStatus adds a single status to the filter.
+	WithStatus(status DiskStatus) BuildableDiskSearchParameters
+	// WithStatuses sets the statuses the returned disks should be in.
+	WithStatuses(list DiskStatusList) BuildableDiskSearchParameters
+	// WithStorageDomainID sets the storage domain the returned disks should be stored on.
+	WithStorageDomainID(storageDomainID string) BuildableDiskSearchParameters
+	// WithAttached sets if the returned disks should be attached to a VM or not.
+	WithAttached(attached bool) BuildableDiskSearchParameters
+}
+
+// DiskSearchParams creates a buildable set of search parameters for SearchDisks.
+func DiskSearchParams() BuildableDiskSearchParameters {
+	return &diskSearchParams{}
+}
+
+type diskSearchParams struct {
+	alias           *string
+	tag             *string
+	statuses        *DiskStatusList
+	storageDomainID *string
+	attached        *bool
+}
+
+func (p *diskSearchParams) Alias() *string {
+	return p.alias
+}
+
+func (p *diskSearchParams) Tag() *string {
+	return p.tag
+}
+
+func (p *diskSearchParams) WithAlias(alias string) BuildableDiskSearchParameters {
+	p.alias = &alias
+	return p
+}
+
+func (p *diskSearchParams) WithTag(tag string) BuildableDiskSearchParameters {
+	p.tag = &tag
+	return p
+}
+
+func (p *diskSearchParams) Statuses() *DiskStatusList {
+	return p.statuses
+}
+
+func (p *diskSearchParams) WithStatus(status DiskStatus) BuildableDiskSearchParameters {
+	var newStatuses DiskStatusList
+	if p.statuses != nil {
+		newStatuses = p.statuses.Copy()
+	}
+	newStatuses = append(newStatuses, status)
+	p.statuses = &newStatuses
+	return p
+}
+
+func (p *diskSearchParams) WithStatuses(list DiskStatusList) BuildableDiskSearchParameters {
+	newStatuses := list.Copy()
+	p.statuses = &newStatuses
+	return p
+}
+
+func (p *diskSearchParams) StorageDomainID() *string {
+	return p.storageDomainID
+}
+
+func (p *diskSearchParams) WithStorageDomainID(storageDomainID string) BuildableDiskSearchParameters {
+	p.storageDomainID = &storageDomainID
+	return p
+}
+
+func (p *diskSearchParams) Attached() *bool {
+	return p.attached
+}
+
+func (p *diskSearchParams) WithAttached(attached bool) BuildableDiskSearchParameters {
+	p.attached = &attached
+	return p
+}
+
 // UpdateDiskParameters describes the possible parameters for updating a disk.
 type UpdateDiskParameters interface {
 	// Alias returns the disk alias to set. It can return nil to leave the alias unchanged.
@@ -324,6 +448,10 @@ func (u *updateDiskParams) MustWithProvisionedSize(size uint64) BuildableUpdateD
 	return builder
 }
 
+// DiskProfileID is the identifier of a disk profile. Disk profiles belong to a storage domain and define the storage
+// QoS applied to the disks assigned to them.
+type DiskProfileID string
+
 // CreateDiskOptionalParameters is a structure that serves to hold the optional parameters for DiskClient.CreateDisk.
 type CreateDiskOptionalParameters interface {
 	// Alias is a secondary name for the disk.
@@ -331,6 +459,14 @@ type CreateDiskOptionalParameters interface {
 
 	// Sparse indicates that the disk should be sparse-provisioned.If it returns nil, the default will be used.
 	Sparse() *bool
+
+	// QuotaID returns the ID of the quota the disk should be assigned to. An empty string leaves the assignment to
+	// the engine.
+	QuotaID() QuotaID
+
+	// DiskProfileID returns the ID of the disk profile the disk should be assigned to. An empty string uses the
+	// default disk profile of the storage domain.
+	DiskProfileID() DiskProfileID
 }
 
 // BuildableCreateDiskParameters is a buildable version of CreateDiskOptionalParameters.
@@ -346,6 +482,17 @@ type BuildableCreateDiskParameters interface {
 	WithSparse(sparse bool) (BuildableCreateDiskParameters, error)
 	// MustWithSparse is the same as WithSparse, but panics instead of returning an error.
 	MustWithSparse(sparse bool) BuildableCreateDiskParameters
+
+	// WithQuotaID sets the quota the disk should be assigned to.
+	WithQuotaID(quotaID QuotaID) (BuildableCreateDiskParameters, error)
+	// MustWithQuotaID is the same as WithQuotaID, but panics instead of returning an error.
+	MustWithQuotaID(quotaID QuotaID) BuildableCreateDiskParameters
+
+	// WithDiskProfileID sets the disk profile the disk should be assigned to. The profile must belong to the storage
+	// domain the disk is created on.
+	WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error)
+	// MustWithDiskProfileID is the same as WithDiskProfileID, but panics instead of returning an error.
+	MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters
 }
 
 // CreateDiskParams creates a buildable set of CreateDiskOptionalParameters for use with
@@ -355,8 +502,47 @@ func CreateDiskParams() BuildableCreateDiskParameters {
 }
 
 type createDiskParams struct {
-	alias  string
-	sparse *bool
+	alias         string
+	sparse        *bool
+	quotaID       QuotaID
+	diskProfileID DiskProfileID
+}
+
+func (c *createDiskParams) QuotaID() QuotaID {
+	return c.quotaID
+}
+
+func (c *createDiskParams) WithQuotaID(quotaID QuotaID) (BuildableCreateDiskParameters, error) {
+	c.quotaID = quotaID
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithQuotaID(quotaID QuotaID) BuildableCreateDiskParameters {
+	builder, err := c.WithQuotaID(quotaID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *createDiskParams) DiskProfileID() DiskProfileID {
+	return c.diskProfileID
+}
+
+func (c *createDiskParams) WithDiskProfileID(diskProfileID DiskProfileID) (BuildableCreateDiskParameters, error) {
+	if diskProfileID == "" {
+		return nil, newError(EBadArgument, "the disk profile ID cannot be empty")
+	}
+	c.diskProfileID = diskProfileID
+	return c, nil
+}
+
+func (c *createDiskParams) MustWithDiskProfileID(diskProfileID DiskProfileID) BuildableCreateDiskParameters {
+	builder, err := c.WithDiskProfileID(diskProfileID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 func (c *createDiskParams) Alias() string {
@@ -452,7 +638,7 @@ type UploadImageResult interface {
 // This can be used for cases where not a full Disk is required, but only the data functionality.
 type DiskData interface {
 	// ID is the unique ID for this disk.
-	ID() string
+	ID() DiskID
 	// Alias is the name for this disk set by the user.
 	Alias() string
 	// ProvisionedSize is the size visible to the virtual machine.
@@ -470,6 +656,12 @@ type DiskData interface {
 	Status() DiskStatus
 	// Sparse indicates sparse provisioning on the disk.
 	Sparse() bool
+	// QuotaID returns the ID of the quota the disk is assigned to, or an empty string if the engine did not report
+	// one.
+	QuotaID() QuotaID
+	// DiskProfileID returns the ID of the disk profile the disk is assigned to, or an empty string if the engine did
+	// not report one.
+	DiskProfileID() DiskProfileID
 }
 
 // Disk is a disk in oVirt.
@@ -500,10 +692,14 @@ type Disk interface {
 
 	// Remove removes the current disk in the oVirt engine.
 	Remove(retries ...RetryStrategy) error
+	// AddPermission grants the role to the principal on the disk.
+	AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (Permission, error)
+	// ListPermissions lists the permissions that apply to the disk.
+	ListPermissions(retries ...RetryStrategy) ([]Permission, error)
 
 	// AttachToVM attaches a disk to this VM.
 	AttachToVM(
-		vmID string,
+		vmID VMID,
 		diskInterface DiskInterface,
 		params CreateDiskAttachmentOptionalParams,
 		retries ...RetryStrategy,
@@ -528,6 +724,11 @@ type Disk interface {
 
 	// WaitForOK waits for the disk status to return to OK.
 	WaitForOK(retries ...RetryStrategy) (Disk, error)
+	// WaitForStatus waits for the disk to reach the desired status. See WaitForDiskStatus for details.
+	WaitForStatus(status DiskStatus, retries ...RetryStrategy) (Disk, error)
+
+	// Clone returns a copy of the disk that shares no data with the original.
+	Clone() Disk
 }
 
 // DiskStatus shows the status of a disk. Certain operations lock a disk, which is important because the disk can then
@@ -544,9 +745,36 @@ const (
 	DiskStatusIllegal DiskStatus = "illegal"
 )
 
+// Validate returns an error if the disk status is not known.
+func (s DiskStatus) Validate() error {
+	for _, v := range DiskStatusValues() {
+		if v == s {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "invalid value for disk status: %s", s)
+}
+
 // DiskStatusList is a list of DiskStatus values.
 type DiskStatusList []DiskStatus
 
+// Copy creates a separate copy of the current status list.
+func (l DiskStatusList) Copy() DiskStatusList {
+	result := make([]DiskStatus, len(l))
+	copy(result, l)
+	return result
+}
+
+// Validate validates the list of statuses.
+func (l DiskStatusList) Validate() error {
+	for _, s := range l {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DiskStatusValues returns all possible values for DiskStatus.
 func DiskStatusValues() DiskStatusList {
 	return []DiskStatus{
@@ -681,7 +909,7 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 	return &disk{
 		client: client,
 
-		id:               id,
+		id:               DiskID(id),
 		alias:            alias,
 		provisionedSize:  uint64(provisionedSize),
 		totalSize:        uint64(totalSize),
@@ -689,13 +917,31 @@ func convertSDKDisk(sdkDisk *ovirtsdk4.Disk, client Client) (Disk, error) {
 		storageDomainIDs: storageDomainIDs,
 		status:           DiskStatus(status),
 		sparse:           sparse,
+		quotaID:          convertSDKDiskQuotaID(sdkDisk),
+		diskProfileID:    convertSDKDiskProfileID(sdkDisk),
 	}, nil
 }
 
+func convertSDKDiskQuotaID(sdkDisk *ovirtsdk4.Disk) QuotaID {
+	if sdkQuota, ok := sdkDisk.Quota(); ok {
+		quotaID, _ := sdkQuota.Id()
+		return QuotaID(quotaID)
+	}
+	return ""
+}
+
+func convertSDKDiskProfileID(sdkDisk *ovirtsdk4.Disk) DiskProfileID {
+	if sdkDiskProfile, ok := sdkDisk.DiskProfile(); ok {
+		diskProfileID, _ := sdkDiskProfile.Id()
+		return DiskProfileID(diskProfileID)
+	}
+	return ""
+}
+
 type disk struct {
 	client Client
 
-	id               string
+	id               DiskID
 	alias            string
 	provisionedSize  uint64
 	format           ImageFormat
@@ -703,12 +949,26 @@ type disk struct {
 	status           DiskStatus
 	totalSize        uint64
 	sparse           bool
+	quotaID          QuotaID
+	diskProfileID    DiskProfileID
+}
+
+func (d *disk) QuotaID() QuotaID {
+	return d.quotaID
+}
+
+func (d *disk) DiskProfileID() DiskProfileID {
+	return d.diskProfileID
 }
 
 func (d *disk) WaitForOK(retries ...RetryStrategy) (Disk, error) {
 	return d.client.WaitForDiskOK(d.id, retries...)
 }
 
+func (d *disk) WaitForStatus(status DiskStatus, retries ...RetryStrategy) (Disk, error) {
+	return d.client.WaitForDiskStatus(d.id, status, retries...)
+}
+
 func (d *disk) StorageDomainIDs() []string {
 	return d.storageDomainIDs
 }
@@ -738,7 +998,7 @@ func (d *disk) Sparse() bool {
 }
 
 func (d *disk) AttachToVM(
-	vmID string,
+	vmID VMID,
 	diskInterface DiskInterface,
 	params CreateDiskAttachmentOptionalParams,
 	retries ...RetryStrategy,
@@ -750,15 +1010,42 @@ func (d *disk) Remove(retries ...RetryStrategy) error {
 	return d.client.RemoveDisk(d.id, retries...)
 }
 
+func (d *disk) AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (
+	Permission,
+	error,
+) {
+	return d.client.AddDiskPermission(d.id, principal, roleID, retries...)
+}
+
+func (d *disk) ListPermissions(retries ...RetryStrategy) ([]Permission, error) {
+	return d.client.ListPermissions(PermissionObjectTypeDisk, string(d.id), retries...)
+}
+
 func (d *disk) TotalSize() uint64 {
 	return d.totalSize
 }
 
+func (d *disk) Clone() Disk {
+	return &disk{
+		client:           d.client,
+		id:               d.id,
+		alias:            d.alias,
+		provisionedSize:  d.provisionedSize,
+		format:           d.format,
+		storageDomainIDs: append([]string{}, d.storageDomainIDs...),
+		status:           d.status,
+		totalSize:        d.totalSize,
+		sparse:           d.sparse,
+		quotaID:          d.quotaID,
+		diskProfileID:    d.diskProfileID,
+	}
+}
+
 func (d disk) Status() DiskStatus {
 	return d.status
 }
 
-func (d disk) ID() string {
+func (d disk) ID() DiskID {
 	return d.id
 }
 
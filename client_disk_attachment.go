@@ -9,13 +9,16 @@ import (
 // DiskAttachmentClient contains the methods required for handling disk attachments.
 type DiskAttachmentClient interface {
 	// CreateDiskAttachment attaches a disk to a VM.
-	CreateDiskAttachment(vmID string, diskID string, diskInterface DiskInterface, params CreateDiskAttachmentOptionalParams, retries ...RetryStrategy) (DiskAttachment, error)
+	CreateDiskAttachment(vmID VMID, diskID DiskID, diskInterface DiskInterface, params CreateDiskAttachmentOptionalParams, retries ...RetryStrategy) (DiskAttachment, error)
+	// AttachDisks attaches several disks to a VM in the order specified. If any of the attachments fails, the
+	// attachments created so far are removed, so the VM is not left with only some of the disks attached.
+	AttachDisks(vmID VMID, specs []DiskAttachmentSpec, retries ...RetryStrategy) ([]DiskAttachment, error)
 	// GetDiskAttachment returns a single disk attachment in a virtual machine.
-	GetDiskAttachment(vmID string, id string, retries ...RetryStrategy) (DiskAttachment, error)
+	GetDiskAttachment(vmID VMID, id string, retries ...RetryStrategy) (DiskAttachment, error)
 	// ListDiskAttachments lists all disk attachments for a virtual machine.
-	ListDiskAttachments(vmID string, retries ...RetryStrategy) ([]DiskAttachment, error)
+	ListDiskAttachments(vmID VMID, retries ...RetryStrategy) ([]DiskAttachment, error)
 	// RemoveDiskAttachment removes the disk attachment in question.
-	RemoveDiskAttachment(vmID string, diskAttachmentID string, retries ...RetryStrategy) error
+	RemoveDiskAttachment(vmID VMID, diskAttachmentID string, retries ...RetryStrategy) error
 }
 
 // DiskInterface describes the means by which a disk will appear to the VM.
@@ -148,9 +151,9 @@ type DiskAttachment interface {
 	// ID returns the identifier of the attachment.
 	ID() string
 	// VMID returns the ID of the virtual machine this attachment belongs to.
-	VMID() string
+	VMID() VMID
 	// DiskID returns the ID of the disk in this attachment.
-	DiskID() string
+	DiskID() DiskID
 	// DiskInterface describes the means by which a disk will appear to the VM.
 	DiskInterface() DiskInterface
 	// Bootable defines whether the disk is bootable
@@ -165,14 +168,17 @@ type DiskAttachment interface {
 
 	// Remove removes the current disk attachment.
 	Remove(retries ...RetryStrategy) error
+
+	// Clone returns a copy of the disk attachment that shares no data with the original.
+	Clone() DiskAttachment
 }
 
 type diskAttachment struct {
 	client Client
 
 	id            string
-	vmid          string
-	diskID        string
+	vmid          VMID
+	diskID        DiskID
 	diskInterface DiskInterface
 	active        bool
 	bootable      bool
@@ -190,11 +196,11 @@ func (d *diskAttachment) ID() string {
 	return d.id
 }
 
-func (d *diskAttachment) VMID() string {
+func (d *diskAttachment) VMID() VMID {
 	return d.vmid
 }
 
-func (d *diskAttachment) DiskID() string {
+func (d *diskAttachment) DiskID() DiskID {
 	return d.diskID
 }
 
@@ -214,6 +220,18 @@ func (d *diskAttachment) Disk(retries ...RetryStrategy) (Disk, error) {
 	return d.client.GetDisk(d.diskID, retries...)
 }
 
+func (d *diskAttachment) Clone() DiskAttachment {
+	return &diskAttachment{
+		client:        d.client,
+		id:            d.id,
+		vmid:          d.vmid,
+		diskID:        d.diskID,
+		diskInterface: d.diskInterface,
+		active:        d.active,
+		bootable:      d.bootable,
+	}
+}
+
 func convertSDKDiskAttachment(object *ovirtsdk4.DiskAttachment, o *oVirtClient) (DiskAttachment, error) {
 	id, ok := object.Id()
 	if !ok {
@@ -251,8 +269,8 @@ func convertSDKDiskAttachment(object *ovirtsdk4.DiskAttachment, o *oVirtClient)
 		client: o,
 
 		id:            id,
-		vmid:          vmID,
-		diskID:        diskID,
+		vmid:          VMID(vmID),
+		diskID:        DiskID(diskID),
 		diskInterface: DiskInterface(diskInterface),
 		bootable:      bootable,
 		active:        active,
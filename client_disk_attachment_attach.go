@@ -0,0 +1,131 @@
+package ovirtclient
+
+// DiskAttachmentSpec describes a single disk attachment for AttachDisks.
+type DiskAttachmentSpec interface {
+	// DiskID returns the ID of the disk to attach.
+	DiskID() DiskID
+	// DiskInterface returns the interface the disk is attached with.
+	DiskInterface() DiskInterface
+	// Params returns the optional parameters passed to CreateDiskAttachment. It may be nil.
+	Params() CreateDiskAttachmentOptionalParams
+}
+
+// NewDiskAttachmentSpec creates a DiskAttachmentSpec for use with AttachDisks. The params may be nil.
+func NewDiskAttachmentSpec(
+	diskID DiskID,
+	diskInterface DiskInterface,
+	params CreateDiskAttachmentOptionalParams,
+) (DiskAttachmentSpec, error) {
+	if diskID == "" {
+		return nil, newError(EBadArgument, "disk ID cannot be empty")
+	}
+	if err := diskInterface.Validate(); err != nil {
+		return nil, err
+	}
+	return &diskAttachmentSpec{
+		diskID:        diskID,
+		diskInterface: diskInterface,
+		params:        params,
+	}, nil
+}
+
+// MustNewDiskAttachmentSpec is identical to NewDiskAttachmentSpec, but panics instead of returning an error.
+func MustNewDiskAttachmentSpec(
+	diskID DiskID,
+	diskInterface DiskInterface,
+	params CreateDiskAttachmentOptionalParams,
+) DiskAttachmentSpec {
+	spec, err := NewDiskAttachmentSpec(diskID, diskInterface, params)
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+type diskAttachmentSpec struct {
+	diskID        DiskID
+	diskInterface DiskInterface
+	params        CreateDiskAttachmentOptionalParams
+}
+
+func (d *diskAttachmentSpec) DiskID() DiskID {
+	return d.diskID
+}
+
+func (d *diskAttachmentSpec) DiskInterface() DiskInterface {
+	return d.diskInterface
+}
+
+func (d *diskAttachmentSpec) Params() CreateDiskAttachmentOptionalParams {
+	return d.params
+}
+
+func (o *oVirtClient) AttachDisks(vmID VMID, specs []DiskAttachmentSpec, retries ...RetryStrategy) (
+	[]DiskAttachment,
+	error,
+) {
+	return attachDisks(o, o.logger, vmID, specs, retries)
+}
+
+// attachDisks implements AttachDisks on top of CreateDiskAttachment. It is shared between the real and the mock
+// client.
+func attachDisks(
+	client Client,
+	logger Logger,
+	vmID VMID,
+	specs []DiskAttachmentSpec,
+	retries []RetryStrategy,
+) ([]DiskAttachment, error) {
+	if err := validateDiskAttachmentSpecs(specs); err != nil {
+		return nil, err
+	}
+	result := make([]DiskAttachment, 0, len(specs))
+	for _, spec := range specs {
+		attachment, err := client.CreateDiskAttachment(
+			vmID,
+			spec.DiskID(),
+			spec.DiskInterface(),
+			spec.Params(),
+			retries...,
+		)
+		if err != nil {
+			rollbackDiskAttachments(client, logger, result, retries)
+			return nil, wrap(err, EUnidentified, "failed to attach disk %s to VM %s", spec.DiskID(), vmID)
+		}
+		result = append(result, attachment)
+	}
+	return result, nil
+}
+
+func validateDiskAttachmentSpecs(specs []DiskAttachmentSpec) error {
+	if len(specs) == 0 {
+		return newError(EBadArgument, "at least one disk attachment must be specified")
+	}
+	seen := map[DiskID]struct{}{}
+	for i, spec := range specs {
+		if spec == nil {
+			return newError(EBadArgument, "disk attachment %d is nil", i)
+		}
+		if _, ok := seen[spec.DiskID()]; ok {
+			return newError(EBadArgument, "disk %s is listed more than once", spec.DiskID())
+		}
+		seen[spec.DiskID()] = struct{}{}
+	}
+	return nil
+}
+
+// rollbackDiskAttachments removes the attachments in reverse order. Failures are logged, but not returned, so the
+// caller sees the error that caused the rollback.
+func rollbackDiskAttachments(client Client, logger Logger, attachments []DiskAttachment, retries []RetryStrategy) {
+	for i := len(attachments) - 1; i >= 0; i-- {
+		attachment := attachments[i]
+		if err := client.RemoveDiskAttachment(attachment.VMID(), attachment.ID(), retries...); err != nil {
+			logger.Warningf(
+				"Failed to remove disk attachment %s on VM %s during rollback, please remove it manually (%v).",
+				attachment.ID(),
+				attachment.VMID(),
+				err,
+			)
+		}
+	}
+}
@@ -7,25 +7,25 @@ import (
 )
 
 func (o *oVirtClient) CreateDiskAttachment(
-	vmID string,
-	diskID string,
+	vmID VMID,
+	diskID DiskID,
 	diskInterface DiskInterface,
 	params CreateDiskAttachmentOptionalParams,
-	retries ...RetryStrategy,
-) (result DiskAttachment, err error) {
+	retries ...RetryStrategy) (result DiskAttachment, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
 	if err := diskInterface.Validate(); err != nil {
 		return nil, wrap(err, EBadArgument, "failed to create disk attachment")
 	}
-	err = retry(
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(vmID)).with("disk_id", diskID).with("disk_interface", diskInterface),
 		fmt.Sprintf("attaching disk %s to vm %s", diskID, vmID),
-		o.logger,
 		retries,
 		func() error {
 			attachmentBuilder := ovirtsdk.NewDiskAttachmentBuilder()
-			attachmentBuilder.Disk(ovirtsdk.NewDiskBuilder().Id(diskID).MustBuild())
+			attachmentBuilder.Disk(ovirtsdk.NewDiskBuilder().Id(string(diskID)).MustBuild())
 			attachmentBuilder.Interface(ovirtsdk.DiskInterface(diskInterface))
-			attachmentBuilder.Vm(ovirtsdk.NewVmBuilder().Id(vmID).MustBuild())
+			attachmentBuilder.Vm(ovirtsdk.NewVmBuilder().Id(string(vmID)).MustBuild())
 			attachmentBuilder.Active(true)
 			if params != nil {
 				if active := params.Active(); active != nil {
@@ -37,8 +37,11 @@ func (o *oVirtClient) CreateDiskAttachment(
 			}
 			attachment := attachmentBuilder.MustBuild()
 
-			addRequest := o.conn.SystemService().VmsService().VmService(vmID).DiskAttachmentsService().Add()
+			addRequest := o.connection().SystemService().VmsService().VmService(string(vmID)).DiskAttachmentsService().Add()
 			addRequest.Attachment(attachment)
+			if correlationID != "" {
+				addRequest.Query("correlation_id", correlationID)
+			}
 			response, err := addRequest.Send()
 			if err != nil {
 				return wrap(
@@ -62,5 +65,5 @@ func (o *oVirtClient) CreateDiskAttachment(
 			return nil
 		},
 	)
-	return result, err
+	return result, withCorrelationID(err, correlationID)
 }
@@ -5,20 +5,19 @@ import (
 )
 
 func (o *oVirtClient) GetDiskAttachment(
-	vmid string,
+	vmid VMID,
 	id string,
-	retries ...RetryStrategy,
-) (result DiskAttachment, err error) {
+	retries ...RetryStrategy) (result DiskAttachment, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDiskAttachment, id),
 		fmt.Sprintf("getting disk attachment %s on VM %s", id, vmid),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.
+			response, err := o.connection().
 				SystemService().
 				VmsService().
-				VmService(vmid).
+				VmService(string(vmid)).
 				DiskAttachmentsService().
 				AttachmentService(id).
 				Get().
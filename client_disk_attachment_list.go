@@ -5,17 +5,22 @@ import (
 )
 
 func (o *oVirtClient) ListDiskAttachments(
-	vmid string,
-	retries ...RetryStrategy,
-) (result []DiskAttachment, err error) {
+	vmid VMID,
+	retries ...RetryStrategy) (result []DiskAttachment, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []DiskAttachment{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(vmid)),
 		fmt.Sprintf("listing disk attachments on VM %s", vmid),
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().VmService(vmid).DiskAttachmentsService().List().Send()
+			response, e := o.connection().
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				DiskAttachmentsService().
+				List().
+				Send()
 			if e != nil {
 				return e
 			}
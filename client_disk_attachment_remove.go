@@ -4,22 +4,27 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveDiskAttachment(vmID string, diskAttachmentID string, retries ...RetryStrategy) error {
+func (o *oVirtClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID string, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	return retry(
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeDiskAttachment, diskAttachmentID).with("vm_id", vmID),
 		fmt.Sprintf("removing disk attachment %s on VM %s", diskAttachmentID, vmID),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.
+			req := o.connection().
 				SystemService().
 				VmsService().
-				VmService(vmID).
+				VmService(string(vmID)).
 				DiskAttachmentsService().
 				AttachmentService(diskAttachmentID).
-				Remove().
-				Send()
+				Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		},
 	)
+	return withCorrelationID(err, correlationID)
 }
@@ -140,3 +140,63 @@ func assertDiskAttachmentCount(t *testing.T, vm ovirtclient.VM, count uint) {
 		t.Fatalf("Invalid number of attachments on VM %s, expected %d, is %d", vm.ID(), count, len(attachments))
 	}
 }
+
+func TestAttachDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk1 := assertCanCreateDisk(t, helper)
+	disk2 := assertCanCreateDisk(t, helper)
+	attachments, err := client.AttachDisks(
+		vm.ID(),
+		[]ovirtclient.DiskAttachmentSpec{
+			ovirtclient.MustNewDiskAttachmentSpec(disk1.ID(), ovirtclient.DiskInterfaceVirtIO, nil),
+			ovirtclient.MustNewDiskAttachmentSpec(disk2.ID(), ovirtclient.DiskInterfaceVirtIO, nil),
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to attach disks to VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("Incorrect number of disk attachments returned (expected: 2, got: %d)", len(attachments))
+	}
+	assertDiskAttachmentMatches(t, attachments[0], disk1, vm)
+	assertDiskAttachmentMatches(t, attachments[1], disk2, vm)
+	assertDiskAttachmentCount(t, vm, 2)
+}
+
+func TestAttachDisksRollback(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("disk_attachment_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	_, err := client.AttachDisks(
+		vm.ID(),
+		[]ovirtclient.DiskAttachmentSpec{
+			ovirtclient.MustNewDiskAttachmentSpec(disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil),
+			ovirtclient.MustNewDiskAttachmentSpec(
+				ovirtclient.DiskID(helper.GenerateRandomID(10)),
+				ovirtclient.DiskInterfaceVirtIO,
+				nil,
+			),
+		},
+	)
+	if err == nil {
+		t.Fatalf("Attaching a non-existent disk did not fail.")
+	}
+	assertDiskAttachmentCount(t, vm, 0)
+}
@@ -22,32 +22,28 @@ func (o *oVirtClient) StartCreateDisk(
 
 	var result *diskWait
 	processName := "creating disk"
-	correlationID := ""
+	correlationID := o.correlationIDFor(retries)
 	if params != nil && params.Alias() != "" {
 		processName = fmt.Sprintf("creating disk %s", params.Alias())
-		correlationID = fmt.Sprintf("disk_create_%s", params.Alias())
-	} else {
+		if correlationID == "" {
+			correlationID = fmt.Sprintf("disk_create_%s", params.Alias())
+		}
+	}
+	if correlationID == "" {
 		correlationID = fmt.Sprintf("disk_create_%s", generateRandomID(5, o.nonSecureRandom))
 	}
-	err := retry(
+	err := o.mutate(
+		resourceOf(ResourceTypeDisk, "").with("storage_domain_id", storageDomainID).with("format", format).with("size", size),
 		processName,
-		o.logger,
 		retries,
 		func() error {
 			addResponse, err := o.createDisk(storageDomainID, size, format, correlationID, params)
 			if err != nil {
-				return wrap(
-					err,
-					EUnidentified,
-					"failed to add disk",
-				)
+				return wrap(err, EUnidentified, "failed to add disk")
 			}
 			sdkDisk, ok := addResponse.Disk()
 			if !ok {
-				return newError(
-					EFieldMissing,
-					"missing disk object from disk add response",
-				)
+				return newError(EFieldMissing, "missing disk object from disk add response")
 			}
 			resultDisk, err := convertSDKDisk(sdkDisk, o)
 			if err != nil {
@@ -64,7 +60,7 @@ func (o *oVirtClient) StartCreateDisk(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, withCorrelationID(err, correlationID)
 	}
 	return result, nil
 }
@@ -84,7 +80,7 @@ func (o *oVirtClient) createDisk(
 			"failed to construct disk object",
 		)
 	}
-	return o.conn.
+	return o.connection().
 		SystemService().
 		DisksService().
 		Add().
@@ -120,6 +116,12 @@ func (o *oVirtClient) buildDiskObjectForCreation(
 		if alias := params.Alias(); alias != "" {
 			diskBuilder.Alias(alias)
 		}
+		if quotaID := params.QuotaID(); quotaID != "" {
+			diskBuilder.Quota(ovirtsdk4.NewQuotaBuilder().Id(string(quotaID)).MustBuild())
+		}
+		if diskProfileID := params.DiskProfileID(); diskProfileID != "" {
+			diskBuilder.DiskProfile(ovirtsdk4.NewDiskProfileBuilder().Id(string(diskProfileID)).MustBuild())
+		}
 	}
 	return diskBuilder.Build()
 }
@@ -12,12 +12,12 @@ import (
 )
 
 // Deprecated: use StartDownloadDisk instead.
-func (o *oVirtClient) StartImageDownload(diskID string, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
+func (o *oVirtClient) StartImageDownload(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
 	o.logger.Debugf("Using StartImageDownload is deprecated, please use StartDownloadDisk instead.")
 	return o.StartDownloadDisk(diskID, format, retries...)
 }
 
-func (o *oVirtClient) StartDownloadDisk(diskID string, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
+func (o *oVirtClient) StartDownloadDisk(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (ImageDownload, error) {
 	retries = defaultRetries(retries, defaultLongTimeouts())
 
 	o.logger.Infof("Starting disk %s image download...", diskID)
@@ -36,7 +36,7 @@ func (o *oVirtClient) StartDownloadDisk(diskID string, format ImageFormat, retri
 		lastError:  nil,
 		ctx:        realCtx,
 		cancel:     cancel,
-		conn:       o.conn,
+		conn:       o.connection(),
 		done:       make(chan struct{}),
 		reader:     nil,
 		httpClient: o.httpClient,
@@ -52,7 +52,7 @@ func (o *oVirtClient) StartDownloadDisk(diskID string, format ImageFormat, retri
 }
 
 // Deprecated: use DownloadDisk instead.
-func (o *oVirtClient) DownloadImage(diskID string, format ImageFormat, retries ...RetryStrategy) (
+func (o *oVirtClient) DownloadImage(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (
 	ImageDownloadReader,
 	error,
 ) {
@@ -61,10 +61,9 @@ func (o *oVirtClient) DownloadImage(diskID string, format ImageFormat, retries .
 }
 
 func (o *oVirtClient) DownloadDisk(
-	diskID string,
+	diskID DiskID,
 	format ImageFormat,
-	retries ...RetryStrategy,
-) (ImageDownloadReader, error) {
+	retries ...RetryStrategy) (ImageDownloadReader, error) {
 	download, err := o.StartDownloadDisk(diskID, format, retries...)
 	if err != nil {
 		return nil, err
@@ -140,9 +139,9 @@ func (i *imageDownload) updateDisk(disk Disk) {
 // This call will also set the exact download size in i.size. This function will retry until a valid URL is obtained
 // or retries are exhausted.
 func (i *imageDownload) transferImage(transferURL string) (httpResponse *http.Response, err error) {
-	return httpResponse, retry(
+	return httpResponse, i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.disk.ID())),
 		fmt.Sprintf("transferring image from %s", transferURL),
-		i.logger,
 		i.retries,
 		func() error {
 			response, err := i.attemptTransferImage(transferURL) //nolint:bodyclose
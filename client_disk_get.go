@@ -6,14 +6,14 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) GetDisk(id string, retries ...RetryStrategy) (result Disk, err error) {
+func (o *oVirtClient) GetDisk(id DiskID, retries ...RetryStrategy) (result Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, string(id)),
 		fmt.Sprintf("getting disk %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().DisksService().DiskService(id).Get().Send()
+			response, err := o.connection().SystemService().DisksService().DiskService(string(id)).Get().Send()
 			if err != nil {
 				return err
 			}
@@ -41,7 +41,7 @@ func generateCorrelationID(prefix string) string {
 func newImageTransfer(
 	cli *oVirtClient,
 	logger Logger,
-	diskID string,
+	diskID DiskID,
 	correlationID string,
 	retries []RetryStrategy,
 	direction ovirtsdk4.ImageTransferDirection,
@@ -58,7 +58,7 @@ func newImageTransfer(
 		cli:             cli,
 		logger:          logger,
 		correlationID:   correlationID,
-		conn:            cli.conn,
+		conn:            cli.connection(),
 		transfer:        nil,
 		transferService: nil,
 		httpClient:      cli.httpClient,
@@ -92,7 +92,7 @@ type imageTransferImpl struct {
 	// retries is the list of retry strategies to use for calls in this transfer.
 	retries []RetryStrategy
 	// diskID is the ID of the disk used for this transfer.
-	diskID string
+	diskID DiskID
 	// cli is the calling client library.
 	cli *oVirtClient
 	// logger is the go-ovirt-client-log logger
@@ -225,7 +225,7 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 	*ovirtsdk4.ImageTransfersService,
 ) {
 	imageTransfersService := i.conn.SystemService().ImageTransfersService()
-	image := ovirtsdk4.NewImageBuilder().Id(i.diskID).MustBuild()
+	image := ovirtsdk4.NewImageBuilder().Id(string(i.diskID)).MustBuild()
 	transfer := ovirtsdk4.
 		NewImageTransferBuilder().
 		Image(image).
@@ -243,9 +243,9 @@ func (i *imageTransferImpl) buildImageTransferRequest() (
 // This function will set the i.transfer and i.transferService variables with the created image transfer and
 // the associated service.
 func (i *imageTransferImpl) createImageTransfer() (err error) {
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf("starting image transfer for disk %s", i.diskID),
-		i.logger,
 		i.retries,
 		i.attemptCreateImageTransfer,
 	)
@@ -284,12 +284,12 @@ func (i *imageTransferImpl) attemptCreateImageTransfer() error {
 //
 // This function is internal to imageTransferImpl, do not call externally.
 func (i *imageTransferImpl) waitForImageTransferReady() (err error) {
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf(
 			"waiting for image transfer to become ready for disk ID %s",
 			i.diskID,
 		),
-		i.logger,
 		i.retries,
 		i.checkImageTransferReady,
 	)
@@ -339,9 +339,9 @@ func (i *imageTransferImpl) checkImageTransferReady() error {
 // finalize still waits for the disk to be OK. This function calls attemptFinalizeTransfer repeatedly until it succeeds
 // or the retries are exhausted.
 func (i *imageTransferImpl) finalizeTransfer() error {
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf("finalizing image for disk %s", i.diskID),
-		i.logger,
 		i.retries,
 		i.attemptFinalizeTransfer,
 	)
@@ -357,9 +357,9 @@ func (i *imageTransferImpl) attemptFinalizeTransfer() error {
 
 // waitForTransferFinalize waits for a transfer to reach a final state.
 func (i *imageTransferImpl) waitForTransferFinalize() error {
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf("waiting for finalizing image transfer for disk %s", i.diskID),
-		i.logger,
 		i.retries,
 		i.attemptWaitForTransferFinalize,
 	)
@@ -377,9 +377,9 @@ func (i *imageTransferImpl) attemptWaitForTransferFinalize() error {
 }
 
 func (i *imageTransferImpl) waitForTransferAbort() error {
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf("waiting for aborting image transfer for disk %s", i.diskID),
-		i.logger,
 		i.retries,
 		i.attemptWaitForTransferAbort,
 	)
@@ -479,9 +479,9 @@ func (i *imageTransferImpl) verifyTransferURL(transferURL string) error {
 		return wrap(err, EUnidentified, "failed to parse transfer URL %s", transferURL)
 	}
 
-	return retry(
+	return i.cli.retry(
+		resourceOf(ResourceTypeDisk, string(i.diskID)),
 		fmt.Sprintf("sending OPTIONS request to %s", transferURL),
-		i.logger,
 		append(i.retries, MaxTries(3)),
 		func() error {
 			return i.optionsRequest(parsedTransferURL)
@@ -535,9 +535,9 @@ func (i *imageTransferImpl) optionsRequest(parsedTransferURL *url.URL) error {
 func (i *imageTransferImpl) abortTransfer() {
 	if i.transfer != nil {
 		errorHappened := false
-		if err := retry(
+		if err := i.cli.retry(
+			resourceOf(ResourceTypeDisk, string(i.diskID)),
 			fmt.Sprintf("canceling transfer for disk %s", i.diskID),
-			i.logger,
 			i.retries,
 			i.attemptAbortTransfer,
 		); err != nil {
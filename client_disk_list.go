@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListDisks(retries ...RetryStrategy) (result []Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Disk{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, ""),
 		"listing disks",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().DisksService().List().Send()
+			response, e := o.connection().SystemService().DisksService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -7,13 +7,13 @@ import (
 func (o *oVirtClient) ListDisksByAlias(alias string, retries ...RetryStrategy) (result []Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Disk{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, ""),
 		fmt.Sprintf("listing disk by alias %s", alias),
-		o.logger,
 		retries,
 		func() error {
 			searchString := fmt.Sprintf("name=%s", alias)
-			response, e := o.conn.SystemService().DisksService().List().Search(searchString).Send()
+			response, e := o.connection().SystemService().DisksService().List().Search(searchString).Send()
 			if e != nil {
 				return e
 			}
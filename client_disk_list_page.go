@@ -0,0 +1,42 @@
+package ovirtclient
+
+func (o *oVirtClient) ListDisksPage(params PageParameters, retries ...RetryStrategy) (result []Disk, err error) {
+	if err := validatePageParameters(params); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = PageParams()
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Disk{}
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, ""),
+		"listing a page of disks",
+		retries,
+		func() error {
+			req := o.connection().SystemService().DisksService().List()
+			if max := params.Max(); max != nil {
+				req.Max(int64(*max))
+			}
+			if qs := pageSearchCriteria(params); qs != "" {
+				req.Search(qs)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			result = make([]Disk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKDisk(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert disk during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
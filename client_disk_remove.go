@@ -4,15 +4,21 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveDisk(diskID string, retries ...RetryStrategy) error {
+func (o *oVirtClient) RemoveDisk(diskID DiskID, retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	return retry(
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeDisk, string(diskID)),
 		fmt.Sprintf("removing disk %s", diskID),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().DisksService().DiskService(diskID).Remove().Send()
+			req := o.connection().SystemService().DisksService().DiskService(string(diskID)).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		},
 	)
+	return withCorrelationID(err, correlationID)
 }
@@ -0,0 +1,87 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (o *oVirtClient) diskSearchCriteria(params DiskSearchParameters, retries []RetryStrategy) (string, error) {
+	var criteria []string
+	if params.Alias() != nil || params.Tag() != nil {
+		// Disks cannot be tagged, so we search for the tags of the VMs the disks are attached to.
+		nameAndTag, err := nameAndTagSearchCriteria("name", params.Alias(), "Vms.tag", params.Tag())
+		if err != nil {
+			return "", err
+		}
+		criteria = append(criteria, nameAndTag)
+	}
+	if statuses := params.Statuses(); statuses != nil {
+		if err := statuses.Validate(); err != nil {
+			return "", wrap(err, EBadArgument, "invalid value for search field statuses")
+		}
+		items := make([]string, len(*statuses))
+		for i, status := range *statuses {
+			items[i] = fmt.Sprintf("status = %s", status)
+		}
+		criteria = append(criteria, fmt.Sprintf("(%s)", strings.Join(items, " OR ")))
+	}
+	if storageDomainID := params.StorageDomainID(); storageDomainID != nil {
+		// The engine search syntax matches storage domains by name, so we look up the name first.
+		storageDomain, err := o.GetStorageDomain(*storageDomainID, retries...)
+		if err != nil {
+			return "", err
+		}
+		quotedName, err := quoteSearchString(storageDomain.Name())
+		if err != nil {
+			return "", newError(EBadArgument, "invalid storage domain search string: %s", storageDomain.Name())
+		}
+		criteria = append(criteria, fmt.Sprintf("Storages.name = %s", quotedName))
+	}
+	if attached := params.Attached(); attached != nil {
+		if *attached {
+			criteria = append(criteria, "number_of_vms > 0")
+		} else {
+			criteria = append(criteria, "number_of_vms = 0")
+		}
+	}
+	if len(criteria) == 0 {
+		return "", newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	return strings.Join(criteria, " AND "), nil
+}
+
+func (o *oVirtClient) SearchDisks(params DiskSearchParameters, retries ...RetryStrategy) (result []Disk, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Disk{}
+	qs, err := o.diskSearchCriteria(params, retries)
+	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			// The storage domain to filter for does not exist, so no disk can match.
+			return result, nil
+		}
+		return nil, err
+	}
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, ""),
+		"searching for disks",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().DisksService().List().Search(qs).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Disks()
+			if !ok {
+				return nil
+			}
+			result = make([]Disk, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKDisk(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert disk during searching item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,60 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestDiskSearch(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	disk, err := disk.WaitForOK()
+	if err != nil {
+		t.Fatalf("Failed to wait for disk %s to become OK (%v)", disk.ID(), err)
+	}
+	params := ovirtclient.DiskSearchParams().
+		WithAlias(disk.Alias()).
+		WithStatus(ovirtclient.DiskStatusOK).
+		WithStorageDomainID(helper.GetStorageDomainID()).
+		WithAttached(false)
+	disks, err := client.SearchDisks(params)
+	if err != nil {
+		t.Fatalf("Failed to search for disk (%v)", err)
+	}
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("The floating disk %s was not found.", disk.ID())
+	}
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanAttachDisk(t, vm, disk)
+	disks, err = client.SearchDisks(params)
+	if err != nil {
+		t.Fatalf("Failed to search for disk (%v)", err)
+	}
+	if len(disks) != 0 {
+		t.Fatalf("The attached disk %s was returned when searching for floating disks.", disk.ID())
+	}
+	disks, err = client.SearchDisks(params.WithAttached(true))
+	if err != nil {
+		t.Fatalf("Failed to search for disk (%v)", err)
+	}
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("The attached disk %s was not found.", disk.ID())
+	}
+}
+
+func TestDiskSearchInvalidStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.SearchDisks(ovirtclient.DiskSearchParams().WithStatus("foo"))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Searching for disks with an invalid status did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -7,43 +7,46 @@ import (
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
-func (o *oVirtClient) UpdateDisk(id string, params UpdateDiskParameters, retries ...RetryStrategy) (
+func (o *oVirtClient) UpdateDisk(id DiskID, params UpdateDiskParameters, retries ...RetryStrategy) (
 	result Disk,
 	err error,
 ) {
 	progress, err := o.StartUpdateDisk(id, params, retries...)
 	if err != nil {
-		return progress.Disk(), err
+		return nil, err
 	}
 	return progress.Wait(retries...)
 }
 
-func (o *oVirtClient) StartUpdateDisk(id string, params UpdateDiskParameters, retries ...RetryStrategy) (
+func (o *oVirtClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, retries ...RetryStrategy) (
 	DiskUpdate,
 	error,
 ) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
 
-	sdkDisk := ovirtsdk.NewDiskBuilder().Id(id)
+	sdkDisk := ovirtsdk.NewDiskBuilder().Id(string(id))
 	if alias := params.Alias(); alias != nil {
 		sdkDisk.Alias(*alias)
 	}
 	if provisionedSize := params.ProvisionedSize(); provisionedSize != nil {
 		sdkDisk.ProvisionedSize(int64(*provisionedSize))
 	}
-	correlationID := fmt.Sprintf("disk_update_%s", generateRandomID(5, o.nonSecureRandom))
+	correlationID := o.correlationIDFor(retries)
+	if correlationID == "" {
+		correlationID = fmt.Sprintf("disk_update_%s", generateRandomID(5, o.nonSecureRandom))
+	}
 
 	var disk Disk
 
-	err := retry(
+	err := o.mutate(
+		resourceOf(ResourceTypeDisk, string(id)),
 		fmt.Sprintf("updating disk %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.
+			response, err := o.connection().
 				SystemService().
 				DisksService().
-				DiskService(id).
+				DiskService(string(id)).
 				Update().
 				Disk(sdkDisk.MustBuild()).
 				Query("correlation_id", correlationID).
@@ -66,7 +69,7 @@ func (o *oVirtClient) StartUpdateDisk(id string, params UpdateDiskParameters, re
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, withCorrelationID(err, correlationID)
 	}
 	return &diskWait{
 		client:        o,
@@ -75,11 +75,10 @@ func (o *oVirtClient) StartImageUpload(
 }
 
 func (o *oVirtClient) UploadToDisk(
-	diskID string,
+	diskID DiskID,
 	size uint64,
 	reader readSeekCloser,
-	retries ...RetryStrategy,
-) error {
+	retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
 	progress, err := o.StartUploadToDisk(diskID, size, reader, retries...)
 	if err != nil {
@@ -90,11 +89,10 @@ func (o *oVirtClient) UploadToDisk(
 }
 
 func (o *oVirtClient) StartUploadToDisk(
-	diskID string,
+	diskID DiskID,
 	size uint64,
 	reader readSeekCloser,
-	retries ...RetryStrategy,
-) (UploadImageProgress, error) {
+	retries ...RetryStrategy) (UploadImageProgress, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
 	o.logger.Infof("Starting disk image upload...")
 	disk, err := o.GetDisk(diskID, retries...)
@@ -117,6 +115,13 @@ func (o *oVirtClient) StartUploadToDisk(
 			disk.TotalSize(),
 		)
 	}
+	if err := o.checkReadOnly(fmt.Sprintf("uploading image to disk %s", diskID)); err != nil {
+		return nil, err
+	}
+	if o.dryRun {
+		o.logger.Infof("Dry run: skipping uploading image to disk %s.", diskID)
+		return nil, newError(EDryRun, "dry run: skipped uploading image to disk %s", diskID)
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	progress := &uploadToDiskProgress{
 		client:        o,
@@ -196,13 +201,13 @@ func (u *uploadToDiskProgress) transfer() error {
 
 // transferImage does an HTTP request to transfer the image to the specified transfer URL.
 func (u *uploadToDiskProgress) transferImage(transfer imageTransfer, transferURL string) error {
-	return retry(
+	return u.client.retry(
+		resourceOf(ResourceTypeDisk, string(u.disk.ID())),
 		fmt.Sprintf(
 			"transferring image for disk %s via HTTP request to %s",
 			u.disk.ID(),
 			transferURL,
 		),
-		u.client.logger,
 		u.retries,
 		func() error {
 			return u.putRequest(transferURL, transfer)
@@ -315,6 +320,19 @@ func (o *oVirtClient) StartUploadToNewDisk(
 	} else if err := format.Validate(); err != nil {
 		return nil, err
 	}
+	if err := o.checkReadOnly(
+		fmt.Sprintf("uploading image to a new disk on storage domain %s", storageDomainID),
+	); err != nil {
+		return nil, err
+	}
+	if o.dryRun {
+		o.logger.Infof("Dry run: skipping uploading image to a new disk on storage domain %s.", storageDomainID)
+		return nil, newError(
+			EDryRun,
+			"dry run: skipped uploading image to a new disk on storage domain %s",
+			storageDomainID,
+		)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 
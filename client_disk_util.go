@@ -1,17 +1,27 @@
 package ovirtclient
 
-import "fmt"
+import (
+	"fmt"
+)
 
-// WaitForDiskOK waits for a disk to be in the OK status, then additionally queries the job that was in progress with
-// the correlation ID. This is necessary because the disk returns OK status before the job has actually finished,
-// resulting in a "disk locked" error on subsequent operations. It uses checkDiskOk as an underlying function.
-func (o *oVirtClient) WaitForDiskOK(diskID string, retries ...RetryStrategy) (disk Disk, err error) {
-	err = retry(
-		fmt.Sprintf("waiting for disk %s to become OK", diskID),
-		o.logger,
+func (o *oVirtClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (disk Disk, err error) {
+	return o.WaitForDiskStatus(diskID, DiskStatusOK, retries...)
+}
+
+func (o *oVirtClient) WaitForDiskStatus(diskID DiskID, status DiskStatus, retries ...RetryStrategy) (
+	disk Disk,
+	err error,
+) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, string(diskID)),
+		fmt.Sprintf("waiting for disk %s status %s", diskID, status),
 		retries,
 		func() error {
-			disk, err = o.checkDiskOK(diskID)
+			disk, err = o.checkDiskStatus(diskID, status)
 			return err
 		},
 	)
@@ -21,19 +31,28 @@ func (o *oVirtClient) WaitForDiskOK(diskID string, retries ...RetryStrategy) (di
 	return disk, nil
 }
 
-// checkDiskOK fetches the disk for the transfer and checks if it is in the OK status. It returns an EPending error if
-// it is not.
-func (o *oVirtClient) checkDiskOK(diskID string) (Disk, error) {
+// checkDiskStatus fetches the disk and checks if it is in the desired status. It returns an EPending error if the
+// disk is still changing, and an EUnexpectedDiskStatus error if it reached a different status.
+func (o *oVirtClient) checkDiskStatus(diskID DiskID, status DiskStatus) (Disk, error) {
 	disk, err := o.GetDisk(diskID)
 	if err != nil {
 		return nil, err
 	}
+	return disk, diskStatusError(disk, status)
+}
+
+// diskStatusError returns an EPending error if the disk is locked or should become locked, and an
+// EUnexpectedDiskStatus error if the disk is in a different status that it will not leave on its own.
+func diskStatusError(disk Disk, status DiskStatus) error {
 	switch disk.Status() {
-	case DiskStatusOK:
-		return disk, nil
+	case status:
+		return nil
 	case DiskStatusLocked:
-		return nil, newError(EPending, "disk status is %s, not %s", disk.Status(), DiskStatusOK)
+		return newError(EPending, "disk status is %s, not %s", disk.Status(), status)
 	default:
-		return nil, newError(EUnexpectedDiskStatus, "disk status is %s, not %s", disk.Status(), DiskStatusOK)
+		if status == DiskStatusLocked {
+			return newError(EPending, "disk status is %s, not %s", disk.Status(), status)
+		}
+		return newError(EUnexpectedDiskStatus, "disk status is %s, not %s", disk.Status(), status)
 	}
 }
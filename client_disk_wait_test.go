@@ -0,0 +1,40 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestWaitForDiskStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	disk := assertCanCreateDisk(t, helper)
+	disk, err := disk.WaitForStatus(ovirtclient.DiskStatusOK)
+	if err != nil {
+		t.Fatalf("Failed to wait for disk %s to become OK (%v)", disk.ID(), err)
+	}
+	if disk.Status() != ovirtclient.DiskStatusOK {
+		t.Fatalf("Incorrect disk status after waiting (expected: %s, got: %s)", ovirtclient.DiskStatusOK, disk.Status())
+	}
+}
+
+func TestWaitForDiskStatusUnexpected(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	disk := assertCanCreateDisk(t, helper)
+	if _, err := client.WaitForDiskOK(disk.ID()); err != nil {
+		t.Fatalf("Failed to wait for disk %s to become OK (%v)", disk.ID(), err)
+	}
+	_, err := client.WaitForDiskStatus(disk.ID(), ovirtclient.DiskStatusIllegal)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EUnexpectedDiskStatus) {
+		t.Fatalf("Waiting for an OK disk to become illegal did not fail with EUnexpectedDiskStatus (%v)", err)
+	}
+	_, err = client.WaitForDiskStatus(disk.ID(), "foo")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Waiting for an invalid disk status did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// mutate is the same as retry, but must be used for calls that change the state of the oVirt Engine. In read-only
+// mode an EReadOnly error is returned without calling the what function. In dry-run mode the what function is called
+// as usual, but the requests changing the engine are logged and fail with an EDryRun error, see dryRunTransport. If an
+// audit sink is configured, the call is recorded in all cases.
+func (o *oVirtClient) mutate(
+	resource callResource,
+	action string,
+	retries []RetryStrategy,
+	what func() error,
+) (err error) {
+	if o.auditSink != nil {
+		record := o.startAuditRecord(resource, action, retries)
+		defer func() {
+			o.finishAuditRecord(record, err)
+		}()
+	}
+	if err := o.checkReadOnly(action); err != nil {
+		return err
+	}
+	return o.runRetry(resource, action, retries, what)
+}
+
+// dryRunTransport is the transport of the API calls in dry-run mode. It logs the requests that would change the state
+// of the oVirt Engine, including their body, and fails them with an EDryRun error instead of sending them. Reads and
+// the requests to the SSO service are sent as usual, so the calls can look up the objects they work on.
+type dryRunTransport struct {
+	base   http.RoundTripper
+	logger Logger
+}
+
+func (d *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet ||
+		strings.HasPrefix(req.URL.Path, "/ovirt-engine/sso/") ||
+		strings.HasPrefix(req.URL.Path, "/ovirt-engine/services/") {
+		return d.base.RoundTrip(req)
+	}
+	body := ""
+	if req.Body != nil {
+		data, err := ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, wrap(err, EUnidentified, "failed to read the request body in dry-run mode")
+		}
+		body = string(data)
+	}
+	if isFenceStatusRequest(req, body) {
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(strings.NewReader(body))
+		return d.base.RoundTrip(req)
+	}
+	d.logger.Infof("Dry run: skipping %s %s with request body: %s", req.Method, req.URL.String(), body)
+	return nil, newError(EDryRun, "dry run: %s %s was not sent to the oVirt Engine", req.Method, req.URL.Path)
+}
+
+// isFenceStatusRequest returns true if the request queries the power status of a host. This is sent to the fence
+// action like the other fence types, but does not change the host.
+func isFenceStatusRequest(req *http.Request, body string) bool {
+	return strings.HasSuffix(req.URL.Path, "/fence") &&
+		strings.Contains(body, "<fence_type>"+string(FenceTypeStatus)+"</fence_type>")
+}
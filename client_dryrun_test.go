@@ -0,0 +1,178 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+	engine := newDryRunEngine()
+	server := httptest.NewServer(engine)
+	defer server.Close()
+	logger := &recordingLogger{lock: &sync.Mutex{}}
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		logger,
+		&dryRunExtraSettings{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	vm, err := client.CreateVM("cluster", ovirtclient.DefaultBlankTemplateID, "dry-run-test", nil)
+	if err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EDryRun) {
+		t.Fatalf("creating a VM in dry-run mode did not return an EDryRun error (%v)", err)
+	}
+	if vm != nil {
+		t.Fatalf("creating a VM in dry-run mode returned a VM")
+	}
+	if !logger.contains("<name>dry-run-test</name>") {
+		t.Fatalf("the request body of the VM creation was not logged")
+	}
+	if err := client.RemoveVM("vm"); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EDryRun) {
+		t.Fatalf("removing a VM in dry-run mode did not return an EDryRun error (%v)", err)
+	}
+	disk, err := client.CreateDisk("storage-domain", ovirtclient.ImageFormatRaw, 512, nil)
+	if err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EDryRun) || disk != nil {
+		t.Fatalf("creating a disk in dry-run mode did not return an EDryRun error (%v)", err)
+	}
+	if _, err := client.CreateVM(
+		"cluster",
+		ovirtclient.DefaultBlankTemplateID,
+		"",
+		nil,
+	); err == nil || !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("the parameters were not validated in dry-run mode (%v)", err)
+	}
+
+	status, err := client.FenceHost("host", ovirtclient.FenceTypeStatus)
+	if err != nil || status != ovirtclient.HostPowerStatusOn {
+		t.Fatalf("querying the power status in dry-run mode failed (%s, %v)", status, err)
+	}
+	if changes := engine.getChanges(); len(changes) != 1 || changes[0] != "POST /ovirt-engine/api/hosts/host/fence" {
+		t.Fatalf("requests other than the power status query reached the engine in dry-run mode (%v)", changes)
+	}
+}
+
+func TestDryRunAuditRecord(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(newDryRunEngine())
+	defer server.Close()
+	sink := &tokenAuditSink{lock: &sync.Mutex{}}
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		&recordingLogger{lock: &sync.Mutex{}},
+		ovirtclient.NewExtraSettings().WithDryRun().WithAuditSink(sink),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	if err := client.AddTagToVM("vm1", "tag1"); !ovirtclient.HasErrorCode(err, ovirtclient.EDryRun) {
+		t.Fatalf("adding a tag to a VM in dry-run mode did not return an EDryRun error (%v)", err)
+	}
+	records := sink.getRecords()
+	if len(records) != 1 {
+		t.Fatalf("incorrect number of audit records (expected: 1, got: %d)", len(records))
+	}
+	record := records[0]
+	if record.Operation() != "AddTagToVM" || !record.DryRun() {
+		t.Fatalf("incorrect call recorded (%s, %t)", record.Operation(), record.DryRun())
+	}
+	if record.ResourceType() != ovirtclient.ResourceTypeVM || record.ResourceID() != "vm1" {
+		t.Fatalf("incorrect resource recorded (%s, %s)", record.ResourceType(), record.ResourceID())
+	}
+	if parameters := record.Parameters(); len(parameters) != 1 || parameters["tag_id"] != "tag1" {
+		t.Fatalf("incorrect parameters recorded (%v)", parameters)
+	}
+}
+
+type dryRunExtraSettings struct {
+	proxyExtraSettings
+}
+
+func (d *dryRunExtraSettings) DryRun() bool {
+	return true
+}
+
+// dryRunEngine is a fake oVirt Engine recording the requests other than reads and logins it receives. It only
+// answers the logins and power status queries.
+type dryRunEngine struct {
+	lock    *sync.Mutex
+	changes []string
+}
+
+func newDryRunEngine() *dryRunEngine {
+	return &dryRunEngine{
+		lock: &sync.Mutex{},
+	}
+}
+
+func (e *dryRunEngine) getChanges() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return append([]string{}, e.changes...)
+}
+
+func (e *dryRunEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/ovirt-engine/sso/oauth/token" {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token"}`))
+		return
+	}
+	if r.Method != http.MethodGet {
+		e.lock.Lock()
+		e.changes = append(e.changes, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		e.lock.Unlock()
+	}
+	if strings.HasSuffix(r.URL.Path, "/fence") {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<action><power_management><status>on</status></power_management></action>`))
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// recordingLogger records the messages logged on the info level.
+type recordingLogger struct {
+	lock     *sync.Mutex
+	messages []string
+}
+
+func (r *recordingLogger) Debugf(_ string, _ ...interface{}) {}
+
+func (r *recordingLogger) Infof(format string, args ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Warningf(_ string, _ ...interface{}) {}
+
+func (r *recordingLogger) Errorf(_ string, _ ...interface{}) {}
+
+func (r *recordingLogger) contains(text string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, message := range r.messages {
+		if strings.Contains(message, text) {
+			return true
+		}
+	}
+	return false
+}
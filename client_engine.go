@@ -0,0 +1,198 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EngineClient contains the functions to query the oVirt Engine itself.
+type EngineClient interface {
+	// GetEngineVersion returns the version of the oVirt Engine. The version is fetched on the first call and cached
+	// until the client logs in again or fails over to a different engine URL. Use the Supports function of the
+	// returned version to check if a feature is available before using it.
+	GetEngineVersion(retries ...RetryStrategy) (EngineVersion, error)
+	// GetEngineCACertificate returns the PEM encoded CA certificate of the oVirt Engine. The engine CA signs the
+	// certificates of the hosts, so SPICE and VNC console clients need it to verify the TLS connection to a host.
+	GetEngineCACertificate(retries ...RetryStrategy) ([]byte, error)
+}
+
+// EngineVersion is the version of the oVirt Engine.
+type EngineVersion interface {
+	// Major returns the major version number, for example 4 in 4.4.10.
+	Major() uint
+	// Minor returns the minor version number, for example 4 in 4.4.10.
+	Minor() uint
+	// Build returns the build number, for example 10 in 4.4.10.
+	Build() uint
+	// Revision returns the revision number, if any.
+	Revision() uint
+	// FullVersion returns the full version string as reported by the engine, for example 4.4.10.7-1.el8.
+	FullVersion() string
+	// AtLeast returns true if the version is equal to or newer than the specified major, minor and build number.
+	AtLeast(major uint, minor uint, build uint) bool
+	// Supports returns true if the engine version supports the specified feature. It returns false for unknown
+	// features.
+	Supports(feature EngineFeature) bool
+}
+
+// EngineFeature is a feature that is only available in newer oVirt Engine versions.
+type EngineFeature string
+
+const (
+	// EngineFeatureIncrementalBackup is the backup API supporting incremental backups of VM disks.
+	EngineFeatureIncrementalBackup EngineFeature = "incremental_backup"
+	// EngineFeatureAutoPinning is the automatic CPU and NUMA pinning of VMs, used by
+	// AutoOptimizeVMCPUPinningSettings.
+	EngineFeatureAutoPinning EngineFeature = "auto_pinning"
+)
+
+// Validate returns an error if the engine feature is not known.
+func (f EngineFeature) Validate() error {
+	for _, feature := range EngineFeatureValues() {
+		if feature == f {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid engine feature: %s must be one of: %s",
+		f,
+		strings.Join(EngineFeatureValues().Strings(), ", "),
+	)
+}
+
+// minimumVersion returns the oldest engine version supporting the feature as major, minor and build number. The ok
+// return value is false for unknown features.
+func (f EngineFeature) minimumVersion() (major uint, minor uint, build uint, ok bool) {
+	switch f {
+	case EngineFeatureIncrementalBackup:
+		return 4, 4, 0, true
+	case EngineFeatureAutoPinning:
+		return 4, 4, 5, true
+	default:
+		return 0, 0, 0, false
+	}
+}
+
+// EngineFeatureList is a list of EngineFeature values.
+type EngineFeatureList []EngineFeature
+
+// Strings creates a string list of the values.
+func (l EngineFeatureList) Strings() []string {
+	result := make([]string, len(l))
+	for i, feature := range l {
+		result[i] = string(feature)
+	}
+	return result
+}
+
+// EngineFeatureValues returns all possible EngineFeature values.
+func EngineFeatureValues() EngineFeatureList {
+	return []EngineFeature{
+		EngineFeatureIncrementalBackup,
+		EngineFeatureAutoPinning,
+	}
+}
+
+type engineVersion struct {
+	major       uint
+	minor       uint
+	build       uint
+	revision    uint
+	fullVersion string
+}
+
+func (e *engineVersion) Major() uint {
+	return e.major
+}
+
+func (e *engineVersion) Minor() uint {
+	return e.minor
+}
+
+func (e *engineVersion) Build() uint {
+	return e.build
+}
+
+func (e *engineVersion) Revision() uint {
+	return e.revision
+}
+
+func (e *engineVersion) FullVersion() string {
+	return e.fullVersion
+}
+
+func (e *engineVersion) AtLeast(major uint, minor uint, build uint) bool {
+	if e.major != major {
+		return e.major > major
+	}
+	if e.minor != minor {
+		return e.minor > minor
+	}
+	return e.build >= build
+}
+
+func (e *engineVersion) Supports(feature EngineFeature) bool {
+	major, minor, build, ok := feature.minimumVersion()
+	if !ok {
+		return false
+	}
+	return e.AtLeast(major, minor, build)
+}
+
+// checkSupports returns an EUnsupported error if the engine version does not support the feature.
+func (e *engineVersion) checkSupports(feature EngineFeature) error {
+	if e.Supports(feature) {
+		return nil
+	}
+	major, minor, build, ok := feature.minimumVersion()
+	if !ok {
+		return newError(EBug, "unknown engine feature: %s", feature)
+	}
+	return newError(
+		EUnsupported,
+		"the %s feature requires oVirt Engine %d.%d.%d or newer, but the engine is running version %s",
+		feature,
+		major,
+		minor,
+		build,
+		e.fullVersion,
+	)
+}
+
+// requireFeature returns an EUnsupported error if the oVirt Engine does not support the feature. This lets calls
+// relying on newer engine features fail with a clear error instead of an opaque HTTP error from the engine.
+func (o *oVirtClient) requireFeature(feature EngineFeature, retries []RetryStrategy) error {
+	version, err := o.GetEngineVersion(retries...)
+	if err != nil {
+		return err
+	}
+	return version.(*engineVersion).checkSupports(feature)
+}
+
+func convertSDKEngineVersion(sdkObject *ovirtsdk.Version) (*engineVersion, error) {
+	major, ok := sdkObject.Major()
+	if !ok {
+		return nil, newFieldNotFound("engine version", "major")
+	}
+	minor, ok := sdkObject.Minor()
+	if !ok {
+		return nil, newFieldNotFound("engine version", "minor")
+	}
+	// The following fields are optional and not always returned by the engine.
+	build, _ := sdkObject.Build_()
+	revision, _ := sdkObject.Revision()
+	fullVersion, ok := sdkObject.FullVersion()
+	if !ok {
+		fullVersion = fmt.Sprintf("%d.%d.%d", major, minor, build)
+	}
+	return &engineVersion{
+		major:       uint(major),
+		minor:       uint(minor),
+		build:       uint(build),
+		revision:    uint(revision),
+		fullVersion: fullVersion,
+	}, nil
+}
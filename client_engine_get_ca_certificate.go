@@ -0,0 +1,54 @@
+package ovirtclient
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+func (o *oVirtClient) GetEngineCACertificate(retries ...RetryStrategy) (result []byte, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeEngine, ""),
+		"fetching the engine CA certificate",
+		retries,
+		func() error {
+			caURL := engineCACertificateURL(o.GetURL())
+			req, e := http.NewRequest(http.MethodGet, caURL, nil)
+			if e != nil {
+				return wrap(e, EBug, "failed to create HTTP request to %s", caURL)
+			}
+			response, e := o.httpClient.Do(req)
+			if e != nil {
+				return wrap(e, EConnection, "HTTP request to %s failed", caURL)
+			}
+			defer func() {
+				_ = response.Body.Close()
+			}()
+			if response.StatusCode != http.StatusOK {
+				return newError(
+					EPermanentHTTPError,
+					"unexpected status code (%d) received while fetching the engine CA certificate",
+					response.StatusCode,
+				)
+			}
+			body, e := ioutil.ReadAll(response.Body)
+			if e != nil {
+				return wrap(e, EConnection, "failed to read the engine CA certificate")
+			}
+			if block, _ := pem.Decode(body); block == nil || block.Type != "CERTIFICATE" {
+				return newError(ENotAnOVirtEngine, "the response from %s is not a PEM encoded certificate", caURL)
+			}
+			result = body
+			return nil
+		})
+	return
+}
+
+// engineCACertificateURL returns the URL of the PKI resource serving the engine CA certificate. The engine URL points
+// to the API, which is a sibling of the PKI resource.
+func engineCACertificateURL(engineURL string) string {
+	base := strings.TrimSuffix(strings.TrimSuffix(engineURL, "/"), "/api")
+	return base + "/services/pki-resource?resource=ca-certificate&format=X509-PEM-CA"
+}
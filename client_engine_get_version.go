@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) GetEngineVersion(retries ...RetryStrategy) (EngineVersion, error) {
+	conn := o.connection()
+	if cached := o.cachedEngineVersion(conn); cached != nil {
+		return cached, nil
+	}
+
+	var result *engineVersion
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err := o.retry(
+		resourceOf(ResourceTypeEngine, ""),
+		"getting oVirt Engine version",
+		retries,
+		func() error {
+			response, err := o.connection().SystemService().Get().Send()
+			if err != nil {
+				return err
+			}
+			api, ok := response.Api()
+			if !ok {
+				return newError(EFieldMissing, "no API information returned when getting the engine version")
+			}
+			productInfo, ok := api.ProductInfo()
+			if !ok {
+				return newFieldNotFound("API", "product info")
+			}
+			sdkVersion, ok := productInfo.Version()
+			if !ok {
+				return newFieldNotFound("product info", "version")
+			}
+			result, err = convertSDKEngineVersion(sdkVersion)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert engine version")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	o.engineVersionLock.Lock()
+	defer o.engineVersionLock.Unlock()
+	o.engineVersion = result
+	o.engineVersionConn = conn
+	return result, nil
+}
+
+// cachedEngineVersion returns the cached engine version if it was fetched through the specified connection, nil
+// otherwise.
+func (o *oVirtClient) cachedEngineVersion(conn *ovirtsdk4.Connection) *engineVersion {
+	o.engineVersionLock.Lock()
+	defer o.engineVersionLock.Unlock()
+	if o.engineVersionConn != conn {
+		return nil
+	}
+	return o.engineVersion
+}
+
+// resetEngineVersion clears the cached engine version. It is called when the connection is replaced, since the new
+// connection may lead to an engine running a different version.
+func (o *oVirtClient) resetEngineVersion() {
+	o.engineVersionLock.Lock()
+	defer o.engineVersionLock.Unlock()
+	o.engineVersion = nil
+	o.engineVersionConn = nil
+}
@@ -0,0 +1,103 @@
+// This file contains tests for the internal engine version handling. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"testing"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+func TestEngineVersionAtLeast(t *testing.T) {
+	t.Parallel()
+	version := &engineVersion{major: 4, minor: 4, build: 5, fullVersion: "4.4.5.11-1.el8"}
+	testCases := []struct {
+		major    uint
+		minor    uint
+		build    uint
+		expected bool
+	}{
+		{4, 4, 5, true},
+		{4, 4, 4, true},
+		{4, 3, 10, true},
+		{3, 6, 0, true},
+		{4, 4, 6, false},
+		{4, 5, 0, false},
+		{5, 0, 0, false},
+	}
+	for _, testCase := range testCases {
+		if result := version.AtLeast(testCase.major, testCase.minor, testCase.build); result != testCase.expected {
+			t.Fatalf(
+				"incorrect result for %s at least %d.%d.%d (expected: %t, got: %t)",
+				version.FullVersion(),
+				testCase.major,
+				testCase.minor,
+				testCase.build,
+				testCase.expected,
+				result,
+			)
+		}
+	}
+}
+
+func TestEngineVersionSupports(t *testing.T) {
+	t.Parallel()
+	oldVersion := &engineVersion{major: 4, minor: 3, build: 10, fullVersion: "4.3.10.4-1.el7"}
+	newVersion := &engineVersion{major: 4, minor: 5, build: 0, fullVersion: "4.5.0.8-1.el8"}
+	for _, feature := range EngineFeatureValues() {
+		if oldVersion.Supports(feature) {
+			t.Fatalf("engine version %s incorrectly supports %s", oldVersion.FullVersion(), feature)
+		}
+		if !newVersion.Supports(feature) {
+			t.Fatalf("engine version %s does not support %s", newVersion.FullVersion(), feature)
+		}
+	}
+	if newVersion.Supports("nonexistent") {
+		t.Fatalf("engine version %s supports an unknown feature", newVersion.FullVersion())
+	}
+}
+
+func TestUnsupportedFeatureRejected(t *testing.T) {
+	t.Parallel()
+	o := &oVirtClient{
+		logger:        &noopLogger{},
+		engineVersion: &engineVersion{major: 4, minor: 3, build: 10, fullVersion: "4.3.10.4-1.el7"},
+	}
+	err := o.AutoOptimizeVMCPUPinningSettings("vm-id", true)
+	if !HasErrorCode(err, EUnsupported) {
+		t.Fatalf("calling a function unsupported by the engine did not return an EUnsupported error (%v)", err)
+	}
+}
+
+func TestEngineVersionClearedOnReconnect(t *testing.T) {
+	t.Parallel()
+	newConnection := func() (*ovirtsdk4.Connection, error) {
+		return ovirtsdk4.NewConnectionBuilder().
+			URL("https://engine.invalid/ovirt-engine/api").
+			Username("admin@internal").
+			Password("password").
+			Insecure(true).
+			Build()
+	}
+	conn, err := newConnection()
+	if err != nil {
+		t.Fatalf("failed to create connection (%v)", err)
+	}
+	o := &oVirtClient{
+		logger:            &noopLogger{},
+		conn:              conn,
+		connect:           newConnection,
+		engineVersion:     &engineVersion{major: 4, minor: 3, build: 10, fullVersion: "4.3.10.4-1.el7"},
+		engineVersionConn: conn,
+	}
+	if o.cachedEngineVersion(conn) == nil {
+		t.Fatalf("the engine version cached for the current connection was not returned")
+	}
+	if err := o.reconnect(conn); err != nil {
+		t.Fatalf("failed to reconnect (%v)", err)
+	}
+	if o.cachedEngineVersion(o.connection()) != nil {
+		t.Fatalf("the cached engine version was not cleared after reconnecting")
+	}
+}
@@ -0,0 +1,169 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ErrataClient lists the Katello errata applicable to VMs and hosts. Errata are only available if the engine is
+// integrated with a Satellite or Foreman/Katello server and the VM or host is registered as a content host there.
+// Otherwise, the lists are empty.
+type ErrataClient interface {
+	// ListVMErrata lists the errata applicable to the VM with the specified ID.
+	ListVMErrata(vmID VMID, retries ...RetryStrategy) ([]Erratum, error)
+	// ListHostErrata lists the errata applicable to the host with the specified ID.
+	ListHostErrata(hostID string, retries ...RetryStrategy) ([]Erratum, error)
+}
+
+// Erratum is a Katello erratum, an update for a set of packages that fixes bugs or security issues or adds
+// enhancements.
+type Erratum interface {
+	// ID returns the identifier of the erratum in Katello.
+	ID() string
+	// Name returns the advisory name of the erratum, for example RHSA-2021:3058.
+	Name() string
+	// Title returns the short description of the erratum.
+	Title() string
+	// Type returns the type of the erratum, for example security.
+	Type() ErratumType
+	// Severity returns the severity of the erratum, for example Important. It is empty for errata without a
+	// severity, such as bug fixes.
+	Severity() string
+	// Summary returns the summary of the changes in the erratum.
+	Summary() string
+	// Solution returns the instructions for applying the erratum.
+	Solution() string
+	// Issued returns the time the erratum was issued. It returns the zero time if Katello did not report it.
+	Issued() time.Time
+	// Packages returns the names of the packages updated by the erratum.
+	Packages() []string
+}
+
+// ErratumType is the type of Katello erratum.
+type ErratumType string
+
+const (
+	// ErratumTypeSecurity is an erratum fixing a security issue.
+	ErratumTypeSecurity ErratumType = "security"
+	// ErratumTypeBugfix is an erratum fixing bugs.
+	ErratumTypeBugfix ErratumType = "bugfix"
+	// ErratumTypeEnhancement is an erratum adding new features.
+	ErratumTypeEnhancement ErratumType = "enhancement"
+)
+
+// Validate returns an error if the erratum type is not known.
+func (e ErratumType) Validate() error {
+	for _, erratumType := range ErratumTypeValues() {
+		if erratumType == e {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid erratum type: %s must be one of: %s",
+		e,
+		strings.Join(ErratumTypeValues().Strings(), ", "),
+	)
+}
+
+// ErratumTypeList is a list of ErratumType values.
+type ErratumTypeList []ErratumType
+
+// Strings creates a string list of the values.
+func (l ErratumTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, erratumType := range l {
+		result[i] = string(erratumType)
+	}
+	return result
+}
+
+// ErratumTypeValues returns all possible ErratumType values.
+func ErratumTypeValues() ErratumTypeList {
+	return []ErratumType{
+		ErratumTypeSecurity,
+		ErratumTypeBugfix,
+		ErratumTypeEnhancement,
+	}
+}
+
+func convertSDKErratum(sdkObject *ovirtsdk.KatelloErratum) (*erratum, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("erratum", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("erratum", "name")
+	}
+	result := &erratum{
+		id:       id,
+		name:     name,
+		packages: []string{},
+	}
+	result.title, _ = sdkObject.Title()
+	erratumType, _ := sdkObject.Type()
+	result.erratumType = ErratumType(erratumType)
+	result.severity, _ = sdkObject.Severity()
+	result.summary, _ = sdkObject.Summary()
+	result.solution, _ = sdkObject.Solution()
+	result.issued, _ = sdkObject.Issued()
+	if sdkPackages, ok := sdkObject.Packages(); ok {
+		for _, sdkPackage := range sdkPackages.Slice() {
+			if packageName, ok := sdkPackage.Name(); ok {
+				result.packages = append(result.packages, packageName)
+			}
+		}
+	}
+	return result, nil
+}
+
+type erratum struct {
+	id          string
+	name        string
+	title       string
+	erratumType ErratumType
+	severity    string
+	summary     string
+	solution    string
+	issued      time.Time
+	packages    []string
+}
+
+func (e *erratum) ID() string {
+	return e.id
+}
+
+func (e *erratum) Name() string {
+	return e.name
+}
+
+func (e *erratum) Title() string {
+	return e.title
+}
+
+func (e *erratum) Type() ErratumType {
+	return e.erratumType
+}
+
+func (e *erratum) Severity() string {
+	return e.severity
+}
+
+func (e *erratum) Summary() string {
+	return e.summary
+}
+
+func (e *erratum) Solution() string {
+	return e.solution
+}
+
+func (e *erratum) Issued() time.Time {
+	return e.issued
+}
+
+func (e *erratum) Packages() []string {
+	return e.packages
+}
@@ -0,0 +1,64 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListVMErrata(vmID VMID, retries ...RetryStrategy) ([]Erratum, error) {
+	return o.listErrata(
+		resourceOf(ResourceTypeVM, string(vmID)),
+		fmt.Sprintf("listing errata of VM %s", vmID),
+		func() *ovirtsdk.KatelloErrataService {
+			return o.connection().SystemService().VmsService().VmService(string(vmID)).KatelloErrataService()
+		},
+		retries,
+	)
+}
+
+func (o *oVirtClient) ListHostErrata(hostID string, retries ...RetryStrategy) ([]Erratum, error) {
+	return o.listErrata(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing errata of host %s", hostID),
+		func() *ovirtsdk.KatelloErrataService {
+			return o.connection().SystemService().HostsService().HostService(hostID).KatelloErrataService()
+		},
+		retries,
+	)
+}
+
+// listErrata lists the errata of the service returned by getService for the resource. The service is fetched on each
+// attempt since the connection may be replaced between retries.
+func (o *oVirtClient) listErrata(
+	resource callResource,
+	action string,
+	getService func() *ovirtsdk.KatelloErrataService,
+	retries []RetryStrategy,
+) (result []Erratum, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Erratum{}
+	err = o.retry(
+		resource,
+		action,
+		retries,
+		func() error {
+			response, e := getService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Errata()
+			if !ok {
+				return nil
+			}
+			result = make([]Erratum, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKErratum(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert erratum during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
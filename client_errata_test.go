@@ -0,0 +1,53 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestErrataListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		errata, err := host.ListErrata()
+		if err != nil {
+			t.Fatalf("failed to list errata of host %s (%v)", host.ID(), err)
+		}
+		for _, erratum := range errata {
+			assertValidErratum(t, erratum)
+		}
+	}
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("errata_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	errata, err := vm.ListErrata()
+	if err != nil {
+		t.Fatalf("failed to list errata of VM %s (%v)", vm.ID(), err)
+	}
+	for _, erratum := range errata {
+		assertValidErratum(t, erratum)
+	}
+}
+
+func assertValidErratum(t *testing.T, erratum ovirtclient.Erratum) {
+	if erratum.ID() == "" || erratum.Name() == "" {
+		t.Fatalf("erratum without ID or name returned")
+	}
+	if erratum.Type() != "" {
+		if err := erratum.Type().Validate(); err != nil {
+			t.Fatalf("invalid type on erratum %s (%v)", erratum.Name(), err)
+		}
+	}
+}
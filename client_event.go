@@ -0,0 +1,465 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// EventClient describes the functions related to the audit log events of the oVirt Engine. Events can be used to react
+// to changes, such as VM lifecycle changes, without polling each object individually.
+type EventClient interface {
+	// ListEvents lists the events matching the params. Use EventListParams to obtain a builder for the params. Events
+	// are returned in ascending order of their index.
+	ListEvents(params EventListParameters, retries ...RetryStrategy) ([]Event, error)
+	// SubscribeEvents starts polling the engine for new events matching the params every pollInterval and delivers
+	// them on the channel returned by the subscription. If no starting index is set in params, only events created
+	// after the subscription was started are delivered. The retries are used for each poll. The subscription must be
+	// closed when no longer needed.
+	SubscribeEvents(
+		params EventListParameters,
+		pollInterval time.Duration,
+		retries ...RetryStrategy,
+	) (EventSubscription, error)
+	// AddEvent adds an external event to the audit log of the engine, for example to leave a trail of the actions
+	// taken by an automation. The customID must be unique among the events of the same origin. The params can be used
+	// to set the origin and to relate the event to a VM or host, and may be nil.
+	AddEvent(
+		severity EventSeverity,
+		description string,
+		customID int64,
+		params AddEventOptionalParameters,
+		retries ...RetryStrategy,
+	) (Event, error)
+}
+
+// EventData is the core of Event, providing only the data access functions.
+type EventData interface {
+	// ID returns the unique identifier of the event.
+	ID() string
+	// Index returns the sequence number of the event. Newer events have a higher index.
+	Index() int64
+	// Code returns the engine-defined event code, which identifies the type of the event.
+	Code() int64
+	// Description returns the human-readable description of the event.
+	Description() string
+	// Severity returns the severity of the event.
+	Severity() EventSeverity
+	// Time returns the time the event was created.
+	Time() time.Time
+	// CorrelationID returns the correlation ID of the operation that triggered this event, if any.
+	CorrelationID() string
+	// VMID returns the ID of the VM this event relates to, or an empty string if it doesn't relate to a VM.
+	VMID() VMID
+	// HostID returns the ID of the host this event relates to, or an empty string if it doesn't relate to a host.
+	HostID() string
+	// ClusterID returns the ID of the cluster this event relates to, or an empty string if it doesn't relate to a
+	// cluster.
+	ClusterID() ClusterID
+	// Origin returns the name of the application that added the event. Events created by the engine itself have the
+	// origin "oVirt".
+	Origin() string
+	// CustomID returns the ID set by the application that added an external event, or -1 for events created by the
+	// engine.
+	CustomID() int64
+}
+
+// Event is an audit log event of the oVirt Engine.
+type Event interface {
+	EventData
+
+	// VM fetches the VM this event relates to. If the event doesn't relate to a VM, an ENotFound error is returned.
+	// This is a network call and may be slow.
+	VM(retries ...RetryStrategy) (VM, error)
+}
+
+// EventSeverity is the severity of an event.
+type EventSeverity string
+
+const (
+	// EventSeverityNormal is an informational event.
+	EventSeverityNormal EventSeverity = "normal"
+	// EventSeverityWarning is an event that may require attention.
+	EventSeverityWarning EventSeverity = "warning"
+	// EventSeverityError is an event indicating a failure.
+	EventSeverityError EventSeverity = "error"
+	// EventSeverityAlert is an event indicating a failure that requires immediate attention.
+	EventSeverityAlert EventSeverity = "alert"
+)
+
+// Validate returns an error if the event severity is not valid.
+func (s EventSeverity) Validate() error {
+	for _, severity := range EventSeverityValues() {
+		if severity == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid event severity: %s must be one of: %s",
+		s,
+		strings.Join(EventSeverityValues().Strings(), ", "),
+	)
+}
+
+// EventSeverityList is a list of EventSeverity values.
+type EventSeverityList []EventSeverity
+
+// Strings creates a string list of the values.
+func (l EventSeverityList) Strings() []string {
+	result := make([]string, len(l))
+	for i, s := range l {
+		result[i] = string(s)
+	}
+	return result
+}
+
+// EventSeverityValues returns all possible EventSeverity values.
+func EventSeverityValues() EventSeverityList {
+	return []EventSeverity{
+		EventSeverityNormal,
+		EventSeverityWarning,
+		EventSeverityError,
+		EventSeverityAlert,
+	}
+}
+
+// EventListParameters describes the parameters for listing events.
+type EventListParameters interface {
+	// From returns the index after which events should be returned. If nil, events are returned from the beginning.
+	From() *int64
+	// Search returns a query in the engine search syntax, for example "severity>normal".
+	Search() *string
+	// Max returns the maximum number of events to return.
+	Max() *uint
+}
+
+// BuildableEventListParameters is a buildable version of EventListParameters.
+type BuildableEventListParameters interface {
+	EventListParameters
+
+	// WithFrom sets the index after which events should be returned.
+	WithFrom(index int64) (BuildableEventListParameters, error)
+	// MustWithFrom is identical to WithFrom, but panics instead of returning an error.
+	MustWithFrom(index int64) BuildableEventListParameters
+	// WithSearch sets a query in the engine search syntax.
+	WithSearch(query string) (BuildableEventListParameters, error)
+	// MustWithSearch is identical to WithSearch, but panics instead of returning an error.
+	MustWithSearch(query string) BuildableEventListParameters
+	// WithMax sets the maximum number of events to return. It must be at least 1.
+	WithMax(max uint) (BuildableEventListParameters, error)
+	// MustWithMax is identical to WithMax, but panics instead of returning an error.
+	MustWithMax(max uint) BuildableEventListParameters
+}
+
+// EventListParams creates a buildable set of parameters for listing events.
+func EventListParams() BuildableEventListParameters {
+	return &eventListParams{}
+}
+
+type eventListParams struct {
+	from   *int64
+	search *string
+	max    *uint
+}
+
+func (e *eventListParams) From() *int64 {
+	return e.from
+}
+
+func (e *eventListParams) Search() *string {
+	return e.search
+}
+
+func (e *eventListParams) Max() *uint {
+	return e.max
+}
+
+func (e *eventListParams) WithFrom(index int64) (BuildableEventListParameters, error) {
+	if index < 0 {
+		return nil, newError(EBadArgument, "the event index must not be negative")
+	}
+	e.from = &index
+	return e, nil
+}
+
+func (e *eventListParams) MustWithFrom(index int64) BuildableEventListParameters {
+	builder, err := e.WithFrom(index)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithSearch(query string) (BuildableEventListParameters, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, newError(EBadArgument, "the event search query must not be empty")
+	}
+	e.search = &query
+	return e, nil
+}
+
+func (e *eventListParams) MustWithSearch(query string) BuildableEventListParameters {
+	builder, err := e.WithSearch(query)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *eventListParams) WithMax(max uint) (BuildableEventListParameters, error) {
+	if max == 0 {
+		return nil, newError(EBadArgument, "the maximum number of events must be at least 1")
+	}
+	e.max = &max
+	return e, nil
+}
+
+func (e *eventListParams) MustWithMax(max uint) BuildableEventListParameters {
+	builder, err := e.WithMax(max)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// defaultEventOrigin is the origin of events added by AddEvent if no origin is specified.
+const defaultEventOrigin = "go-ovirt-client"
+
+// AddEventOptionalParameters are the optional parameters for adding an external event.
+type AddEventOptionalParameters interface {
+	// Origin returns the name of the application adding the event. If nil, "go-ovirt-client" is used.
+	Origin() *string
+	// VMID returns the ID of the VM the event relates to, if any.
+	VMID() *VMID
+	// HostID returns the ID of the host the event relates to, if any.
+	HostID() *string
+}
+
+// BuildableAddEventParameters is a buildable version of AddEventOptionalParameters.
+type BuildableAddEventParameters interface {
+	AddEventOptionalParameters
+
+	// WithOrigin sets the name of the application adding the event. It must not be empty.
+	WithOrigin(origin string) (BuildableAddEventParameters, error)
+	// MustWithOrigin is identical to WithOrigin, but panics instead of returning an error.
+	MustWithOrigin(origin string) BuildableAddEventParameters
+	// WithVMID relates the event to the specified VM.
+	WithVMID(vmID VMID) (BuildableAddEventParameters, error)
+	// MustWithVMID is identical to WithVMID, but panics instead of returning an error.
+	MustWithVMID(vmID VMID) BuildableAddEventParameters
+	// WithHostID relates the event to the specified host.
+	WithHostID(hostID string) (BuildableAddEventParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID string) BuildableAddEventParameters
+}
+
+// AddEventParams creates a buildable set of optional parameters for adding an external event.
+func AddEventParams() BuildableAddEventParameters {
+	return &addEventParams{}
+}
+
+type addEventParams struct {
+	origin *string
+	vmID   *VMID
+	hostID *string
+}
+
+func (a *addEventParams) Origin() *string {
+	return a.origin
+}
+
+func (a *addEventParams) VMID() *VMID {
+	return a.vmID
+}
+
+func (a *addEventParams) HostID() *string {
+	return a.hostID
+}
+
+func (a *addEventParams) WithOrigin(origin string) (BuildableAddEventParameters, error) {
+	if strings.TrimSpace(origin) == "" {
+		return nil, newError(EBadArgument, "the event origin must not be empty")
+	}
+	a.origin = &origin
+	return a, nil
+}
+
+func (a *addEventParams) MustWithOrigin(origin string) BuildableAddEventParameters {
+	builder, err := a.WithOrigin(origin)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (a *addEventParams) WithVMID(vmID VMID) (BuildableAddEventParameters, error) {
+	if vmID == "" {
+		return nil, newError(EBadArgument, "the VM ID must not be empty")
+	}
+	a.vmID = &vmID
+	return a, nil
+}
+
+func (a *addEventParams) MustWithVMID(vmID VMID) BuildableAddEventParameters {
+	builder, err := a.WithVMID(vmID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (a *addEventParams) WithHostID(hostID string) (BuildableAddEventParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "the host ID must not be empty")
+	}
+	a.hostID = &hostID
+	return a, nil
+}
+
+func (a *addEventParams) MustWithHostID(hostID string) BuildableAddEventParameters {
+	builder, err := a.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateAddEvent checks the required parameters of AddEvent and returns the origin to use.
+func validateAddEvent(severity EventSeverity, description string, params AddEventOptionalParameters) (string, error) {
+	if err := severity.Validate(); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(description) == "" {
+		return "", newError(EBadArgument, "the event description must not be empty")
+	}
+	if params != nil && params.Origin() != nil {
+		return *params.Origin(), nil
+	}
+	return defaultEventOrigin, nil
+}
+
+func convertSDKEvent(sdkObject *ovirtsdk.Event, client Client) (Event, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("event", "id")
+	}
+	index, ok := sdkObject.Index()
+	if !ok {
+		return nil, newFieldNotFound("event", "index")
+	}
+	code, ok := sdkObject.Code()
+	if !ok {
+		return nil, newFieldNotFound("event", "code")
+	}
+	severity, ok := sdkObject.Severity()
+	if !ok {
+		return nil, newFieldNotFound("event", "severity")
+	}
+	// The following fields are optional and only returned if the event has them.
+	description, _ := sdkObject.Description()
+	eventTime, _ := sdkObject.Time()
+	correlationID, _ := sdkObject.CorrelationId()
+	origin, _ := sdkObject.Origin()
+	customID, ok := sdkObject.CustomId()
+	if !ok {
+		customID = -1
+	}
+	result := &event{
+		client:        client,
+		id:            id,
+		index:         index,
+		code:          code,
+		description:   description,
+		severity:      EventSeverity(severity),
+		time:          eventTime,
+		correlationID: correlationID,
+		origin:        origin,
+		customID:      customID,
+	}
+	if vm, ok := sdkObject.Vm(); ok {
+		vmID, _ := vm.Id()
+		result.vmID = VMID(vmID)
+	}
+	if host, ok := sdkObject.Host(); ok {
+		result.hostID, _ = host.Id()
+	}
+	if cluster, ok := sdkObject.Cluster(); ok {
+		clusterID, _ := cluster.Id()
+		result.clusterID = ClusterID(clusterID)
+	}
+	return result, nil
+}
+
+type event struct {
+	client Client
+
+	id            string
+	index         int64
+	code          int64
+	description   string
+	severity      EventSeverity
+	time          time.Time
+	correlationID string
+	vmID          VMID
+	hostID        string
+	clusterID     ClusterID
+	origin        string
+	customID      int64
+}
+
+func (e event) ID() string {
+	return e.id
+}
+
+func (e event) Index() int64 {
+	return e.index
+}
+
+func (e event) Code() int64 {
+	return e.code
+}
+
+func (e event) Description() string {
+	return e.description
+}
+
+func (e event) Severity() EventSeverity {
+	return e.severity
+}
+
+func (e event) Time() time.Time {
+	return e.time
+}
+
+func (e event) CorrelationID() string {
+	return e.correlationID
+}
+
+func (e event) VMID() VMID {
+	return e.vmID
+}
+
+func (e event) HostID() string {
+	return e.hostID
+}
+
+func (e event) ClusterID() ClusterID {
+	return e.clusterID
+}
+
+func (e event) Origin() string {
+	return e.origin
+}
+
+func (e event) CustomID() int64 {
+	return e.customID
+}
+
+func (e event) VM(retries ...RetryStrategy) (VM, error) {
+	if e.vmID == "" {
+		return nil, newError(ENotFound, "event %s does not relate to a VM", e.id)
+	}
+	return e.client.GetVM(e.vmID, retries...)
+}
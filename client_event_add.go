@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddEvent(
+	severity EventSeverity,
+	description string,
+	customID int64,
+	params AddEventOptionalParameters,
+	retries ...RetryStrategy,
+) (result Event, err error) {
+	origin, err := validateAddEvent(severity, description, params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	eventBuilder := ovirtsdk.NewEventBuilder().
+		Severity(ovirtsdk.LogSeverity(severity)).
+		Description(description).
+		CustomId(customID).
+		Origin(origin)
+	if params != nil {
+		if vmID := params.VMID(); vmID != nil {
+			eventBuilder.Vm(ovirtsdk.NewVmBuilder().Id(string(*vmID)).MustBuild())
+		}
+		if hostID := params.HostID(); hostID != nil {
+			eventBuilder.Host(ovirtsdk.NewHostBuilder().Id(*hostID).MustBuild())
+		}
+	}
+	err = o.mutate(
+		resourceOf(ResourceTypeEvent, "").with("severity", severity).with("custom_id", customID),
+		"adding event",
+		retries,
+		func() error {
+			req := o.connection().SystemService().EventsService().Add().Event(eventBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkEvent, ok := response.Event()
+			if !ok {
+				return newError(EFieldMissing, "missing event in response")
+			}
+			result, e = convertSDKEvent(sdkEvent, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert event")
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
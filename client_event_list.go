@@ -0,0 +1,49 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (o *oVirtClient) ListEvents(params EventListParameters, retries ...RetryStrategy) (result []Event, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Event{}
+	err = o.retry(
+		resourceOf(ResourceTypeEvent, ""),
+		"listing events",
+		retries,
+		func() error {
+			req := o.connection().SystemService().EventsService().List()
+			if params != nil {
+				if from := params.From(); from != nil {
+					req.From(*from)
+				}
+				if search := params.Search(); search != nil {
+					req.Search(*search)
+				}
+				if max := params.Max(); max != nil {
+					req.Max(int64(*max))
+				}
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Events()
+			if !ok {
+				return nil
+			}
+			result = make([]Event, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKEvent(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert event during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	// The engine returns the newest events first, but consumers expect them in the order they happened.
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Index() < result[j].Index()
+	})
+	return
+}
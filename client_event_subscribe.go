@@ -0,0 +1,154 @@
+package ovirtclient
+
+import (
+	"sync"
+	"time"
+)
+
+// EventSubscription is a running subscription to events created by SubscribeEvents.
+type EventSubscription interface {
+	// Events returns the channel new events are delivered on in ascending order of their index. The channel is
+	// closed when the subscription is closed.
+	Events() <-chan Event
+	// Errors returns the channel errors are delivered on if polling fails. Polling continues after an error. The
+	// channel is closed when the subscription is closed.
+	Errors() <-chan error
+	// Close stops polling and closes the channels. It is safe to call Close multiple times.
+	Close()
+}
+
+func (o *oVirtClient) SubscribeEvents(
+	params EventListParameters,
+	pollInterval time.Duration,
+	retries ...RetryStrategy,
+) (EventSubscription, error) {
+	return subscribeEvents(o, o.logger, params, pollInterval, retries)
+}
+
+// subscribeEvents implements the polling subscription on top of ListEvents. It is shared between the real and the mock
+// client.
+func subscribeEvents(
+	client EventClient,
+	logger Logger,
+	params EventListParameters,
+	pollInterval time.Duration,
+	retries []RetryStrategy,
+) (EventSubscription, error) {
+	if pollInterval <= 0 {
+		return nil, newError(EBadArgument, "the poll interval must be positive")
+	}
+	var search *string
+	var from *int64
+	if params != nil {
+		search = params.Search()
+		from = params.From()
+	}
+	if from == nil {
+		latest, err := latestEventIndex(client, retries)
+		if err != nil {
+			return nil, err
+		}
+		from = &latest
+	}
+	sub := &eventSubscription{
+		client:       client,
+		logger:       logger,
+		search:       search,
+		from:         *from,
+		pollInterval: pollInterval,
+		retries:      retries,
+		events:       make(chan Event),
+		errors:       make(chan error, 1),
+		done:         make(chan struct{}),
+	}
+	go sub.run()
+	return sub, nil
+}
+
+func latestEventIndex(client EventClient, retries []RetryStrategy) (int64, error) {
+	events, err := client.ListEvents(EventListParams().MustWithMax(1), retries...)
+	if err != nil {
+		return 0, wrap(err, EUnidentified, "failed to fetch the latest event index")
+	}
+	latest := int64(0)
+	for _, e := range events {
+		if e.Index() > latest {
+			latest = e.Index()
+		}
+	}
+	return latest, nil
+}
+
+type eventSubscription struct {
+	client       EventClient
+	logger       Logger
+	search       *string
+	from         int64
+	pollInterval time.Duration
+	retries      []RetryStrategy
+	events       chan Event
+	errors       chan error
+	done         chan struct{}
+	closeOnce    sync.Once
+}
+
+func (e *eventSubscription) Events() <-chan Event {
+	return e.events
+}
+
+func (e *eventSubscription) Errors() <-chan error {
+	return e.errors
+}
+
+func (e *eventSubscription) Close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+}
+
+func (e *eventSubscription) run() {
+	defer close(e.events)
+	defer close(e.errors)
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-ticker.C:
+		}
+		if !e.poll() {
+			return
+		}
+	}
+}
+
+// poll fetches and delivers new events. It returns false if the subscription has been closed.
+func (e *eventSubscription) poll() bool {
+	params := EventListParams().MustWithFrom(e.from)
+	if e.search != nil {
+		params = params.MustWithSearch(*e.search)
+	}
+	events, err := e.client.ListEvents(params, e.retries...)
+	if err != nil {
+		e.logger.Debugf("Failed to poll events, retrying on next poll. (%v)", err)
+		select {
+		case e.errors <- err:
+		default:
+			// The consumer has not yet read the previous error, drop this one.
+		}
+		return true
+	}
+	for _, event := range events {
+		if event.Index() <= e.from {
+			continue
+		}
+		select {
+		case <-e.done:
+			return false
+		case e.events <- event:
+			e.from = event.Index()
+		}
+	}
+	return true
+}
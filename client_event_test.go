@@ -0,0 +1,101 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestEventList(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)), nil)
+
+	events, err := client.ListEvents(ovirtclient.EventListParams().MustWithMax(100))
+	if err != nil {
+		t.Fatalf("failed to list events (%v)", err)
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i-1].Index() > events[i].Index() {
+			t.Fatalf("events not returned in ascending order of their index")
+		}
+	}
+	for _, event := range events {
+		if event.VMID() == vm.ID() {
+			return
+		}
+	}
+	t.Fatalf("no event found for newly created VM %s", vm.ID())
+}
+
+func TestEventSubscription(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	subscription, err := client.SubscribeEvents(nil, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to subscribe to events (%v)", err)
+	}
+	defer subscription.Close()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)), nil)
+
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case event := <-subscription.Events():
+			if event.VMID() == vm.ID() {
+				return
+			}
+		case err := <-subscription.Errors():
+			t.Fatalf("failed to poll events (%v)", err)
+		case <-timeout:
+			t.Fatalf("timeout while waiting for an event for VM %s", vm.ID())
+		}
+	}
+}
+
+func TestAddEvent(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)), nil)
+	origin := fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5))
+	customID := time.Now().Unix()
+	params := ovirtclient.AddEventParams().MustWithOrigin(origin).MustWithVMID(vm.ID())
+
+	event, err := client.AddEvent(ovirtclient.EventSeverityWarning, "Test event", customID, params)
+	if err != nil {
+		t.Fatalf("failed to add event (%v)", err)
+	}
+	if event.Origin() != origin || event.CustomID() != customID {
+		t.Fatalf("incorrect origin or custom ID on event %s (%s, %d)", event.ID(), event.Origin(), event.CustomID())
+	}
+	if event.Severity() != ovirtclient.EventSeverityWarning || event.VMID() != vm.ID() {
+		t.Fatalf("incorrect severity or VM on event %s (%s, %s)", event.ID(), event.Severity(), event.VMID())
+	}
+
+	events, err := client.ListEvents(ovirtclient.EventListParams().MustWithFrom(event.Index() - 1))
+	if err != nil {
+		t.Fatalf("failed to list events (%v)", err)
+	}
+	found := false
+	for _, e := range events {
+		if e.ID() == event.ID() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("added event %s not found in the event list", event.ID())
+	}
+
+	if _, err := client.AddEvent(ovirtclient.EventSeverityNormal, "Duplicate", customID, params); err == nil {
+		t.Fatalf("adding an event with a duplicate custom ID did not fail")
+	}
+}
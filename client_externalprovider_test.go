@@ -0,0 +1,122 @@
+package ovirtclient_test
+
+import (
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestHostProviderListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	providers, err := client.ListHostProviders()
+	if err != nil {
+		t.Fatalf("failed to list host providers (%v)", err)
+	}
+	if len(providers) == 0 {
+		t.Skipf("No external host providers registered, skipping test.")
+	}
+	for _, provider := range providers {
+		fetchedProvider, err := client.GetHostProvider(provider.ID())
+		if err != nil {
+			t.Fatalf("failed to fetch host provider %s (%v)", provider.ID(), err)
+		}
+		if fetchedProvider.Name() != provider.Name() {
+			t.Fatalf("host provider name mismatch (%s != %s)", fetchedProvider.Name(), provider.Name())
+		}
+		if fetchedProvider.URL() != provider.URL() {
+			t.Fatalf("host provider URL mismatch (%s != %s)", fetchedProvider.URL(), provider.URL())
+		}
+	}
+}
+
+func TestImageProviderListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	providers, err := client.ListImageProviders()
+	if err != nil {
+		t.Fatalf("failed to list image providers (%v)", err)
+	}
+	if len(providers) == 0 {
+		t.Skipf("No external image providers registered, skipping test.")
+	}
+	for _, provider := range providers {
+		fetchedProvider, err := client.GetImageProvider(provider.ID())
+		if err != nil {
+			t.Fatalf("failed to fetch image provider %s (%v)", provider.ID(), err)
+		}
+		if fetchedProvider.Name() != provider.Name() {
+			t.Fatalf("image provider name mismatch (%s != %s)", fetchedProvider.Name(), provider.Name())
+		}
+		if fetchedProvider.URL() != provider.URL() {
+			t.Fatalf("image provider URL mismatch (%s != %s)", fetchedProvider.URL(), provider.URL())
+		}
+	}
+}
+
+func TestGlanceImageImport(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	image := findGlanceImage(t, client)
+	disksBefore, err := client.ListDisksByAlias(image.Name())
+	if err != nil {
+		t.Fatalf("failed to list disks with alias %s (%v)", image.Name(), err)
+	}
+	if err := image.Import(helper.GetStorageDomainID(), false); err != nil {
+		t.Fatalf("failed to import image %s (%v)", image.ID(), err)
+	}
+	disksAfter, err := client.ListDisksByAlias(image.Name())
+	if err != nil {
+		t.Fatalf("failed to list disks with alias %s (%v)", image.Name(), err)
+	}
+	for _, disk := range disksAfter {
+		if diskIn(disk, disksBefore) {
+			continue
+		}
+		if err := disk.Remove(); err != nil {
+			t.Fatalf("failed to remove imported disk %s (%v)", disk.ID(), err)
+		}
+		return
+	}
+	t.Fatalf("no disk named %s found after importing image %s", image.Name(), image.ID())
+}
+
+func diskIn(disk ovirtclient.Disk, disks []ovirtclient.Disk) bool {
+	for _, d := range disks {
+		if d.ID() == disk.ID() {
+			return true
+		}
+	}
+	return false
+}
+
+// findGlanceImage returns a CirrOS image from the first image provider offering one, or skips the test.
+func findGlanceImage(t *testing.T, client ovirtclient.Client) ovirtclient.GlanceImage {
+	providers, err := client.ListImageProviders()
+	if err != nil {
+		t.Fatalf("failed to list image providers (%v)", err)
+	}
+	for _, provider := range providers {
+		images, err := provider.ListImages()
+		if err != nil {
+			t.Fatalf("failed to list images on image provider %s (%v)", provider.ID(), err)
+		}
+		for _, image := range images {
+			if image.ProviderID() != provider.ID() {
+				t.Fatalf("image %s has incorrect provider ID (%s != %s)", image.ID(), image.ProviderID(), provider.ID())
+			}
+			if strings.HasPrefix(image.Name(), "CirrOS") {
+				return image
+			}
+		}
+	}
+	t.Skipf("No image provider offers a CirrOS image, skipping test.")
+	return nil
+}
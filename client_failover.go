@@ -0,0 +1,69 @@
+package ovirtclient
+
+import (
+	"errors"
+	"net"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// failingOver wraps the what function to switch to the next engine URL and repeat the call once if the current engine
+// cannot be reached. Only failures to establish a connection trigger a failover, since the request has not been sent
+// in this case and can be safely repeated against another engine. It does nothing if no failover URLs have been
+// configured. Subsequent failures continue with the next URL in order, starting over with the first one after the
+// last.
+func (o *oVirtClient) failingOver(what func() error) func() error {
+	if len(o.urls) < 2 || o.connect == nil {
+		return what
+	}
+	return func() error {
+		conn := o.connection()
+		err := what()
+		if !isConnectionFailure(err) {
+			return err
+		}
+		url, failoverErr := o.failover(conn)
+		if failoverErr != nil {
+			o.logger.Warningf("Failed to fail over to the next oVirt Engine URL. (%v)", failoverErr)
+			return err
+		}
+		o.logger.Warningf("Cannot reach the oVirt Engine, failing over to %s. (%v)", url, err)
+		return what()
+	}
+}
+
+// failover replaces the connection that failed with a connection to the next engine URL and returns the URL. If
+// another call has already replaced the failed connection the new connection is kept.
+func (o *oVirtClient) failover(failed *ovirtsdk4.Connection) (string, error) {
+	o.connLock.Lock()
+	defer o.connLock.Unlock()
+	if o.conn != failed {
+		return o.url, nil
+	}
+	previousURL := o.url
+	previousIndex := o.urlIndex
+	o.urlIndex = (o.urlIndex + 1) % len(o.urls)
+	o.url = o.urls[o.urlIndex]
+	conn, err := o.connect()
+	if err != nil {
+		o.url = previousURL
+		o.urlIndex = previousIndex
+		return "", err
+	}
+	o.conn = conn
+	o.resetEngineVersion()
+	return o.url, nil
+}
+
+// isConnectionFailure returns true if the error indicates that no connection to the engine could be established, for
+// example because the connection was refused or the host name could not be resolved. Errors after the connection has
+// been established, such as timeouts, TLS errors or connection resets, return false, since the engine may already have
+// received and executed the request.
+func isConnectionFailure(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,175 @@
+package ovirtclient_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// TestFailoverOnConnectionFailure tests that the client switches to the failover URL if the primary engine cannot be
+// reached.
+func TestFailoverOnConnectionFailure(t *testing.T) {
+	t.Parallel()
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL + "/ovirt-engine/api"
+	unreachable.Close()
+
+	standby := httptest.NewServer(http.HandlerFunc(serveStandbyEngine))
+	defer standby.Close()
+	standbyURL := standby.URL + "/ovirt-engine/api"
+
+	client, err := ovirtclient.NewWithVerify(
+		unreachableURL,
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&failoverExtraSettings{failoverURLs: []string{standbyURL}},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("the call did not succeed after failing over (%v)", err)
+	}
+	if url := client.GetURL(); url != standbyURL {
+		t.Fatalf("the client did not switch to the failover URL (expected: %s, got: %s)", standbyURL, url)
+	}
+}
+
+func TestFailoverInvalidURL(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.NewWithVerify(
+		"https://localhost/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&failoverExtraSettings{failoverURLs: []string{"localhost"}},
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("an invalid failover URL did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+// serveStandbyEngine is a fake oVirt Engine that accepts all logins.
+func serveStandbyEngine(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/ovirt-engine/sso/oauth/token":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token"}`))
+	case "/ovirt-engine/api":
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<api></api>`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+type failoverExtraSettings struct {
+	proxyExtraSettings
+
+	failoverURLs []string
+}
+
+func (f *failoverExtraSettings) DryRun() bool {
+	return false
+}
+
+func (f *failoverExtraSettings) ReadOnly() bool {
+	return false
+}
+
+func (f *failoverExtraSettings) FailoverURLs() []string {
+	return f.failoverURLs
+}
+
+// TestNoFailoverOnTimeout tests that the client does not fail over and repeat a call that timed out, since the engine
+// may already have executed it.
+func TestNoFailoverOnTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ovirt-engine/api" {
+			<-release
+		}
+		serveStandbyEngine(w, r)
+	}))
+	defer primary.Close()
+	defer close(release)
+
+	assertNoFailover(
+		t,
+		primary.URL+"/ovirt-engine/api",
+		ovirtclient.HTTPTransportParams().MustWithRequestTimeout(200*time.Millisecond),
+	)
+}
+
+// TestNoFailoverOnConnectionReset tests that the client does not fail over and repeat a call if the connection is
+// reset after the request was sent.
+func TestNoFailoverOnConnectionReset(t *testing.T) {
+	t.Parallel()
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ovirt-engine/api" {
+			serveStandbyEngine(w, r)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("failed to hijack connection (%v)", err)
+			return
+		}
+		if tcpConn, ok := conn.(*net.TCPConn); ok {
+			_ = tcpConn.SetLinger(0)
+		}
+		_ = conn.Close()
+	}))
+	defer primary.Close()
+
+	assertNoFailover(t, primary.URL+"/ovirt-engine/api", nil)
+}
+
+// assertNoFailover checks that a failing call against the primary URL does not fail over to a standby engine.
+func assertNoFailover(t *testing.T, primaryURL string, transport ovirtclient.HTTPTransportParameters) {
+	var standbyCalls int32
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&standbyCalls, 1)
+		serveStandbyEngine(w, r)
+	}))
+	defer standby.Close()
+
+	client, err := ovirtclient.NewWithVerify(
+		primaryURL,
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&transportExtraSettings{
+			failoverExtraSettings: failoverExtraSettings{failoverURLs: []string{standby.URL + "/ovirt-engine/api"}},
+			transport:             transport,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	if err := client.Test(ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("the call against the failing engine succeeded")
+	}
+	if url := client.GetURL(); url != primaryURL {
+		t.Fatalf("the client failed over after the request was sent (now using %s)", url)
+	}
+	if calls := atomic.LoadInt32(&standbyCalls); calls != 0 {
+		t.Fatalf("the call was repeated against the standby engine (%d requests)", calls)
+	}
+}
@@ -0,0 +1,103 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// GroupClient contains the functions to look up the groups known to the engine and the groups of the directory
+// services configured in the engine. Use UserClient.ListDirectoryDomains to find the directory services.
+type GroupClient interface {
+	// ListGroups lists the groups known to the engine.
+	ListGroups(retries ...RetryStrategy) ([]Group, error)
+	// GetGroup returns the group with the specified ID.
+	GetGroup(id string, retries ...RetryStrategy) (Group, error)
+	// GetGroupByName returns the group with the specified name. If groups with the same name exist in several
+	// authorization domains, an EConflict error is returned.
+	GetGroupByName(name string, retries ...RetryStrategy) (Group, error)
+	// ListDirectoryGroups lists the groups in a directory service, including the ones not yet known to the engine.
+	// The search is passed to the directory service and supports wildcards, such as "name=admins*". An empty search
+	// lists all groups.
+	ListDirectoryGroups(domainID string, search string, retries ...RetryStrategy) ([]GroupData, error)
+}
+
+// GroupData contains the data of a group.
+type GroupData interface {
+	// ID returns the identifier of the group. For groups only listed in a directory service this is the ID in the
+	// directory service.
+	ID() string
+	// Name returns the name of the group.
+	Name() string
+	// DomainName returns the name of the authorization domain the group belongs to.
+	DomainName() string
+}
+
+// Group is a group known to the engine.
+type Group interface {
+	GroupData
+
+	// PermissionPrincipal returns the principal to use for granting permissions to the group.
+	PermissionPrincipal() PermissionPrincipal
+}
+
+// findGroupByName returns the group matching the name as described in GroupClient.GetGroupByName.
+func findGroupByName(groups []Group, name string) (Group, error) {
+	var result Group
+	for _, g := range groups {
+		if g.Name() != name {
+			continue
+		}
+		if result != nil {
+			return nil, newError(EConflict, "multiple groups with the name %s found", name)
+		}
+		result = g
+	}
+	if result == nil {
+		return nil, newError(ENotFound, "no group with the name %s found", name)
+	}
+	return result, nil
+}
+
+func convertSDKGroup(sdkObject *ovirtsdk.Group, client Client) (*group, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("group", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("group", "name")
+	}
+	var domainName string
+	if sdkDomain, ok := sdkObject.Domain(); ok {
+		domainName, _ = sdkDomain.Name()
+	}
+	return &group{
+		client:     client,
+		id:         id,
+		name:       name,
+		domainName: domainName,
+	}, nil
+}
+
+type group struct {
+	client Client
+
+	id         string
+	name       string
+	domainName string
+}
+
+func (g *group) ID() string {
+	return g.id
+}
+
+func (g *group) Name() string {
+	return g.name
+}
+
+func (g *group) DomainName() string {
+	return g.domainName
+}
+
+func (g *group) PermissionPrincipal() PermissionPrincipal {
+	return GroupPrincipal(g.id)
+}
@@ -0,0 +1,40 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListDirectoryGroups(domainID string, search string, retries ...RetryStrategy) (
+	result []GroupData,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []GroupData{}
+	err = o.retry(
+		resourceOf(ResourceTypeDirectoryDomain, domainID),
+		fmt.Sprintf("listing groups of directory domain %s", domainID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().DomainsService().DomainService(domainID).GroupsService().List()
+			if search != "" {
+				req.Search(search)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Groups()
+			if !ok {
+				return nil
+			}
+			result = make([]GroupData, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKGroup(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert directory group during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetGroup(id string, retries ...RetryStrategy) (result Group, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeGroup, id),
+		fmt.Sprintf("getting group %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().GroupsService().GroupService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Get()
+			if !ok {
+				return newError(ENotFound, "no group returned when getting group ID %s", id)
+			}
+			result, e = convertSDKGroup(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert group %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) ListGroups(retries ...RetryStrategy) (result []Group, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Group{}
+	err = o.retry(
+		resourceOf(ResourceTypeGroup, ""),
+		"listing groups",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().GroupsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Groups()
+			if !ok {
+				return nil
+			}
+			result = make([]Group, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKGroup(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert group during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) GetGroupByName(name string, retries ...RetryStrategy) (Group, error) {
+	groups, err := o.ListGroups(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findGroupByName(groups, name)
+}
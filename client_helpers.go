@@ -14,3 +14,27 @@ func quoteSearchString(text string) (string, error) {
 	}
 	return fmt.Sprintf("\"%s\"", text), nil
 }
+
+// nameAndTagSearchCriteria builds an engine search query that matches nameField exactly against name and tagField
+// against tag. Nil values are left out of the query, but at least one of them must be set.
+func nameAndTagSearchCriteria(nameField string, name *string, tagField string, tag *string) (string, error) {
+	var criteria []string
+	if name != nil {
+		quotedName, err := quoteSearchString(*name)
+		if err != nil {
+			return "", newError(EBadArgument, "invalid %s search string: %s", nameField, *name)
+		}
+		criteria = append(criteria, fmt.Sprintf("%s = %s", nameField, quotedName))
+	}
+	if tag != nil {
+		quotedTag, err := quoteSearchString(*tag)
+		if err != nil {
+			return "", newError(EBadArgument, "invalid tag search string: %s", *tag)
+		}
+		criteria = append(criteria, fmt.Sprintf("%s = %s", tagField, quotedTag))
+	}
+	if len(criteria) == 0 {
+		return "", newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	return strings.Join(criteria, " AND "), nil
+}
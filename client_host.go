@@ -8,8 +8,39 @@ import (
 
 // HostClient contains the API portion that deals with hosts.
 type HostClient interface {
+	// ListHosts lists all hosts the user has access to.
 	ListHosts(retries ...RetryStrategy) ([]Host, error)
+	// GetHost returns a single host based on its ID.
 	GetHost(id string, retries ...RetryStrategy) (Host, error)
+	// SearchHosts lists the hosts matching the criteria specified in params. The filtering is done by the engine,
+	// so large host lists don't need to be fetched.
+	SearchHosts(params HostSearchParameters, retries ...RetryStrategy) ([]Host, error)
+	// AddTagToHost assigns the tag with the ID tagID to the host.
+	AddTagToHost(id string, tagID TagID, retries ...RetryStrategy) error
+	// FenceHost performs a power management action on the host using its fence agents and returns the power status
+	// of the host reported by the agents. The host must have power management enabled and at least one fence agent
+	// configured. FenceTypeStatus does not change the host and is therefore also allowed in dry-run and read-only
+	// mode.
+	FenceHost(id string, fenceType FenceType, retries ...RetryStrategy) (HostPowerStatus, error)
+	// ListHostNUMANodes lists the NUMA nodes of the host. The list is empty if the host does not support NUMA.
+	ListHostNUMANodes(hostID string, retries ...RetryStrategy) ([]HostNUMANode, error)
+	// ListHostHugePages lists the free hugepages of the host for each hugepage size, sorted by size. The list is
+	// empty if the host has no hugepages configured or the engine does not report them.
+	ListHostHugePages(hostID string, retries ...RetryStrategy) ([]HostHugePages, error)
+	// ListHostDevices lists the PCI, USB, SCSI and other devices of the host. Devices can be passed through to VMs.
+	ListHostDevices(hostID string, retries ...RetryStrategy) ([]HostDevice, error)
+	// ListHostFenceAgents lists the fence agents configured on the host.
+	ListHostFenceAgents(hostID string, retries ...RetryStrategy) ([]FenceAgent, error)
+	// GetHostFenceAgent returns a single fence agent configured on the host.
+	GetHostFenceAgent(hostID string, agentID string, retries ...RetryStrategy) (FenceAgent, error)
+	// UpdateHostFenceAgent updates the configuration of a fence agent on the host. Use UpdateFenceAgentParams to
+	// obtain a builder for the parameters.
+	UpdateHostFenceAgent(
+		hostID string,
+		agentID string,
+		params UpdateFenceAgentParameters,
+		retries ...RetryStrategy,
+	) (FenceAgent, error)
 }
 
 // HostData is the core of Host, providing only data access functions.
@@ -17,9 +48,64 @@ type HostData interface {
 	// ID returns the identifier of the host in question.
 	ID() string
 	// ClusterID returns the ID of the cluster this host belongs to.
-	ClusterID() string
+	ClusterID() ClusterID
 	// Status returns the status of this host.
 	Status() HostStatus
+	// Name returns the user-facing name of the host.
+	Name() string
+	// Address returns the hostname or IP address the engine uses to reach the host.
+	Address() string
+	// CPU returns the physical CPUs of the host. The values are zero if the engine has not yet retrieved them, for
+	// example because the host was never up.
+	CPU() HostCPU
+	// Memory returns the total physical memory of the host in bytes, or 0 if the engine has not yet retrieved it.
+	Memory() uint64
+	// MaxSchedulingMemory returns the memory in bytes that is still available for scheduling new VMs on the host.
+	MaxSchedulingMemory() uint64
+	// NUMASupported returns true if the host supports NUMA, which is required for pinning VMs to NUMA nodes.
+	NUMASupported() bool
+	// TagIDs returns the IDs of the tags assigned to the host.
+	TagIDs() []TagID
+	// SSH returns the SSH access details of the host. The values are empty if the engine does not report them.
+	SSH() HostSSH
+	// Certificate returns the details of the certificate the host presents to console clients. Console clients also
+	// need the CA certificate of the engine, which signs the host certificates. Use ConsoleCACertificate to fetch it.
+	Certificate() HostCertificate
+}
+
+// HostSSH describes how the engine reaches the host using SSH.
+type HostSSH interface {
+	// Port returns the SSH port of the host.
+	Port() uint
+	// Fingerprint returns the fingerprint of the SSH host key, for example SHA256:... . Use it to verify the host
+	// key when connecting to the host.
+	Fingerprint() string
+	// PublicKey returns the SSH host key in the authorized_keys format, for example ssh-ed25519 AAAA... . It can be
+	// added to a known_hosts file together with the address of the host.
+	PublicKey() string
+}
+
+// HostCertificate describes the certificate of a host. SPICE console clients use the subject to verify the TLS
+// connection to the host.
+type HostCertificate interface {
+	// Subject returns the subject of the certificate, for example O=example.com,CN=host.example.com.
+	Subject() string
+	// Organization returns the organization of the certificate.
+	Organization() string
+}
+
+// HostCPU describes the physical CPUs of a host.
+type HostCPU interface {
+	// Name returns the model name of the CPU as reported by the host.
+	Name() string
+	// Speed returns the speed of the CPU in MHz.
+	Speed() uint
+	// Type returns the CPU type the engine detected for the host, for example "Secure Intel Cascadelake Server
+	// Family". A VM can only run on the host if its cluster CPU type is supported by this CPU type. The engine API
+	// does not expose the individual CPU flags of the host.
+	Type() string
+	// Topo returns the number of sockets, cores per socket and threads per core of the host.
+	Topo() VMCPUTopo
 }
 
 // Host is the representation of a host returned from the oVirt Engine API. Hosts, also known as hypervisors, are the
@@ -29,6 +115,74 @@ type HostData interface {
 // See https://www.ovirt.org/documentation/administration_guide/#chap-Hosts for details.
 type Host interface {
 	HostData
+
+	// ListNICs lists the network interfaces of this host. This is a network call and may be slow.
+	ListNICs(retries ...RetryStrategy) ([]HostNIC, error)
+	// ListNUMANodes lists the NUMA nodes of this host. This is a network call and may be slow.
+	ListNUMANodes(retries ...RetryStrategy) ([]HostNUMANode, error)
+	// ListHugePages lists the free hugepages of this host. This is a network call and may be slow.
+	ListHugePages(retries ...RetryStrategy) ([]HostHugePages, error)
+	// ListDevices lists the devices of this host. This is a network call and may be slow.
+	ListDevices(retries ...RetryStrategy) ([]HostDevice, error)
+	// ListErrata lists the Katello errata applicable to this host. This is a network call and may be slow.
+	ListErrata(retries ...RetryStrategy) ([]Erratum, error)
+	// ListFenceAgents lists the fence agents configured on this host. This is a network call and may be slow.
+	ListFenceAgents(retries ...RetryStrategy) ([]FenceAgent, error)
+	// Fence performs a power management action on this host. See HostClient.FenceHost for details.
+	Fence(fenceType FenceType, retries ...RetryStrategy) (HostPowerStatus, error)
+	// ConsoleCACertificate fetches the PEM encoded CA certificate console clients need to verify the connection to
+	// this host. See EngineClient.GetEngineCACertificate for details.
+	ConsoleCACertificate(retries ...RetryStrategy) ([]byte, error)
+	// AddTag assigns the specified tag to this host. See HostClient.AddTagToHost for details.
+	AddTag(tagID TagID, retries ...RetryStrategy) error
+}
+
+// HostSearchParameters declares the parameters that can be passed to SearchHosts. Each parameter is declared as
+// a pointer, where a nil value means that the parameter is not searched for. All parameters are used together as an
+// AND filter.
+type HostSearchParameters interface {
+	// Name will match the name of the host exactly.
+	Name() *string
+	// Tag will match the name of a tag assigned to the host.
+	Tag() *string
+}
+
+// BuildableHostSearchParameters is a buildable version of HostSearchParameters.
+type BuildableHostSearchParameters interface {
+	HostSearchParameters
+
+	// WithName sets the name to search for.
+	WithName(name string) BuildableHostSearchParameters
+	// WithTag sets the tag name to search for.
+	WithTag(tag string) BuildableHostSearchParameters
+}
+
+// HostSearchParams creates a buildable set of search parameters for SearchHosts.
+func HostSearchParams() BuildableHostSearchParameters {
+	return &hostSearchParams{}
+}
+
+type hostSearchParams struct {
+	name *string
+	tag  *string
+}
+
+func (p *hostSearchParams) Name() *string {
+	return p.name
+}
+
+func (p *hostSearchParams) Tag() *string {
+	return p.tag
+}
+
+func (p *hostSearchParams) WithName(name string) BuildableHostSearchParameters {
+	p.name = &name
+	return p
+}
+
+func (p *hostSearchParams) WithTag(tag string) BuildableHostSearchParameters {
+	p.tag = &tag
+	return p
 }
 
 // HostStatus represents the complex states an oVirt host can be in.
@@ -127,30 +281,242 @@ func convertSDKHost(sdkHost *ovirtsdk4.Host, client Client) (Host, error) {
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch cluster ID from host %s", id)
 	}
+	name, ok := sdkHost.Name()
+	if !ok {
+		return nil, newError(EFieldMissing, "returned host %s did not contain a name", id)
+	}
+	// The following fields are only filled once the engine has connected to the host.
+	address, _ := sdkHost.Address()
+	memory, _ := sdkHost.Memory()
+	maxSchedulingMemory, _ := sdkHost.MaxSchedulingMemory()
+	numaSupported, _ := sdkHost.NumaSupported()
+	var tagIDs []TagID
+	if sdkTags, ok := sdkHost.Tags(); ok {
+		for _, sdkTag := range sdkTags.Slice() {
+			tagID, _ := sdkTag.Id()
+			tagIDs = append(tagIDs, TagID(tagID))
+		}
+	}
 	return &host{
-		client:    client,
-		id:        id,
-		status:    HostStatus(status),
-		clusterID: clusterID,
+		client:              client,
+		id:                  id,
+		status:              HostStatus(status),
+		clusterID:           ClusterID(clusterID),
+		name:                name,
+		address:             address,
+		cpu:                 convertSDKHostCPU(sdkHost),
+		memory:              uint64(memory),
+		maxSchedulingMemory: uint64(maxSchedulingMemory),
+		numaSupported:       numaSupported,
+		tagIDs:              tagIDs,
+		ssh:                 convertSDKHostSSH(sdkHost),
+		certificate:         convertSDKHostCertificate(sdkHost),
 	}, nil
 }
 
+func convertSDKHostSSH(sdkHost *ovirtsdk4.Host) *hostSSH {
+	ssh := &hostSSH{}
+	sdkSSH, ok := sdkHost.Ssh()
+	if !ok {
+		return ssh
+	}
+	if port, ok := sdkSSH.Port(); ok {
+		ssh.port = uint(port)
+	}
+	ssh.fingerprint, _ = sdkSSH.Fingerprint()
+	ssh.publicKey, _ = sdkSSH.PublicKey()
+	return ssh
+}
+
+func convertSDKHostCertificate(sdkHost *ovirtsdk4.Host) *hostCertificate {
+	certificate := &hostCertificate{}
+	sdkCertificate, ok := sdkHost.Certificate()
+	if !ok {
+		return certificate
+	}
+	certificate.subject, _ = sdkCertificate.Subject()
+	certificate.organization, _ = sdkCertificate.Organization()
+	return certificate
+}
+
+func convertSDKHostCPU(sdkHost *ovirtsdk4.Host) *hostCPU {
+	cpu := &hostCPU{
+		topo: &vmCPUTopo{},
+	}
+	sdkCPU, ok := sdkHost.Cpu()
+	if !ok {
+		return cpu
+	}
+	cpu.name, _ = sdkCPU.Name()
+	cpu.cpuType, _ = sdkCPU.Type()
+	if speed, ok := sdkCPU.Speed(); ok {
+		cpu.speed = uint(speed)
+	}
+	if sdkTopo, ok := sdkCPU.Topology(); ok {
+		if cores, ok := sdkTopo.Cores(); ok {
+			cpu.topo.cores = uint(cores)
+		}
+		if threads, ok := sdkTopo.Threads(); ok {
+			cpu.topo.threads = uint(threads)
+		}
+		if sockets, ok := sdkTopo.Sockets(); ok {
+			cpu.topo.sockets = uint(sockets)
+		}
+	}
+	return cpu
+}
+
 type host struct {
 	client Client
 
-	id        string
-	clusterID string
-	status    HostStatus
+	id                  string
+	clusterID           ClusterID
+	status              HostStatus
+	name                string
+	address             string
+	cpu                 *hostCPU
+	memory              uint64
+	maxSchedulingMemory uint64
+	numaSupported       bool
+	tagIDs              []TagID
+	ssh                 *hostSSH
+	certificate         *hostCertificate
 }
 
 func (h host) ID() string {
 	return h.id
 }
 
-func (h host) ClusterID() string {
+func (h host) ClusterID() ClusterID {
 	return h.clusterID
 }
 
 func (h host) Status() HostStatus {
 	return h.status
 }
+
+func (h host) Name() string {
+	return h.name
+}
+
+func (h host) Address() string {
+	return h.address
+}
+
+func (h host) CPU() HostCPU {
+	return h.cpu
+}
+
+func (h host) Memory() uint64 {
+	return h.memory
+}
+
+func (h host) MaxSchedulingMemory() uint64 {
+	return h.maxSchedulingMemory
+}
+
+func (h host) NUMASupported() bool {
+	return h.numaSupported
+}
+
+func (h host) ListNICs(retries ...RetryStrategy) ([]HostNIC, error) {
+	return h.client.ListHostNICs(h.id, retries...)
+}
+
+func (h host) ListNUMANodes(retries ...RetryStrategy) ([]HostNUMANode, error) {
+	return h.client.ListHostNUMANodes(h.id, retries...)
+}
+
+func (h host) ListErrata(retries ...RetryStrategy) ([]Erratum, error) {
+	return h.client.ListHostErrata(h.id, retries...)
+}
+
+func (h host) ListHugePages(retries ...RetryStrategy) ([]HostHugePages, error) {
+	return h.client.ListHostHugePages(h.id, retries...)
+}
+
+func (h host) ListDevices(retries ...RetryStrategy) ([]HostDevice, error) {
+	return h.client.ListHostDevices(h.id, retries...)
+}
+
+func (h host) ListFenceAgents(retries ...RetryStrategy) ([]FenceAgent, error) {
+	return h.client.ListHostFenceAgents(h.id, retries...)
+}
+
+func (h host) Fence(fenceType FenceType, retries ...RetryStrategy) (HostPowerStatus, error) {
+	return h.client.FenceHost(h.id, fenceType, retries...)
+}
+
+func (h host) TagIDs() []TagID {
+	return h.tagIDs
+}
+
+func (h host) AddTag(tagID TagID, retries ...RetryStrategy) error {
+	return h.client.AddTagToHost(h.id, tagID, retries...)
+}
+
+func (h host) SSH() HostSSH {
+	return h.ssh
+}
+
+func (h host) Certificate() HostCertificate {
+	return h.certificate
+}
+
+func (h host) ConsoleCACertificate(retries ...RetryStrategy) ([]byte, error) {
+	return h.client.GetEngineCACertificate(retries...)
+}
+
+type hostSSH struct {
+	port        uint
+	fingerprint string
+	publicKey   string
+}
+
+func (s *hostSSH) Port() uint {
+	return s.port
+}
+
+func (s *hostSSH) Fingerprint() string {
+	return s.fingerprint
+}
+
+func (s *hostSSH) PublicKey() string {
+	return s.publicKey
+}
+
+type hostCertificate struct {
+	subject      string
+	organization string
+}
+
+func (c *hostCertificate) Subject() string {
+	return c.subject
+}
+
+func (c *hostCertificate) Organization() string {
+	return c.organization
+}
+
+type hostCPU struct {
+	name    string
+	speed   uint
+	cpuType string
+	topo    *vmCPUTopo
+}
+
+func (c *hostCPU) Name() string {
+	return c.name
+}
+
+func (c *hostCPU) Speed() uint {
+	return c.speed
+}
+
+func (c *hostCPU) Type() string {
+	return c.cpuType
+}
+
+func (c *hostCPU) Topo() VMCPUTopo {
+	return c.topo
+}
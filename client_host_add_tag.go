@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddTagToHost(id string, tagID TagID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeHost, id).with("tag_id", tagID),
+		fmt.Sprintf("adding tag %s to host %s", tagID, id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().HostsService().HostService(id).TagsService().Add().
+				Tag(ovirtsdk.NewTagBuilder().Id(string(tagID)).MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,222 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostDevice is a device of a host as reported by the engine, for example a PCI card or a USB device. Devices that
+// are not used by the host can be passed through to VMs.
+type HostDevice interface {
+	// ID returns the identifier of the device.
+	ID() string
+	// HostID returns the ID of the host the device belongs to.
+	HostID() string
+	// Name returns the name of the device on the host, for example pci_0000_00_02_0.
+	Name() string
+	// Capability returns the type of the device.
+	Capability() HostDeviceCapability
+	// VendorID returns the hexadecimal ID of the device vendor, for example 0x8086. It returns an empty string if the
+	// engine does not report a vendor.
+	VendorID() string
+	// VendorName returns the name of the device vendor, if known.
+	VendorName() string
+	// ProductID returns the hexadecimal ID of the product, for example 0x1572. It returns an empty string if the
+	// engine does not report a product.
+	ProductID() string
+	// ProductName returns the name of the product, if known.
+	ProductName() string
+	// ParentDeviceName returns the name of the parent device, or an empty string if the device has no parent.
+	ParentDeviceName() string
+	// Driver returns the name of the kernel driver the device is bound to, if any.
+	Driver() string
+}
+
+// HostDeviceCapability is the type of a host device.
+type HostDeviceCapability string
+
+const (
+	// HostDeviceCapabilitySystem is the root device of the host.
+	HostDeviceCapabilitySystem HostDeviceCapability = "system"
+	// HostDeviceCapabilityPCI is a PCI device.
+	HostDeviceCapabilityPCI HostDeviceCapability = "pci"
+	// HostDeviceCapabilityUSB is a USB bus.
+	HostDeviceCapabilityUSB HostDeviceCapability = "usb"
+	// HostDeviceCapabilityUSBDevice is a device attached to a USB bus.
+	HostDeviceCapabilityUSBDevice HostDeviceCapability = "usb_device"
+	// HostDeviceCapabilitySCSIHost is a SCSI host adapter.
+	HostDeviceCapabilitySCSIHost HostDeviceCapability = "scsi_host"
+	// HostDeviceCapabilitySCSI is a SCSI device, for example a disk or a tape drive.
+	HostDeviceCapabilitySCSI HostDeviceCapability = "scsi"
+	// HostDeviceCapabilitySCSIGeneric is the generic SCSI interface of a SCSI device.
+	HostDeviceCapabilitySCSIGeneric HostDeviceCapability = "scsi_generic"
+	// HostDeviceCapabilityStorage is a block storage device.
+	HostDeviceCapabilityStorage HostDeviceCapability = "storage"
+	// HostDeviceCapabilityNet is a network interface.
+	HostDeviceCapabilityNet HostDeviceCapability = "net"
+)
+
+// Validate returns an error if the host device capability is not known.
+func (c HostDeviceCapability) Validate() error {
+	for _, capability := range HostDeviceCapabilityValues() {
+		if capability == c {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid host device capability: %s must be one of: %s",
+		c,
+		strings.Join(HostDeviceCapabilityValues().Strings(), ", "),
+	)
+}
+
+// HostDeviceCapabilityList is a list of HostDeviceCapability values.
+type HostDeviceCapabilityList []HostDeviceCapability
+
+// Strings creates a string list of the values.
+func (l HostDeviceCapabilityList) Strings() []string {
+	result := make([]string, len(l))
+	for i, capability := range l {
+		result[i] = string(capability)
+	}
+	return result
+}
+
+// HostDeviceCapabilityValues returns all possible HostDeviceCapability values.
+func HostDeviceCapabilityValues() HostDeviceCapabilityList {
+	return []HostDeviceCapability{
+		HostDeviceCapabilitySystem,
+		HostDeviceCapabilityPCI,
+		HostDeviceCapabilityUSB,
+		HostDeviceCapabilityUSBDevice,
+		HostDeviceCapabilitySCSIHost,
+		HostDeviceCapabilitySCSI,
+		HostDeviceCapabilitySCSIGeneric,
+		HostDeviceCapabilityStorage,
+		HostDeviceCapabilityNet,
+	}
+}
+
+func (o *oVirtClient) ListHostDevices(hostID string, retries ...RetryStrategy) (result []HostDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []HostDevice{}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing devices for host %s", hostID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				DevicesService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Devices()
+			if !ok {
+				return nil
+			}
+			result = make([]HostDevice, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHostDevice(sdkObject, hostID)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host device during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func convertSDKHostDevice(sdkObject *ovirtsdk4.HostDevice, hostID string) (*hostDevice, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("host device", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("host device", "name")
+	}
+	capability, ok := sdkObject.Capability()
+	if !ok {
+		return nil, newFieldNotFound("host device", "capability")
+	}
+	result := &hostDevice{
+		id:         id,
+		hostID:     hostID,
+		name:       name,
+		capability: HostDeviceCapability(capability),
+	}
+	// Vendor, product, parent and driver are not reported for all device types.
+	if vendor, ok := sdkObject.Vendor(); ok {
+		result.vendorID, _ = vendor.Id()
+		result.vendorName, _ = vendor.Name()
+	}
+	if product, ok := sdkObject.Product(); ok {
+		result.productID, _ = product.Id()
+		result.productName, _ = product.Name()
+	}
+	if parent, ok := sdkObject.ParentDevice(); ok {
+		result.parentDeviceName, _ = parent.Name()
+	}
+	result.driver, _ = sdkObject.Driver()
+	return result, nil
+}
+
+type hostDevice struct {
+	id               string
+	hostID           string
+	name             string
+	capability       HostDeviceCapability
+	vendorID         string
+	vendorName       string
+	productID        string
+	productName      string
+	parentDeviceName string
+	driver           string
+}
+
+func (h *hostDevice) ID() string {
+	return h.id
+}
+
+func (h *hostDevice) HostID() string {
+	return h.hostID
+}
+
+func (h *hostDevice) Name() string {
+	return h.name
+}
+
+func (h *hostDevice) Capability() HostDeviceCapability {
+	return h.capability
+}
+
+func (h *hostDevice) VendorID() string {
+	return h.vendorID
+}
+
+func (h *hostDevice) VendorName() string {
+	return h.vendorName
+}
+
+func (h *hostDevice) ProductID() string {
+	return h.productID
+}
+
+func (h *hostDevice) ProductName() string {
+	return h.productName
+}
+
+func (h *hostDevice) ParentDeviceName() string {
+	return h.parentDeviceName
+}
+
+func (h *hostDevice) Driver() string {
+	return h.driver
+}
@@ -0,0 +1,131 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FenceType is the power management action FenceHost performs on a host.
+type FenceType string
+
+const (
+	// FenceTypeRestart powers the host off and on again.
+	FenceTypeRestart FenceType = "restart"
+	// FenceTypeStart powers the host on.
+	FenceTypeStart FenceType = "start"
+	// FenceTypeStop powers the host off.
+	FenceTypeStop FenceType = "stop"
+	// FenceTypeStatus only queries the power status of the host without changing it.
+	FenceTypeStatus FenceType = "status"
+)
+
+// Validate returns an error if the fence type is not known.
+func (f FenceType) Validate() error {
+	for _, fenceType := range FenceTypeValues() {
+		if fenceType == f {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid fence type: %s must be one of: %s",
+		f,
+		strings.Join(FenceTypeValues().Strings(), ", "),
+	)
+}
+
+// FenceTypeList is a list of FenceType values.
+type FenceTypeList []FenceType
+
+// Strings creates a string list of the values.
+func (l FenceTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, fenceType := range l {
+		result[i] = string(fenceType)
+	}
+	return result
+}
+
+// FenceTypeValues returns all possible FenceType values.
+func FenceTypeValues() FenceTypeList {
+	return []FenceType{
+		FenceTypeRestart,
+		FenceTypeStart,
+		FenceTypeStop,
+		FenceTypeStatus,
+	}
+}
+
+// HostPowerStatus is the power status of a host as reported by its fence agents.
+type HostPowerStatus string
+
+const (
+	// HostPowerStatusOn indicates that the host is powered on.
+	HostPowerStatusOn HostPowerStatus = "on"
+	// HostPowerStatusOff indicates that the host is powered off.
+	HostPowerStatusOff HostPowerStatus = "off"
+	// HostPowerStatusUnknown indicates that the fence agents could not determine the power status.
+	HostPowerStatusUnknown HostPowerStatus = "unknown"
+)
+
+// HostPowerStatusList is a list of HostPowerStatus values.
+type HostPowerStatusList []HostPowerStatus
+
+// Strings creates a string list of the values.
+func (l HostPowerStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, status := range l {
+		result[i] = string(status)
+	}
+	return result
+}
+
+// HostPowerStatusValues returns all possible HostPowerStatus values.
+func HostPowerStatusValues() HostPowerStatusList {
+	return []HostPowerStatus{
+		HostPowerStatusOn,
+		HostPowerStatusOff,
+		HostPowerStatusUnknown,
+	}
+}
+
+func (o *oVirtClient) FenceHost(id string, fenceType FenceType, retries ...RetryStrategy) (
+	result HostPowerStatus,
+	err error,
+) {
+	if err := fenceType.Validate(); err != nil {
+		return "", err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	action := fmt.Sprintf("fencing host %s with %s", id, fenceType)
+	what := func() error {
+		req := o.connection().SystemService().
+			HostsService().
+			HostService(id).
+			Fence().
+			FenceType(string(fenceType))
+		if correlationID != "" {
+			req.Query("correlation_id", correlationID)
+		}
+		response, err := req.Send()
+		if err != nil {
+			return err
+		}
+		result = HostPowerStatusUnknown
+		if powerManagement, ok := response.PowerManagement(); ok {
+			if status, ok := powerManagement.Status(); ok {
+				result = HostPowerStatus(status)
+			}
+		}
+		return nil
+	}
+	// Querying the status does not change the host, so it is allowed in dry-run and read-only mode.
+	if fenceType == FenceTypeStatus {
+		err = o.retry(resourceOf(ResourceTypeHost, id).with("fence_type", fenceType), action, retries, what)
+	} else {
+		err = o.mutate(resourceOf(ResourceTypeHost, id).with("fence_type", fenceType), action, retries, what)
+	}
+	err = withCorrelationID(err, correlationID)
+	return
+}
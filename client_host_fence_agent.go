@@ -0,0 +1,378 @@
+package ovirtclient
+
+import (
+	"sort"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// FenceAgent is a power management agent configured on a host, for example an IPMI interface. The engine uses the
+// fence agents of a host in their order to power it on or off, see FenceHost.
+type FenceAgent interface {
+	// ID returns the identifier of the fence agent.
+	ID() string
+	// HostID returns the ID of the host the fence agent belongs to.
+	HostID() string
+	// Type returns the type of the fence agent, for example "ipmilan".
+	Type() string
+	// Address returns the hostname or IP address of the fence agent.
+	Address() string
+	// Port returns the port of the fence agent, or 0 if the default port of the agent type is used.
+	Port() uint
+	// Username returns the username used to log in to the fence agent. The password is never returned by the engine.
+	Username() string
+	// Order returns the position of the fence agent in the order the agents are tried in, starting with 1.
+	Order() uint
+	// Options returns the agent type specific options, for example "lanplus" for IPMI.
+	Options() map[string]string
+	// EncryptOptions returns true if the options are encrypted in the engine database.
+	EncryptOptions() bool
+
+	// Update updates the fence agent with the specified parameters.
+	Update(params UpdateFenceAgentParameters, retries ...RetryStrategy) (FenceAgent, error)
+}
+
+// UpdateFenceAgentParameters describes the possible parameters for updating a fence agent. Use
+// UpdateFenceAgentParams to obtain a builder.
+type UpdateFenceAgentParameters interface {
+	// Type returns the fence agent type to set. It can return nil to leave the type unchanged.
+	Type() *string
+	// Address returns the address to set. It can return nil to leave the address unchanged.
+	Address() *string
+	// Port returns the port to set. It can return nil to leave the port unchanged.
+	Port() *uint
+	// Username returns the username to set. It can return nil to leave the username unchanged.
+	Username() *string
+	// Password returns the password to set. It can return nil to leave the password unchanged.
+	Password() *string
+	// Order returns the position in the order of the fence agents to set. It can return nil to leave the order
+	// unchanged.
+	Order() *uint
+	// Options returns the options to set, replacing all existing options. It can return nil to leave the options
+	// unchanged.
+	Options() map[string]string
+}
+
+// BuildableUpdateFenceAgentParameters is a buildable version of UpdateFenceAgentParameters.
+type BuildableUpdateFenceAgentParameters interface {
+	UpdateFenceAgentParameters
+
+	// WithType sets the fence agent type. It returns an error if the type is empty.
+	WithType(agentType string) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithType is identical to WithType, but panics instead of returning an error.
+	MustWithType(agentType string) BuildableUpdateFenceAgentParameters
+	// WithAddress sets the address of the fence agent. It returns an error if the address is empty.
+	WithAddress(address string) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithAddress is identical to WithAddress, but panics instead of returning an error.
+	MustWithAddress(address string) BuildableUpdateFenceAgentParameters
+	// WithPort sets the port of the fence agent. It returns an error if the port is not between 1 and 65535.
+	WithPort(port uint) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithPort is identical to WithPort, but panics instead of returning an error.
+	MustWithPort(port uint) BuildableUpdateFenceAgentParameters
+	// WithUsername sets the username used to log in to the fence agent.
+	WithUsername(username string) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithUsername is identical to WithUsername, but panics instead of returning an error.
+	MustWithUsername(username string) BuildableUpdateFenceAgentParameters
+	// WithPassword sets the password used to log in to the fence agent.
+	WithPassword(password string) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithPassword is identical to WithPassword, but panics instead of returning an error.
+	MustWithPassword(password string) BuildableUpdateFenceAgentParameters
+	// WithOrder sets the position of the fence agent in the order the agents are tried in. It returns an error if the
+	// order is 0.
+	WithOrder(order uint) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithOrder is identical to WithOrder, but panics instead of returning an error.
+	MustWithOrder(order uint) BuildableUpdateFenceAgentParameters
+	// WithOptions sets the agent type specific options, replacing all existing options.
+	WithOptions(options map[string]string) (BuildableUpdateFenceAgentParameters, error)
+	// MustWithOptions is identical to WithOptions, but panics instead of returning an error.
+	MustWithOptions(options map[string]string) BuildableUpdateFenceAgentParameters
+}
+
+// UpdateFenceAgentParams creates a builder for the parameters of UpdateHostFenceAgent.
+func UpdateFenceAgentParams() BuildableUpdateFenceAgentParameters {
+	return &updateFenceAgentParams{}
+}
+
+type updateFenceAgentParams struct {
+	agentType *string
+	address   *string
+	port      *uint
+	username  *string
+	password  *string
+	order     *uint
+	options   map[string]string
+}
+
+func (u *updateFenceAgentParams) Type() *string {
+	return u.agentType
+}
+
+func (u *updateFenceAgentParams) Address() *string {
+	return u.address
+}
+
+func (u *updateFenceAgentParams) Port() *uint {
+	return u.port
+}
+
+func (u *updateFenceAgentParams) Username() *string {
+	return u.username
+}
+
+func (u *updateFenceAgentParams) Password() *string {
+	return u.password
+}
+
+func (u *updateFenceAgentParams) Order() *uint {
+	return u.order
+}
+
+func (u *updateFenceAgentParams) Options() map[string]string {
+	return u.options
+}
+
+func (u *updateFenceAgentParams) WithType(agentType string) (BuildableUpdateFenceAgentParameters, error) {
+	if agentType == "" {
+		return nil, newError(EBadArgument, "the fence agent type must not be empty")
+	}
+	u.agentType = &agentType
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithType(agentType string) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithType(agentType)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithAddress(address string) (BuildableUpdateFenceAgentParameters, error) {
+	if address == "" {
+		return nil, newError(EBadArgument, "the fence agent address must not be empty")
+	}
+	u.address = &address
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithAddress(address string) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithAddress(address)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithPort(port uint) (BuildableUpdateFenceAgentParameters, error) {
+	if port == 0 || port > 65535 {
+		return nil, newError(EBadArgument, "invalid fence agent port %d, must be between 1 and 65535", port)
+	}
+	u.port = &port
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithPort(port uint) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithPort(port)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithUsername(username string) (BuildableUpdateFenceAgentParameters, error) {
+	u.username = &username
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithUsername(username string) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithUsername(username)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithPassword(password string) (BuildableUpdateFenceAgentParameters, error) {
+	u.password = &password
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithPassword(password string) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithPassword(password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithOrder(order uint) (BuildableUpdateFenceAgentParameters, error) {
+	if order == 0 {
+		return nil, newError(EBadArgument, "the fence agent order must start with 1")
+	}
+	u.order = &order
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithOrder(order uint) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithOrder(order)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateFenceAgentParams) WithOptions(options map[string]string) (BuildableUpdateFenceAgentParameters, error) {
+	u.options = make(map[string]string, len(options))
+	for name, value := range options {
+		u.options[name] = value
+	}
+	return u, nil
+}
+
+func (u *updateFenceAgentParams) MustWithOptions(options map[string]string) BuildableUpdateFenceAgentParameters {
+	builder, err := u.WithOptions(options)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func convertSDKFenceAgent(sdkObject *ovirtsdk4.Agent, hostID string, client Client) (*fenceAgent, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "ID")
+	}
+	agentType, ok := sdkObject.Type()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "type")
+	}
+	address, ok := sdkObject.Address()
+	if !ok {
+		return nil, newFieldNotFound("fence agent", "address")
+	}
+	// The following fields are optional and not returned by the engine if they are not set.
+	port, _ := sdkObject.Port()
+	username, _ := sdkObject.Username()
+	order, _ := sdkObject.Order()
+	encryptOptions, _ := sdkObject.EncryptOptions()
+	options := map[string]string{}
+	if sdkOptions, ok := sdkObject.Options(); ok {
+		for _, sdkOption := range sdkOptions.Slice() {
+			name, ok := sdkOption.Name()
+			if !ok {
+				continue
+			}
+			options[name], _ = sdkOption.Value()
+		}
+	}
+	return &fenceAgent{
+		client:         client,
+		id:             id,
+		hostID:         hostID,
+		agentType:      agentType,
+		address:        address,
+		port:           uint(port),
+		username:       username,
+		order:          uint(order),
+		options:        options,
+		encryptOptions: encryptOptions,
+	}, nil
+}
+
+// buildSDKFenceAgent creates the SDK object for updating a fence agent from params.
+func buildSDKFenceAgent(id string, params UpdateFenceAgentParameters) (*ovirtsdk4.Agent, error) {
+	builder := ovirtsdk4.NewAgentBuilder().Id(id)
+	if agentType := params.Type(); agentType != nil {
+		builder.Type(*agentType)
+	}
+	if address := params.Address(); address != nil {
+		builder.Address(*address)
+	}
+	if port := params.Port(); port != nil {
+		builder.Port(int64(*port))
+	}
+	if username := params.Username(); username != nil {
+		builder.Username(*username)
+	}
+	if password := params.Password(); password != nil {
+		builder.Password(*password)
+	}
+	if order := params.Order(); order != nil {
+		builder.Order(int64(*order))
+	}
+	if options := params.Options(); options != nil {
+		names := make([]string, 0, len(options))
+		for name := range options {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		sdkOptions := make([]*ovirtsdk4.Option, len(names))
+		for i, name := range names {
+			sdkOption, err := ovirtsdk4.NewOptionBuilder().Name(name).Value(options[name]).Build()
+			if err != nil {
+				return nil, wrap(err, EBug, "failed to build fence agent option %s", name)
+			}
+			sdkOptions[i] = sdkOption
+		}
+		builder.OptionsOfAny(sdkOptions...)
+	}
+	sdkAgent, err := builder.Build()
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to build fence agent %s", id)
+	}
+	return sdkAgent, nil
+}
+
+type fenceAgent struct {
+	client Client
+
+	id             string
+	hostID         string
+	agentType      string
+	address        string
+	port           uint
+	username       string
+	order          uint
+	options        map[string]string
+	encryptOptions bool
+}
+
+func (f *fenceAgent) ID() string {
+	return f.id
+}
+
+func (f *fenceAgent) HostID() string {
+	return f.hostID
+}
+
+func (f *fenceAgent) Type() string {
+	return f.agentType
+}
+
+func (f *fenceAgent) Address() string {
+	return f.address
+}
+
+func (f *fenceAgent) Port() uint {
+	return f.port
+}
+
+func (f *fenceAgent) Username() string {
+	return f.username
+}
+
+func (f *fenceAgent) Order() uint {
+	return f.order
+}
+
+func (f *fenceAgent) Options() map[string]string {
+	return f.options
+}
+
+func (f *fenceAgent) EncryptOptions() bool {
+	return f.encryptOptions
+}
+
+func (f *fenceAgent) Update(params UpdateFenceAgentParameters, retries ...RetryStrategy) (FenceAgent, error) {
+	return f.client.UpdateHostFenceAgent(f.hostID, f.id, params, retries...)
+}
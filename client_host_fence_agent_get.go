@@ -0,0 +1,49 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetHostFenceAgent(hostID string, agentID string, retries ...RetryStrategy) (
+	result FenceAgent,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeFenceAgent, agentID),
+		fmt.Sprintf("getting fence agent %s on host %s", agentID, hostID),
+		retries,
+		func() error {
+			response, err := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				FenceAgentsService().
+				AgentService(agentID).
+				Get().
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Agent()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no fence agent returned when getting fence agent %s on host %s",
+					agentID,
+					hostID,
+				)
+			}
+			result, err = convertSDKFenceAgent(sdkObject, hostID, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert fence agent %s on host %s",
+					agentID,
+					hostID,
+				)
+			}
+			return nil
+		})
+	return
+}
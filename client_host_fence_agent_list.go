@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostFenceAgents(hostID string, retries ...RetryStrategy) (result []FenceAgent, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []FenceAgent{}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing fence agents for host %s", hostID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				FenceAgentsService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Agents()
+			if !ok {
+				return nil
+			}
+			result = make([]FenceAgent, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKFenceAgent(sdkObject, hostID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert fence agent during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,56 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) UpdateHostFenceAgent(
+	hostID string,
+	agentID string,
+	params UpdateFenceAgentParameters,
+	retries ...RetryStrategy,
+) (result FenceAgent, err error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the parameters for updating a fence agent must not be nil")
+	}
+	sdkAgent, err := buildSDKFenceAgent(agentID, params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeFenceAgent, agentID).with("host_id", hostID),
+		fmt.Sprintf("updating fence agent %s on host %s", agentID, hostID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				FenceAgentsService().
+				AgentService(agentID).
+				Update().
+				Agent(sdkAgent)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Agent()
+			if !ok {
+				return newError(
+					EFieldMissing,
+					"missing fence agent object from fence agent update response",
+				)
+			}
+			result, err = convertSDKFenceAgent(sdkObject, hostID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert fence agent %s on host %s", agentID, hostID)
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetHost(id string, retries ...RetryStrategy) (result Host, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeHost, id),
 		fmt.Sprintf("getting host %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().HostsService().HostService(id).Get().Send()
+			response, err := o.connection().SystemService().HostsService().HostService(id).Get().Send()
 			if err != nil {
 				return err
 			}
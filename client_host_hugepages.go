@@ -0,0 +1,89 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostHugePages describes the free hugepages of a single size on a host. Use it to check if a VM with hugepages
+// fits a host.
+type HostHugePages interface {
+	// Size returns the size of the hugepages, which can be compared to VMData.HugePages.
+	Size() VMHugePages
+	// Free returns the number of free hugepages of this size.
+	Free() uint64
+}
+
+func (o *oVirtClient) ListHostHugePages(hostID string, retries ...RetryStrategy) (result []HostHugePages, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []HostHugePages{}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing hugepages for host %s", hostID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				StatisticsService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Statistics()
+			if !ok {
+				return nil
+			}
+			result, e = convertSDKHostHugePages(sdkObjects.Slice())
+			return e
+		})
+	return
+}
+
+// convertSDKHostHugePages extracts the free hugepages from the statistics of a host. The engine reports them as
+// statistics named hugepages.<size in KiB>.free, other statistics are ignored.
+func convertSDKHostHugePages(sdkObjects []*ovirtsdk4.Statistic) ([]HostHugePages, error) {
+	hugePages := []*hostHugePages{}
+	for _, sdkObject := range sdkObjects {
+		name, ok := sdkObject.Name()
+		if !ok || !strings.HasPrefix(name, "hugepages.") || !strings.HasSuffix(name, ".free") {
+			continue
+		}
+		sizeString := strings.TrimSuffix(strings.TrimPrefix(name, "hugepages."), ".free")
+		size, err := strconv.ParseUint(sizeString, 10, 64)
+		if err != nil {
+			return nil, wrap(err, EBug, "invalid hugepage size in host statistic %s", name)
+		}
+		var free uint64
+		if sdkValues, ok := sdkObject.Values(); ok && len(sdkValues.Slice()) > 0 {
+			if datum, ok := sdkValues.Slice()[0].Datum(); ok {
+				free = uint64(datum)
+			}
+		}
+		hugePages = append(hugePages, &hostHugePages{size: VMHugePages(size), free: free})
+	}
+	sort.Slice(hugePages, func(i, j int) bool { return hugePages[i].size < hugePages[j].size })
+	result := make([]HostHugePages, len(hugePages))
+	for i, item := range hugePages {
+		result[i] = item
+	}
+	return result, nil
+}
+
+type hostHugePages struct {
+	size VMHugePages
+	free uint64
+}
+
+func (h *hostHugePages) Size() VMHugePages {
+	return h.size
+}
+
+func (h *hostHugePages) Free() uint64 {
+	return h.free
+}
@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListHosts(retries ...RetryStrategy) (result []Host, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Host{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeHost, ""),
 		"listing hosts",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().HostsService().List().Send()
+			response, e := o.connection().SystemService().HostsService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -0,0 +1,113 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostNUMANode is a NUMA node of a host. Use it to check if a VM with NUMA pinning fits a host.
+type HostNUMANode interface {
+	// Index returns the index of the NUMA node on the host, starting with 0.
+	Index() uint
+	// Memory returns the memory of the NUMA node in bytes.
+	Memory() uint64
+	// CPUs returns the indexes of the logical CPUs belonging to the NUMA node.
+	CPUs() []uint
+	// Distances returns the distance of the NUMA node to each NUMA node of the host, indexed by the index of the
+	// other node. The distance to the node itself is typically 10.
+	Distances() []uint
+}
+
+func (o *oVirtClient) ListHostNUMANodes(hostID string, retries ...RetryStrategy) (result []HostNUMANode, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []HostNUMANode{}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing NUMA nodes for host %s", hostID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				NumaNodesService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Nodes()
+			if !ok {
+				return nil
+			}
+			result = make([]HostNUMANode, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHostNUMANode(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert NUMA node during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func convertSDKHostNUMANode(sdkObject *ovirtsdk4.NumaNode) (*hostNUMANode, error) {
+	index, ok := sdkObject.Index()
+	if !ok {
+		return nil, newFieldNotFound("NUMA node", "index")
+	}
+	// The engine reports the memory of NUMA nodes in MiB.
+	memory, _ := sdkObject.Memory()
+	cpus := []uint{}
+	if sdkCPU, ok := sdkObject.Cpu(); ok {
+		if sdkCores, ok := sdkCPU.Cores(); ok {
+			for _, sdkCore := range sdkCores.Slice() {
+				if coreIndex, ok := sdkCore.Index(); ok {
+					cpus = append(cpus, uint(coreIndex))
+				}
+			}
+		}
+	}
+	distances := []uint{}
+	if nodeDistance, ok := sdkObject.NodeDistance(); ok {
+		for _, field := range strings.Fields(nodeDistance) {
+			distance, err := strconv.ParseUint(field, 10, 32)
+			if err != nil {
+				return nil, wrap(err, EBug, "invalid distance %s for NUMA node %d", field, index)
+			}
+			distances = append(distances, uint(distance))
+		}
+	}
+	return &hostNUMANode{
+		index:     uint(index),
+		memory:    uint64(memory) * 1024 * 1024,
+		cpus:      cpus,
+		distances: distances,
+	}, nil
+}
+
+type hostNUMANode struct {
+	index     uint
+	memory    uint64
+	cpus      []uint
+	distances []uint
+}
+
+func (h *hostNUMANode) Index() uint {
+	return h.index
+}
+
+func (h *hostNUMANode) Memory() uint64 {
+	return h.memory
+}
+
+func (h *hostNUMANode) CPUs() []uint {
+	return h.cpus
+}
+
+func (h *hostNUMANode) Distances() []uint {
+	return h.distances
+}
@@ -0,0 +1,33 @@
+package ovirtclient
+
+func (o *oVirtClient) SearchHosts(params HostSearchParameters, retries ...RetryStrategy) (result []Host, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Host{}
+	qs, err := nameAndTagSearchCriteria("name", params.Name(), "tag", params.Tag())
+	if err != nil {
+		return nil, err
+	}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, ""),
+		"searching for hosts",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().HostsService().List().Search(qs).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Hosts()
+			if !ok {
+				return nil
+			}
+			result = make([]Host, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHost(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host during searching item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
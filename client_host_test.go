@@ -0,0 +1,258 @@
+package ovirtclient_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestListAndGetHosts(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("no hosts returned")
+	}
+	for _, host := range hosts {
+		fetchedHost, err := client.GetHost(host.ID())
+		if err != nil {
+			t.Fatalf("failed to fetch host %s (%v)", host.ID(), err)
+		}
+		if fetchedHost.Name() != host.Name() {
+			t.Fatalf("host name mismatch on host %s (%s != %s)", host.ID(), fetchedHost.Name(), host.Name())
+		}
+		if fetchedHost.ClusterID() != host.ClusterID() {
+			t.Fatalf("cluster ID mismatch on host %s (%s != %s)", host.ID(), fetchedHost.ClusterID(), host.ClusterID())
+		}
+		if fetchedHost.Status() != ovirtclient.HostStatusUp {
+			continue
+		}
+		if fetchedHost.Address() == "" {
+			t.Fatalf("no address returned for host %s", host.ID())
+		}
+		if fetchedHost.Memory() == 0 {
+			t.Fatalf("no memory returned for host %s", host.ID())
+		}
+		if fetchedHost.CPU().Topo().Cores() == 0 {
+			t.Fatalf("no CPU cores returned for host %s", host.ID())
+		}
+	}
+}
+
+func TestFenceHostStatus(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	host, agents := findFencedHost(t, client)
+	status, err := host.Fence(ovirtclient.FenceTypeStatus)
+	if err != nil {
+		t.Fatalf("failed to query power status of host %s (%v)", host.ID(), err)
+	}
+	if host.Status() == ovirtclient.HostStatusUp && status != ovirtclient.HostPowerStatusOn {
+		t.Fatalf("incorrect power status for running host %s (%s)", host.ID(), status)
+	}
+	agent, err := client.GetHostFenceAgent(host.ID(), agents[0].ID())
+	if err != nil {
+		t.Fatalf("failed to fetch fence agent %s on host %s (%v)", agents[0].ID(), host.ID(), err)
+	}
+	if agent.Address() != agents[0].Address() {
+		t.Fatalf("address mismatch on fence agent %s (%s != %s)", agent.ID(), agent.Address(), agents[0].Address())
+	}
+}
+
+func TestFenceHostInvalidType(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.FenceHost("nonexistent", "invalid")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("fencing with an invalid type did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestFenceHostStopAndStart(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+
+	host, _ := findFencedHost(t, client)
+	status, err := client.FenceHost(host.ID(), ovirtclient.FenceTypeStop)
+	if err != nil {
+		t.Fatalf("failed to stop host %s (%v)", host.ID(), err)
+	}
+	if status != ovirtclient.HostPowerStatusOff {
+		t.Fatalf("incorrect power status after stopping host %s (%s)", host.ID(), status)
+	}
+	if status, err = client.FenceHost(host.ID(), ovirtclient.FenceTypeStart); err != nil {
+		t.Fatalf("failed to start host %s (%v)", host.ID(), err)
+	}
+	if status != ovirtclient.HostPowerStatusOn {
+		t.Fatalf("incorrect power status after starting host %s (%s)", host.ID(), status)
+	}
+}
+
+func TestUpdateHostFenceAgent(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+
+	host, agents := findFencedHost(t, client)
+	updated, err := agents[0].Update(
+		ovirtclient.UpdateFenceAgentParams().
+			MustWithAddress("bmc.example.com").
+			MustWithPort(623).
+			MustWithOptions(map[string]string{"lanplus": "1", "privlvl": "operator"}),
+	)
+	if err != nil {
+		t.Fatalf("failed to update fence agent %s on host %s (%v)", agents[0].ID(), host.ID(), err)
+	}
+	fetched, err := client.GetHostFenceAgent(host.ID(), updated.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch fence agent %s on host %s (%v)", updated.ID(), host.ID(), err)
+	}
+	if fetched.Address() != "bmc.example.com" || fetched.Port() != 623 {
+		t.Fatalf("fence agent was not updated (address: %s, port: %d)", fetched.Address(), fetched.Port())
+	}
+	if fetched.Options()["privlvl"] != "operator" {
+		t.Fatalf("fence agent options were not updated (%v)", fetched.Options())
+	}
+	if fetched.Type() != agents[0].Type() {
+		t.Fatalf("fence agent type changed (%s != %s)", fetched.Type(), agents[0].Type())
+	}
+}
+
+func findFencedHost(t *testing.T, client ovirtclient.Client) (ovirtclient.Host, []ovirtclient.FenceAgent) {
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		agents, err := host.ListFenceAgents()
+		if err != nil {
+			t.Fatalf("failed to list fence agents on host %s (%v)", host.ID(), err)
+		}
+		if len(agents) > 0 {
+			return host, agents
+		}
+	}
+	t.Skipf("no host with fence agents found, skipping test")
+	return nil, nil
+}
+
+func TestHostNUMANodesAndHugePages(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		nodes, err := host.ListNUMANodes()
+		if err != nil {
+			t.Fatalf("failed to list NUMA nodes of host %s (%v)", host.ID(), err)
+		}
+		if len(nodes) > 0 && !host.NUMASupported() {
+			t.Fatalf("host %s returned NUMA nodes, but does not support NUMA", host.ID())
+		}
+		for _, node := range nodes {
+			if len(node.Distances()) != len(nodes) {
+				t.Fatalf(
+					"incorrect number of distances for NUMA node %d of host %s (expected: %d, got: %d)",
+					node.Index(),
+					host.ID(),
+					len(nodes),
+					len(node.Distances()),
+				)
+			}
+		}
+		hugePages, err := host.ListHugePages()
+		if err != nil {
+			t.Fatalf("failed to list hugepages of host %s (%v)", host.ID(), err)
+		}
+		for i := 1; i < len(hugePages); i++ {
+			if hugePages[i-1].Size() >= hugePages[i].Size() {
+				t.Fatalf("hugepages of host %s are not sorted by size", host.ID())
+			}
+		}
+	}
+}
+
+func TestHostDevices(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		devices, err := host.ListDevices()
+		if err != nil {
+			t.Fatalf("failed to list devices of host %s (%v)", host.ID(), err)
+		}
+		names := map[string]bool{}
+		for _, device := range devices {
+			names[device.Name()] = true
+		}
+		for _, device := range devices {
+			if device.HostID() != host.ID() {
+				t.Fatalf("device %s has incorrect host ID (%s != %s)", device.ID(), device.HostID(), host.ID())
+			}
+			if device.Capability() == "" {
+				t.Fatalf("no capability returned for device %s of host %s", device.Name(), host.ID())
+			}
+			if parent := device.ParentDeviceName(); parent != "" && !names[parent] {
+				t.Fatalf("parent device %s of device %s not found on host %s", parent, device.Name(), host.ID())
+			}
+		}
+	}
+}
+
+func TestHostAccessDetails(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		if host.Status() != ovirtclient.HostStatusUp {
+			continue
+		}
+		if host.SSH().Port() == 0 {
+			t.Fatalf("no SSH port returned for host %s", host.ID())
+		}
+		if host.SSH().Fingerprint() == "" {
+			t.Fatalf("no SSH fingerprint returned for host %s", host.ID())
+		}
+		if host.Certificate().Subject() == "" {
+			t.Fatalf("no certificate subject returned for host %s", host.ID())
+		}
+	}
+	if len(hosts) == 0 {
+		t.Fatalf("no hosts returned")
+	}
+	caCertificate, err := hosts[0].ConsoleCACertificate()
+	if err != nil {
+		t.Fatalf("failed to fetch the console CA certificate (%v)", err)
+	}
+	block, _ := pem.Decode(caCertificate)
+	if block == nil || block.Type != "CERTIFICATE" {
+		t.Fatalf("the console CA certificate is not a PEM encoded certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		t.Fatalf("failed to parse the console CA certificate (%v)", err)
+	}
+}
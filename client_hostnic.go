@@ -0,0 +1,278 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostNICClient contains the functions related to the physical network interfaces of hosts.
+type HostNICClient interface {
+	// ListHostNICs lists all network interfaces of the host specified in hostID.
+	ListHostNICs(hostID string, retries ...RetryStrategy) ([]HostNIC, error)
+	// GetHostNIC returns a single network interface with the ID nicID on the host specified in hostID.
+	GetHostNIC(hostID string, nicID string, retries ...RetryStrategy) (HostNIC, error)
+	// ListHostNICLabels lists the network labels assigned to the host NIC. Networks with the same label are attached
+	// to the NIC automatically.
+	ListHostNICLabels(hostID string, nicID string, retries ...RetryStrategy) ([]string, error)
+	// AddHostNICLabel assigns a network label to the host NIC. Networks carrying the same label are attached to the
+	// NIC automatically.
+	AddHostNICLabel(hostID string, nicID string, label string, retries ...RetryStrategy) error
+	// RemoveHostNICLabel removes a network label from the host NIC.
+	RemoveHostNICLabel(hostID string, nicID string, label string, retries ...RetryStrategy) error
+	// UpdateHostNICVirtualFunctions changes the SR-IOV virtual function configuration of the host NIC. Use
+	// UpdateHostNICVirtualFunctionsParams to obtain a builder for the parameters. It returns an error with the code
+	// EUnsupported if the NIC is not SR-IOV capable.
+	UpdateHostNICVirtualFunctions(
+		hostID string,
+		nicID string,
+		params UpdateHostNICVirtualFunctionsParameters,
+		retries ...RetryStrategy,
+	) (HostNIC, error)
+}
+
+// HostNICData is the core of HostNIC, providing only data access functions.
+type HostNICData interface {
+	// ID returns the identifier of the host NIC.
+	ID() string
+	// Name returns the name of the network interface on the host, for example eth0.
+	Name() string
+	// HostID returns the ID of the host this NIC belongs to.
+	HostID() string
+	// MAC returns the MAC address of the network interface. It may be empty for some virtual interfaces.
+	MAC() string
+	// VirtualFunctionsConfiguration returns the SR-IOV virtual function configuration of this NIC. It returns nil
+	// if the NIC is not SR-IOV capable.
+	VirtualFunctionsConfiguration() HostNICVirtualFunctionsConfiguration
+}
+
+// HostNICVirtualFunctionsConfiguration describes the SR-IOV virtual functions of a host NIC. Virtual functions can be
+// attached directly to VMs using VNIC profiles with VNICPassThroughModeEnabled.
+type HostNICVirtualFunctionsConfiguration interface {
+	// MaxNumberOfVirtualFunctions returns the maximum number of virtual functions the NIC supports.
+	MaxNumberOfVirtualFunctions() uint
+	// NumberOfVirtualFunctions returns the number of virtual functions currently configured on the NIC.
+	NumberOfVirtualFunctions() uint
+	// AllNetworksAllowed indicates if all networks may be attached to the virtual functions of this NIC.
+	AllNetworksAllowed() bool
+}
+
+// UpdateHostNICVirtualFunctionsParameters contains the changes to the SR-IOV virtual function configuration of a
+// host NIC. Each method can return nil to leave an attribute unchanged.
+type UpdateHostNICVirtualFunctionsParameters interface {
+	// NumberOfVirtualFunctions potentially returns the new number of virtual functions.
+	NumberOfVirtualFunctions() *uint
+	// AllNetworksAllowed potentially returns a change in the permission to attach all networks.
+	AllNetworksAllowed() *bool
+}
+
+// BuildableUpdateHostNICVirtualFunctionsParameters is a buildable version of
+// UpdateHostNICVirtualFunctionsParameters.
+type BuildableUpdateHostNICVirtualFunctionsParameters interface {
+	UpdateHostNICVirtualFunctionsParameters
+
+	// WithNumberOfVirtualFunctions sets the number of virtual functions to configure.
+	WithNumberOfVirtualFunctions(number uint) (BuildableUpdateHostNICVirtualFunctionsParameters, error)
+	// MustWithNumberOfVirtualFunctions is identical to WithNumberOfVirtualFunctions, but panics instead of returning
+	// an error.
+	MustWithNumberOfVirtualFunctions(number uint) BuildableUpdateHostNICVirtualFunctionsParameters
+
+	// WithAllNetworksAllowed sets if all networks may be attached to the virtual functions.
+	WithAllNetworksAllowed(allowed bool) (BuildableUpdateHostNICVirtualFunctionsParameters, error)
+	// MustWithAllNetworksAllowed is identical to WithAllNetworksAllowed, but panics instead of returning an error.
+	MustWithAllNetworksAllowed(allowed bool) BuildableUpdateHostNICVirtualFunctionsParameters
+}
+
+// UpdateHostNICVirtualFunctionsParams creates a buildable set of parameters for changing the SR-IOV configuration of
+// a host NIC.
+func UpdateHostNICVirtualFunctionsParams() BuildableUpdateHostNICVirtualFunctionsParameters {
+	return &updateHostNICVirtualFunctionsParams{}
+}
+
+type updateHostNICVirtualFunctionsParams struct {
+	numberOfVirtualFunctions *uint
+	allNetworksAllowed       *bool
+}
+
+func (u *updateHostNICVirtualFunctionsParams) NumberOfVirtualFunctions() *uint {
+	return u.numberOfVirtualFunctions
+}
+
+func (u *updateHostNICVirtualFunctionsParams) AllNetworksAllowed() *bool {
+	return u.allNetworksAllowed
+}
+
+func (u *updateHostNICVirtualFunctionsParams) WithNumberOfVirtualFunctions(
+	number uint,
+) (BuildableUpdateHostNICVirtualFunctionsParameters, error) {
+	u.numberOfVirtualFunctions = &number
+	return u, nil
+}
+
+func (u *updateHostNICVirtualFunctionsParams) MustWithNumberOfVirtualFunctions(
+	number uint,
+) BuildableUpdateHostNICVirtualFunctionsParameters {
+	builder, err := u.WithNumberOfVirtualFunctions(number)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateHostNICVirtualFunctionsParams) WithAllNetworksAllowed(
+	allowed bool,
+) (BuildableUpdateHostNICVirtualFunctionsParameters, error) {
+	u.allNetworksAllowed = &allowed
+	return u, nil
+}
+
+func (u *updateHostNICVirtualFunctionsParams) MustWithAllNetworksAllowed(
+	allowed bool,
+) BuildableUpdateHostNICVirtualFunctionsParameters {
+	builder, err := u.WithAllNetworksAllowed(allowed)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// HostNIC is a physical network interface of a host.
+type HostNIC interface {
+	HostNICData
+
+	// Host fetches the host this NIC belongs to. This is a network call and may be slow.
+	Host(retries ...RetryStrategy) (Host, error)
+	// Labels lists the network labels assigned to this NIC. This is a network call and may be slow.
+	Labels(retries ...RetryStrategy) ([]string, error)
+	// AddLabel assigns a network label to this NIC. This is a network call and may be slow.
+	AddLabel(label string, retries ...RetryStrategy) error
+	// RemoveLabel removes a network label from this NIC. This is a network call and may be slow.
+	RemoveLabel(label string, retries ...RetryStrategy) error
+	// UpdateVirtualFunctions changes the SR-IOV virtual function configuration of this NIC. This is a network call
+	// and may be slow.
+	UpdateVirtualFunctions(params UpdateHostNICVirtualFunctionsParameters, retries ...RetryStrategy) (HostNIC, error)
+}
+
+func convertSDKHostNIC(sdkObject *ovirtsdk4.HostNic, hostID string, client Client) (HostNIC, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("host NIC", "name")
+	}
+	mac := ""
+	if sdkMAC, ok := sdkObject.Mac(); ok {
+		mac, _ = sdkMAC.Address()
+	}
+	var vfConfig *hostNICVirtualFunctionsConfiguration
+	if sdkVFConfig, ok := sdkObject.VirtualFunctionsConfiguration(); ok {
+		vfConfig = convertSDKHostNICVirtualFunctionsConfiguration(sdkVFConfig)
+	}
+	return &hostNIC{
+		client:   client,
+		id:       id,
+		name:     name,
+		hostID:   hostID,
+		mac:      mac,
+		vfConfig: vfConfig,
+	}, nil
+}
+
+func convertSDKHostNICVirtualFunctionsConfiguration(
+	sdkObject *ovirtsdk4.HostNicVirtualFunctionsConfiguration,
+) *hostNICVirtualFunctionsConfiguration {
+	maxNumber, _ := sdkObject.MaxNumberOfVirtualFunctions()
+	number, _ := sdkObject.NumberOfVirtualFunctions()
+	allNetworksAllowed, _ := sdkObject.AllNetworksAllowed()
+	return &hostNICVirtualFunctionsConfiguration{
+		maxNumberOfVirtualFunctions: uint(maxNumber),
+		numberOfVirtualFunctions:    uint(number),
+		allNetworksAllowed:          allNetworksAllowed,
+	}
+}
+
+type hostNIC struct {
+	client Client
+
+	id       string
+	name     string
+	hostID   string
+	mac      string
+	vfConfig *hostNICVirtualFunctionsConfiguration
+}
+
+func (h hostNIC) VirtualFunctionsConfiguration() HostNICVirtualFunctionsConfiguration {
+	if h.vfConfig == nil {
+		return nil
+	}
+	return h.vfConfig
+}
+
+func (h hostNIC) UpdateVirtualFunctions(
+	params UpdateHostNICVirtualFunctionsParameters,
+	retries ...RetryStrategy,
+) (HostNIC, error) {
+	return h.client.UpdateHostNICVirtualFunctions(h.hostID, h.id, params, retries...)
+}
+
+// withVirtualFunctionsConfiguration returns a copy of the host NIC with the new virtual function configuration.
+func (h hostNIC) withVirtualFunctionsConfiguration(vfConfig *hostNICVirtualFunctionsConfiguration) *hostNIC {
+	return &hostNIC{
+		client:   h.client,
+		id:       h.id,
+		name:     h.name,
+		hostID:   h.hostID,
+		mac:      h.mac,
+		vfConfig: vfConfig,
+	}
+}
+
+type hostNICVirtualFunctionsConfiguration struct {
+	maxNumberOfVirtualFunctions uint
+	numberOfVirtualFunctions    uint
+	allNetworksAllowed          bool
+}
+
+func (h hostNICVirtualFunctionsConfiguration) MaxNumberOfVirtualFunctions() uint {
+	return h.maxNumberOfVirtualFunctions
+}
+
+func (h hostNICVirtualFunctionsConfiguration) NumberOfVirtualFunctions() uint {
+	return h.numberOfVirtualFunctions
+}
+
+func (h hostNICVirtualFunctionsConfiguration) AllNetworksAllowed() bool {
+	return h.allNetworksAllowed
+}
+
+func (h hostNIC) ID() string {
+	return h.id
+}
+
+func (h hostNIC) Name() string {
+	return h.name
+}
+
+func (h hostNIC) HostID() string {
+	return h.hostID
+}
+
+func (h hostNIC) MAC() string {
+	return h.mac
+}
+
+func (h hostNIC) Host(retries ...RetryStrategy) (Host, error) {
+	return h.client.GetHost(h.hostID, retries...)
+}
+
+func (h hostNIC) Labels(retries ...RetryStrategy) ([]string, error) {
+	return h.client.ListHostNICLabels(h.hostID, h.id, retries...)
+}
+
+func (h hostNIC) AddLabel(label string, retries ...RetryStrategy) error {
+	return h.client.AddHostNICLabel(h.hostID, h.id, label, retries...)
+}
+
+func (h hostNIC) RemoveLabel(label string, retries ...RetryStrategy) error {
+	return h.client.RemoveHostNICLabel(h.hostID, h.id, label, retries...)
+}
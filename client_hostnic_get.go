@@ -0,0 +1,46 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetHostNIC(hostID string, nicID string, retries ...RetryStrategy) (result HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeHostNIC, nicID),
+		fmt.Sprintf("getting NIC %s on host %s", nicID, hostID),
+		retries,
+		func() error {
+			response, err := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				NicsService().
+				NicService(nicID).
+				Get().
+				Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Nic()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no NIC returned when getting NIC %s on host %s",
+					nicID,
+					hostID,
+				)
+			}
+			result, err = convertSDKHostNIC(sdkObject, hostID, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert NIC %s on host %s",
+					nicID,
+					hostID,
+				)
+			}
+			return nil
+		})
+	return
+}
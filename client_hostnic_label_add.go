@@ -0,0 +1,20 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) AddHostNICLabel(hostID string, nicID string, label string, retries ...RetryStrategy) error {
+	return o.addNetworkLabel(
+		o.connection().SystemService().
+			HostsService().
+			HostService(hostID).
+			NicsService().
+			NicService(nicID).
+			NetworkLabelsService(),
+		resourceOf(ResourceTypeHostNIC, nicID).with("host_id", hostID),
+		fmt.Sprintf("NIC %s on host %s", nicID, hostID),
+		label,
+		retries,
+	)
+}
@@ -0,0 +1,19 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostNICLabels(hostID string, nicID string, retries ...RetryStrategy) ([]string, error) {
+	return o.listNetworkLabels(
+		o.connection().SystemService().
+			HostsService().
+			HostService(hostID).
+			NicsService().
+			NicService(nicID).
+			NetworkLabelsService(),
+		resourceOf(ResourceTypeHostNIC, nicID).with("host_id", hostID),
+		fmt.Sprintf("NIC %s on host %s", nicID, hostID),
+		retries,
+	)
+}
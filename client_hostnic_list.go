@@ -0,0 +1,33 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListHostNICs(hostID string, retries ...RetryStrategy) (result []HostNIC, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []HostNIC{}
+	err = o.retry(
+		resourceOf(ResourceTypeHost, hostID),
+		fmt.Sprintf("listing NICs for host %s", hostID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().HostsService().HostService(hostID).NicsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Nics()
+			if !ok {
+				return nil
+			}
+			result = make([]HostNIC, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHostNIC(sdkObject, hostID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host NIC during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
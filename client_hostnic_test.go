@@ -0,0 +1,75 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestHostNICListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		nics, err := host.ListNICs()
+		if err != nil {
+			t.Fatalf("failed to list NICs on host %s (%v)", host.ID(), err)
+		}
+		for _, nic := range nics {
+			fetchedNIC, err := client.GetHostNIC(host.ID(), nic.ID())
+			if err != nil {
+				t.Fatalf("failed to fetch NIC %s on host %s (%v)", nic.ID(), host.ID(), err)
+			}
+			if fetchedNIC.HostID() != host.ID() {
+				t.Fatalf("host ID mismatch on NIC %s (%s != %s)", nic.ID(), fetchedNIC.HostID(), host.ID())
+			}
+			if _, err := fetchedNIC.Labels(); err != nil {
+				t.Fatalf("failed to list labels on NIC %s on host %s (%v)", nic.ID(), host.ID(), err)
+			}
+		}
+	}
+}
+
+func TestHostNICTooManyVirtualFunctions(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	nic := findSRIOVHostNIC(t, client)
+	vfConfig := nic.VirtualFunctionsConfiguration()
+	_, err := nic.UpdateVirtualFunctions(
+		ovirtclient.UpdateHostNICVirtualFunctionsParams().
+			MustWithNumberOfVirtualFunctions(vfConfig.MaxNumberOfVirtualFunctions() + 1),
+	)
+	if err == nil {
+		t.Fatalf("configuring more virtual functions than supported did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("configuring more virtual functions than supported returned an unexpected error (%v)", err)
+	}
+}
+
+func findSRIOVHostNIC(t *testing.T, client ovirtclient.Client) ovirtclient.HostNIC {
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	for _, host := range hosts {
+		nics, err := host.ListNICs()
+		if err != nil {
+			t.Fatalf("failed to list NICs on host %s (%v)", host.ID(), err)
+		}
+		for _, nic := range nics {
+			if nic.VirtualFunctionsConfiguration() != nil {
+				return nic
+			}
+		}
+	}
+	t.Skipf("No SR-IOV capable host NIC found, skipping test.")
+	return nil
+}
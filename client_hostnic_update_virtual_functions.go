@@ -0,0 +1,72 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateHostNICVirtualFunctions(
+	hostID string,
+	nicID string,
+	params UpdateHostNICVirtualFunctionsParameters,
+	retries ...RetryStrategy,
+) (HostNIC, error) {
+	nic, err := o.GetHostNIC(hostID, nicID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateHostNICVirtualFunctionsUpdate(nic, params); err != nil {
+		return nil, err
+	}
+
+	vfConfigBuilder := ovirtsdk.NewHostNicVirtualFunctionsConfigurationBuilder()
+	if number := params.NumberOfVirtualFunctions(); number != nil {
+		vfConfigBuilder.NumberOfVirtualFunctions(int64(*number))
+	}
+	if allowed := params.AllNetworksAllowed(); allowed != nil {
+		vfConfigBuilder.AllNetworksAllowed(*allowed)
+	}
+
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeHostNIC, nicID).with("host_id", hostID),
+		fmt.Sprintf("updating virtual functions of NIC %s on host %s", nicID, hostID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				HostsService().
+				HostService(hostID).
+				NicsService().
+				NicService(nicID).
+				UpdateVirtualFunctionsConfiguration().
+				VirtualFunctionsConfiguration(vfConfigBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	if err != nil {
+		return nil, withCorrelationID(err, correlationID)
+	}
+	return o.GetHostNIC(hostID, nicID, retries...)
+}
+
+func validateHostNICVirtualFunctionsUpdate(nic HostNIC, params UpdateHostNICVirtualFunctionsParameters) error {
+	vfConfig := nic.VirtualFunctionsConfiguration()
+	if vfConfig == nil {
+		return newError(EUnsupported, "NIC %s on host %s is not SR-IOV capable", nic.ID(), nic.HostID())
+	}
+	if number := params.NumberOfVirtualFunctions(); number != nil && *number > vfConfig.MaxNumberOfVirtualFunctions() {
+		return newError(
+			EBadArgument,
+			"NIC %s supports at most %d virtual functions, %d requested",
+			nic.ID(),
+			vfConfig.MaxNumberOfVirtualFunctions(),
+			*number,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// HostProviderClient describes the functions related to external host providers, such as Foreman or Red Hat
+// Satellite. External host providers supply hosts that can be provisioned and added to the engine.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#chap-External_Providers for details.
+type HostProviderClient interface {
+	// GetHostProvider returns a single external host provider based on its ID.
+	GetHostProvider(id string, retries ...RetryStrategy) (HostProvider, error)
+	// ListHostProviders returns all external host providers registered with the oVirt engine.
+	ListHostProviders(retries ...RetryStrategy) ([]HostProvider, error)
+}
+
+// HostProvider is an external host provider, such as Foreman, registered with the oVirt engine.
+type HostProvider interface {
+	// ID returns the auto-generated identifier for this provider.
+	ID() string
+	// Name returns the user-given name for this provider.
+	Name() string
+	// Description returns the user-given description for this provider.
+	Description() string
+	// URL returns the URL the engine uses to reach the provider.
+	URL() string
+	// RequiresAuthentication indicates if the engine authenticates to the provider.
+	RequiresAuthentication() bool
+	// Username returns the user name the engine uses to authenticate to the provider.
+	Username() string
+}
+
+func convertSDKHostProvider(sdkObject *ovirtsdk4.ExternalHostProvider) (HostProvider, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("host provider", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("host provider", "name")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	url, _ := sdkObject.Url()
+	requiresAuthentication, _ := sdkObject.RequiresAuthentication()
+	username, _ := sdkObject.Username()
+	return &hostProvider{
+		id:                     id,
+		name:                   name,
+		description:            description,
+		url:                    url,
+		requiresAuthentication: requiresAuthentication,
+		username:               username,
+	}, nil
+}
+
+type hostProvider struct {
+	id                     string
+	name                   string
+	description            string
+	url                    string
+	requiresAuthentication bool
+	username               string
+}
+
+func (h hostProvider) ID() string {
+	return h.id
+}
+
+func (h hostProvider) Name() string {
+	return h.name
+}
+
+func (h hostProvider) Description() string {
+	return h.description
+}
+
+func (h hostProvider) URL() string {
+	return h.url
+}
+
+func (h hostProvider) RequiresAuthentication() bool {
+	return h.requiresAuthentication
+}
+
+func (h hostProvider) Username() string {
+	return h.username
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetHostProvider(id string, retries ...RetryStrategy) (result HostProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeHostProvider, id),
+		fmt.Sprintf("getting host provider %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().ExternalHostProvidersService().ProviderService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Provider()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no host provider returned when getting host provider ID %s",
+					id,
+				)
+			}
+			result, e = convertSDKHostProvider(sdkObject)
+			if e != nil {
+				return wrap(
+					e,
+					EBug,
+					"failed to convert host provider %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+func (o *oVirtClient) ListHostProviders(retries ...RetryStrategy) (result []HostProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []HostProvider{}
+	err = o.retry(
+		resourceOf(ResourceTypeHostProvider, ""),
+		"listing host providers",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().ExternalHostProvidersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Providers()
+			if !ok {
+				return nil
+			}
+			result = make([]HostProvider, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKHostProvider(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert host provider during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,113 @@
+package ovirtclient
+
+// This file contains helpers that accept IDs as plain strings. They exist to ease upgrading from versions of this
+// library that used strings for all IDs and will be removed in a future release.
+
+// GetVMByStringID fetches a VM by an ID stored as a string.
+//
+// Deprecated: use client.GetVM(VMID(id)) instead.
+func GetVMByStringID(client VMClient, id string, retries ...RetryStrategy) (VM, error) {
+	return client.GetVM(VMID(id), retries...)
+}
+
+// RemoveVMByStringID removes a VM by an ID stored as a string.
+//
+// Deprecated: use client.RemoveVM(VMID(id)) instead.
+func RemoveVMByStringID(client VMClient, id string, retries ...RetryStrategy) error {
+	return client.RemoveVM(VMID(id), retries...)
+}
+
+// StartVMByStringID starts a VM by an ID stored as a string.
+//
+// Deprecated: use client.StartVM(VMID(id)) instead.
+func StartVMByStringID(client VMClient, id string, retries ...RetryStrategy) error {
+	return client.StartVM(VMID(id), retries...)
+}
+
+// StopVMByStringID stops a VM by an ID stored as a string.
+//
+// Deprecated: use client.StopVM(VMID(id), force) instead.
+func StopVMByStringID(client VMClient, id string, force bool, retries ...RetryStrategy) error {
+	return client.StopVM(VMID(id), force, retries...)
+}
+
+// ShutdownVMByStringID shuts down a VM by an ID stored as a string.
+//
+// Deprecated: use client.ShutdownVM(VMID(id), force) instead.
+func ShutdownVMByStringID(client VMClient, id string, force bool, retries ...RetryStrategy) error {
+	return client.ShutdownVM(VMID(id), force, retries...)
+}
+
+// GetDiskByStringID fetches a disk by an ID stored as a string.
+//
+// Deprecated: use client.GetDisk(DiskID(id)) instead.
+func GetDiskByStringID(client DiskClient, id string, retries ...RetryStrategy) (Disk, error) {
+	return client.GetDisk(DiskID(id), retries...)
+}
+
+// RemoveDiskByStringID removes a disk by an ID stored as a string.
+//
+// Deprecated: use client.RemoveDisk(DiskID(id)) instead.
+func RemoveDiskByStringID(client DiskClient, id string, retries ...RetryStrategy) error {
+	return client.RemoveDisk(DiskID(id), retries...)
+}
+
+// GetClusterByStringID fetches a cluster by an ID stored as a string.
+//
+// Deprecated: use client.GetCluster(ClusterID(id)) instead.
+func GetClusterByStringID(client ClusterClient, id string, retries ...RetryStrategy) (Cluster, error) {
+	return client.GetCluster(ClusterID(id), retries...)
+}
+
+// GetNICByStringID fetches a NIC of a VM by IDs stored as strings.
+//
+// Deprecated: use client.GetNIC(VMID(vmID), NICID(id)) instead.
+func GetNICByStringID(client NICClient, vmID string, id string, retries ...RetryStrategy) (NIC, error) {
+	return client.GetNIC(VMID(vmID), NICID(id), retries...)
+}
+
+// RemoveNICByStringID removes a NIC from a VM by IDs stored as strings.
+//
+// Deprecated: use client.RemoveNIC(VMID(vmID), NICID(id)) instead.
+func RemoveNICByStringID(client NICClient, vmID string, id string, retries ...RetryStrategy) error {
+	return client.RemoveNIC(VMID(vmID), NICID(id), retries...)
+}
+
+// GetTagByStringID fetches a tag by an ID stored as a string.
+//
+// Deprecated: use client.GetTag(TagID(id)) instead.
+func GetTagByStringID(client TagClient, id string, retries ...RetryStrategy) (Tag, error) {
+	return client.GetTag(TagID(id), retries...)
+}
+
+// RemoveTagByStringID removes a tag by an ID stored as a string.
+//
+// Deprecated: use client.RemoveTag(TagID(id)) instead.
+func RemoveTagByStringID(client TagClient, id string, retries ...RetryStrategy) error {
+	return client.RemoveTag(TagID(id), retries...)
+}
+
+// AddTagToVMByStringID adds a tag to a VM by IDs stored as strings.
+//
+// Deprecated: use client.AddTagToVM(VMID(vmID), TagID(tagID)) instead.
+func AddTagToVMByStringID(client VMClient, vmID string, tagID string, retries ...RetryStrategy) error {
+	return client.AddTagToVM(VMID(vmID), TagID(tagID), retries...)
+}
+
+// GetTemplateByStringID fetches a template by an ID stored as a string.
+//
+// Deprecated: use client.GetTemplate(TemplateID(id)) instead.
+func GetTemplateByStringID(client TemplateClient, id string, retries ...RetryStrategy) (Template, error) {
+	return client.GetTemplate(TemplateID(id), retries...)
+}
+
+// VMIDsFromStrings converts a list of VM IDs stored as strings, for example for StartVMs.
+//
+// Deprecated: convert the IDs with VMID(id) when reading them instead.
+func VMIDsFromStrings(ids []string) []VMID {
+	result := make([]VMID, len(ids))
+	for i, id := range ids {
+		result[i] = VMID(id)
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestStringIDHelpers(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+
+	fetchedVM, err := ovirtclient.GetVMByStringID(client, string(vm.ID()))
+	if err != nil {
+		t.Fatalf("failed to fetch VM by string ID (%v)", err)
+	}
+	if fetchedVM.ID() != vm.ID() {
+		t.Fatalf("fetched VM ID mismatch (expected: %s, got: %s)", vm.ID(), fetchedVM.ID())
+	}
+	if err := ovirtclient.AddTagToVMByStringID(client, string(vm.ID()), string(tag.ID())); err != nil {
+		t.Fatalf("failed to add tag to VM by string IDs (%v)", err)
+	}
+	if ids := ovirtclient.VMIDsFromStrings([]string{string(vm.ID())}); len(ids) != 1 || ids[0] != vm.ID() {
+		t.Fatalf("incorrect converted VM IDs: %v", ids)
+	}
+}
@@ -0,0 +1,215 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// ImageProviderClient describes the functions related to external image providers, such as OpenStack Glance. The
+// engine comes with the public ovirt-image-repository provider preconfigured.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#chap-External_Providers for details.
+type ImageProviderClient interface {
+	// GetImageProvider returns a single external image provider based on its ID.
+	GetImageProvider(id string, retries ...RetryStrategy) (ImageProvider, error)
+	// ListImageProviders returns all external image providers registered with the oVirt engine.
+	ListImageProviders(retries ...RetryStrategy) ([]ImageProvider, error)
+	// ListGlanceImages lists the images available on the external image provider specified in providerID.
+	ListGlanceImages(providerID string, retries ...RetryStrategy) ([]GlanceImage, error)
+	// ImportGlanceImage imports the image specified in imageID from the image provider specified in providerID into
+	// the data storage domain with the ID storageDomainID and waits for the import to finish. The resulting disk is
+	// named after the image. If asTemplate is true a template using the imported disk is created in the first
+	// cluster of the datacenter the storage domain is attached to.
+	ImportGlanceImage(
+		providerID string,
+		imageID string,
+		storageDomainID string,
+		asTemplate bool,
+		retries ...RetryStrategy,
+	) error
+}
+
+// ImageProviderData is the core of ImageProvider, providing only the data access functions.
+type ImageProviderData interface {
+	// ID returns the auto-generated identifier for this provider.
+	ID() string
+	// Name returns the user-given name for this provider.
+	Name() string
+	// Description returns the user-given description for this provider.
+	Description() string
+	// URL returns the URL the engine uses to reach the provider.
+	URL() string
+	// RequiresAuthentication indicates if the engine authenticates to the provider.
+	RequiresAuthentication() bool
+	// Username returns the user name the engine uses to authenticate to the provider.
+	Username() string
+	// AuthenticationURL returns the URL of the identity service (Keystone) used for authentication.
+	AuthenticationURL() string
+	// TenantName returns the name of the OpenStack tenant used for authentication.
+	TenantName() string
+}
+
+// ImageProvider is an external image provider, such as Glance, registered with the oVirt engine.
+type ImageProvider interface {
+	ImageProviderData
+
+	// ListImages lists the images available on this provider. This is a network call and may be slow.
+	ListImages(retries ...RetryStrategy) ([]GlanceImage, error)
+}
+
+// GlanceImageData is the core of GlanceImage, providing only the data access functions.
+type GlanceImageData interface {
+	// ID returns the identifier of the image on the image provider.
+	ID() string
+	// Name returns the name of the image on the image provider.
+	Name() string
+	// Description returns the description of the image, if any.
+	Description() string
+	// ProviderID returns the ID of the image provider this image belongs to.
+	ProviderID() string
+}
+
+// GlanceImage is an image as seen on an external image provider. It can be imported into a storage domain using
+// Import().
+type GlanceImage interface {
+	GlanceImageData
+
+	// Provider fetches the image provider this image belongs to. This is a network call and may be slow.
+	Provider(retries ...RetryStrategy) (ImageProvider, error)
+	// Import imports this image into the specified storage domain, optionally as a template, and waits for the
+	// import to finish. This is a network call and may be slow.
+	Import(storageDomainID string, asTemplate bool, retries ...RetryStrategy) error
+}
+
+func convertSDKImageProvider(sdkObject *ovirtsdk4.OpenStackImageProvider, client Client) (ImageProvider, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("image provider", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("image provider", "name")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	url, _ := sdkObject.Url()
+	requiresAuthentication, _ := sdkObject.RequiresAuthentication()
+	username, _ := sdkObject.Username()
+	authenticationURL, _ := sdkObject.AuthenticationUrl()
+	tenantName, _ := sdkObject.TenantName()
+	return &imageProvider{
+		client:                 client,
+		id:                     id,
+		name:                   name,
+		description:            description,
+		url:                    url,
+		requiresAuthentication: requiresAuthentication,
+		username:               username,
+		authenticationURL:      authenticationURL,
+		tenantName:             tenantName,
+	}, nil
+}
+
+type imageProvider struct {
+	client Client
+
+	id                     string
+	name                   string
+	description            string
+	url                    string
+	requiresAuthentication bool
+	username               string
+	authenticationURL      string
+	tenantName             string
+}
+
+func (i imageProvider) ID() string {
+	return i.id
+}
+
+func (i imageProvider) Name() string {
+	return i.name
+}
+
+func (i imageProvider) Description() string {
+	return i.description
+}
+
+func (i imageProvider) URL() string {
+	return i.url
+}
+
+func (i imageProvider) RequiresAuthentication() bool {
+	return i.requiresAuthentication
+}
+
+func (i imageProvider) Username() string {
+	return i.username
+}
+
+func (i imageProvider) AuthenticationURL() string {
+	return i.authenticationURL
+}
+
+func (i imageProvider) TenantName() string {
+	return i.tenantName
+}
+
+func (i imageProvider) ListImages(retries ...RetryStrategy) ([]GlanceImage, error) {
+	return i.client.ListGlanceImages(i.id, retries...)
+}
+
+func convertSDKGlanceImage(
+	sdkObject *ovirtsdk4.OpenStackImage,
+	providerID string,
+	client Client,
+) (GlanceImage, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("Glance image", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("Glance image", "name")
+	}
+	description, _ := sdkObject.Description()
+	return &glanceImage{
+		client:      client,
+		id:          id,
+		name:        name,
+		description: description,
+		providerID:  providerID,
+	}, nil
+}
+
+type glanceImage struct {
+	client Client
+
+	id          string
+	name        string
+	description string
+	providerID  string
+}
+
+func (g glanceImage) ID() string {
+	return g.id
+}
+
+func (g glanceImage) Name() string {
+	return g.name
+}
+
+func (g glanceImage) Description() string {
+	return g.description
+}
+
+func (g glanceImage) ProviderID() string {
+	return g.providerID
+}
+
+func (g glanceImage) Provider(retries ...RetryStrategy) (ImageProvider, error) {
+	return g.client.GetImageProvider(g.providerID, retries...)
+}
+
+func (g glanceImage) Import(storageDomainID string, asTemplate bool, retries ...RetryStrategy) error {
+	return g.client.ImportGlanceImage(g.providerID, g.id, storageDomainID, asTemplate, retries...)
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetImageProvider(id string, retries ...RetryStrategy) (result ImageProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeImageProvider, id),
+		fmt.Sprintf("getting image provider %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().OpenstackImageProvidersService().ProviderService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Provider()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no image provider returned when getting image provider ID %s",
+					id,
+				)
+			}
+			result, e = convertSDKImageProvider(sdkObject, o)
+			if e != nil {
+				return wrap(
+					e,
+					EBug,
+					"failed to convert image provider %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,119 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ImportGlanceImage(
+	providerID string,
+	imageID string,
+	storageDomainID string,
+	asTemplate bool,
+	retries ...RetryStrategy,
+) (err error) {
+	if err := validateGlanceImportParameters(providerID, imageID, storageDomainID); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	image, err := o.getGlanceImage(providerID, imageID, retries)
+	if err != nil {
+		return err
+	}
+	var sdkCluster *ovirtsdk.Cluster
+	if asTemplate {
+		clusterID, err := o.glanceImportClusterID(storageDomainID, retries)
+		if err != nil {
+			return err
+		}
+		sdkCluster = ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()
+	}
+	correlationID := o.storageDomainJobCorrelationID("glance_import", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeImageProvider, providerID).
+			with("image_id", imageID).
+			with("storage_domain_id", storageDomainID).
+			with("as_template", asTemplate),
+		fmt.Sprintf("importing image %s from image provider %s", imageID, providerID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(providerID).
+				ImagesService().
+				ImageService(imageID).
+				Import().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID).MustBuild()).
+				Disk(ovirtsdk.NewDiskBuilder().Alias(image.Name()).MustBuild()).
+				ImportAsTemplate(asTemplate).
+				Query("correlation_id", correlationID)
+			if sdkCluster != nil {
+				req.Cluster(sdkCluster)
+			}
+			_, err := req.Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
+
+// getGlanceImage fetches a single image from an image provider. The engine has no call to fetch images individually
+// from the provider, so this lists all images.
+func (o *oVirtClient) getGlanceImage(providerID string, imageID string, retries []RetryStrategy) (GlanceImage, error) {
+	images, err := o.ListGlanceImages(providerID, retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range images {
+		if image.ID() == imageID {
+			return image, nil
+		}
+	}
+	return nil, newError(ENotFound, "image with ID %s not found on image provider %s", imageID, providerID)
+}
+
+// glanceImportClusterID returns the cluster to create templates in when importing images into the specified storage
+// domain. The engine requires a cluster for this, so the first cluster of the datacenter the storage domain is
+// attached to is used.
+func (o *oVirtClient) glanceImportClusterID(storageDomainID string, retries []RetryStrategy) (ClusterID, error) {
+	storageDomain, err := o.GetStorageDomain(storageDomainID, retries...)
+	if err != nil {
+		return "", err
+	}
+	datacenterIDs := storageDomain.DatacenterIDs()
+	if len(datacenterIDs) == 0 {
+		return "", newError(
+			EBadArgument,
+			"storage domain %s is not attached to a datacenter, cannot import image as template",
+			storageDomainID,
+		)
+	}
+	clusters, err := o.ListDatacenterClusters(datacenterIDs[0], retries...)
+	if err != nil {
+		return "", err
+	}
+	if len(clusters) == 0 {
+		return "", newError(
+			ENotFound,
+			"datacenter %s has no clusters, cannot import image as template",
+			datacenterIDs[0],
+		)
+	}
+	return clusters[0].ID(), nil
+}
+
+func validateGlanceImportParameters(providerID string, imageID string, storageDomainID string) error {
+	if providerID == "" {
+		return newError(EBadArgument, "image provider ID cannot be empty")
+	}
+	if imageID == "" {
+		return newError(EBadArgument, "image ID cannot be empty")
+	}
+	if storageDomainID == "" {
+		return newError(EBadArgument, "storage domain ID cannot be empty")
+	}
+	return nil
+}
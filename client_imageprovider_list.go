@@ -0,0 +1,29 @@
+package ovirtclient
+
+func (o *oVirtClient) ListImageProviders(retries ...RetryStrategy) (result []ImageProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []ImageProvider{}
+	err = o.retry(
+		resourceOf(ResourceTypeImageProvider, ""),
+		"listing image providers",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().OpenstackImageProvidersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Providers()
+			if !ok {
+				return nil
+			}
+			result = make([]ImageProvider, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKImageProvider(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert image provider during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListGlanceImages(providerID string, retries ...RetryStrategy) (result []GlanceImage, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []GlanceImage{}
+	err = o.retry(
+		resourceOf(ResourceTypeImageProvider, providerID),
+		fmt.Sprintf("listing images on image provider %s", providerID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				OpenstackImageProvidersService().
+				ProviderService(providerID).
+				ImagesService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Images()
+			if !ok {
+				return nil
+			}
+			result = make([]GlanceImage, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKGlanceImage(sdkObject, providerID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert Glance image during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,120 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// InstanceTypeID is the identifier of an instance type. It has a special type so the compiler can catch errors when
+// the instance type ID is erroneously passed elsewhere.
+type InstanceTypeID string
+
+// InstanceTypeClient contains the functions to look up instance types. Instance types are predefined hardware
+// configurations, such as Small or Large, that can be applied to VMs on creation using
+// BuildableVMParameters.WithInstanceTypeID.
+type InstanceTypeClient interface {
+	// ListInstanceTypes lists all instance types in the engine.
+	ListInstanceTypes(retries ...RetryStrategy) ([]InstanceType, error)
+	// GetInstanceType returns the instance type with the specified ID.
+	GetInstanceType(id InstanceTypeID, retries ...RetryStrategy) (InstanceType, error)
+	// GetInstanceTypeByName returns the instance type with the specified name, for example Small.
+	GetInstanceTypeByName(name string, retries ...RetryStrategy) (InstanceType, error)
+}
+
+// InstanceType is a predefined hardware configuration for VMs.
+type InstanceType interface {
+	// ID returns the identifier of the instance type.
+	ID() InstanceTypeID
+	// Name returns the name of the instance type.
+	Name() string
+	// Description returns the description of the instance type.
+	Description() string
+	// CPU returns the CPU topology of VMs using this instance type.
+	CPU() VMCPUTopo
+	// Memory returns the memory of VMs using this instance type in bytes.
+	Memory() uint64
+	// MaxMemory returns the maximum memory VMs using this instance type can be hot-plugged to in bytes, or 0 if the
+	// engine did not report it.
+	MaxMemory() uint64
+}
+
+// findInstanceTypeByName returns the instance type with the specified name from the list.
+func findInstanceTypeByName(instanceTypes []InstanceType, name string) (InstanceType, error) {
+	for _, i := range instanceTypes {
+		if i.Name() == name {
+			return i, nil
+		}
+	}
+	return nil, newError(ENotFound, "no instance type with the name %s found", name)
+}
+
+func convertSDKInstanceType(sdkObject *ovirtsdk.InstanceType) (*instanceType, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("instance type", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("instance type", "name")
+	}
+	memory, ok := sdkObject.Memory()
+	if !ok {
+		return nil, newFieldNotFound("instance type", "memory")
+	}
+	sdkCPU, ok := sdkObject.Cpu()
+	if !ok {
+		return nil, newFieldNotFound("instance type", "CPU")
+	}
+	sdkTopo, ok := sdkCPU.Topology()
+	if !ok {
+		return nil, newFieldNotFound("CPU of instance type", "topology")
+	}
+	cores, _ := sdkTopo.Cores()
+	threads, _ := sdkTopo.Threads()
+	sockets, _ := sdkTopo.Sockets()
+	description, _ := sdkObject.Description()
+	var maxMemory int64
+	if memoryPolicy, ok := sdkObject.MemoryPolicy(); ok {
+		maxMemory, _ = memoryPolicy.Max()
+	}
+	return &instanceType{
+		id:          InstanceTypeID(id),
+		name:        name,
+		description: description,
+		cpu:         &vmCPUTopo{uint(cores), uint(threads), uint(sockets)},
+		memory:      uint64(memory),
+		maxMemory:   uint64(maxMemory),
+	}, nil
+}
+
+type instanceType struct {
+	id          InstanceTypeID
+	name        string
+	description string
+	cpu         *vmCPUTopo
+	memory      uint64
+	maxMemory   uint64
+}
+
+func (i instanceType) ID() InstanceTypeID {
+	return i.id
+}
+
+func (i instanceType) Name() string {
+	return i.name
+}
+
+func (i instanceType) Description() string {
+	return i.description
+}
+
+func (i instanceType) CPU() VMCPUTopo {
+	return i.cpu
+}
+
+func (i instanceType) Memory() uint64 {
+	return i.memory
+}
+
+func (i instanceType) MaxMemory() uint64 {
+	return i.maxMemory
+}
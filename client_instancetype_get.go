@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetInstanceType(id InstanceTypeID, retries ...RetryStrategy) (result InstanceType, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeInstanceType, string(id)),
+		fmt.Sprintf("getting instance type %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().InstanceTypesService().InstanceTypeService(string(id)).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.InstanceType()
+			if !ok {
+				return newError(ENotFound, "no instance type returned when getting instance type ID %s", id)
+			}
+			result, e = convertSDKInstanceType(sdkObject)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert instance type %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) ListInstanceTypes(retries ...RetryStrategy) (result []InstanceType, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []InstanceType{}
+	err = o.retry(
+		resourceOf(ResourceTypeInstanceType, ""),
+		"listing instance types",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().InstanceTypesService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.InstanceType()
+			if !ok {
+				return nil
+			}
+			result = make([]InstanceType, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKInstanceType(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert instance type during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) GetInstanceTypeByName(name string, retries ...RetryStrategy) (InstanceType, error) {
+	instanceTypes, err := o.ListInstanceTypes(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findInstanceTypeByName(instanceTypes, name)
+}
@@ -0,0 +1,68 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestGetInstanceTypeByName(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	instanceTypes, err := client.ListInstanceTypes()
+	if err != nil {
+		t.Fatalf("failed to list instance types (%v)", err)
+	}
+	if len(instanceTypes) == 0 {
+		t.Fatalf("no instance types returned")
+	}
+
+	instanceType, err := client.GetInstanceTypeByName("Small")
+	if err != nil {
+		t.Fatalf("failed to find the Small instance type (%v)", err)
+	}
+	if instanceType.Memory() == 0 || instanceType.CPU().Sockets() == 0 {
+		t.Fatalf("no memory or CPU reported for the Small instance type")
+	}
+	fetchedInstanceType, err := client.GetInstanceType(instanceType.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch instance type %s (%v)", instanceType.ID(), err)
+	}
+	if fetchedInstanceType.Name() != "Small" {
+		t.Fatalf("incorrect name for instance type %s: %s", instanceType.ID(), fetchedInstanceType.Name())
+	}
+	if _, err := client.GetInstanceTypeByName("nonexistent"); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("finding a nonexistent instance type did not return a not found error (%v)", err)
+	}
+}
+
+func TestVMCreationWithInstanceType(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	instanceType, err := client.GetInstanceTypeByName("Medium")
+	if err != nil {
+		t.Fatalf("failed to find the Medium instance type (%v)", err)
+	}
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().MustWithInstanceTypeID(instanceType.ID()),
+	)
+	if vm.InstanceTypeID() != instanceType.ID() {
+		t.Fatalf("incorrect instance type on VM %s (%s != %s)", vm.ID(), vm.InstanceTypeID(), instanceType.ID())
+	}
+	if vm.CPU().Topo().Sockets() != instanceType.CPU().Sockets() {
+		t.Fatalf(
+			"the VM %s does not use the CPU topology of the instance type (%d sockets instead of %d)",
+			vm.ID(),
+			vm.CPU().Topo().Sockets(),
+			instanceType.CPU().Sockets(),
+		)
+	}
+}
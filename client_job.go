@@ -0,0 +1,278 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+//go:generate go run scripts/rest.go -i "Job" -n "job"
+
+// JobClient describes the functions related to engine jobs. The engine creates jobs for asynchronous actions, such as
+// exports, snapshots or migrations. Jobs can be tracked using the correlation ID passed to the action that started
+// them.
+type JobClient interface {
+	// GetJob returns a single job based on its ID.
+	GetJob(id string, retries ...RetryStrategy) (Job, error)
+	// ListJobs lists all jobs the engine currently knows about.
+	ListJobs(retries ...RetryStrategy) ([]Job, error)
+	// ListJobsByCorrelationID lists all jobs started by actions with the specified correlation ID.
+	ListJobsByCorrelationID(correlationID string, retries ...RetryStrategy) ([]Job, error)
+	// ListJobSteps lists the steps of the job specified by jobID.
+	ListJobSteps(jobID string, retries ...RetryStrategy) ([]JobStep, error)
+	// WaitForJob waits until all jobs with the specified correlation ID have finished and returns them. If any of the
+	// jobs fails or is aborted an EJobFailed error is returned. If no job with the correlation ID exists yet, this
+	// function keeps waiting until the retries are exhausted.
+	WaitForJob(correlationID string, retries ...RetryStrategy) ([]Job, error)
+}
+
+// JobData is the core of Job, providing only the data access functions.
+type JobData interface {
+	// ID returns the unique identifier of the job.
+	ID() string
+	// Description returns the human-readable description of the job.
+	Description() string
+	// Status returns the current status of the job.
+	Status() JobStatus
+	// StartTime returns the time the job was started.
+	StartTime() time.Time
+	// EndTime returns the time the job ended, or nil if the job has not ended yet.
+	EndTime() *time.Time
+	// AutoCleared indicates if the job is automatically removed from the engine after it ended.
+	AutoCleared() bool
+	// External indicates if the job was created by an external system.
+	External() bool
+}
+
+// Job is an engine job tracking an asynchronous action.
+type Job interface {
+	JobData
+
+	// Steps lists the steps of this job. This is a network call and may be slow.
+	Steps(retries ...RetryStrategy) ([]JobStep, error)
+}
+
+// JobStatus is the status of an engine job.
+type JobStatus string
+
+const (
+	// JobStatusStarted indicates that the job is running.
+	JobStatusStarted JobStatus = "started"
+	// JobStatusFinished indicates that the job completed successfully.
+	JobStatusFinished JobStatus = "finished"
+	// JobStatusFailed indicates that the job failed.
+	JobStatusFailed JobStatus = "failed"
+	// JobStatusAborted indicates that the job was aborted.
+	JobStatusAborted JobStatus = "aborted"
+	// JobStatusUnknown indicates that the engine doesn't know the status of the job, for example after a restart.
+	JobStatusUnknown JobStatus = "unknown"
+)
+
+// Validate returns an error if the job status is not valid.
+func (s JobStatus) Validate() error {
+	for _, status := range JobStatusValues() {
+		if status == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid job status: %s must be one of: %s",
+		s,
+		strings.Join(JobStatusValues().Strings(), ", "),
+	)
+}
+
+// Ended returns true if the job is no longer running.
+func (s JobStatus) Ended() bool {
+	return s == JobStatusFinished || s == JobStatusFailed || s == JobStatusAborted
+}
+
+// JobStatusList is a list of JobStatus values.
+type JobStatusList []JobStatus
+
+// Strings creates a string list of the values.
+func (l JobStatusList) Strings() []string {
+	result := make([]string, len(l))
+	for i, s := range l {
+		result[i] = string(s)
+	}
+	return result
+}
+
+// JobStatusValues returns all possible JobStatus values.
+func JobStatusValues() JobStatusList {
+	return []JobStatus{
+		JobStatusStarted,
+		JobStatusFinished,
+		JobStatusFailed,
+		JobStatusAborted,
+		JobStatusUnknown,
+	}
+}
+
+// JobStep is a single step of an engine job.
+type JobStep interface {
+	// ID returns the unique identifier of the step.
+	ID() string
+	// JobID returns the ID of the job this step belongs to.
+	JobID() string
+	// Description returns the human-readable description of the step.
+	Description() string
+	// Type returns the type of the step, for example "executing".
+	Type() string
+	// Number returns the order of the step within the job.
+	Number() int64
+	// Status returns the current status of the step. Steps use the same statuses as jobs.
+	Status() JobStatus
+}
+
+func convertSDKJob(sdkObject *ovirtsdk.Job, client Client) (Job, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("job", "id")
+	}
+	status, ok := sdkObject.Status()
+	if !ok {
+		return nil, newFieldNotFound("job", "status")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	startTime, _ := sdkObject.StartTime()
+	autoCleared, _ := sdkObject.AutoCleared()
+	external, _ := sdkObject.External()
+	result := &job{
+		client:      client,
+		id:          id,
+		description: description,
+		status:      JobStatus(status),
+		startTime:   startTime,
+		autoCleared: autoCleared,
+		external:    external,
+	}
+	if endTime, ok := sdkObject.EndTime(); ok {
+		result.endTime = &endTime
+	}
+	return result, nil
+}
+
+type job struct {
+	client Client
+
+	id          string
+	description string
+	status      JobStatus
+	startTime   time.Time
+	endTime     *time.Time
+	autoCleared bool
+	external    bool
+	// correlationID is only used by the mock client since the engine doesn't return it.
+	correlationID string
+}
+
+func (j job) ID() string {
+	return j.id
+}
+
+func (j job) Description() string {
+	return j.description
+}
+
+func (j job) Status() JobStatus {
+	return j.status
+}
+
+func (j job) StartTime() time.Time {
+	return j.startTime
+}
+
+func (j job) EndTime() *time.Time {
+	return j.endTime
+}
+
+func (j job) AutoCleared() bool {
+	return j.autoCleared
+}
+
+func (j job) External() bool {
+	return j.external
+}
+
+func (j job) Steps(retries ...RetryStrategy) ([]JobStep, error) {
+	return j.client.ListJobSteps(j.id, retries...)
+}
+
+func convertSDKJobStep(sdkObject *ovirtsdk.Step, jobID string) (JobStep, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("job step", "id")
+	}
+	status, ok := sdkObject.Status()
+	if !ok {
+		return nil, newFieldNotFound("job step", "status")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	stepType, _ := sdkObject.Type()
+	number, _ := sdkObject.Number()
+	return &jobStep{
+		id:          id,
+		jobID:       jobID,
+		description: description,
+		stepType:    string(stepType),
+		number:      number,
+		status:      JobStatus(status),
+	}, nil
+}
+
+type jobStep struct {
+	id          string
+	jobID       string
+	description string
+	stepType    string
+	number      int64
+	status      JobStatus
+}
+
+func (j jobStep) ID() string {
+	return j.id
+}
+
+func (j jobStep) JobID() string {
+	return j.jobID
+}
+
+func (j jobStep) Description() string {
+	return j.description
+}
+
+func (j jobStep) Type() string {
+	return j.stepType
+}
+
+func (j jobStep) Number() int64 {
+	return j.number
+}
+
+func (j jobStep) Status() JobStatus {
+	return j.status
+}
+
+// checkJobsEnded returns nil if all jobs have ended successfully, an EPending error if any of them is still running
+// and an EJobFailed error if any of them has failed.
+func checkJobsEnded(correlationID string, jobs []Job) error {
+	if len(jobs) == 0 {
+		return newError(EPending, "no jobs with correlation ID %s found yet", correlationID)
+	}
+	for _, j := range jobs {
+		switch j.Status() {
+		case JobStatusFailed, JobStatusAborted:
+			return newError(EJobFailed, "job %s (%s) ended with status %s", j.ID(), j.Description(), j.Status())
+		case JobStatusFinished:
+		default:
+			return newError(EPending, "job %s (%s) is in status %s", j.ID(), j.Description(), j.Status())
+		}
+	}
+	return nil
+}
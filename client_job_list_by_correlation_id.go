@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListJobsByCorrelationID(
+	correlationID string,
+	retries ...RetryStrategy,
+) (result []Job, err error) {
+	quotedCorrelationID, err := quoteSearchString(correlationID)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid correlation ID: %s", correlationID)
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Job{}
+	err = o.retry(
+		resourceOf(ResourceTypeJob, ""),
+		fmt.Sprintf("listing jobs with correlation ID %s", correlationID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				JobsService().
+				List().
+				Search(fmt.Sprintf("correlation_id=%s", quotedCorrelationID)).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Jobs()
+			if !ok {
+				return nil
+			}
+			result = make([]Job, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKJob(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert job during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,33 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListJobSteps(jobID string, retries ...RetryStrategy) (result []JobStep, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []JobStep{}
+	err = o.retry(
+		resourceOf(ResourceTypeJob, jobID),
+		fmt.Sprintf("listing steps of job %s", jobID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().JobsService().JobService(jobID).StepsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Steps()
+			if !ok {
+				return nil
+			}
+			result = make([]JobStep, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKJobStep(sdkObject, jobID)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert job step during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
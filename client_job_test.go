@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestJobList(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	jobs, err := client.ListJobs()
+	if err != nil {
+		t.Fatalf("failed to list jobs (%v)", err)
+	}
+	for _, job := range jobs {
+		if err := job.Status().Validate(); err != nil {
+			t.Fatalf("job %s has an invalid status (%v)", job.ID(), err)
+		}
+	}
+}
+
+func TestWaitForNonExistentJob(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.WaitForJob(
+		helper.GenerateRandomID(10),
+		ovirtclient.ExponentialBackoff(1),
+		ovirtclient.Timeout(time.Second),
+	)
+	if err == nil {
+		t.Fatalf("waiting for a non-existent job did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETimeout) {
+		t.Fatalf("waiting for a non-existent job returned an unexpected error (%v)", err)
+	}
+}
@@ -0,0 +1,21 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForJob(correlationID string, retries ...RetryStrategy) (result []Job, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeJob, ""),
+		fmt.Sprintf("waiting for jobs with correlation ID %s", correlationID),
+		retries,
+		func() error {
+			result, err = o.ListJobsByCorrelationID(correlationID, retries...)
+			if err != nil {
+				return err
+			}
+			return checkJobsEnded(correlationID, result)
+		})
+	return
+}
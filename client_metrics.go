@@ -0,0 +1,18 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// MetricsCollector receives measurements about the calls the client makes to the oVirt Engine. It can be used to
+// export metrics to a monitoring system. A collector for Prometheus is provided in the separate
+// github.com/ovirt/go-ovirt-client/prometheus module. A collector can be passed to the client using ExtraSettingsV3.
+//
+// The collector is called from multiple goroutines concurrently and must therefore be thread-safe.
+type MetricsCollector interface {
+	// ObserveAPICall is called after each attempt of an API call, including attempts that are retried later. The
+	// operation is the name of the client function that made the call, for example "RemoveVM", which identifies both
+	// the resource and the operation performed on it. The duration is the time the attempt took. The code is
+	// the error code of the attempt, or an empty string if the attempt was successful.
+	ObserveAPICall(operation string, duration time.Duration, code ErrorCode)
+}
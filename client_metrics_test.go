@@ -0,0 +1,63 @@
+// This file contains tests for the internal metrics functionality. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type metricsObservation struct {
+	operation string
+	code      ErrorCode
+}
+
+type testMetricsCollector struct {
+	lock         *sync.Mutex
+	observations []metricsObservation
+}
+
+func (c *testMetricsCollector) ObserveAPICall(operation string, _ time.Duration, code ErrorCode) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.observations = append(c.observations, metricsObservation{operation, code})
+}
+
+func TestMetricsCollectorObservesAttempts(t *testing.T) {
+	t.Parallel()
+	collector := &testMetricsCollector{lock: &sync.Mutex{}}
+	o := &oVirtClient{
+		logger:  &noopLogger{},
+		metrics: collector,
+	}
+	tries := 0
+	err := o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"testing metrics",
+		[]RetryStrategy{ExponentialBackoff(1), MaxTries(3)},
+		func() error {
+			tries++
+			if tries < 2 {
+				return newError(EConnection, "test failure")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	if len(collector.observations) != 2 {
+		t.Fatalf("incorrect number of observations (expected: 2, got: %d)", len(collector.observations))
+	}
+	expected := []metricsObservation{
+		{"TestMetricsCollectorObservesAttempts", EConnection},
+		{"TestMetricsCollectorObservesAttempts", ""},
+	}
+	for i, observation := range collector.observations {
+		if observation != expected[i] {
+			t.Fatalf("incorrect observation #%d (expected: %v, got: %v)", i, expected[i], observation)
+		}
+	}
+}
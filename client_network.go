@@ -14,6 +14,16 @@ type NetworkClient interface {
 	GetNetwork(id string, retries ...RetryStrategy) (Network, error)
 	// ListNetworks returns all networks on the oVirt engine.
 	ListNetworks(retries ...RetryStrategy) ([]Network, error)
+	// ListNetworkLabels lists the labels assigned to the network specified in networkID. Labels are plain strings
+	// that double as their own identifier. Networks are automatically attached to host NICs with the same label.
+	ListNetworkLabels(networkID string, retries ...RetryStrategy) ([]string, error)
+	// AddNetworkLabel assigns a label to the network specified in networkID.
+	AddNetworkLabel(networkID string, label string, retries ...RetryStrategy) error
+	// RemoveNetworkLabel removes a label from the network specified in networkID.
+	RemoveNetworkLabel(networkID string, label string, retries ...RetryStrategy) error
+	// RemoveNetwork removes the network specified in id. Networks that are still used by VNIC profiles cannot be
+	// removed.
+	RemoveNetwork(id string, retries ...RetryStrategy) error
 }
 
 // NetworkData is the core of Network, providing only the data access functions, but not the client
@@ -25,6 +35,9 @@ type NetworkData interface {
 	Name() string
 	// DatacenterID is the identifier of the datacenter object.
 	DatacenterID() string
+	// ExternalProviderID returns the ID of the external network provider backing this network. It returns an empty
+	// string if the network is not provided by an external network provider.
+	ExternalProviderID() string
 }
 
 // Network is the interface defining the fields for networks.
@@ -33,6 +46,18 @@ type Network interface {
 
 	// Datacenter fetches the datacenter associated with this network. This is a network call and may be slow.
 	Datacenter(retries ...RetryStrategy) (Datacenter, error)
+	// ExternalProvider fetches the external network provider backing this network. If the network is not provided
+	// by an external network provider an error with the code ENotFound is returned. This is a network call and may
+	// be slow.
+	ExternalProvider(retries ...RetryStrategy) (NetworkProvider, error)
+	// Labels lists the labels assigned to this network. This is a network call and may be slow.
+	Labels(retries ...RetryStrategy) ([]string, error)
+	// AddLabel assigns a label to this network. This is a network call and may be slow.
+	AddLabel(label string, retries ...RetryStrategy) error
+	// RemoveLabel removes a label from this network. This is a network call and may be slow.
+	RemoveLabel(label string, retries ...RetryStrategy) error
+	// Remove removes this network. This is a network call and may be slow.
+	Remove(retries ...RetryStrategy) error
 }
 
 func convertSDKNetwork(sdkObject *ovirtsdk4.Network, client *oVirtClient) (Network, error) {
@@ -52,20 +77,26 @@ func convertSDKNetwork(sdkObject *ovirtsdk4.Network, client *oVirtClient) (Netwo
 	if !ok {
 		return nil, newFieldNotFound("datacenter on network", "ID")
 	}
+	externalProviderID := ""
+	if externalProvider, ok := sdkObject.ExternalProvider(); ok {
+		externalProviderID, _ = externalProvider.Id()
+	}
 	return &network{
-		client: client,
-		id:     id,
-		name:   name,
-		dcID:   dcID,
+		client:             client,
+		id:                 id,
+		name:               name,
+		dcID:               dcID,
+		externalProviderID: externalProviderID,
 	}, nil
 }
 
 type network struct {
 	client Client
 
-	id   string
-	name string
-	dcID string
+	id                 string
+	name               string
+	dcID               string
+	externalProviderID string
 }
 
 func (n network) ID() string {
@@ -83,3 +114,30 @@ func (n network) DatacenterID() string {
 func (n network) Datacenter(retries ...RetryStrategy) (Datacenter, error) {
 	return n.client.GetDatacenter(n.dcID, retries...)
 }
+
+func (n network) ExternalProviderID() string {
+	return n.externalProviderID
+}
+
+func (n network) ExternalProvider(retries ...RetryStrategy) (NetworkProvider, error) {
+	if n.externalProviderID == "" {
+		return nil, newError(ENotFound, "network %s is not provided by an external network provider", n.id)
+	}
+	return n.client.GetNetworkProvider(n.externalProviderID, retries...)
+}
+
+func (n network) Labels(retries ...RetryStrategy) ([]string, error) {
+	return n.client.ListNetworkLabels(n.id, retries...)
+}
+
+func (n network) AddLabel(label string, retries ...RetryStrategy) error {
+	return n.client.AddNetworkLabel(n.id, label, retries...)
+}
+
+func (n network) RemoveLabel(label string, retries ...RetryStrategy) error {
+	return n.client.RemoveNetworkLabel(n.id, label, retries...)
+}
+
+func (n network) Remove(retries ...RetryStrategy) error {
+	return n.client.RemoveNetwork(n.id, retries...)
+}
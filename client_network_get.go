@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetNetwork(id string, retries ...RetryStrategy) (result Network, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeNetwork, id),
 		fmt.Sprintf("getting network %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().NetworksService().NetworkService(id).Get().Send()
+			response, err := o.connection().SystemService().NetworksService().NetworkService(id).Get().Send()
 			if err != nil {
 				return err
 			}
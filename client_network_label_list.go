@@ -0,0 +1,14 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListNetworkLabels(networkID string, retries ...RetryStrategy) ([]string, error) {
+	return o.listNetworkLabels(
+		o.connection().SystemService().NetworksService().NetworkService(networkID).NetworkLabelsService(),
+		resourceOf(ResourceTypeNetwork, networkID),
+		fmt.Sprintf("network %s", networkID),
+		retries,
+	)
+}
@@ -0,0 +1,15 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveNetworkLabel(networkID string, label string, retries ...RetryStrategy) error {
+	return o.removeNetworkLabel(
+		o.connection().SystemService().NetworksService().NetworkService(networkID).NetworkLabelsService(),
+		resourceOf(ResourceTypeNetwork, networkID),
+		fmt.Sprintf("network %s", networkID),
+		label,
+		retries,
+	)
+}
@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestNetworkLabels(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	network, err := vnicProfile.Network()
+	if err != nil {
+		t.Fatalf("failed to fetch test network (%v)", err)
+	}
+
+	label := fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5))
+	if err := network.AddLabel(label); err != nil {
+		t.Fatalf("failed to add label %s to network %s (%v)", label, network.ID(), err)
+	}
+	t.Cleanup(
+		func() {
+			if err := network.RemoveLabel(label); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+				t.Fatalf("failed to remove label %s from network %s (%v)", label, network.ID(), err)
+			}
+		})
+	assertNetworkHasLabel(t, network, label, true)
+
+	if err := network.RemoveLabel(label); err != nil {
+		t.Fatalf("failed to remove label %s from network %s (%v)", label, network.ID(), err)
+	}
+	assertNetworkHasLabel(t, network, label, false)
+}
+
+func TestInvalidNetworkLabel(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	err = client.AddNetworkLabel(vnicProfile.NetworkID(), "invalid label!")
+	if err == nil {
+		t.Fatalf("adding an invalid network label did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("adding an invalid network label did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func assertNetworkHasLabel(t *testing.T, network ovirtclient.Network, label string, expected bool) {
+	labels, err := network.Labels()
+	if err != nil {
+		t.Fatalf("failed to list labels on network %s (%v)", network.ID(), err)
+	}
+	found := false
+	for _, l := range labels {
+		if l == label {
+			found = true
+		}
+	}
+	if found != expected {
+		t.Fatalf("unexpected presence of label %s on network %s (expected: %t)", label, network.ID(), expected)
+	}
+}
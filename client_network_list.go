@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListNetworks(retries ...RetryStrategy) (result []Network, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Network{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeNetwork, ""),
 		"listing networks",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().NetworksService().List().Send()
+			response, e := o.connection().SystemService().NetworksService().List().Send()
 			if e != nil {
 				return e
 			}
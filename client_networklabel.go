@@ -0,0 +1,109 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"regexp"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+var networkLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+func validateNetworkLabel(label string) error {
+	if !networkLabelRegexp.MatchString(label) {
+		return newError(
+			EBadArgument,
+			"invalid network label: %s (labels may only contain alphanumeric characters, - and _)",
+			label,
+		)
+	}
+	return nil
+}
+
+func (o *oVirtClient) listNetworkLabels(
+	service *ovirtsdk.NetworkLabelsService,
+	resource callResource,
+	target string,
+	retries []RetryStrategy,
+) (result []string, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []string{}
+	err = o.retry(
+		resource,
+		fmt.Sprintf("listing network labels on %s", target),
+		retries,
+		func() error {
+			response, e := service.List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Labels()
+			if !ok {
+				return nil
+			}
+			result = make([]string, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				id, ok := sdkObject.Id()
+				if !ok {
+					return newFieldNotFound("network label", "ID")
+				}
+				result[i] = id
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) addNetworkLabel(
+	service *ovirtsdk.NetworkLabelsService,
+	resource callResource,
+	target string,
+	label string,
+	retries []RetryStrategy,
+) error {
+	if err := validateNetworkLabel(label); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resource.with("label", label),
+		fmt.Sprintf("adding network label %s to %s", label, target),
+		retries,
+		func() error {
+			req := service.Add().Label(ovirtsdk.NewNetworkLabelBuilder().Id(label).MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	return withCorrelationID(err, correlationID)
+}
+
+func (o *oVirtClient) removeNetworkLabel(
+	service *ovirtsdk.NetworkLabelsService,
+	resource callResource,
+	target string,
+	label string,
+	retries []RetryStrategy,
+) error {
+	if err := validateNetworkLabel(label); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resource.with("label", label),
+		fmt.Sprintf("removing network label %s from %s", label, target),
+		retries,
+		func() error {
+			req := service.LabelService(label).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	return withCorrelationID(err, correlationID)
+}
@@ -0,0 +1,210 @@
+package ovirtclient
+
+import (
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// NetworkProviderClient describes the functions related to external network providers, such as OVN. External
+// network providers supply networks that can be imported into or created in a datacenter and then used with
+// VNIC profiles like any other network.
+//
+// See https://www.ovirt.org/documentation/administration_guide/#chap-External_Providers for details.
+type NetworkProviderClient interface {
+	// GetNetworkProvider returns a single external network provider based on its ID.
+	GetNetworkProvider(id string, retries ...RetryStrategy) (NetworkProvider, error)
+	// ListNetworkProviders returns all external network providers registered with the oVirt engine.
+	ListNetworkProviders(retries ...RetryStrategy) ([]NetworkProvider, error)
+	// ListNetworkProviderNetworks lists the networks available on the external network provider specified in
+	// providerID. These networks are not necessarily imported into the oVirt engine.
+	ListNetworkProviderNetworks(providerID string, retries ...RetryStrategy) ([]ExternalNetwork, error)
+	// ImportNetworkFromProvider imports the network specified in externalNetworkID from the provider specified in
+	// providerID into the datacenter with the ID datacenterID. It returns the resulting oVirt network.
+	ImportNetworkFromProvider(
+		providerID string,
+		externalNetworkID string,
+		datacenterID string,
+		retries ...RetryStrategy,
+	) (Network, error)
+	// CreateProviderNetwork creates a new network on the external network provider specified in providerID and
+	// adds it to the datacenter with the ID datacenterID.
+	CreateProviderNetwork(
+		providerID string,
+		datacenterID string,
+		name string,
+		retries ...RetryStrategy,
+	) (Network, error)
+}
+
+// NetworkProviderData is the core of NetworkProvider, providing only the data access functions.
+type NetworkProviderData interface {
+	// ID returns the auto-generated identifier for this provider.
+	ID() string
+	// Name returns the user-given name for this provider.
+	Name() string
+	// Description returns the user-given description for this provider.
+	Description() string
+	// URL returns the URL the engine uses to reach the provider.
+	URL() string
+	// ExternalPluginType returns the plugin type of the provider, for example "ovirt-provider-ovn".
+	ExternalPluginType() string
+	// ReadOnly indicates if the engine is only allowed to read networks from the provider.
+	ReadOnly() bool
+	// AutoSync indicates if the engine automatically synchronizes the networks of the provider.
+	AutoSync() bool
+}
+
+// NetworkProvider is an external network provider, such as OVN, registered with the oVirt engine.
+type NetworkProvider interface {
+	NetworkProviderData
+
+	// ListNetworks lists the networks available on this provider. This is a network call and may be slow.
+	ListNetworks(retries ...RetryStrategy) ([]ExternalNetwork, error)
+	// CreateNetwork creates a new network on this provider and adds it to the specified datacenter. This is a
+	// network call and may be slow.
+	CreateNetwork(datacenterID string, name string, retries ...RetryStrategy) (Network, error)
+}
+
+// ExternalNetworkData is the core of ExternalNetwork, providing only the data access functions.
+type ExternalNetworkData interface {
+	// ID returns the identifier of the network on the external provider.
+	ID() string
+	// Name returns the name of the network on the external provider.
+	Name() string
+	// ProviderID returns the ID of the external network provider this network belongs to.
+	ProviderID() string
+}
+
+// ExternalNetwork is a network as seen on an external network provider. It can be imported into a datacenter using
+// Import().
+type ExternalNetwork interface {
+	ExternalNetworkData
+
+	// Provider fetches the network provider this network belongs to. This is a network call and may be slow.
+	Provider(retries ...RetryStrategy) (NetworkProvider, error)
+	// Import imports this network into the specified datacenter. This is a network call and may be slow.
+	Import(datacenterID string, retries ...RetryStrategy) (Network, error)
+}
+
+func convertSDKNetworkProvider(sdkObject *ovirtsdk4.OpenStackNetworkProvider, client Client) (NetworkProvider, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("network provider", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("network provider", "name")
+	}
+	// The following fields are optional and not always returned by the engine.
+	description, _ := sdkObject.Description()
+	url, _ := sdkObject.Url()
+	externalPluginType, _ := sdkObject.ExternalPluginType()
+	readOnly, _ := sdkObject.ReadOnly()
+	autoSync, _ := sdkObject.AutoSync()
+	return &networkProvider{
+		client:             client,
+		id:                 id,
+		name:               name,
+		description:        description,
+		url:                url,
+		externalPluginType: externalPluginType,
+		readOnly:           readOnly,
+		autoSync:           autoSync,
+	}, nil
+}
+
+type networkProvider struct {
+	client Client
+
+	id                 string
+	name               string
+	description        string
+	url                string
+	externalPluginType string
+	readOnly           bool
+	autoSync           bool
+}
+
+func (n networkProvider) ID() string {
+	return n.id
+}
+
+func (n networkProvider) Name() string {
+	return n.name
+}
+
+func (n networkProvider) Description() string {
+	return n.description
+}
+
+func (n networkProvider) URL() string {
+	return n.url
+}
+
+func (n networkProvider) ExternalPluginType() string {
+	return n.externalPluginType
+}
+
+func (n networkProvider) ReadOnly() bool {
+	return n.readOnly
+}
+
+func (n networkProvider) AutoSync() bool {
+	return n.autoSync
+}
+
+func (n networkProvider) ListNetworks(retries ...RetryStrategy) ([]ExternalNetwork, error) {
+	return n.client.ListNetworkProviderNetworks(n.id, retries...)
+}
+
+func (n networkProvider) CreateNetwork(datacenterID string, name string, retries ...RetryStrategy) (Network, error) {
+	return n.client.CreateProviderNetwork(n.id, datacenterID, name, retries...)
+}
+
+func convertSDKExternalNetwork(
+	sdkObject *ovirtsdk4.OpenStackNetwork,
+	providerID string,
+	client Client,
+) (ExternalNetwork, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("external network", "id")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("external network", "name")
+	}
+	return &externalNetwork{
+		client:     client,
+		id:         id,
+		name:       name,
+		providerID: providerID,
+	}, nil
+}
+
+type externalNetwork struct {
+	client Client
+
+	id         string
+	name       string
+	providerID string
+}
+
+func (e externalNetwork) ID() string {
+	return e.id
+}
+
+func (e externalNetwork) Name() string {
+	return e.name
+}
+
+func (e externalNetwork) ProviderID() string {
+	return e.providerID
+}
+
+func (e externalNetwork) Provider(retries ...RetryStrategy) (NetworkProvider, error) {
+	return e.client.GetNetworkProvider(e.providerID, retries...)
+}
+
+func (e externalNetwork) Import(datacenterID string, retries ...RetryStrategy) (Network, error) {
+	return e.client.ImportNetworkFromProvider(e.providerID, e.id, datacenterID, retries...)
+}
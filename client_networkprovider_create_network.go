@@ -0,0 +1,66 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateProviderNetwork(
+	providerID string,
+	datacenterID string,
+	name string,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	if err := validateProviderNetworkParameters(providerID, datacenterID, name); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeNetworkProvider, providerID).with("datacenter_id", datacenterID).with("name", name),
+		fmt.Sprintf("creating network %s on network provider %s", name, providerID),
+		retries,
+		func() error {
+			networkBuilder := ovirtsdk.NewNetworkBuilder().
+				Name(name).
+				DataCenter(ovirtsdk.NewDataCenterBuilder().Id(datacenterID).MustBuild()).
+				ExternalProvider(ovirtsdk.NewOpenStackNetworkProviderBuilder().Id(providerID).MustBuild())
+			req := o.connection().SystemService().NetworksService().Add().Network(networkBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Network()
+			if !ok {
+				return newFieldNotFound("response from network creation", "network")
+			}
+			result, err = convertSDKNetwork(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert newly created network %s",
+					name,
+				)
+			}
+			return nil
+		})
+	return result, withCorrelationID(err, correlationID)
+}
+
+func validateProviderNetworkParameters(providerID string, datacenterID string, name string) error {
+	if providerID == "" {
+		return newError(EBadArgument, "network provider ID cannot be empty")
+	}
+	if datacenterID == "" {
+		return newError(EBadArgument, "datacenter ID cannot be empty")
+	}
+	if name == "" {
+		return newError(EBadArgument, "network name cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetNetworkProvider(id string, retries ...RetryStrategy) (result NetworkProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeNetworkProvider, id),
+		fmt.Sprintf("getting network provider %s", id),
+		retries,
+		func() error {
+			response, err := o.connection().SystemService().OpenstackNetworkProvidersService().ProviderService(id).Get().Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Provider()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no network provider returned when getting network provider ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKNetworkProvider(sdkObject, o)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert network provider %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
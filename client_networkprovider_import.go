@@ -0,0 +1,130 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ImportNetworkFromProvider(
+	providerID string,
+	externalNetworkID string,
+	datacenterID string,
+	retries ...RetryStrategy,
+) (result Network, err error) {
+	if err := validateNetworkImportParameters(providerID, externalNetworkID, datacenterID); err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+
+	externalNetworkService := o.connection().SystemService().
+		OpenstackNetworkProvidersService().
+		ProviderService(providerID).
+		NetworksService().
+		NetworkService(externalNetworkID)
+
+	externalNetworkName, err := o.getExternalNetworkName(externalNetworkService, providerID, externalNetworkID, retries)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.mutate(
+		resourceOf(ResourceTypeNetworkProvider, providerID).
+			with("external_network_id", externalNetworkID).
+			with("datacenter_id", datacenterID),
+		fmt.Sprintf(
+			"importing external network %s from provider %s into datacenter %s",
+			externalNetworkID,
+			providerID,
+			datacenterID,
+		),
+		retries,
+		func() error {
+			req := externalNetworkService.
+				Import().
+				DataCenter(ovirtsdk.NewDataCenterBuilder().Id(datacenterID).MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	if err != nil {
+		return nil, withCorrelationID(err, correlationID)
+	}
+
+	return o.findImportedNetwork(providerID, externalNetworkName, datacenterID, retries)
+}
+
+func (o *oVirtClient) getExternalNetworkName(
+	externalNetworkService *ovirtsdk.OpenstackNetworkService,
+	providerID string,
+	externalNetworkID string,
+	retries []RetryStrategy,
+) (name string, err error) {
+	err = o.retry(
+		resourceOf(ResourceTypeNetworkProvider, providerID),
+		fmt.Sprintf("getting external network %s from provider %s", externalNetworkID, providerID),
+		retries,
+		func() error {
+			response, err := externalNetworkService.Get().Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Network()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no external network returned when getting external network ID %s",
+					externalNetworkID,
+				)
+			}
+			name, ok = sdkObject.Name()
+			if !ok {
+				return newFieldNotFound("external network", "name")
+			}
+			return nil
+		})
+	return name, err
+}
+
+// findImportedNetwork locates the oVirt network created by an import since the import call itself does not return
+// the resulting network.
+func (o *oVirtClient) findImportedNetwork(
+	providerID string,
+	name string,
+	datacenterID string,
+	retries []RetryStrategy,
+) (Network, error) {
+	networks, err := o.ListNetworks(retries...)
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks {
+		if network.Name() == name &&
+			network.DatacenterID() == datacenterID &&
+			network.ExternalProviderID() == providerID {
+			return network, nil
+		}
+	}
+	return nil, newError(
+		ENotFound,
+		"imported external network %s not found in datacenter %s",
+		name,
+		datacenterID,
+	)
+}
+
+func validateNetworkImportParameters(providerID string, externalNetworkID string, datacenterID string) error {
+	if providerID == "" {
+		return newError(EBadArgument, "network provider ID cannot be empty")
+	}
+	if externalNetworkID == "" {
+		return newError(EBadArgument, "external network ID cannot be empty")
+	}
+	if datacenterID == "" {
+		return newError(EBadArgument, "datacenter ID cannot be empty")
+	}
+	return nil
+}
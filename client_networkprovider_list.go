@@ -0,0 +1,29 @@
+package ovirtclient
+
+func (o *oVirtClient) ListNetworkProviders(retries ...RetryStrategy) (result []NetworkProvider, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []NetworkProvider{}
+	err = o.retry(
+		resourceOf(ResourceTypeNetworkProvider, ""),
+		"listing network providers",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().OpenstackNetworkProvidersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Providers()
+			if !ok {
+				return nil
+			}
+			result = make([]NetworkProvider, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKNetworkProvider(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert network provider during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
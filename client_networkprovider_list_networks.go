@@ -0,0 +1,41 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListNetworkProviderNetworks(
+	providerID string,
+	retries ...RetryStrategy,
+) (result []ExternalNetwork, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []ExternalNetwork{}
+	err = o.retry(
+		resourceOf(ResourceTypeNetworkProvider, providerID),
+		fmt.Sprintf("listing networks on network provider %s", providerID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				OpenstackNetworkProvidersService().
+				ProviderService(providerID).
+				NetworksService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Networks()
+			if !ok {
+				return nil
+			}
+			result = make([]ExternalNetwork, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKExternalNetwork(sdkObject, providerID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert external network during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
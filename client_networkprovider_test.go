@@ -0,0 +1,109 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestNetworkProviderListing(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	providers, err := client.ListNetworkProviders()
+	if err != nil {
+		t.Fatalf("failed to list network providers (%v)", err)
+	}
+	if len(providers) == 0 {
+		t.Skipf("No external network providers registered, skipping test.")
+	}
+	for _, provider := range providers {
+		fetchedProvider, err := client.GetNetworkProvider(provider.ID())
+		if err != nil {
+			t.Fatalf("failed to fetch network provider %s (%v)", provider.ID(), err)
+		}
+		if fetchedProvider.Name() != provider.Name() {
+			t.Fatalf("network provider name mismatch (%s != %s)", fetchedProvider.Name(), provider.Name())
+		}
+		externalNetworks, err := provider.ListNetworks()
+		if err != nil {
+			t.Fatalf("failed to list networks on network provider %s (%v)", provider.ID(), err)
+		}
+		for _, externalNetwork := range externalNetworks {
+			if externalNetwork.ProviderID() != provider.ID() {
+				t.Fatalf(
+					"external network %s has incorrect provider ID (%s != %s)",
+					externalNetwork.ID(),
+					externalNetwork.ProviderID(),
+					provider.ID(),
+				)
+			}
+		}
+	}
+}
+
+func TestProviderNetworkWithVNICProfile(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	provider := findWritableNetworkProvider(t, client)
+	datacenterID := findTestDatacenterID(t, helper)
+
+	network, err := provider.CreateNetwork(datacenterID, fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)))
+	if err != nil {
+		t.Fatalf("failed to create network on network provider %s (%v)", provider.ID(), err)
+	}
+	t.Cleanup(func() {
+		if err := network.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Errorf("failed to remove network %s (%v)", network.ID(), err)
+		}
+	})
+	if network.ExternalProviderID() != provider.ID() {
+		t.Fatalf(
+			"created network has incorrect external provider ID (%s != %s)",
+			network.ExternalProviderID(),
+			provider.ID(),
+		)
+	}
+
+	vnicProfile, err := client.CreateVNICProfile(
+		fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)),
+		network.ID(),
+		ovirtclient.CreateVNICProfileParams(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VNIC profile on provider network (%v)", err)
+	}
+	if err := vnicProfile.Remove(); err != nil {
+		t.Fatalf("failed to remove VNIC profile (%v)", err)
+	}
+}
+
+func findWritableNetworkProvider(t *testing.T, client ovirtclient.Client) ovirtclient.NetworkProvider {
+	providers, err := client.ListNetworkProviders()
+	if err != nil {
+		t.Fatalf("failed to list network providers (%v)", err)
+	}
+	for _, provider := range providers {
+		if !provider.ReadOnly() {
+			return provider
+		}
+	}
+	t.Skipf("No writable external network provider registered, skipping test.")
+	return nil
+}
+
+func findTestDatacenterID(t *testing.T, helper ovirtclient.TestHelper) string {
+	vnicProfile, err := helper.GetClient().GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+	network, err := vnicProfile.Network()
+	if err != nil {
+		t.Fatalf("failed to fetch network of test VNIC profile (%v)", err)
+	}
+	return network.DatacenterID()
+}
@@ -1,14 +1,20 @@
 package ovirtclient
 
 import (
+	"net"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
+// NICID is an identifier for a network interface. It has a special type so the compiler can catch errors when the NIC
+// ID is erroneously passed elsewhere.
+type NICID string
+
 // NICClient defines the methods related to dealing with network interfaces.
 type NICClient interface {
 	// CreateNIC adds a new NIC to a VM specified in vmid.
 	CreateNIC(
-		vmid string,
+		vmid VMID,
 		vnicProfileID string,
 		name string,
 		optional OptionalNICParameters,
@@ -16,26 +22,50 @@ type NICClient interface {
 	) (NIC, error)
 	// UpdateNIC allows updating the NIC.
 	UpdateNIC(
-		vmid string,
-		nicID string,
+		vmid VMID,
+		nicID NICID,
 		params UpdateNICParameters,
 		retries ...RetryStrategy,
 	) (NIC, error)
 	// GetNIC returns one specific NIC with the ID specified in id, attached to a VM with the ID specified in vmid.
-	GetNIC(vmid string, id string, retries ...RetryStrategy) (NIC, error)
+	GetNIC(vmid VMID, id NICID, retries ...RetryStrategy) (NIC, error)
 	// ListNICs lists all NICs attached to the VM specified in vmid.
-	ListNICs(vmid string, retries ...RetryStrategy) ([]NIC, error)
+	ListNICs(vmid VMID, retries ...RetryStrategy) ([]NIC, error)
 	// RemoveNIC removes the network interface specified.
-	RemoveNIC(vmid string, id string, retries ...RetryStrategy) error
+	RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) error
+	// ListNICReportedDevices lists the devices the guest agent reports for the NIC specified in nicID on the VM
+	// specified in vmid. The list is empty if the VM is not running or no guest agent is installed.
+	ListNICReportedDevices(vmid VMID, nicID NICID, retries ...RetryStrategy) ([]ReportedDevice, error)
 }
 
 // OptionalNICParameters is an interface that declares the source of optional parameters for NIC creation.
-type OptionalNICParameters interface{}
+type OptionalNICParameters interface {
+	// MAC returns the MAC address to assign to the NIC. If empty, the engine assigns a MAC address from the MAC pool
+	// of the cluster.
+	MAC() string
+	// Plugged returns if the NIC should be plugged into the VM. If nil, the NIC is plugged.
+	Plugged() *bool
+	// Linked returns if the network cable of the NIC should be connected. If nil, the NIC is linked.
+	Linked() *bool
+}
 
 // BuildableNICParameters is a modifiable version of OptionalNICParameters. You can use CreateNICParams() to create a
 // new copy, or implement your own.
 type BuildableNICParameters interface {
 	OptionalNICParameters
+
+	// WithMAC sets the MAC address of the NIC, for example 56:6f:00:00:00:01.
+	WithMAC(mac string) (BuildableNICParameters, error)
+	// MustWithMAC is identical to WithMAC, but panics instead of returning an error.
+	MustWithMAC(mac string) BuildableNICParameters
+	// WithPlugged sets if the NIC should be plugged into the VM.
+	WithPlugged(plugged bool) (BuildableNICParameters, error)
+	// MustWithPlugged is identical to WithPlugged, but panics instead of returning an error.
+	MustWithPlugged(plugged bool) BuildableNICParameters
+	// WithLinked sets if the network cable of the NIC should be connected.
+	WithLinked(linked bool) (BuildableNICParameters, error)
+	// MustWithLinked is identical to WithLinked, but panics instead of returning an error.
+	MustWithLinked(linked bool) BuildableNICParameters
 }
 
 // CreateNICParams returns a buildable structure of OptionalNICParameters.
@@ -43,7 +73,74 @@ func CreateNICParams() BuildableNICParameters {
 	return &nicParams{}
 }
 
-type nicParams struct{}
+type nicParams struct {
+	mac     string
+	plugged *bool
+	linked  *bool
+}
+
+func (n *nicParams) MAC() string {
+	return n.mac
+}
+
+func (n *nicParams) Plugged() *bool {
+	return n.plugged
+}
+
+func (n *nicParams) Linked() *bool {
+	return n.linked
+}
+
+func (n *nicParams) WithMAC(mac string) (BuildableNICParameters, error) {
+	if err := validateNICMAC(mac); err != nil {
+		return nil, err
+	}
+	n.mac = mac
+	return n, nil
+}
+
+func (n *nicParams) MustWithMAC(mac string) BuildableNICParameters {
+	builder, err := n.WithMAC(mac)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *nicParams) WithPlugged(plugged bool) (BuildableNICParameters, error) {
+	n.plugged = &plugged
+	return n, nil
+}
+
+func (n *nicParams) MustWithPlugged(plugged bool) BuildableNICParameters {
+	builder, err := n.WithPlugged(plugged)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (n *nicParams) WithLinked(linked bool) (BuildableNICParameters, error) {
+	n.linked = &linked
+	return n, nil
+}
+
+func (n *nicParams) MustWithLinked(linked bool) BuildableNICParameters {
+	builder, err := n.WithLinked(linked)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateNICMAC returns an error if the MAC address is not a 48-bit MAC address the engine accepts.
+func validateNICMAC(mac string) error {
+	hardwareAddr, err := net.ParseMAC(mac)
+	if err != nil || len(hardwareAddr) != 6 {
+		return newError(EBadArgument, "invalid MAC address: %s", mac)
+	}
+	return nil
+}
 
 // UpdateNICParameters is an interface that declares methods of changeable parameters for NIC's. Each
 // method can return nil to leave an attribute unchanged, or a new value for the attribute.
@@ -117,13 +214,19 @@ func (u *updateNICParams) MustWithVNICProfileID(id string) BuildableUpdateNICPar
 // NICData is the core of NIC which only provides data-access functions.
 type NICData interface {
 	// ID is the identifier for this network interface.
-	ID() string
+	ID() NICID
 	// Name is the user-given name of the network interface.
 	Name() string
 	// VMID is the identified of the VM this NIC is attached to. May be nil if the NIC is not attached.
-	VMID() string
+	VMID() VMID
 	// VNICProfileID returns the ID of the VNIC profile in use by the NIC.
 	VNICProfileID() string
+	// MAC returns the MAC address of the NIC. It may be empty if the engine did not report it.
+	MAC() string
+	// Plugged returns true if the NIC is plugged into the VM.
+	Plugged() bool
+	// Linked returns true if the network cable of the NIC is connected.
+	Linked() bool
 }
 
 // NIC represents a network interface.
@@ -140,6 +243,102 @@ type NIC interface {
 	Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error)
 	// Remove removes the current network interface. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
+	// ReportedDevices lists the devices the guest agent reports for this NIC. This involves an API call and may be
+	// slow.
+	ReportedDevices(retries ...RetryStrategy) ([]ReportedDevice, error)
+	// IPAddresses returns all IP addresses the guest agent reports for this NIC. This involves an API call and may
+	// be slow.
+	IPAddresses(retries ...RetryStrategy) ([]net.IP, error)
+	// Clone returns a copy of the NIC that shares no data with the original.
+	Clone() NIC
+}
+
+// ReportedDevice is a network device reported by the guest agent running inside a VM.
+type ReportedDevice interface {
+	// ID returns the identifier of the reported device.
+	ID() string
+	// Name returns the name of the device inside the guest operating system, for example eth0.
+	Name() string
+	// MAC returns the MAC address of the device. It may be empty if the guest agent didn't report it.
+	MAC() string
+	// IPAddresses returns the IP addresses assigned to the device inside the guest operating system.
+	IPAddresses() []net.IP
+	// Clone returns a copy of the reported device that shares no data with the original.
+	Clone() ReportedDevice
+}
+
+func convertSDKReportedDevice(sdkObject *ovirtsdk.ReportedDevice) (ReportedDevice, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("reported device", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("reported device", "name")
+	}
+	mac := ""
+	if sdkMAC, ok := sdkObject.Mac(); ok {
+		mac, _ = sdkMAC.Address()
+	}
+	var ips []net.IP
+	if sdkIPs, ok := sdkObject.Ips(); ok {
+		for _, sdkIP := range sdkIPs.Slice() {
+			address, ok := sdkIP.Address()
+			if !ok {
+				continue
+			}
+			ip := net.ParseIP(address)
+			if ip == nil {
+				return nil, newError(EBug, "invalid IP address reported for device %s: %s", id, address)
+			}
+			ips = append(ips, ip)
+		}
+	}
+	return &reportedDevice{
+		id:          id,
+		name:        name,
+		mac:         mac,
+		ipAddresses: ips,
+	}, nil
+}
+
+type reportedDevice struct {
+	id          string
+	name        string
+	mac         string
+	ipAddresses []net.IP
+}
+
+func (r reportedDevice) ID() string {
+	return r.id
+}
+
+func (r reportedDevice) Name() string {
+	return r.name
+}
+
+func (r reportedDevice) MAC() string {
+	return r.mac
+}
+
+func (r reportedDevice) IPAddresses() []net.IP {
+	return r.ipAddresses
+}
+
+func (r reportedDevice) Clone() ReportedDevice {
+	var ipAddresses []net.IP
+	if r.ipAddresses != nil {
+		ipAddresses = make([]net.IP, len(r.ipAddresses))
+		for i, ip := range r.ipAddresses {
+			ipAddresses[i] = append(net.IP{}, ip...)
+		}
+	}
+	return &reportedDevice{
+		id:          r.id,
+		name:        r.name,
+		mac:         r.mac,
+		ipAddresses: ipAddresses,
+	}
 }
 
 func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
@@ -167,22 +366,38 @@ func convertSDKNIC(sdkObject *ovirtsdk.Nic, cli Client) (NIC, error) {
 	if !ok {
 		return nil, newFieldNotFound("vNIC Profile on VM", "ID")
 	}
-	return &nic{
-		cli,
-		id,
-		name,
-		vmid,
-		vnicProfileID,
-	}, nil
+	result := &nic{
+		client:        cli,
+		id:            NICID(id),
+		name:          name,
+		vmid:          VMID(vmid),
+		vnicProfileID: vnicProfileID,
+		// The engine treats NICs without these fields as plugged and linked.
+		plugged: true,
+		linked:  true,
+	}
+	if mac, ok := sdkObject.Mac(); ok {
+		result.mac, _ = mac.Address()
+	}
+	if plugged, ok := sdkObject.Plugged(); ok {
+		result.plugged = plugged
+	}
+	if linked, ok := sdkObject.Linked(); ok {
+		result.linked = linked
+	}
+	return result, nil
 }
 
 type nic struct {
 	client Client
 
-	id            string
+	id            NICID
 	name          string
-	vmid          string
+	vmid          VMID
 	vnicProfileID string
+	mac           string
+	plugged       bool
+	linked        bool
 }
 
 func (n nic) Update(params UpdateNICParameters, retries ...RetryStrategy) (NIC, error) {
@@ -201,7 +416,19 @@ func (n nic) VNICProfileID() string {
 	return n.vnicProfileID
 }
 
-func (n nic) ID() string {
+func (n nic) MAC() string {
+	return n.mac
+}
+
+func (n nic) Plugged() bool {
+	return n.plugged
+}
+
+func (n nic) Linked() bool {
+	return n.linked
+}
+
+func (n nic) ID() NICID {
 	return n.id
 }
 
@@ -209,7 +436,7 @@ func (n nic) Name() string {
 	return n.name
 }
 
-func (n nic) VMID() string {
+func (n nic) VMID() VMID {
 	return n.vmid
 }
 
@@ -217,6 +444,35 @@ func (n nic) Remove(retries ...RetryStrategy) error {
 	return n.client.RemoveNIC(n.vmid, n.id, retries...)
 }
 
+func (n nic) ReportedDevices(retries ...RetryStrategy) ([]ReportedDevice, error) {
+	return n.client.ListNICReportedDevices(n.vmid, n.id, retries...)
+}
+
+func (n nic) IPAddresses(retries ...RetryStrategy) ([]net.IP, error) {
+	devices, err := n.ReportedDevices(retries...)
+	if err != nil {
+		return nil, err
+	}
+	var result []net.IP
+	for _, device := range devices {
+		result = append(result, device.IPAddresses()...)
+	}
+	return result, nil
+}
+
+func (n nic) Clone() NIC {
+	return &nic{
+		client:        n.client,
+		id:            n.id,
+		name:          n.name,
+		vmid:          n.vmid,
+		vnicProfileID: n.vnicProfileID,
+		mac:           n.mac,
+		plugged:       n.plugged,
+		linked:        n.linked,
+	}
+}
+
 func (n nic) withName(name string) *nic {
 	return &nic{
 		client:        n.client,
@@ -224,6 +480,9 @@ func (n nic) withName(name string) *nic {
 		name:          name,
 		vmid:          n.vmid,
 		vnicProfileID: n.vnicProfileID,
+		mac:           n.mac,
+		plugged:       n.plugged,
+		linked:        n.linked,
 	}
 }
 
@@ -234,5 +493,8 @@ func (n nic) withVNICProfileID(vnicProfileID string) *nic {
 		name:          n.name,
 		vmid:          n.vmid,
 		vnicProfileID: vnicProfileID,
+		mac:           n.mac,
+		plugged:       n.plugged,
+		linked:        n.linked,
 	}
 }
@@ -7,28 +7,32 @@ import (
 )
 
 func (o *oVirtClient) CreateNIC(
-	vmid string,
+	vmid VMID,
 	vnicProfileID string,
 	name string,
-	_ OptionalNICParameters,
-	retries ...RetryStrategy,
-) (result NIC, err error) {
-	if err := validateNICCreationParameters(vmid, name); err != nil {
+	params OptionalNICParameters,
+	retries ...RetryStrategy) (result NIC, err error) {
+	if err := validateNICCreationParameters(vmid, name, params); err != nil {
 		return nil, err
 	}
+	if params == nil {
+		params = CreateNICParams()
+	}
 
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(vmid)).with("vnic_profile_id", vnicProfileID).with("name", name),
 		fmt.Sprintf("creating NIC for VM %s", vmid),
-		o.logger,
 		retries,
 		func() error {
-			nicBuilder := ovirtsdk.NewNicBuilder()
-			nicBuilder.Name(name)
-			nicBuilder.VnicProfile(ovirtsdk.NewVnicProfileBuilder().Id(vnicProfileID).MustBuild())
-			nic := nicBuilder.MustBuild()
+			nic := buildSDKNIC(name, vnicProfileID, params)
 
-			response, err := o.conn.SystemService().VmsService().VmService(vmid).NicsService().Add().Nic(nic).Send()
+			req := o.connection().SystemService().VmsService().VmService(string(vmid)).NicsService().Add().Nic(nic)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
 			if err != nil {
 				return err
 			}
@@ -52,15 +56,34 @@ func (o *oVirtClient) CreateNIC(
 			return nil
 		},
 	)
-	return result, err
+	return result, withCorrelationID(err, correlationID)
+}
+
+func buildSDKNIC(name string, vnicProfileID string, params OptionalNICParameters) *ovirtsdk.Nic {
+	nicBuilder := ovirtsdk.NewNicBuilder()
+	nicBuilder.Name(name)
+	nicBuilder.VnicProfile(ovirtsdk.NewVnicProfileBuilder().Id(vnicProfileID).MustBuild())
+	if mac := params.MAC(); mac != "" {
+		nicBuilder.MacBuilder(ovirtsdk.NewMacBuilder().Address(mac))
+	}
+	if plugged := params.Plugged(); plugged != nil {
+		nicBuilder.Plugged(*plugged)
+	}
+	if linked := params.Linked(); linked != nil {
+		nicBuilder.Linked(*linked)
+	}
+	return nicBuilder.MustBuild()
 }
 
-func validateNICCreationParameters(vmid string, name string) error {
+func validateNICCreationParameters(vmid VMID, name string, params OptionalNICParameters) error {
 	if vmid == "" {
 		return newError(EBadArgument, "VM ID cannot be empty")
 	}
 	if name == "" {
 		return newError(EBadArgument, "NIC name cannot be empty")
 	}
+	if params != nil && params.MAC() != "" {
+		return validateNICMAC(params.MAC())
+	}
 	return nil
 }
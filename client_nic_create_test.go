@@ -2,6 +2,7 @@ package ovirtclient_test
 
 import (
 	"fmt"
+	"net"
 	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client"
@@ -58,3 +59,81 @@ func TestDuplicateVMNICCreation(t *testing.T) {
 	assertCanRemoveNIC(t, nic1)
 	assertNICCount(t, vm, 0)
 }
+
+func TestVMNICRemovalFromVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	otherVM := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	nic := assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams(),
+	)
+	if err := otherVM.RemoveNIC(nic.ID()); err == nil {
+		t.Fatalf("removing a NIC through a different VM did not result in an error")
+	}
+	assertNICCount(t, vm, 1)
+	if err := vm.RemoveNIC(nic.ID()); err != nil {
+		t.Fatalf("failed to remove NIC %s from VM %s (%v)", nic.ID(), vm.ID(), err)
+	}
+	assertNICCount(t, vm, 0)
+}
+
+func TestVMNICCreationWithMACAndState(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	id := helper.GenerateRandomID(4)
+	mac := net.HardwareAddr{0x56, 0x6f, id[0], id[1], id[2], id[3]}.String()
+	nic := assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams().MustWithMAC(mac).MustWithPlugged(false).MustWithLinked(false),
+	)
+	nic, err := helper.GetClient().GetNIC(vm.ID(), nic.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch NIC %s (%v)", nic.ID(), err)
+	}
+	if nic.MAC() != mac {
+		t.Fatalf("incorrect MAC address on NIC (expected: %s, got: %s)", mac, nic.MAC())
+	}
+	if nic.Plugged() {
+		t.Fatalf("the NIC was created plugged")
+	}
+	if nic.Linked() {
+		t.Fatalf("the NIC was created linked")
+	}
+}
+
+func TestVMNICCreationWithInvalidMAC(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ovirtclient.CreateNICParams().WithMAC("not-a-mac"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("setting an invalid MAC address did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -4,14 +4,21 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) GetNIC(vmid string, id string, retries ...RetryStrategy) (result NIC, err error) {
+func (o *oVirtClient) GetNIC(vmid VMID, id NICID, retries ...RetryStrategy) (result NIC, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeNIC, string(id)),
 		fmt.Sprintf("getting NIC %s for VM %s", id, vmid),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(vmid).NicsService().NicService(id).Get().Send()
+			response, err := o.connection().
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(id)).
+				Get().
+				Send()
 			if err != nil {
 				return err
 			}
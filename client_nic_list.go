@@ -4,14 +4,14 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) ListNICs(vmid string, retries ...RetryStrategy) (result []NIC, err error) {
+func (o *oVirtClient) ListNICs(vmid VMID, retries ...RetryStrategy) (result []NIC, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(vmid)),
 		fmt.Sprintf("listing NICs for VM %s", vmid),
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().VmService(vmid).NicsService().List().Send()
+			response, e := o.connection().SystemService().VmsService().VmService(string(vmid)).NicsService().List().Send()
 			if e != nil {
 				return e
 			}
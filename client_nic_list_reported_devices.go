@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListNICReportedDevices(
+	vmid VMID,
+	nicID NICID,
+	retries ...RetryStrategy) (result []ReportedDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []ReportedDevice{}
+	err = o.retry(
+		resourceOf(ResourceTypeNIC, string(nicID)),
+		fmt.Sprintf("listing reported devices for NIC %s on VM %s", nicID, vmid),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(nicID)).
+				ReportedDevicesService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.ReportedDevice()
+			if !ok {
+				return nil
+			}
+			result = make([]ReportedDevice, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKReportedDevice(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert reported device during listing item #%d", i)
+				}
+			}
+			return nil
+		},
+	)
+	return
+}
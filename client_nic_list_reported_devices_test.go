@@ -0,0 +1,42 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestNICReportedDevicesOnStoppedVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("nic_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	nic := assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams(),
+	)
+
+	devices, err := nic.ReportedDevices()
+	if err != nil {
+		t.Fatalf("failed to list reported devices for NIC %s (%v)", nic.ID(), err)
+	}
+	if len(devices) != 0 {
+		t.Fatalf("a stopped VM should not have any reported devices (found %d)", len(devices))
+	}
+	ips, err := nic.IPAddresses()
+	if err != nil {
+		t.Fatalf("failed to list IP addresses for NIC %s (%v)", nic.ID(), err)
+	}
+	if len(ips) != 0 {
+		t.Fatalf("a stopped VM should not have any reported IP addresses (found %d)", len(ips))
+	}
+}
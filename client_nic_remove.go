@@ -4,18 +4,30 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveNIC(vmid string, id string, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeNIC, string(id)).with("vm_id", vmid),
 		fmt.Sprintf("removing NIC %s from VM %s", id, vmid),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(vmid).NicsService().NicService(id).Remove().Send()
+			req := o.connection().
+				SystemService().
+				VmsService().
+				VmService(string(vmid)).
+				NicsService().
+				NicService(string(id)).
+				Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
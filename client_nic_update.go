@@ -7,14 +7,19 @@ import (
 )
 
 func (o *oVirtClient) UpdateNIC(
-	vmid string,
-	nicID string,
+	vmid VMID,
+	nicID NICID,
 	params UpdateNICParameters,
-	retries ...RetryStrategy,
-) (result NIC, err error) {
-	req := o.conn.SystemService().VmsService().VmService(vmid).NicsService().NicService(nicID).Update()
+	retries ...RetryStrategy) (result NIC, err error) {
+	req := o.connection().
+		SystemService().
+		VmsService().
+		VmService(string(vmid)).
+		NicsService().
+		NicService(string(nicID)).
+		Update()
 
-	nicBuilder := ovirtsdk.NewNicBuilder().Id(nicID)
+	nicBuilder := ovirtsdk.NewNicBuilder().Id(string(nicID))
 	if name := params.Name(); name != nil {
 		nicBuilder.Name(*name)
 	}
@@ -24,10 +29,14 @@ func (o *oVirtClient) UpdateNIC(
 
 	req.Nic(nicBuilder.MustBuild())
 
-	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	if correlationID != "" {
+		req.Query("correlation_id", correlationID)
+	}
+	err = o.mutate(
+		resourceOf(ResourceTypeNIC, string(nicID)).with("vm_id", vmid),
 		fmt.Sprintf("updating NIC %s for VM %s", nicID, vmid),
-		o.logger,
 		retries,
 		func() error {
 			update, err := req.Send()
@@ -45,5 +54,5 @@ func (o *oVirtClient) UpdateNIC(
 			result = nic
 			return nil
 		})
-	return result, err
+	return result, withCorrelationID(err, correlationID)
 }
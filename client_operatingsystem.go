@@ -0,0 +1,87 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// OperatingSystemClient contains the functions to look up the operating systems the engine supports for VMs. The
+// catalog can be used to offer valid choices to users and to validate the operating system of a VM before creating
+// it.
+type OperatingSystemClient interface {
+	// ListOperatingSystems lists all operating systems supported by the engine.
+	ListOperatingSystems(retries ...RetryStrategy) ([]OperatingSystem, error)
+	// GetOperatingSystem returns the operating system with the specified ID.
+	GetOperatingSystem(id string, retries ...RetryStrategy) (OperatingSystem, error)
+	// GetOperatingSystemByName returns the operating system with the specified name, for example rhel_8x64. It
+	// returns an ENotFound error if the engine does not support the operating system.
+	GetOperatingSystemByName(name string, retries ...RetryStrategy) (OperatingSystem, error)
+}
+
+// OperatingSystem is an operating system the engine supports for VMs.
+type OperatingSystem interface {
+	// ID returns the identifier of the operating system in the engine configuration.
+	ID() string
+	// Name returns the unique name of the operating system, for example rhel_8x64. This is the value the engine
+	// expects as the operating system type of a VM.
+	Name() string
+	// Description returns the human-readable name of the operating system, for example "Red Hat Enterprise Linux
+	// 8.x x64".
+	Description() string
+	// Architecture returns the CPU architecture the operating system runs on.
+	Architecture() CPUArchitecture
+}
+
+// findOperatingSystemByName returns the operating system with the specified name from the list.
+func findOperatingSystemByName(operatingSystems []OperatingSystem, name string) (OperatingSystem, error) {
+	for _, o := range operatingSystems {
+		if o.Name() == name {
+			return o, nil
+		}
+	}
+	return nil, newError(ENotFound, "no operating system with the name %s found", name)
+}
+
+func convertSDKOperatingSystem(sdkObject *ovirtsdk.OperatingSystemInfo) (*operatingSystem, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("operating system", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("operating system", "name")
+	}
+	description, _ := sdkObject.Description()
+	architecture, ok := sdkObject.Architecture()
+	if !ok {
+		architecture = ovirtsdk.ARCHITECTURE_UNDEFINED
+	}
+	return &operatingSystem{
+		id:           id,
+		name:         name,
+		description:  description,
+		architecture: CPUArchitecture(architecture),
+	}, nil
+}
+
+type operatingSystem struct {
+	id           string
+	name         string
+	description  string
+	architecture CPUArchitecture
+}
+
+func (o operatingSystem) ID() string {
+	return o.id
+}
+
+func (o operatingSystem) Name() string {
+	return o.name
+}
+
+func (o operatingSystem) Description() string {
+	return o.description
+}
+
+func (o operatingSystem) Architecture() CPUArchitecture {
+	return o.architecture
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetOperatingSystem(id string, retries ...RetryStrategy) (result OperatingSystem, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeOperatingSystem, id),
+		fmt.Sprintf("getting operating system %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().OperatingSystemsService().OperatingSystemService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.OperatingSystem()
+			if !ok {
+				return newError(ENotFound, "no operating system returned when getting operating system ID %s", id)
+			}
+			result, e = convertSDKOperatingSystem(sdkObject)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert operating system %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) ListOperatingSystems(retries ...RetryStrategy) (result []OperatingSystem, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []OperatingSystem{}
+	err = o.retry(
+		resourceOf(ResourceTypeOperatingSystem, ""),
+		"listing operating systems",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().OperatingSystemsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.OperatingSystem()
+			if !ok {
+				return nil
+			}
+			result = make([]OperatingSystem, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKOperatingSystem(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert operating system during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) GetOperatingSystemByName(name string, retries ...RetryStrategy) (OperatingSystem, error) {
+	operatingSystems, err := o.ListOperatingSystems(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findOperatingSystemByName(operatingSystems, name)
+}
@@ -0,0 +1,39 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestGetOperatingSystemByName(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	operatingSystems, err := client.ListOperatingSystems()
+	if err != nil {
+		t.Fatalf("failed to list operating systems (%v)", err)
+	}
+	if len(operatingSystems) == 0 {
+		t.Fatalf("no operating systems returned")
+	}
+
+	operatingSystem, err := client.GetOperatingSystemByName("other_linux")
+	if err != nil {
+		t.Fatalf("failed to find the other_linux operating system (%v)", err)
+	}
+	if operatingSystem.Architecture() != ovirtclient.CPUArchitectureX86_64 {
+		t.Fatalf("incorrect architecture for other_linux: %s", operatingSystem.Architecture())
+	}
+	fetchedOperatingSystem, err := client.GetOperatingSystem(operatingSystem.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch operating system %s (%v)", operatingSystem.ID(), err)
+	}
+	if fetchedOperatingSystem.Name() != "other_linux" {
+		t.Fatalf("incorrect name for operating system %s: %s", operatingSystem.ID(), fetchedOperatingSystem.Name())
+	}
+	if _, err := client.GetOperatingSystemByName("nonexistent"); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("finding a nonexistent operating system did not return a not found error (%v)", err)
+	}
+}
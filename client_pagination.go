@@ -0,0 +1,137 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// defaultPageSize is the number of items the oVirt Engine returns per page if a page is requested without specifying
+// the maximum number of items.
+const defaultPageSize = 100
+
+// PageParameters describes the parameters used to fetch a single page of a list or search call. This allows large
+// installations to fetch items in batches instead of fetching everything at once.
+type PageParameters interface {
+	// Max returns the maximum number of items to return. If nil, all items are returned, or, if Page is set, the
+	// engine default page size of 100 items is used.
+	Max() *uint
+	// Page returns the number of the page to return, starting with 1. If nil, the first page is returned.
+	Page() *uint
+}
+
+// BuildablePageParameters is a buildable version of PageParameters.
+type BuildablePageParameters interface {
+	PageParameters
+
+	// WithMax sets the maximum number of items to return. It must be at least 1.
+	WithMax(max uint) (BuildablePageParameters, error)
+	// MustWithMax is identical to WithMax, but panics instead of returning an error.
+	MustWithMax(max uint) BuildablePageParameters
+	// WithPage sets the page to return, starting with 1.
+	WithPage(page uint) (BuildablePageParameters, error)
+	// MustWithPage is identical to WithPage, but panics instead of returning an error.
+	MustWithPage(page uint) BuildablePageParameters
+}
+
+// PageParams creates a buildable set of page parameters for easier use.
+func PageParams() BuildablePageParameters {
+	return &pageParams{}
+}
+
+type pageParams struct {
+	max  *uint
+	page *uint
+}
+
+func (p *pageParams) Max() *uint {
+	return p.max
+}
+
+func (p *pageParams) Page() *uint {
+	return p.page
+}
+
+func (p *pageParams) WithMax(max uint) (BuildablePageParameters, error) {
+	if max == 0 {
+		return nil, newError(EBadArgument, "the maximum number of items must be at least 1")
+	}
+	p.max = &max
+	return p, nil
+}
+
+func (p *pageParams) MustWithMax(max uint) BuildablePageParameters {
+	builder, err := p.WithMax(max)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (p *pageParams) WithPage(page uint) (BuildablePageParameters, error) {
+	if page == 0 {
+		return nil, newError(EBadArgument, "page numbers start with 1")
+	}
+	p.page = &page
+	return p, nil
+}
+
+func (p *pageParams) MustWithPage(page uint) BuildablePageParameters {
+	builder, err := p.WithPage(page)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validatePageParameters(params PageParameters) error {
+	if params == nil {
+		return nil
+	}
+	if max := params.Max(); max != nil && *max == 0 {
+		return newError(EBadArgument, "the maximum number of items must be at least 1")
+	}
+	if page := params.Page(); page != nil && *page == 0 {
+		return newError(EBadArgument, "page numbers start with 1")
+	}
+	return nil
+}
+
+// pageSearchCriteria returns the engine search criteria for selecting a page, or an empty string if no page is
+// requested.
+func pageSearchCriteria(params PageParameters) string {
+	if params == nil {
+		return ""
+	}
+	if page := params.Page(); page != nil {
+		return fmt.Sprintf("page %d", *page)
+	}
+	return ""
+}
+
+// mockPage returns the start and end index of the requested page in a list of length items.
+func mockPage(length int, params PageParameters) (int, int) {
+	if params == nil {
+		return 0, length
+	}
+	max := params.Max()
+	page := params.Page()
+	if max == nil && page == nil {
+		return 0, length
+	}
+	pageSize := uint(defaultPageSize)
+	if max != nil {
+		pageSize = *max
+	}
+	pageNumber := uint(1)
+	if page != nil {
+		pageNumber = *page
+	}
+	start := int((pageNumber - 1) * pageSize)
+	if start > length {
+		start = length
+	}
+	end := start + int(pageSize)
+	if end > length {
+		end = length
+	}
+	return start, end
+}
@@ -0,0 +1,59 @@
+package ovirtclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// TestListPageWithNilParams tests that the page functions treat nil params as no paging options on both the real and
+// the mock client.
+func TestListPageWithNilParams(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(servePageEngine))
+	defer server.Close()
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	for name, c := range map[string]ovirtclient.Client{
+		"real": client,
+		"mock": ovirtclient.NewMockWithLogger(ovirtclientlog.NewTestLogger(t)),
+	} {
+		if _, err := c.ListVMsPage(nil); err != nil {
+			t.Fatalf("failed to list VMs with nil page params on the %s client (%v)", name, err)
+		}
+		if _, err := c.ListDisksPage(nil); err != nil {
+			t.Fatalf("failed to list disks with nil page params on the %s client (%v)", name, err)
+		}
+	}
+}
+
+// servePageEngine is a fake oVirt Engine returning empty VM and disk lists.
+func servePageEngine(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/ovirt-engine/sso/oauth/token":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token"}`))
+	case "/ovirt-engine/api/vms":
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<vms></vms>`))
+	case "/ovirt-engine/api/disks":
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<disks></disks>`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
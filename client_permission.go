@@ -0,0 +1,270 @@
+package ovirtclient
+
+import (
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// PermissionClient contains the functions to manage the permissions on VMs, templates and disks. A permission grants a
+// role to a user or group on an object.
+type PermissionClient interface {
+	// AddVMPermission grants the role to the principal on the VM.
+	AddVMPermission(vmID VMID, principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (
+		Permission,
+		error,
+	)
+	// AddTemplatePermission grants the role to the principal on the template.
+	AddTemplatePermission(
+		templateID TemplateID,
+		principal PermissionPrincipal,
+		roleID string,
+		retries ...RetryStrategy,
+	) (Permission, error)
+	// AddDiskPermission grants the role to the principal on the disk.
+	AddDiskPermission(diskID DiskID, principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (
+		Permission,
+		error,
+	)
+	// ListPermissions lists the permissions that apply to a VM, template or disk. The result may include permissions
+	// inherited from the objects containing it, such as the cluster, datacenter or the whole system.
+	ListPermissions(objectType PermissionObjectType, objectID string, retries ...RetryStrategy) ([]Permission, error)
+	// RemovePermission removes a permission from a VM, template or disk.
+	RemovePermission(objectType PermissionObjectType, objectID string, id string, retries ...RetryStrategy) error
+}
+
+// PermissionData is the core of Permission, providing only data access functions.
+type PermissionData interface {
+	// ID returns the identifier of the permission.
+	ID() string
+	// RoleID returns the ID of the role granted by the permission.
+	RoleID() string
+	// Principal returns the user or group the role is granted to.
+	Principal() PermissionPrincipal
+	// ObjectType returns the type of the object the permission is granted on.
+	ObjectType() PermissionObjectType
+	// ObjectID returns the ID of the object the permission is granted on. It is empty for system permissions.
+	ObjectID() string
+}
+
+// Permission is a role granted to a user or group on an object.
+type Permission interface {
+	PermissionData
+
+	// Remove removes the permission. This only works for permissions granted on VMs, templates and disks.
+	Remove(retries ...RetryStrategy) error
+}
+
+// PermissionPrincipal is the user or group a permission is granted to. Use UserPrincipal or GroupPrincipal to create
+// one.
+type PermissionPrincipal interface {
+	// Type returns whether the principal is a user or a group.
+	Type() PermissionPrincipalType
+	// ID returns the ID of the user or group.
+	ID() string
+}
+
+// UserPrincipal returns a PermissionPrincipal for the user with the specified ID.
+func UserPrincipal(userID string) PermissionPrincipal {
+	return &permissionPrincipal{PermissionPrincipalTypeUser, userID}
+}
+
+// GroupPrincipal returns a PermissionPrincipal for the group with the specified ID.
+func GroupPrincipal(groupID string) PermissionPrincipal {
+	return &permissionPrincipal{PermissionPrincipalTypeGroup, groupID}
+}
+
+type permissionPrincipal struct {
+	principalType PermissionPrincipalType
+	id            string
+}
+
+func (p *permissionPrincipal) Type() PermissionPrincipalType {
+	return p.principalType
+}
+
+func (p *permissionPrincipal) ID() string {
+	return p.id
+}
+
+// PermissionPrincipalType is the type of principal a permission is granted to.
+type PermissionPrincipalType string
+
+const (
+	// PermissionPrincipalTypeUser indicates that the permission is granted to a user.
+	PermissionPrincipalTypeUser PermissionPrincipalType = "user"
+	// PermissionPrincipalTypeGroup indicates that the permission is granted to a group.
+	PermissionPrincipalTypeGroup PermissionPrincipalType = "group"
+)
+
+// PermissionObjectType is the type of object a permission is granted on.
+type PermissionObjectType string
+
+const (
+	// PermissionObjectTypeVM is a permission on a VM.
+	PermissionObjectTypeVM PermissionObjectType = "vm"
+	// PermissionObjectTypeTemplate is a permission on a template.
+	PermissionObjectTypeTemplate PermissionObjectType = "template"
+	// PermissionObjectTypeDisk is a permission on a disk.
+	PermissionObjectTypeDisk PermissionObjectType = "disk"
+	// PermissionObjectTypeCluster is a permission on a cluster, inherited by the objects in it.
+	PermissionObjectTypeCluster PermissionObjectType = "cluster"
+	// PermissionObjectTypeDatacenter is a permission on a datacenter, inherited by the objects in it.
+	PermissionObjectTypeDatacenter PermissionObjectType = "datacenter"
+	// PermissionObjectTypeSystem is a permission on the whole system, inherited by all objects.
+	PermissionObjectTypeSystem PermissionObjectType = "system"
+)
+
+// Validate returns an error if the object type is not one permissions can be managed on using this client.
+func (p PermissionObjectType) Validate() error {
+	for _, objectType := range PermissionManagedObjectTypeValues() {
+		if objectType == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid permission object type: %s must be one of: %s",
+		p,
+		strings.Join(PermissionManagedObjectTypeValues().Strings(), ", "),
+	)
+}
+
+// PermissionObjectTypeList is a list of PermissionObjectType values.
+type PermissionObjectTypeList []PermissionObjectType
+
+// Strings creates a string list of the values.
+func (l PermissionObjectTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, objectType := range l {
+		result[i] = string(objectType)
+	}
+	return result
+}
+
+// PermissionObjectTypeValues returns all possible PermissionObjectType values.
+func PermissionObjectTypeValues() PermissionObjectTypeList {
+	return []PermissionObjectType{
+		PermissionObjectTypeVM,
+		PermissionObjectTypeTemplate,
+		PermissionObjectTypeDisk,
+		PermissionObjectTypeCluster,
+		PermissionObjectTypeDatacenter,
+		PermissionObjectTypeSystem,
+	}
+}
+
+// PermissionManagedObjectTypeValues returns the PermissionObjectType values that permissions can be added to, listed
+// on and removed from using PermissionClient.
+func PermissionManagedObjectTypeValues() PermissionObjectTypeList {
+	return []PermissionObjectType{
+		PermissionObjectTypeVM,
+		PermissionObjectTypeTemplate,
+		PermissionObjectTypeDisk,
+	}
+}
+
+func validatePermissionParameters(principal PermissionPrincipal, roleID string) error {
+	if principal == nil || principal.ID() == "" {
+		return newError(EBadArgument, "a user or group must be specified for the permission")
+	}
+	if principal.Type() != PermissionPrincipalTypeUser && principal.Type() != PermissionPrincipalTypeGroup {
+		return newError(EBadArgument, "invalid permission principal type: %s", principal.Type())
+	}
+	if roleID == "" {
+		return newError(EBadArgument, "role ID cannot be empty for the permission")
+	}
+	return nil
+}
+
+func convertSDKPermission(sdkObject *ovirtsdk.Permission, client Client) (*permission, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("permission", "ID")
+	}
+	sdkRole, ok := sdkObject.Role()
+	if !ok {
+		return nil, newFieldNotFound("permission", "role")
+	}
+	roleID, ok := sdkRole.Id()
+	if !ok {
+		return nil, newFieldNotFound("role of permission", "ID")
+	}
+	var principal *permissionPrincipal
+	if sdkUser, ok := sdkObject.User(); ok {
+		userID, _ := sdkUser.Id()
+		principal = &permissionPrincipal{PermissionPrincipalTypeUser, userID}
+	} else if sdkGroup, ok := sdkObject.Group(); ok {
+		groupID, _ := sdkGroup.Id()
+		principal = &permissionPrincipal{PermissionPrincipalTypeGroup, groupID}
+	} else {
+		return nil, newFieldNotFound("permission", "user or group")
+	}
+	objectType, objectID := convertSDKPermissionObject(sdkObject)
+	return &permission{
+		client:     client,
+		id:         id,
+		roleID:     roleID,
+		principal:  principal,
+		objectType: objectType,
+		objectID:   objectID,
+	}, nil
+}
+
+func convertSDKPermissionObject(sdkObject *ovirtsdk.Permission) (PermissionObjectType, string) {
+	if sdkVM, ok := sdkObject.Vm(); ok {
+		id, _ := sdkVM.Id()
+		return PermissionObjectTypeVM, id
+	}
+	if sdkTemplate, ok := sdkObject.Template(); ok {
+		id, _ := sdkTemplate.Id()
+		return PermissionObjectTypeTemplate, id
+	}
+	if sdkDisk, ok := sdkObject.Disk(); ok {
+		id, _ := sdkDisk.Id()
+		return PermissionObjectTypeDisk, id
+	}
+	if sdkCluster, ok := sdkObject.Cluster(); ok {
+		id, _ := sdkCluster.Id()
+		return PermissionObjectTypeCluster, id
+	}
+	if sdkDatacenter, ok := sdkObject.DataCenter(); ok {
+		id, _ := sdkDatacenter.Id()
+		return PermissionObjectTypeDatacenter, id
+	}
+	return PermissionObjectTypeSystem, ""
+}
+
+type permission struct {
+	client Client
+
+	id         string
+	roleID     string
+	principal  *permissionPrincipal
+	objectType PermissionObjectType
+	objectID   string
+}
+
+func (p *permission) ID() string {
+	return p.id
+}
+
+func (p *permission) RoleID() string {
+	return p.roleID
+}
+
+func (p *permission) Principal() PermissionPrincipal {
+	return p.principal
+}
+
+func (p *permission) ObjectType() PermissionObjectType {
+	return p.objectType
+}
+
+func (p *permission) ObjectID() string {
+	return p.objectID
+}
+
+func (p *permission) Remove(retries ...RetryStrategy) error {
+	return p.client.RemovePermission(p.objectType, p.objectID, p.id, retries...)
+}
@@ -0,0 +1,109 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AddVMPermission(
+	vmID VMID,
+	principal PermissionPrincipal,
+	roleID string,
+	retries ...RetryStrategy,
+) (Permission, error) {
+	return o.addPermission(PermissionObjectTypeVM, string(vmID), principal, roleID, retries...)
+}
+
+func (o *oVirtClient) AddTemplatePermission(
+	templateID TemplateID,
+	principal PermissionPrincipal,
+	roleID string,
+	retries ...RetryStrategy,
+) (Permission, error) {
+	return o.addPermission(PermissionObjectTypeTemplate, string(templateID), principal, roleID, retries...)
+}
+
+func (o *oVirtClient) AddDiskPermission(
+	diskID DiskID,
+	principal PermissionPrincipal,
+	roleID string,
+	retries ...RetryStrategy,
+) (Permission, error) {
+	return o.addPermission(PermissionObjectTypeDisk, string(diskID), principal, roleID, retries...)
+}
+
+func (o *oVirtClient) addPermission(
+	objectType PermissionObjectType,
+	objectID string,
+	principal PermissionPrincipal,
+	roleID string,
+	retries ...RetryStrategy,
+) (result Permission, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	if err := validatePermissionParameters(principal, roleID); err != nil {
+		return nil, err
+	}
+	service, err := o.permissionsService(objectType, objectID)
+	if err != nil {
+		return nil, err
+	}
+	correlationID := o.correlationIDFor(retries)
+
+	builder := ovirtsdk.NewPermissionBuilder().Role(ovirtsdk.NewRoleBuilder().Id(roleID).MustBuild())
+	if principal.Type() == PermissionPrincipalTypeUser {
+		builder.User(ovirtsdk.NewUserBuilder().Id(principal.ID()).MustBuild())
+	} else {
+		builder.Group(ovirtsdk.NewGroupBuilder().Id(principal.ID()).MustBuild())
+	}
+
+	err = o.mutate(
+		resourceOf(ResourceType(objectType), objectID).
+			with("principal_type", principal.Type()).
+			with("principal_id", principal.ID()).
+			with("role_id", roleID),
+		fmt.Sprintf("adding permission for %s %s on %s %s", principal.Type(), principal.ID(), objectType, objectID),
+		retries,
+		func() error {
+			req := service.Add().Permission(builder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Permission()
+			if !ok {
+				return newError(EFieldMissing, "missing permission in response")
+			}
+			result, e = convertSDKPermission(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert permission")
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
+
+// permissionsService returns the service for the permissions of a VM, template or disk.
+func (o *oVirtClient) permissionsService(objectType PermissionObjectType, objectID string) (
+	*ovirtsdk.AssignedPermissionsService,
+	error,
+) {
+	if err := objectType.Validate(); err != nil {
+		return nil, err
+	}
+	if objectID == "" {
+		return nil, newError(EBadArgument, "%s ID cannot be empty for managing permissions", objectType)
+	}
+	switch objectType {
+	case PermissionObjectTypeTemplate:
+		return o.connection().SystemService().TemplatesService().TemplateService(objectID).PermissionsService(), nil
+	case PermissionObjectTypeDisk:
+		return o.connection().SystemService().DisksService().DiskService(objectID).PermissionsService(), nil
+	default:
+		return o.connection().SystemService().VmsService().VmService(objectID).PermissionsService(), nil
+	}
+}
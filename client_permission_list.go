@@ -0,0 +1,41 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListPermissions(
+	objectType PermissionObjectType,
+	objectID string,
+	retries ...RetryStrategy,
+) (result []Permission, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	service, err := o.permissionsService(objectType, objectID)
+	if err != nil {
+		return nil, err
+	}
+	result = []Permission{}
+	err = o.retry(
+		resourceOf(ResourceType(objectType), objectID),
+		fmt.Sprintf("listing permissions of %s %s", objectType, objectID),
+		retries,
+		func() error {
+			response, e := service.List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Permissions()
+			if !ok {
+				return nil
+			}
+			result = make([]Permission, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKPermission(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert permission during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,33 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemovePermission(
+	objectType PermissionObjectType,
+	objectID string,
+	id string,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	service, err := o.permissionsService(objectType, objectID)
+	if err != nil {
+		return err
+	}
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypePermission, id).with("object_type", objectType).with("object_id", objectID),
+		fmt.Sprintf("removing permission %s from %s %s", id, objectType, objectID),
+		retries,
+		func() error {
+			req := service.PermissionService(id).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,111 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+// TestVMPermissions runs against the mock only to avoid changing the permissions of the admin user in a live
+// engine.
+func TestVMPermissions(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	vm, err := client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "test-permissions", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	admin, err := client.GetUserByName("admin")
+	if err != nil {
+		t.Fatalf("failed to find admin user (%v)", err)
+	}
+	principal := admin.PermissionPrincipal()
+	userRoleID := findUserRoleID(t, client)
+
+	permission, err := vm.AddPermission(principal, userRoleID)
+	if err != nil {
+		t.Fatalf("failed to add permission to VM (%v)", err)
+	}
+	if permission.ObjectType() != ovirtclient.PermissionObjectTypeVM || permission.ObjectID() != string(vm.ID()) {
+		t.Fatalf("the permission was granted on %s %s", permission.ObjectType(), permission.ObjectID())
+	}
+	if _, err := vm.AddPermission(principal, userRoleID); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("adding the same permission twice did not return a conflict error (%v)", err)
+	}
+	if _, err := vm.AddPermission(principal, ""); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("adding a permission without a role did not fail (%v)", err)
+	}
+
+	permissions, err := vm.ListPermissions()
+	if err != nil {
+		t.Fatalf("failed to list permissions (%v)", err)
+	}
+	if len(permissions) != 1 || permissions[0].Principal().ID() != principal.ID() ||
+		permissions[0].Principal().Type() != ovirtclient.PermissionPrincipalTypeUser ||
+		permissions[0].RoleID() != userRoleID {
+		t.Fatalf("incorrect permissions returned for VM %s", vm.ID())
+	}
+
+	if err := permission.Remove(); err != nil {
+		t.Fatalf("failed to remove permission (%v)", err)
+	}
+	if err := permission.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("removing a permission twice did not return a not found error (%v)", err)
+	}
+	if _, err := client.ListPermissions(
+		ovirtclient.PermissionObjectTypeCluster,
+		string(clusters[0].ID()),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("listing the permissions of a cluster did not return a bad argument error (%v)", err)
+	}
+}
+
+// TestDiskPermissionsAreRemovedWithDisk runs against the mock only to avoid changing the permissions of the Everyone
+// group in a live engine.
+func TestDiskPermissionsAreRemovedWithDisk(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	sd, err := client.SelectStorageDomain(nil)
+	if err != nil {
+		t.Fatalf("failed to select storage domain (%v)", err)
+	}
+	disk, err := client.CreateDisk(sd.ID(), ovirtclient.ImageFormatRaw, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+	userRoleID := findUserRoleID(t, client)
+	everyone, err := client.GetGroupByName("Everyone")
+	if err != nil {
+		t.Fatalf("failed to find the Everyone group (%v)", err)
+	}
+	if _, err := disk.AddPermission(ovirtclient.GroupPrincipal("nonexistent"), userRoleID); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("adding a permission for a nonexistent group did not fail (%v)", err)
+	}
+	if _, err := disk.AddPermission(everyone.PermissionPrincipal(), userRoleID); err != nil {
+		t.Fatalf("failed to add permission to disk (%v)", err)
+	}
+	if err := disk.Remove(); err != nil {
+		t.Fatalf("failed to remove disk (%v)", err)
+	}
+	if _, err := client.ListPermissions(
+		ovirtclient.PermissionObjectTypeDisk,
+		string(disk.ID()),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("listing the permissions of a removed disk did not return a not found error (%v)", err)
+	}
+}
+
+func findUserRoleID(t *testing.T, client ovirtclient.Client) string {
+	role, err := client.GetRoleByName("UserRole")
+	if err != nil {
+		t.Fatalf("failed to find UserRole (%v)", err)
+	}
+	return role.ID()
+}
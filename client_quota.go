@@ -0,0 +1,557 @@
+package ovirtclient
+
+import (
+	"math"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// QuotaClient contains the functions related to quotas. Quotas limit the CPU, memory and storage the VMs and disks
+// assigned to them can use, and belong to a datacenter. They are only enforced if the quota mode of the datacenter is
+// set to enforced.
+// See https://www.ovirt.org/documentation/administration_guide/#chap-Quotas_and_Service_Level_Agreement_Policy for
+// details.
+type QuotaClient interface {
+	// ListQuotas lists all quotas of a datacenter.
+	ListQuotas(datacenterID string, retries ...RetryStrategy) ([]Quota, error)
+	// GetQuota returns a single quota of a datacenter.
+	GetQuota(datacenterID string, id QuotaID, retries ...RetryStrategy) (Quota, error)
+	// CreateQuota creates a new quota without limits in the datacenter. Use CreateQuotaParams to obtain a builder for
+	// the optional parameters.
+	CreateQuota(datacenterID string, name string, params OptionalQuotaParameters, retries ...RetryStrategy) (
+		Quota,
+		error,
+	)
+	// RemoveQuota removes a quota from the datacenter.
+	RemoveQuota(datacenterID string, id QuotaID, retries ...RetryStrategy) error
+	// ListQuotaClusterLimits lists the CPU and memory limits of a quota.
+	ListQuotaClusterLimits(datacenterID string, id QuotaID, retries ...RetryStrategy) ([]QuotaClusterLimit, error)
+	// AddQuotaClusterLimit adds a CPU and memory limit to the quota. The limit applies to the specified cluster, or
+	// to all clusters of the datacenter if clusterID is empty. The vcpuLimit and memoryLimit (in bytes) can be
+	// QuotaUnlimited.
+	AddQuotaClusterLimit(
+		datacenterID string,
+		id QuotaID,
+		clusterID ClusterID,
+		vcpuLimit int64,
+		memoryLimit int64,
+		retries ...RetryStrategy,
+	) (QuotaClusterLimit, error)
+	// ListQuotaStorageLimits lists the storage limits of a quota.
+	ListQuotaStorageLimits(datacenterID string, id QuotaID, retries ...RetryStrategy) ([]QuotaStorageLimit, error)
+	// AddQuotaStorageLimit adds a storage limit to the quota. The limit applies to the specified storage domain, or
+	// to all storage domains of the datacenter if storageDomainID is empty. The limit is in bytes and must be a
+	// multiple of 1 GiB since the engine stores it in GiB, or QuotaUnlimited.
+	AddQuotaStorageLimit(
+		datacenterID string,
+		id QuotaID,
+		storageDomainID string,
+		limit int64,
+		retries ...RetryStrategy,
+	) (QuotaStorageLimit, error)
+}
+
+// QuotaID is the identifier of a quota.
+type QuotaID string
+
+// QuotaUnlimited indicates that a quota limit does not restrict the usage.
+const QuotaUnlimited int64 = -1
+
+// quotaGiB is the unit the engine uses for memory and storage limits.
+const quotaGiB = 1024 * 1024 * 1024
+
+// QuotaData is the core of a Quota, providing only data access functions.
+type QuotaData interface {
+	// ID returns the identifier of the quota.
+	ID() QuotaID
+	// Name returns the name of the quota.
+	Name() string
+	// Description returns the description of the quota.
+	Description() string
+	// DatacenterID returns the ID of the datacenter the quota belongs to.
+	DatacenterID() string
+	// ClusterSoftLimitPct returns the percentage of the cluster limits after which the engine warns the users.
+	ClusterSoftLimitPct() uint
+	// ClusterHardLimitPct returns the percentage by which the cluster limits may be exceeded (the grace).
+	ClusterHardLimitPct() uint
+	// StorageSoftLimitPct returns the percentage of the storage limits after which the engine warns the users.
+	StorageSoftLimitPct() uint
+	// StorageHardLimitPct returns the percentage by which the storage limits may be exceeded (the grace).
+	StorageHardLimitPct() uint
+}
+
+// Quota is a quota of a datacenter.
+type Quota interface {
+	QuotaData
+
+	// ListClusterLimits lists the CPU and memory limits of the quota.
+	ListClusterLimits(retries ...RetryStrategy) ([]QuotaClusterLimit, error)
+	// AddClusterLimit adds a CPU and memory limit to the quota, see QuotaClient.AddQuotaClusterLimit.
+	AddClusterLimit(clusterID ClusterID, vcpuLimit int64, memoryLimit int64, retries ...RetryStrategy) (
+		QuotaClusterLimit,
+		error,
+	)
+	// ListStorageLimits lists the storage limits of the quota.
+	ListStorageLimits(retries ...RetryStrategy) ([]QuotaStorageLimit, error)
+	// AddStorageLimit adds a storage limit to the quota, see QuotaClient.AddQuotaStorageLimit.
+	AddStorageLimit(storageDomainID string, limit int64, retries ...RetryStrategy) (QuotaStorageLimit, error)
+	// Remove removes the quota.
+	Remove(retries ...RetryStrategy) error
+}
+
+// QuotaClusterLimit is a CPU and memory limit of a quota.
+type QuotaClusterLimit interface {
+	// ID returns the identifier of the limit.
+	ID() string
+	// QuotaID returns the ID of the quota the limit belongs to.
+	QuotaID() QuotaID
+	// ClusterID returns the ID of the cluster the limit applies to, or an empty string if it applies to all
+	// clusters of the datacenter.
+	ClusterID() ClusterID
+	// VCPULimit returns the number of virtual CPUs the VMs in the quota may use, or QuotaUnlimited.
+	VCPULimit() int64
+	// VCPUUsage returns the number of virtual CPUs the VMs in the quota currently use.
+	VCPUUsage() uint64
+	// MemoryLimit returns the memory in bytes the VMs in the quota may use, or QuotaUnlimited.
+	MemoryLimit() int64
+	// MemoryUsage returns the memory in bytes the VMs in the quota currently use.
+	MemoryUsage() uint64
+}
+
+// QuotaStorageLimit is a storage limit of a quota.
+type QuotaStorageLimit interface {
+	// ID returns the identifier of the limit.
+	ID() string
+	// QuotaID returns the ID of the quota the limit belongs to.
+	QuotaID() QuotaID
+	// StorageDomainID returns the ID of the storage domain the limit applies to, or an empty string if it applies
+	// to all storage domains of the datacenter.
+	StorageDomainID() string
+	// Limit returns the storage in bytes the disks in the quota may use, or QuotaUnlimited.
+	Limit() int64
+	// Usage returns the storage in bytes the disks in the quota currently use.
+	Usage() uint64
+}
+
+// OptionalQuotaParameters are the optional parameters for creating a quota. The percentages that return nil are set
+// to the engine defaults.
+type OptionalQuotaParameters interface {
+	// Description returns the description of the quota.
+	Description() string
+	// ClusterSoftLimitPct returns the percentage of the cluster limits after which the engine warns the users.
+	ClusterSoftLimitPct() *uint
+	// ClusterHardLimitPct returns the percentage by which the cluster limits may be exceeded.
+	ClusterHardLimitPct() *uint
+	// StorageSoftLimitPct returns the percentage of the storage limits after which the engine warns the users.
+	StorageSoftLimitPct() *uint
+	// StorageHardLimitPct returns the percentage by which the storage limits may be exceeded.
+	StorageHardLimitPct() *uint
+}
+
+// BuildableQuotaParameters is a buildable version of OptionalQuotaParameters.
+type BuildableQuotaParameters interface {
+	OptionalQuotaParameters
+
+	// WithDescription sets the description of the quota.
+	WithDescription(description string) (BuildableQuotaParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableQuotaParameters
+	// WithClusterSoftLimitPct sets the percentage of the cluster limits after which the engine warns the users. It
+	// returns an error if the percentage is larger than 100.
+	WithClusterSoftLimitPct(pct uint) (BuildableQuotaParameters, error)
+	// MustWithClusterSoftLimitPct is identical to WithClusterSoftLimitPct, but panics instead of returning an error.
+	MustWithClusterSoftLimitPct(pct uint) BuildableQuotaParameters
+	// WithClusterHardLimitPct sets the percentage by which the cluster limits may be exceeded.
+	WithClusterHardLimitPct(pct uint) (BuildableQuotaParameters, error)
+	// MustWithClusterHardLimitPct is identical to WithClusterHardLimitPct, but panics instead of returning an error.
+	MustWithClusterHardLimitPct(pct uint) BuildableQuotaParameters
+	// WithStorageSoftLimitPct sets the percentage of the storage limits after which the engine warns the users. It
+	// returns an error if the percentage is larger than 100.
+	WithStorageSoftLimitPct(pct uint) (BuildableQuotaParameters, error)
+	// MustWithStorageSoftLimitPct is identical to WithStorageSoftLimitPct, but panics instead of returning an error.
+	MustWithStorageSoftLimitPct(pct uint) BuildableQuotaParameters
+	// WithStorageHardLimitPct sets the percentage by which the storage limits may be exceeded.
+	WithStorageHardLimitPct(pct uint) (BuildableQuotaParameters, error)
+	// MustWithStorageHardLimitPct is identical to WithStorageHardLimitPct, but panics instead of returning an error.
+	MustWithStorageHardLimitPct(pct uint) BuildableQuotaParameters
+}
+
+// CreateQuotaParams creates a buildable set of optional parameters for CreateQuota.
+func CreateQuotaParams() BuildableQuotaParameters {
+	return &quotaParams{}
+}
+
+type quotaParams struct {
+	description         string
+	clusterSoftLimitPct *uint
+	clusterHardLimitPct *uint
+	storageSoftLimitPct *uint
+	storageHardLimitPct *uint
+}
+
+func (q *quotaParams) Description() string {
+	return q.description
+}
+
+func (q *quotaParams) ClusterSoftLimitPct() *uint {
+	return q.clusterSoftLimitPct
+}
+
+func (q *quotaParams) ClusterHardLimitPct() *uint {
+	return q.clusterHardLimitPct
+}
+
+func (q *quotaParams) StorageSoftLimitPct() *uint {
+	return q.storageSoftLimitPct
+}
+
+func (q *quotaParams) StorageHardLimitPct() *uint {
+	return q.storageHardLimitPct
+}
+
+func (q *quotaParams) WithDescription(description string) (BuildableQuotaParameters, error) {
+	q.description = description
+	return q, nil
+}
+
+func (q *quotaParams) MustWithDescription(description string) BuildableQuotaParameters {
+	builder, err := q.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (q *quotaParams) WithClusterSoftLimitPct(pct uint) (BuildableQuotaParameters, error) {
+	if pct > 100 {
+		return nil, newError(EBadArgument, "the cluster soft limit must be at most 100%%, %d given", pct)
+	}
+	q.clusterSoftLimitPct = &pct
+	return q, nil
+}
+
+func (q *quotaParams) MustWithClusterSoftLimitPct(pct uint) BuildableQuotaParameters {
+	builder, err := q.WithClusterSoftLimitPct(pct)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (q *quotaParams) WithClusterHardLimitPct(pct uint) (BuildableQuotaParameters, error) {
+	q.clusterHardLimitPct = &pct
+	return q, nil
+}
+
+func (q *quotaParams) MustWithClusterHardLimitPct(pct uint) BuildableQuotaParameters {
+	builder, err := q.WithClusterHardLimitPct(pct)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (q *quotaParams) WithStorageSoftLimitPct(pct uint) (BuildableQuotaParameters, error) {
+	if pct > 100 {
+		return nil, newError(EBadArgument, "the storage soft limit must be at most 100%%, %d given", pct)
+	}
+	q.storageSoftLimitPct = &pct
+	return q, nil
+}
+
+func (q *quotaParams) MustWithStorageSoftLimitPct(pct uint) BuildableQuotaParameters {
+	builder, err := q.WithStorageSoftLimitPct(pct)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (q *quotaParams) WithStorageHardLimitPct(pct uint) (BuildableQuotaParameters, error) {
+	q.storageHardLimitPct = &pct
+	return q, nil
+}
+
+func (q *quotaParams) MustWithStorageHardLimitPct(pct uint) BuildableQuotaParameters {
+	builder, err := q.WithStorageHardLimitPct(pct)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func validateQuotaCreationParameters(datacenterID string, name string) error {
+	if datacenterID == "" {
+		return newError(EBadArgument, "datacenter ID cannot be empty for quota creation")
+	}
+	if name == "" {
+		return newError(EBadArgument, "name cannot be empty for quota creation")
+	}
+	return nil
+}
+
+func validateQuotaClusterLimit(vcpuLimit int64, memoryLimit int64) error {
+	if vcpuLimit < QuotaUnlimited {
+		return newError(EBadArgument, "invalid vCPU limit %d, must be positive or QuotaUnlimited", vcpuLimit)
+	}
+	if memoryLimit < QuotaUnlimited {
+		return newError(EBadArgument, "invalid memory limit %d, must be positive or QuotaUnlimited", memoryLimit)
+	}
+	return nil
+}
+
+func validateQuotaStorageLimit(limit int64) error {
+	if limit < QuotaUnlimited || (limit != QuotaUnlimited && limit%quotaGiB != 0) {
+		return newError(
+			EBadArgument,
+			"invalid storage limit %d, must be a positive multiple of 1 GiB or QuotaUnlimited",
+			limit,
+		)
+	}
+	return nil
+}
+
+// quotaBytesToGiB converts a limit in bytes to the GiB the engine expects, keeping QuotaUnlimited.
+func quotaBytesToGiB(limit int64) float64 {
+	if limit == QuotaUnlimited {
+		return float64(QuotaUnlimited)
+	}
+	return float64(limit) / quotaGiB
+}
+
+// quotaGiBToBytes converts a limit in GiB returned by the engine to bytes, keeping QuotaUnlimited.
+func quotaGiBToBytes(limit float64) int64 {
+	if limit < 0 {
+		return QuotaUnlimited
+	}
+	return int64(math.Round(limit * quotaGiB))
+}
+
+func convertSDKQuota(sdkObject *ovirtsdk.Quota, client Client) (*quota, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("quota", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("quota", "name")
+	}
+	sdkDatacenter, ok := sdkObject.DataCenter()
+	if !ok {
+		return nil, newFieldNotFound("quota", "datacenter")
+	}
+	datacenterID, ok := sdkDatacenter.Id()
+	if !ok {
+		return nil, newFieldNotFound("datacenter of quota", "ID")
+	}
+	description, _ := sdkObject.Description()
+	clusterSoftLimitPct, _ := sdkObject.ClusterSoftLimitPct()
+	clusterHardLimitPct, _ := sdkObject.ClusterHardLimitPct()
+	storageSoftLimitPct, _ := sdkObject.StorageSoftLimitPct()
+	storageHardLimitPct, _ := sdkObject.StorageHardLimitPct()
+	return &quota{
+		client:              client,
+		id:                  QuotaID(id),
+		name:                name,
+		description:         description,
+		datacenterID:        datacenterID,
+		clusterSoftLimitPct: uint(clusterSoftLimitPct),
+		clusterHardLimitPct: uint(clusterHardLimitPct),
+		storageSoftLimitPct: uint(storageSoftLimitPct),
+		storageHardLimitPct: uint(storageHardLimitPct),
+	}, nil
+}
+
+func convertSDKQuotaClusterLimit(sdkObject *ovirtsdk.QuotaClusterLimit, quotaID QuotaID) (*quotaClusterLimit, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("quota cluster limit", "ID")
+	}
+	var clusterID ClusterID
+	if sdkCluster, ok := sdkObject.Cluster(); ok {
+		if id, ok := sdkCluster.Id(); ok {
+			clusterID = ClusterID(id)
+		}
+	}
+	vcpuLimit, ok := sdkObject.VcpuLimit()
+	if !ok {
+		vcpuLimit = QuotaUnlimited
+	}
+	memoryLimit, ok := sdkObject.MemoryLimit()
+	if !ok {
+		memoryLimit = float64(QuotaUnlimited)
+	}
+	vcpuUsage, _ := sdkObject.VcpuUsage()
+	memoryUsage, _ := sdkObject.MemoryUsage()
+	return &quotaClusterLimit{
+		id:          id,
+		quotaID:     quotaID,
+		clusterID:   clusterID,
+		vcpuLimit:   vcpuLimit,
+		vcpuUsage:   uint64(vcpuUsage),
+		memoryLimit: quotaGiBToBytes(memoryLimit),
+		memoryUsage: uint64(quotaGiBToBytes(memoryUsage)),
+	}, nil
+}
+
+func convertSDKQuotaStorageLimit(sdkObject *ovirtsdk.QuotaStorageLimit, quotaID QuotaID) (*quotaStorageLimit, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("quota storage limit", "ID")
+	}
+	var storageDomainID string
+	if sdkStorageDomain, ok := sdkObject.StorageDomain(); ok {
+		storageDomainID, _ = sdkStorageDomain.Id()
+	}
+	limit, ok := sdkObject.Limit()
+	if !ok {
+		limit = QuotaUnlimited
+	}
+	usage, _ := sdkObject.Usage()
+	return &quotaStorageLimit{
+		id:              id,
+		quotaID:         quotaID,
+		storageDomainID: storageDomainID,
+		limit:           quotaGiBToBytes(float64(limit)),
+		usage:           uint64(quotaGiBToBytes(usage)),
+	}, nil
+}
+
+type quota struct {
+	client Client
+
+	id                  QuotaID
+	name                string
+	description         string
+	datacenterID        string
+	clusterSoftLimitPct uint
+	clusterHardLimitPct uint
+	storageSoftLimitPct uint
+	storageHardLimitPct uint
+}
+
+func (q *quota) ID() QuotaID {
+	return q.id
+}
+
+func (q *quota) Name() string {
+	return q.name
+}
+
+func (q *quota) Description() string {
+	return q.description
+}
+
+func (q *quota) DatacenterID() string {
+	return q.datacenterID
+}
+
+func (q *quota) ClusterSoftLimitPct() uint {
+	return q.clusterSoftLimitPct
+}
+
+func (q *quota) ClusterHardLimitPct() uint {
+	return q.clusterHardLimitPct
+}
+
+func (q *quota) StorageSoftLimitPct() uint {
+	return q.storageSoftLimitPct
+}
+
+func (q *quota) StorageHardLimitPct() uint {
+	return q.storageHardLimitPct
+}
+
+func (q *quota) ListClusterLimits(retries ...RetryStrategy) ([]QuotaClusterLimit, error) {
+	return q.client.ListQuotaClusterLimits(q.datacenterID, q.id, retries...)
+}
+
+func (q *quota) AddClusterLimit(
+	clusterID ClusterID,
+	vcpuLimit int64,
+	memoryLimit int64,
+	retries ...RetryStrategy,
+) (QuotaClusterLimit, error) {
+	return q.client.AddQuotaClusterLimit(q.datacenterID, q.id, clusterID, vcpuLimit, memoryLimit, retries...)
+}
+
+func (q *quota) ListStorageLimits(retries ...RetryStrategy) ([]QuotaStorageLimit, error) {
+	return q.client.ListQuotaStorageLimits(q.datacenterID, q.id, retries...)
+}
+
+func (q *quota) AddStorageLimit(storageDomainID string, limit int64, retries ...RetryStrategy) (
+	QuotaStorageLimit,
+	error,
+) {
+	return q.client.AddQuotaStorageLimit(q.datacenterID, q.id, storageDomainID, limit, retries...)
+}
+
+func (q *quota) Remove(retries ...RetryStrategy) error {
+	return q.client.RemoveQuota(q.datacenterID, q.id, retries...)
+}
+
+type quotaClusterLimit struct {
+	id          string
+	quotaID     QuotaID
+	clusterID   ClusterID
+	vcpuLimit   int64
+	vcpuUsage   uint64
+	memoryLimit int64
+	memoryUsage uint64
+}
+
+func (q *quotaClusterLimit) ID() string {
+	return q.id
+}
+
+func (q *quotaClusterLimit) QuotaID() QuotaID {
+	return q.quotaID
+}
+
+func (q *quotaClusterLimit) ClusterID() ClusterID {
+	return q.clusterID
+}
+
+func (q *quotaClusterLimit) VCPULimit() int64 {
+	return q.vcpuLimit
+}
+
+func (q *quotaClusterLimit) VCPUUsage() uint64 {
+	return q.vcpuUsage
+}
+
+func (q *quotaClusterLimit) MemoryLimit() int64 {
+	return q.memoryLimit
+}
+
+func (q *quotaClusterLimit) MemoryUsage() uint64 {
+	return q.memoryUsage
+}
+
+type quotaStorageLimit struct {
+	id              string
+	quotaID         QuotaID
+	storageDomainID string
+	limit           int64
+	usage           uint64
+}
+
+func (q *quotaStorageLimit) ID() string {
+	return q.id
+}
+
+func (q *quotaStorageLimit) QuotaID() QuotaID {
+	return q.quotaID
+}
+
+func (q *quotaStorageLimit) StorageDomainID() string {
+	return q.storageDomainID
+}
+
+func (q *quotaStorageLimit) Limit() int64 {
+	return q.limit
+}
+
+func (q *quotaStorageLimit) Usage() uint64 {
+	return q.usage
+}
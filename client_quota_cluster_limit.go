@@ -0,0 +1,98 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListQuotaClusterLimits(datacenterID string, id QuotaID, retries ...RetryStrategy) (
+	result []QuotaClusterLimit,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []QuotaClusterLimit{}
+	err = o.retry(
+		resourceOf(ResourceTypeQuota, string(id)),
+		fmt.Sprintf("listing cluster limits of quota %s", id),
+		retries,
+		func() error {
+			response, e := o.quotaService(datacenterID, id).QuotaClusterLimitsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Limits()
+			if !ok {
+				return nil
+			}
+			result = make([]QuotaClusterLimit, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKQuotaClusterLimit(sdkObject, id)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert quota cluster limit during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) AddQuotaClusterLimit(
+	datacenterID string,
+	id QuotaID,
+	clusterID ClusterID,
+	vcpuLimit int64,
+	memoryLimit int64,
+	retries ...RetryStrategy,
+) (result QuotaClusterLimit, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	if err := validateQuotaClusterLimit(vcpuLimit, memoryLimit); err != nil {
+		return nil, err
+	}
+	correlationID := o.correlationIDFor(retries)
+
+	builder := ovirtsdk.NewQuotaClusterLimitBuilder().
+		VcpuLimit(vcpuLimit).
+		MemoryLimit(quotaBytesToGiB(memoryLimit))
+	if clusterID != "" {
+		builder.Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild())
+	}
+
+	err = o.mutate(
+		resourceOf(ResourceTypeQuota, string(id)).
+			with("datacenter_id", datacenterID).
+			with("cluster_id", clusterID).
+			with("vcpu_limit", vcpuLimit).
+			with("memory_limit", memoryLimit),
+		fmt.Sprintf("adding cluster limit to quota %s", id),
+		retries,
+		func() error {
+			req := o.quotaService(datacenterID, id).QuotaClusterLimitsService().Add().Limit(builder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Limit()
+			if !ok {
+				return newError(EFieldMissing, "missing quota cluster limit in response")
+			}
+			result, e = convertSDKQuotaClusterLimit(sdkObject, id)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert quota cluster limit")
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
+
+func (o *oVirtClient) quotaService(datacenterID string, id QuotaID) *ovirtsdk.QuotaService {
+	return o.connection().SystemService().
+		DataCentersService().
+		DataCenterService(datacenterID).
+		QuotasService().
+		QuotaService(string(id))
+}
@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) CreateQuota(
+	datacenterID string,
+	name string,
+	params OptionalQuotaParameters,
+	retries ...RetryStrategy,
+) (result Quota, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	if err := validateQuotaCreationParameters(datacenterID, name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &quotaParams{}
+	}
+	correlationID := o.correlationIDFor(retries)
+
+	builder := ovirtsdk.NewQuotaBuilder().Name(name).Description(params.Description())
+	if pct := params.ClusterSoftLimitPct(); pct != nil {
+		builder.ClusterSoftLimitPct(int64(*pct))
+	}
+	if pct := params.ClusterHardLimitPct(); pct != nil {
+		builder.ClusterHardLimitPct(int64(*pct))
+	}
+	if pct := params.StorageSoftLimitPct(); pct != nil {
+		builder.StorageSoftLimitPct(int64(*pct))
+	}
+	if pct := params.StorageHardLimitPct(); pct != nil {
+		builder.StorageHardLimitPct(int64(*pct))
+	}
+
+	err = o.mutate(
+		resourceOf(ResourceTypeDatacenter, datacenterID).with("name", name),
+		fmt.Sprintf("creating quota %s in datacenter %s", name, datacenterID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				QuotasService().
+				Add().
+				Quota(builder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Quota()
+			if !ok {
+				return newError(EFieldMissing, "missing quota in response")
+			}
+			result, e = convertSDKQuota(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert quota")
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
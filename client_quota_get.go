@@ -0,0 +1,40 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetQuota(datacenterID string, id QuotaID, retries ...RetryStrategy) (result Quota, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeQuota, string(id)),
+		fmt.Sprintf("getting quota %s of datacenter %s", id, datacenterID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				QuotasService().
+				QuotaService(string(id)).
+				Get().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Quota()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no quota returned when getting quota %s of datacenter %s",
+					id,
+					datacenterID,
+				)
+			}
+			result, e = convertSDKQuota(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert quota %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListQuotas(datacenterID string, retries ...RetryStrategy) (result []Quota, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Quota{}
+	err = o.retry(
+		resourceOf(ResourceTypeDatacenter, datacenterID),
+		fmt.Sprintf("listing quotas of datacenter %s", datacenterID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				QuotasService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Quotas()
+			if !ok {
+				return nil
+			}
+			result = make([]Quota, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKQuota(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert quota during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveQuota(datacenterID string, id QuotaID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeQuota, string(id)).with("datacenter_id", datacenterID),
+		fmt.Sprintf("removing quota %s from datacenter %s", id, datacenterID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				QuotasService().
+				QuotaService(string(id)).
+				Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ListQuotaStorageLimits(datacenterID string, id QuotaID, retries ...RetryStrategy) (
+	result []QuotaStorageLimit,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []QuotaStorageLimit{}
+	err = o.retry(
+		resourceOf(ResourceTypeQuota, string(id)),
+		fmt.Sprintf("listing storage limits of quota %s", id),
+		retries,
+		func() error {
+			response, e := o.quotaService(datacenterID, id).QuotaStorageLimitsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Limits()
+			if !ok {
+				return nil
+			}
+			result = make([]QuotaStorageLimit, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKQuotaStorageLimit(sdkObject, id)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert quota storage limit during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) AddQuotaStorageLimit(
+	datacenterID string,
+	id QuotaID,
+	storageDomainID string,
+	limit int64,
+	retries ...RetryStrategy,
+) (result QuotaStorageLimit, err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	if err := validateQuotaStorageLimit(limit); err != nil {
+		return nil, err
+	}
+	correlationID := o.correlationIDFor(retries)
+
+	builder := ovirtsdk.NewQuotaStorageLimitBuilder().Limit(int64(quotaBytesToGiB(limit)))
+	if storageDomainID != "" {
+		builder.StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID).MustBuild())
+	}
+
+	err = o.mutate(
+		resourceOf(ResourceTypeQuota, string(id)).
+			with("datacenter_id", datacenterID).
+			with("storage_domain_id", storageDomainID).
+			with("limit", limit),
+		fmt.Sprintf("adding storage limit to quota %s", id),
+		retries,
+		func() error {
+			req := o.quotaService(datacenterID, id).QuotaStorageLimitsService().Add().Limit(builder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Limit()
+			if !ok {
+				return newError(EFieldMissing, "missing quota storage limit in response")
+			}
+			result, e = convertSDKQuotaStorageLimit(sdkObject, id)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert quota storage limit")
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,125 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+const gib = 1024 * 1024 * 1024
+
+// TestQuotaLimits runs against the mock only since quotas are only enforced if the datacenter is configured for it.
+func TestQuotaLimits(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	sd, err := client.SelectStorageDomain(nil)
+	if err != nil {
+		t.Fatalf("failed to select storage domain (%v)", err)
+	}
+	datacenterID := sd.DatacenterIDs()[0]
+	q := createTestQuota(t, client, datacenterID)
+	if q.ClusterSoftLimitPct() != 90 || q.StorageHardLimitPct() == 0 {
+		t.Fatalf("incorrect limit percentages on quota %s", q.ID())
+	}
+
+	if _, err := q.AddStorageLimit(sd.ID(), gib+1); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("adding a storage limit that is not a multiple of 1 GiB did not fail (%v)", err)
+	}
+	if _, err := q.AddStorageLimit(sd.ID(), 10*gib); err != nil {
+		t.Fatalf("failed to add storage limit (%v)", err)
+	}
+	if _, err := q.AddStorageLimit("", ovirtclient.QuotaUnlimited); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EConflict,
+	) {
+		t.Fatalf("adding a global storage limit next to a storage domain limit did not fail (%v)", err)
+	}
+	if _, err := q.AddClusterLimit("", 4, ovirtclient.QuotaUnlimited); err != nil {
+		t.Fatalf("failed to add cluster limit (%v)", err)
+	}
+
+	storageLimits, err := q.ListStorageLimits()
+	if err != nil {
+		t.Fatalf("failed to list storage limits (%v)", err)
+	}
+	if len(storageLimits) != 1 || storageLimits[0].Limit() != 10*gib || storageLimits[0].StorageDomainID() != sd.ID() {
+		t.Fatalf("incorrect storage limits returned")
+	}
+	clusterLimits, err := q.ListClusterLimits()
+	if err != nil {
+		t.Fatalf("failed to list cluster limits (%v)", err)
+	}
+	if len(clusterLimits) != 1 || clusterLimits[0].VCPULimit() != 4 ||
+		clusterLimits[0].MemoryLimit() != ovirtclient.QuotaUnlimited {
+		t.Fatalf("incorrect cluster limits returned")
+	}
+}
+
+// TestQuotaAssignment runs against the mock only since quotas are only enforced if the datacenter is configured for
+// it.
+func TestQuotaAssignment(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	sd, err := client.SelectStorageDomain(nil)
+	if err != nil {
+		t.Fatalf("failed to select storage domain (%v)", err)
+	}
+	q := createTestQuota(t, client, sd.DatacenterIDs()[0])
+
+	if _, err := client.CreateDisk(
+		sd.ID(),
+		ovirtclient.ImageFormatRaw,
+		gib,
+		ovirtclient.CreateDiskParams().MustWithQuotaID("nonexistent"),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("creating a disk with a nonexistent quota did not fail (%v)", err)
+	}
+	disk, err := client.CreateDisk(
+		sd.ID(),
+		ovirtclient.ImageFormatRaw,
+		gib,
+		ovirtclient.CreateDiskParams().MustWithQuotaID(q.ID()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+	if disk.QuotaID() != q.ID() {
+		t.Fatalf("the disk was assigned to quota %s instead of %s", disk.QuotaID(), q.ID())
+	}
+
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	vm, err := client.CreateVM(
+		clusters[0].ID(),
+		ovirtclient.DefaultBlankTemplateID,
+		"test-quota-vm",
+		ovirtclient.CreateVMParams().MustWithQuotaID(q.ID()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	if vm.QuotaID() != q.ID() {
+		t.Fatalf("the VM was assigned to quota %s instead of %s", vm.QuotaID(), q.ID())
+	}
+
+	if err := q.Remove(); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("removing a quota that is still in use did not fail (%v)", err)
+	}
+}
+
+func createTestQuota(t *testing.T, client ovirtclient.Client, datacenterID string) ovirtclient.Quota {
+	q, err := client.CreateQuota(
+		datacenterID,
+		"test-quota",
+		ovirtclient.CreateQuotaParams().MustWithDescription("Test quota").MustWithClusterSoftLimitPct(90),
+	)
+	if err != nil {
+		t.Fatalf("failed to create quota (%v)", err)
+	}
+	if _, err := client.GetQuota(datacenterID, q.ID()); err != nil {
+		t.Fatalf("failed to fetch created quota %s (%v)", q.ID(), err)
+	}
+	return q
+}
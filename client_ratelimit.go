@@ -0,0 +1,95 @@
+package ovirtclient
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket limiting the rate of API calls made by the client. Tokens are added at the
+// configured rate up to the burst size. Each call takes a token and waits if none are available.
+type rateLimiter struct {
+	lock   *sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates a rate limiter allowing requestsPerSecond calls on average and up to burst calls at once. If
+// requestsPerSecond is not positive, nil is returned, which disables rate limiting.
+func newRateLimiter(requestsPerSecond float64, burst uint) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = 1
+	}
+	return &rateLimiter{
+		lock:   &sync.Mutex{},
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, waiting until it becomes available if needed. If any of the contexts ends
+// while waiting, the token is returned to the bucket and the context error is returned.
+func (r *rateLimiter) wait(contexts []context.Context) error {
+	r.lock.Lock()
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+	// The token is reserved even if it isn't available yet, so concurrent callers queue up behind each other.
+	r.tokens--
+	var delay time.Duration
+	if r.tokens < 0 {
+		delay = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+	r.lock.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	// As in the retry function we need a dynamic number of select cases, so we use the reflection library.
+	chans := []reflect.SelectCase{
+		{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(timer.C),
+		},
+	}
+	for _, ctx := range contexts {
+		chans = append(chans, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ctx.Done()),
+		})
+	}
+	chosen, _, _ := reflect.Select(chans)
+	if chosen == 0 {
+		return nil
+	}
+	r.lock.Lock()
+	r.tokens++
+	r.lock.Unlock()
+	return contexts[chosen-1].Err()
+}
+
+// rateLimited wraps the what function to wait for the rate limiter of the client before each call. If any of the
+// contexts ends while waiting, the call is not made. If no rate limit is configured the function is returned as is.
+func (o *oVirtClient) rateLimited(contexts []context.Context, what func() error) func() error {
+	if o.rateLimiter == nil {
+		return what
+	}
+	return func() error {
+		if err := o.rateLimiter.wait(contexts); err != nil {
+			return err
+		}
+		return what()
+	}
+}
@@ -0,0 +1,70 @@
+// This file contains tests for the internal rate limiter. It is therefore excluded from the testpackage check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDisabled(t *testing.T) {
+	t.Parallel()
+	if newRateLimiter(0, 10) != nil {
+		t.Fatalf("a zero rate did not disable the rate limiter")
+	}
+}
+
+func TestRateLimiterLimitsCalls(t *testing.T) {
+	t.Parallel()
+	o := &oVirtClient{
+		logger:      &noopLogger{},
+		rateLimiter: newRateLimiter(10, 2),
+	}
+	calls := 0
+	startTime := time.Now()
+	for i := 0; i < 7; i++ {
+		if err := o.retry(resourceOf(ResourceTypeVM, ""), "testing rate limit", []RetryStrategy{MaxTries(1)}, func() error {
+			calls++
+			return nil
+		}); err != nil {
+			t.Fatalf("call failed (%v)", err)
+		}
+	}
+	elapsed := time.Since(startTime)
+	if calls != 7 {
+		t.Fatalf("incorrect number of calls (expected: 7, got: %d)", calls)
+	}
+	// The first 2 calls use the burst, the remaining 5 calls have to wait 100ms each.
+	if elapsed < 450*time.Millisecond {
+		t.Fatalf("the rate limiter did not delay calls (took %s)", elapsed)
+	}
+	if elapsed > 2*time.Second {
+		t.Fatalf("the rate limiter delayed calls too much (took %s)", elapsed)
+	}
+}
+
+func TestRateLimiterStopsWaitingWhenContextEnds(t *testing.T) {
+	t.Parallel()
+	o := &oVirtClient{
+		logger:      &noopLogger{},
+		rateLimiter: newRateLimiter(0.1, 1),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	calls := 0
+	startTime := time.Now()
+	retries := []RetryStrategy{ContextStrategy(ctx), MaxTries(1)}
+	for i := 0; i < 2; i++ {
+		_ = o.retry(resourceOf(ResourceTypeVM, ""), "testing rate limit", retries, func() error {
+			calls++
+			return nil
+		})
+	}
+	if elapsed := time.Since(startTime); elapsed > 2*time.Second {
+		t.Fatalf("the rate limiter kept waiting after the context ended (took %s)", elapsed)
+	}
+	if calls != 1 {
+		t.Fatalf("the rate limited call was made after the context ended (%d calls)", calls)
+	}
+}
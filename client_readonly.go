@@ -0,0 +1,10 @@
+package ovirtclient
+
+// checkReadOnly returns an EReadOnly error if the client is in read-only mode. The action describes the rejected
+// call in the "ing" form, for example "removing VM 1234".
+func (o *oVirtClient) checkReadOnly(action string) error {
+	if !o.readOnly {
+		return nil
+	}
+	return newError(EReadOnly, "the client is in read-only mode, refusing %s", action)
+}
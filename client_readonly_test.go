@@ -0,0 +1,48 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+	// The engine URL is never contacted, any call reaching the engine fails.
+	client, err := ovirtclient.NewWithVerify(
+		"https://engine.invalid/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&readOnlyExtraSettings{},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	if _, err := client.CreateVM(
+		"cluster",
+		ovirtclient.DefaultBlankTemplateID,
+		"read-only-test",
+		nil,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EReadOnly) {
+		t.Fatalf("creating a VM in read-only mode did not return a read-only error (%v)", err)
+	}
+	if err := client.RemoveVM("vm"); !ovirtclient.HasErrorCode(err, ovirtclient.EReadOnly) {
+		t.Fatalf("removing a VM in read-only mode did not return a read-only error (%v)", err)
+	}
+	if _, err := client.CreateTag("tag", "", ""); !ovirtclient.HasErrorCode(err, ovirtclient.EReadOnly) {
+		t.Fatalf("creating a tag in read-only mode did not return a read-only error (%v)", err)
+	}
+}
+
+type readOnlyExtraSettings struct {
+	dryRunExtraSettings
+}
+
+func (r *readOnlyExtraSettings) ReadOnly() bool {
+	return true
+}
@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"errors"
+	"net/http"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// connection returns the current SDK connection.
+func (o *oVirtClient) connection() *ovirtsdk4.Connection {
+	o.connLock.RLock()
+	defer o.connLock.RUnlock()
+	return o.conn
+}
+
+// reauthenticating wraps the what function to log in again and repeat the call once if the engine rejects the call
+// because the session has expired. This happens for long-running clients when the engine invalidates the SSO token,
+// for example after the session timeout or an engine restart.
+func (o *oVirtClient) reauthenticating(what func() error) func() error {
+	if o.connect == nil {
+		return what
+	}
+	return func() error {
+		conn := o.connection()
+		err := what()
+		if !isSessionExpired(err) {
+			return err
+		}
+		o.logger.Debugf("The oVirt Engine session has expired, logging in again...")
+		if err := o.reconnect(conn); err != nil {
+			return wrap(err, EAccessDenied, "failed to log in again after the session has expired")
+		}
+		return what()
+	}
+}
+
+// reconnect replaces the expired SDK connection with a new one. If another call has already replaced the expired
+// connection the new connection is kept.
+func (o *oVirtClient) reconnect(expired *ovirtsdk4.Connection) error {
+	o.connLock.Lock()
+	defer o.connLock.Unlock()
+	if o.conn != expired {
+		return nil
+	}
+	conn, err := o.connect()
+	if err != nil {
+		return err
+	}
+	o.conn = conn
+	o.resetEngineVersion()
+	return nil
+}
+
+// isSessionExpired returns true if the error indicates that the engine rejected an API call with an HTTP 401
+// response. Failed logins have no status code and are therefore not considered an expired session.
+func isSessionExpired(err error) bool {
+	var authErr *ovirtsdk4.AuthError
+	return errors.As(err, &authErr) && authErr.Code == http.StatusUnauthorized
+}
@@ -0,0 +1,79 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// TestReauthenticationOnExpiredSession tests that the client logs in again and repeats the call if the engine
+// rejects a call because the session has expired.
+func TestReauthenticationOnExpiredSession(t *testing.T) {
+	t.Parallel()
+	engine := newExpiringSessionEngine()
+	server := httptest.NewServer(engine)
+	defer server.Close()
+
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("the call did not succeed after the session expired (%v)", err)
+	}
+	if logins := engine.getLogins(); logins != 2 {
+		t.Fatalf("incorrect number of logins (expected: 2, got: %d)", logins)
+	}
+}
+
+// expiringSessionEngine is a fake oVirt Engine that rejects the token of the first login as expired.
+type expiringSessionEngine struct {
+	lock   *sync.Mutex
+	logins int
+}
+
+func newExpiringSessionEngine() *expiringSessionEngine {
+	return &expiringSessionEngine{
+		lock: &sync.Mutex{},
+	}
+}
+
+func (e *expiringSessionEngine) getLogins() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.logins
+}
+
+func (e *expiringSessionEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	switch r.URL.Path {
+	case "/ovirt-engine/sso/oauth/token":
+		e.logins++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d"}`, e.logins)
+	case "/ovirt-engine/api":
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", 2) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<api></api>`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,172 @@
+package ovirtclient
+
+// ResourceClients gives access to the clients of the individual resource types, for example:
+//
+//   vms, err := client.VMs().ListVMs()
+//
+// Components that only work with a few resource types can depend on the narrower interfaces, such as VMClient,
+// instead of Client. This makes it easier to mock only the calls they use in tests.
+type ResourceClients interface {
+	// Disks returns the client for disks.
+	Disks() DiskClient
+	// DiskAttachments returns the client for disk attachments.
+	DiskAttachments() DiskAttachmentClient
+	// VMs returns the client for virtual machines.
+	VMs() VMClient
+	// NICs returns the client for NICs of virtual machines.
+	NICs() NICClient
+	// VNICProfiles returns the client for VNIC profiles.
+	VNICProfiles() VNICProfileClient
+	// Networks returns the client for networks.
+	Networks() NetworkClient
+	// NetworkProviders returns the client for network providers.
+	NetworkProviders() NetworkProviderClient
+	// HostProviders returns the client for external host providers.
+	HostProviders() HostProviderClient
+	// ImageProviders returns the client for external image providers.
+	ImageProviders() ImageProviderClient
+	// Datacenters returns the client for datacenters.
+	Datacenters() DatacenterClient
+	// Clusters returns the client for clusters.
+	Clusters() ClusterClient
+	// StorageDomains returns the client for storage domains.
+	StorageDomains() StorageDomainClient
+	// Hosts returns the client for hosts.
+	Hosts() HostClient
+	// HostNICs returns the client for NICs of hosts.
+	HostNICs() HostNICClient
+	// Templates returns the client for templates.
+	Templates() TemplateClient
+	// TemplateDisks returns the client for disks of templates.
+	TemplateDisks() TemplateDiskClient
+	// Tags returns the client for tags.
+	Tags() TagClient
+	// Quotas returns the client for quotas.
+	Quotas() QuotaClient
+	// Permissions returns the client for permissions.
+	Permissions() PermissionClient
+	// Users returns the client for users.
+	Users() UserClient
+	// Groups returns the client for groups.
+	Groups() GroupClient
+	// Roles returns the client for roles.
+	Roles() RoleClient
+	// InstanceTypes returns the client for instance types.
+	InstanceTypes() InstanceTypeClient
+	// OperatingSystems returns the client for the operating systems supported by the engine.
+	OperatingSystems() OperatingSystemClient
+	// Errata returns the client for Katello errata.
+	Errata() ErrataClient
+	// Events returns the client for events.
+	Events() EventClient
+	// Jobs returns the client for jobs.
+	Jobs() JobClient
+}
+
+func (o *oVirtClient) Disks() DiskClient {
+	return o
+}
+
+func (o *oVirtClient) DiskAttachments() DiskAttachmentClient {
+	return o
+}
+
+func (o *oVirtClient) VMs() VMClient {
+	return o
+}
+
+func (o *oVirtClient) NICs() NICClient {
+	return o
+}
+
+func (o *oVirtClient) VNICProfiles() VNICProfileClient {
+	return o
+}
+
+func (o *oVirtClient) Networks() NetworkClient {
+	return o
+}
+
+func (o *oVirtClient) NetworkProviders() NetworkProviderClient {
+	return o
+}
+
+func (o *oVirtClient) HostProviders() HostProviderClient {
+	return o
+}
+
+func (o *oVirtClient) ImageProviders() ImageProviderClient {
+	return o
+}
+
+func (o *oVirtClient) Datacenters() DatacenterClient {
+	return o
+}
+
+func (o *oVirtClient) Clusters() ClusterClient {
+	return o
+}
+
+func (o *oVirtClient) StorageDomains() StorageDomainClient {
+	return o
+}
+
+func (o *oVirtClient) Hosts() HostClient {
+	return o
+}
+
+func (o *oVirtClient) HostNICs() HostNICClient {
+	return o
+}
+
+func (o *oVirtClient) Templates() TemplateClient {
+	return o
+}
+
+func (o *oVirtClient) TemplateDisks() TemplateDiskClient {
+	return o
+}
+
+func (o *oVirtClient) Tags() TagClient {
+	return o
+}
+
+func (o *oVirtClient) Quotas() QuotaClient {
+	return o
+}
+
+func (o *oVirtClient) Permissions() PermissionClient {
+	return o
+}
+
+func (o *oVirtClient) Users() UserClient {
+	return o
+}
+
+func (o *oVirtClient) Groups() GroupClient {
+	return o
+}
+
+func (o *oVirtClient) Roles() RoleClient {
+	return o
+}
+
+func (o *oVirtClient) InstanceTypes() InstanceTypeClient {
+	return o
+}
+
+func (o *oVirtClient) OperatingSystems() OperatingSystemClient {
+	return o
+}
+
+func (o *oVirtClient) Errata() ErrataClient {
+	return o
+}
+
+func (o *oVirtClient) Events() EventClient {
+	return o
+}
+
+func (o *oVirtClient) Jobs() JobClient {
+	return o
+}
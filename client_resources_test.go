@@ -0,0 +1,28 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestResourceClients(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	if _, err := client.Clusters().GetCluster(helper.GetClusterID()); err != nil {
+		t.Fatalf("failed to get cluster via the cluster client (%v)", err)
+	}
+	if _, err := client.Templates().GetTemplate(helper.GetBlankTemplateID()); err != nil {
+		t.Fatalf("failed to get template via the template client (%v)", err)
+	}
+	assertCanListVMsWithVMClient(t, client.VMs())
+}
+
+// assertCanListVMsWithVMClient only depends on the VMClient to make sure it can be used on its own.
+func assertCanListVMsWithVMClient(t *testing.T, vmClient ovirtclient.VMClient) {
+	if _, err := vmClient.ListVMs(); err != nil {
+		t.Fatalf("failed to list VMs via the VM client (%v)", err)
+	}
+}
@@ -0,0 +1,90 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// RoleClient contains the functions to look up roles. Roles are sets of permits that can be granted to users and
+// groups on objects using PermissionClient. The IDs of the built-in roles are engine-specific, so they should be
+// looked up by name, for example UserVmManager.
+type RoleClient interface {
+	// ListRoles lists all roles in the engine.
+	ListRoles(retries ...RetryStrategy) ([]Role, error)
+	// GetRole returns the role with the specified ID.
+	GetRole(id string, retries ...RetryStrategy) (Role, error)
+	// GetRoleByName returns the role with the specified name, for example UserVmManager.
+	GetRoleByName(name string, retries ...RetryStrategy) (Role, error)
+}
+
+// Role is a set of permits that can be granted to users and groups.
+type Role interface {
+	// ID returns the identifier of the role.
+	ID() string
+	// Name returns the name of the role.
+	Name() string
+	// Description returns the description of the role.
+	Description() string
+	// Administrative returns true if the role grants administrative permits, false if it is a user role.
+	Administrative() bool
+	// Mutable returns true if the role can be changed. Built-in roles are not mutable.
+	Mutable() bool
+}
+
+// findRoleByName returns the role with the specified name from the list.
+func findRoleByName(roles []Role, name string) (Role, error) {
+	for _, r := range roles {
+		if r.Name() == name {
+			return r, nil
+		}
+	}
+	return nil, newError(ENotFound, "no role with the name %s found", name)
+}
+
+func convertSDKRole(sdkObject *ovirtsdk.Role) (*role, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("role", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("role", "name")
+	}
+	description, _ := sdkObject.Description()
+	administrative, _ := sdkObject.Administrative()
+	mutable, _ := sdkObject.Mutable()
+	return &role{
+		id:             id,
+		name:           name,
+		description:    description,
+		administrative: administrative,
+		mutable:        mutable,
+	}, nil
+}
+
+type role struct {
+	id             string
+	name           string
+	description    string
+	administrative bool
+	mutable        bool
+}
+
+func (r *role) ID() string {
+	return r.id
+}
+
+func (r *role) Name() string {
+	return r.name
+}
+
+func (r *role) Description() string {
+	return r.description
+}
+
+func (r *role) Administrative() bool {
+	return r.administrative
+}
+
+func (r *role) Mutable() bool {
+	return r.mutable
+}
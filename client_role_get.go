@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetRole(id string, retries ...RetryStrategy) (result Role, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeRole, id),
+		fmt.Sprintf("getting role %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().RolesService().RoleService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Role()
+			if !ok {
+				return newError(ENotFound, "no role returned when getting role ID %s", id)
+			}
+			result, e = convertSDKRole(sdkObject)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert role %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) ListRoles(retries ...RetryStrategy) (result []Role, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Role{}
+	err = o.retry(
+		resourceOf(ResourceTypeRole, ""),
+		"listing roles",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().RolesService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Roles()
+			if !ok {
+				return nil
+			}
+			result = make([]Role, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKRole(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert role during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) GetRoleByName(name string, retries ...RetryStrategy) (Role, error) {
+	roles, err := o.ListRoles(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findRoleByName(roles, name)
+}
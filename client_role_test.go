@@ -0,0 +1,30 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestGetRoleByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	role, err := client.GetRoleByName("UserVmManager")
+	if err != nil {
+		t.Fatalf("failed to find the UserVmManager role (%v)", err)
+	}
+	if role.Administrative() {
+		t.Fatalf("the UserVmManager role is reported as administrative")
+	}
+	fetchedRole, err := client.GetRole(role.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch role %s (%v)", role.ID(), err)
+	}
+	if fetchedRole.Name() != "UserVmManager" {
+		t.Fatalf("incorrect name for role %s: %s", role.ID(), fetchedRole.Name())
+	}
+	if _, err := client.GetRoleByName("nonexistent"); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("finding a nonexistent role did not return a not found error (%v)", err)
+	}
+}
@@ -0,0 +1,180 @@
+package ovirtclient
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StatsClient provides statistics about the calls the client made to the oVirt Engine.
+type StatsClient interface {
+	// Stats returns a snapshot of the statistics about the API calls made since the client was created. The snapshot
+	// is not updated by later calls, call Stats again to get fresh values. It is intended to be embedded into the
+	// health or status endpoints of services using the client. Use a MetricsCollector instead for exporting metrics
+	// to a monitoring system.
+	//
+	// The mock client does not make API calls and therefore always returns empty statistics.
+	Stats() ClientStats
+}
+
+// ClientStats is a snapshot of the statistics about the API calls of a client.
+type ClientStats interface {
+	// Since returns the time the client was created, which is when the statistics started to be collected.
+	Since() time.Time
+	// Operations returns the statistics for each operation, keyed by the name of the client function that made the
+	// calls, for example "RemoveVM". Operations that were never called are not included.
+	Operations() map[string]OperationStats
+}
+
+// OperationStats is a snapshot of the statistics about the API calls of a single operation. Each attempt of a call
+// counts as a separate request, including attempts that were retried later.
+type OperationStats interface {
+	// Requests returns the number of requests made for the operation.
+	Requests() uint64
+	// Errors returns the number of failed requests for the operation.
+	Errors() uint64
+	// ErrorsByCode returns the number of failed requests for the operation, keyed by the error code.
+	ErrorsByCode() map[ErrorCode]uint64
+	// LatencyPercentile returns the latency at the specified percentile between 0 and 100, for example 99 for the
+	// p99 latency. The percentiles are calculated from the last 1024 requests of the operation. It returns 0 if
+	// there were no requests.
+	LatencyPercentile(percentile float64) time.Duration
+}
+
+// statsLatencySamples is the number of latencies kept for each operation to calculate the percentiles. The oldest
+// samples are discarded to keep the memory use of long-running clients bounded.
+const statsLatencySamples = 1024
+
+// statsCollector records the statistics of the API calls. It implements MetricsCollector so it can be fed from the
+// same place as a user-supplied collector. All functions can be called on a nil collector, in which case nothing is
+// recorded.
+type statsCollector struct {
+	lock       *sync.Mutex
+	since      time.Time
+	operations map[string]*operationStatsCollector
+}
+
+type operationStatsCollector struct {
+	requests     uint64
+	errors       uint64
+	errorsByCode map[ErrorCode]uint64
+	// latencies is a ring buffer of the last statsLatencySamples latencies. next is the index of the next sample to
+	// overwrite once the buffer is full.
+	latencies []time.Duration
+	next      int
+}
+
+func newStatsCollector() *statsCollector {
+	return &statsCollector{
+		lock:       &sync.Mutex{},
+		since:      time.Now(),
+		operations: map[string]*operationStatsCollector{},
+	}
+}
+
+func (s *statsCollector) ObserveAPICall(operation string, duration time.Duration, code ErrorCode) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	op, ok := s.operations[operation]
+	if !ok {
+		op = &operationStatsCollector{
+			errorsByCode: map[ErrorCode]uint64{},
+		}
+		s.operations[operation] = op
+	}
+	op.requests++
+	if code != "" {
+		op.errors++
+		op.errorsByCode[code]++
+	}
+	if len(op.latencies) < statsLatencySamples {
+		op.latencies = append(op.latencies, duration)
+	} else {
+		op.latencies[op.next] = duration
+		op.next = (op.next + 1) % statsLatencySamples
+	}
+}
+
+// snapshot returns a copy of the current statistics.
+func (s *statsCollector) snapshot() ClientStats {
+	if s == nil {
+		return &clientStats{operations: map[string]OperationStats{}}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	operations := make(map[string]OperationStats, len(s.operations))
+	for name, op := range s.operations {
+		errorsByCode := make(map[ErrorCode]uint64, len(op.errorsByCode))
+		for code, count := range op.errorsByCode {
+			errorsByCode[code] = count
+		}
+		latencies := append([]time.Duration{}, op.latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		operations[name] = &operationStats{
+			requests:     op.requests,
+			errors:       op.errors,
+			errorsByCode: errorsByCode,
+			latencies:    latencies,
+		}
+	}
+	return &clientStats{
+		since:      s.since,
+		operations: operations,
+	}
+}
+
+type clientStats struct {
+	since      time.Time
+	operations map[string]OperationStats
+}
+
+func (c *clientStats) Since() time.Time {
+	return c.since
+}
+
+func (c *clientStats) Operations() map[string]OperationStats {
+	return c.operations
+}
+
+type operationStats struct {
+	requests     uint64
+	errors       uint64
+	errorsByCode map[ErrorCode]uint64
+	// latencies contains the sampled latencies in ascending order.
+	latencies []time.Duration
+}
+
+func (o *operationStats) Requests() uint64 {
+	return o.requests
+}
+
+func (o *operationStats) Errors() uint64 {
+	return o.errors
+}
+
+func (o *operationStats) ErrorsByCode() map[ErrorCode]uint64 {
+	return o.errorsByCode
+}
+
+func (o *operationStats) LatencyPercentile(percentile float64) time.Duration {
+	if len(o.latencies) == 0 {
+		return 0
+	}
+	// Nearest-rank method: the smallest sample that is greater than or equal to the percentile of all samples.
+	rank := int(math.Ceil(percentile / 100 * float64(len(o.latencies))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(o.latencies) {
+		rank = len(o.latencies)
+	}
+	return o.latencies[rank-1]
+}
+
+func (o *oVirtClient) Stats() ClientStats {
+	return o.stats.snapshot()
+}
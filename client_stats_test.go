@@ -0,0 +1,83 @@
+// This file contains tests for the internal statistics functionality. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatsRecordAttempts(t *testing.T) {
+	t.Parallel()
+	o := &oVirtClient{
+		logger: &noopLogger{},
+		stats:  newStatsCollector(),
+	}
+	tries := 0
+	err := o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"testing stats",
+		[]RetryStrategy{ExponentialBackoff(1), MaxTries(3)},
+		func() error {
+			tries++
+			if tries < 3 {
+				return newError(EConnection, "test failure")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	stats, ok := o.Stats().Operations()["TestStatsRecordAttempts"]
+	if !ok {
+		t.Fatalf("no statistics recorded for the operation (got: %v)", o.Stats().Operations())
+	}
+	if stats.Requests() != 3 {
+		t.Fatalf("incorrect number of requests (expected: 3, got: %d)", stats.Requests())
+	}
+	if stats.Errors() != 2 {
+		t.Fatalf("incorrect number of errors (expected: 2, got: %d)", stats.Errors())
+	}
+	if stats.ErrorsByCode()[EConnection] != 2 {
+		t.Fatalf("incorrect number of %s errors (expected: 2, got: %d)", EConnection, stats.ErrorsByCode()[EConnection])
+	}
+}
+
+func TestStatsLatencyPercentiles(t *testing.T) {
+	t.Parallel()
+	collector := newStatsCollector()
+	// Record more samples than kept to check that the oldest are discarded.
+	for i := 0; i < statsLatencySamples; i++ {
+		collector.ObserveAPICall("GetVM", time.Hour, "")
+	}
+	for i := 1; i <= statsLatencySamples; i++ {
+		collector.ObserveAPICall("GetVM", time.Duration(i)*time.Millisecond, "")
+	}
+	stats := collector.snapshot().Operations()["GetVM"]
+	if stats.Requests() != 2*statsLatencySamples {
+		t.Fatalf("incorrect number of requests (expected: %d, got: %d)", 2*statsLatencySamples, stats.Requests())
+	}
+	for percentile, expected := range map[float64]time.Duration{
+		0:   time.Millisecond,
+		50:  512 * time.Millisecond,
+		99:  1014 * time.Millisecond,
+		100: 1024 * time.Millisecond,
+	} {
+		if latency := stats.LatencyPercentile(percentile); latency != expected {
+			t.Fatalf("incorrect p%v latency (expected: %s, got: %s)", percentile, expected, latency)
+		}
+	}
+}
+
+func TestStatsEmpty(t *testing.T) {
+	t.Parallel()
+	stats := NewMock().Stats()
+	if len(stats.Operations()) != 0 {
+		t.Fatalf("the mock client returned statistics (%v)", stats.Operations())
+	}
+	if stats.Since().IsZero() {
+		t.Fatalf("the mock client returned no creation time")
+	}
+}
@@ -13,13 +13,70 @@ import (
 type StorageDomainClient interface {
 	// ListStorageDomains lists all storage domains.
 	ListStorageDomains(retries ...RetryStrategy) ([]StorageDomain, error)
-	// GetStorageDomain returns a single storage domain, or an error if the storage domain could not be found.
+	// GetStorageDomain returns a single storage domain, or an error if the storage domain could not be found. The
+	// available, used and committed space are fetched from the engine on every call, call GetStorageDomain again to
+	// check the capacity before creating disks.
 	GetStorageDomain(id string, retries ...RetryStrategy) (StorageDomain, error)
 	// GetStorageDomainDisk returns a single disk from a specific storage domain, or an error if no disk can be found.
-	GetDiskFromStorageDomain(id string, diskID string, retries ...RetryStrategy) (result Disk, err error)
+	GetDiskFromStorageDomain(id string, diskID DiskID, retries ...RetryStrategy) (result Disk, err error)
 	// RemoveStorageDomainDisk removes a disk from a specific storage domain, but leaves the disk on other storage
 	// domains if any. If the disk is not present on any more storage domains, the entire disk will be removed.
-	RemoveDiskFromStorageDomain(id string, diskID string, retries ...RetryStrategy) error
+	RemoveDiskFromStorageDomain(id string, diskID DiskID, retries ...RetryStrategy) error
+	// AttachStorageDomain attaches the storage domain to the specified datacenter. The engine activates the storage
+	// domain after attaching it.
+	AttachStorageDomain(datacenterID string, id string, retries ...RetryStrategy) error
+	// DetachStorageDomain detaches the storage domain from the specified datacenter. The storage domain must be in
+	// maintenance, see DeactivateStorageDomain.
+	DetachStorageDomain(datacenterID string, id string, retries ...RetryStrategy) error
+	// ActivateStorageDomain activates a storage domain in maintenance in the specified datacenter.
+	ActivateStorageDomain(datacenterID string, id string, retries ...RetryStrategy) error
+	// DeactivateStorageDomain puts an active storage domain in the specified datacenter into maintenance.
+	DeactivateStorageDomain(datacenterID string, id string, retries ...RetryStrategy) error
+	// RemoveStorageDomain removes a storage domain that is not attached to any datacenter. The params must specify
+	// either the host to use for the removal, or that the storage domain should only be destroyed in the engine
+	// database. Use RemoveStorageDomainParams to obtain a builder for the parameters.
+	RemoveStorageDomain(id string, params RemoveStorageDomainParameters, retries ...RetryStrategy) error
+	// SelectStorageDomain returns the active data storage domain that matches the params and has the most available
+	// space, for example to pick the storage domain for a new disk. It returns an ENotFound error if no storage
+	// domain matches. The params may be nil to select from all active storage domains. Use SelectStorageDomainParams
+	// to obtain a builder for the params.
+	SelectStorageDomain(params StorageDomainSelectionParameters, retries ...RetryStrategy) (StorageDomain, error)
+	// ListStorageDomainVMs lists the VMs stored on an export storage domain, or the unregistered VMs on a data
+	// storage domain, for example one attached from another engine.
+	ListStorageDomainVMs(id string, retries ...RetryStrategy) ([]StorageDomainVM, error)
+	// ListStorageDomainTemplates lists the templates stored on an export storage domain, or the unregistered
+	// templates on a data storage domain.
+	ListStorageDomainTemplates(id string, retries ...RetryStrategy) ([]StorageDomainTemplate, error)
+	// ImportVMFromStorageDomain imports a VM from an export storage domain into the specified cluster and waits for
+	// the import to finish. The params may be nil. Use ImportFromStorageDomainParams to obtain a builder for the
+	// params.
+	ImportVMFromStorageDomain(
+		storageDomainID string,
+		vmID VMID,
+		clusterID ClusterID,
+		params OptionalStorageDomainImportParameters,
+		retries ...RetryStrategy,
+	) error
+	// ImportTemplateFromStorageDomain imports a template from an export storage domain into the specified cluster
+	// and waits for the import to finish. The params may be nil.
+	ImportTemplateFromStorageDomain(
+		storageDomainID string,
+		templateID TemplateID,
+		clusterID ClusterID,
+		params OptionalStorageDomainImportParameters,
+		retries ...RetryStrategy,
+	) error
+	// RegisterVMFromStorageDomain registers an unregistered VM from a data storage domain in the specified cluster
+	// and waits for the registration to finish.
+	RegisterVMFromStorageDomain(storageDomainID string, vmID VMID, clusterID ClusterID, retries ...RetryStrategy) error
+	// RegisterTemplateFromStorageDomain registers an unregistered template from a data storage domain in the
+	// specified cluster and waits for the registration to finish.
+	RegisterTemplateFromStorageDomain(
+		storageDomainID string,
+		templateID TemplateID,
+		clusterID ClusterID,
+		retries ...RetryStrategy,
+	) error
 }
 
 // StorageDomainData is the core of StorageDomain, providing only data access functions.
@@ -30,18 +87,137 @@ type StorageDomainData interface {
 	Name() string
 	// Available returns the number of available bytes on the storage domain
 	Available() uint64
+	// Used returns the number of bytes used on the storage domain. Sparse disks only count with the space they
+	// actually use.
+	Used() uint64
+	// Committed returns the number of bytes promised to the disks on the storage domain, which is the sum of their
+	// provisioned sizes. It can be larger than the capacity of the storage domain if it has sparse disks
+	// (overcommitment).
+	Committed() uint64
 	// StorageType returns the type of the storage domain
 	StorageType() StorageDomainType
+	// Function returns what the storage domain is used for, for example storing disks or exporting VMs.
+	Function() StorageDomainFunction
 	// Status returns the status of the storage domain. This status may be unknown if the storage domain is external.
 	// Check ExternalStatus as well.
 	Status() StorageDomainStatus
 	// ExternalStatus returns the external status of a storage domain.
 	ExternalStatus() StorageDomainExternalStatus
+	// DatacenterIDs returns the IDs of the datacenters the storage domain is attached to. Data storage domains can
+	// only be attached to a single datacenter at a time.
+	DatacenterIDs() []string
 }
 
 // StorageDomain represents a storage domain returned from the oVirt Engine API.
 type StorageDomain interface {
 	StorageDomainData
+
+	// Attach attaches the storage domain to the specified datacenter.
+	Attach(datacenterID string, retries ...RetryStrategy) error
+	// Detach detaches the storage domain from the specified datacenter.
+	Detach(datacenterID string, retries ...RetryStrategy) error
+	// Activate activates the storage domain in the specified datacenter.
+	Activate(datacenterID string, retries ...RetryStrategy) error
+	// Deactivate puts the storage domain in the specified datacenter into maintenance.
+	Deactivate(datacenterID string, retries ...RetryStrategy) error
+	// Remove removes the storage domain.
+	Remove(params RemoveStorageDomainParameters, retries ...RetryStrategy) error
+}
+
+// RemoveStorageDomainParameters describes the parameters for removing a storage domain. Use
+// RemoveStorageDomainParams to obtain a builder.
+type RemoveStorageDomainParameters interface {
+	// HostID returns the ID of the host that removes the storage domain from the storage. It may be empty if Destroy
+	// is true.
+	HostID() string
+	// Format returns true if the storage domain should be formatted when removing it, deleting all data on it.
+	Format() bool
+	// Destroy returns true if the storage domain should only be removed from the engine database without touching
+	// the storage. This is useful if the storage is no longer reachable.
+	Destroy() bool
+}
+
+// BuildableRemoveStorageDomainParameters is a buildable version of RemoveStorageDomainParameters.
+type BuildableRemoveStorageDomainParameters interface {
+	RemoveStorageDomainParameters
+
+	// WithHostID sets the ID of the host that removes the storage domain. It returns an error if the ID is empty.
+	WithHostID(hostID string) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithHostID is identical to WithHostID, but panics instead of returning an error.
+	MustWithHostID(hostID string) BuildableRemoveStorageDomainParameters
+	// WithFormat sets if the storage domain should be formatted when removing it.
+	WithFormat(format bool) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithFormat is identical to WithFormat, but panics instead of returning an error.
+	MustWithFormat(format bool) BuildableRemoveStorageDomainParameters
+	// WithDestroy sets if the storage domain should only be removed from the engine database.
+	WithDestroy(destroy bool) (BuildableRemoveStorageDomainParameters, error)
+	// MustWithDestroy is identical to WithDestroy, but panics instead of returning an error.
+	MustWithDestroy(destroy bool) BuildableRemoveStorageDomainParameters
+}
+
+// RemoveStorageDomainParams creates a builder for the parameters of RemoveStorageDomain.
+func RemoveStorageDomainParams() BuildableRemoveStorageDomainParameters {
+	return &removeStorageDomainParams{}
+}
+
+type removeStorageDomainParams struct {
+	hostID  string
+	format  bool
+	destroy bool
+}
+
+func (r *removeStorageDomainParams) HostID() string {
+	return r.hostID
+}
+
+func (r *removeStorageDomainParams) Format() bool {
+	return r.format
+}
+
+func (r *removeStorageDomainParams) Destroy() bool {
+	return r.destroy
+}
+
+func (r *removeStorageDomainParams) WithHostID(hostID string) (BuildableRemoveStorageDomainParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "the host ID must not be empty")
+	}
+	r.hostID = hostID
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithHostID(hostID string) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *removeStorageDomainParams) WithFormat(format bool) (BuildableRemoveStorageDomainParameters, error) {
+	r.format = format
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithFormat(format bool) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (r *removeStorageDomainParams) WithDestroy(destroy bool) (BuildableRemoveStorageDomainParameters, error) {
+	r.destroy = destroy
+	return r, nil
+}
+
+func (r *removeStorageDomainParams) MustWithDestroy(destroy bool) BuildableRemoveStorageDomainParameters {
+	builder, err := r.WithDestroy(destroy)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 // StorageDomainType represents the type of the storage domain.
@@ -68,9 +244,33 @@ const (
 	StorageDomainTypePosixFS StorageDomainType = "posixfs"
 )
 
+// Validate returns an error if the storage domain type is not known.
+func (s StorageDomainType) Validate() error {
+	for _, storageType := range StorageDomainTypeValues() {
+		if storageType == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid storage domain type: %s must be one of: %s",
+		s,
+		strings.Join(StorageDomainTypeValues().Strings(), ", "),
+	)
+}
+
 // StorageDomainTypeList is a list of possible StorageDomainTypes.
 type StorageDomainTypeList []StorageDomainType
 
+// Strings creates a string list of the values.
+func (l StorageDomainTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, storageType := range l {
+		result[i] = string(storageType)
+	}
+	return result
+}
+
 // FileStorageDomainTypeList is a list of possible StorageDomainTypes which are considered file storage.
 type FileStorageDomainTypeList []StorageDomainType
 
@@ -99,6 +299,52 @@ func StorageDomainTypeValues() StorageDomainTypeList {
 	}
 }
 
+// StorageDomainFunction describes what a storage domain is used for. It is called "Domain Function" in the
+// administration portal.
+type StorageDomainFunction string
+
+const (
+	// StorageDomainFunctionData is a storage domain that stores the disks of VMs and templates.
+	StorageDomainFunctionData StorageDomainFunction = "data"
+	// StorageDomainFunctionExport is a legacy storage domain that stores exported VMs and templates for moving
+	// them between datacenters.
+	StorageDomainFunctionExport StorageDomainFunction = "export"
+	// StorageDomainFunctionImage is a storage domain that provides disk images from an external provider, such as
+	// Glance.
+	StorageDomainFunctionImage StorageDomainFunction = "image"
+	// StorageDomainFunctionISO is a storage domain that stores ISO and floppy images.
+	StorageDomainFunctionISO StorageDomainFunction = "iso"
+	// StorageDomainFunctionManagedBlockStorage is a storage domain that stores disks on managed block storage.
+	StorageDomainFunctionManagedBlockStorage StorageDomainFunction = "managed_block_storage"
+	// StorageDomainFunctionVolume is a storage domain that provides volumes from an external provider, such as
+	// Cinder.
+	StorageDomainFunctionVolume StorageDomainFunction = "volume"
+)
+
+// StorageDomainFunctionList is a list of StorageDomainFunction values.
+type StorageDomainFunctionList []StorageDomainFunction
+
+// Strings creates a string list of the values.
+func (l StorageDomainFunctionList) Strings() []string {
+	result := make([]string, len(l))
+	for i, function := range l {
+		result[i] = string(function)
+	}
+	return result
+}
+
+// StorageDomainFunctionValues returns all possible StorageDomainFunction values.
+func StorageDomainFunctionValues() StorageDomainFunctionList {
+	return []StorageDomainFunction{
+		StorageDomainFunctionData,
+		StorageDomainFunctionExport,
+		StorageDomainFunctionImage,
+		StorageDomainFunctionISO,
+		StorageDomainFunctionManagedBlockStorage,
+		StorageDomainFunctionVolume,
+	}
+}
+
 // StorageDomainStatus represents the status a domain can be in. Either this status field, or the
 // StorageDomainExternalStatus must be set.
 //
@@ -244,6 +490,15 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 	if available < 0 {
 		return nil, newError(EBug, "invalid available bytes returned from storage domain: %d", available)
 	}
+	// Similar to the available space, the used and committed space are not returned in all statuses.
+	used, _ := sdkStorageDomain.Used()
+	if used < 0 {
+		return nil, newError(EBug, "invalid used bytes returned from storage domain: %d", used)
+	}
+	committed, _ := sdkStorageDomain.Committed()
+	if committed < 0 {
+		return nil, newError(EBug, "invalid committed bytes returned from storage domain: %d", committed)
+	}
 	storage, ok := sdkStorageDomain.Storage()
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch hostStorage of storage domain")
@@ -252,6 +507,10 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 	if !ok {
 		return nil, newError(EFieldMissing, "failed to fetch storage type of storage domain")
 	}
+	function, ok := sdkStorageDomain.Type()
+	if !ok {
+		return nil, newError(EFieldMissing, "failed to fetch function of storage domain")
+	}
 	// It is OK for the storage domain status to not be present if the external status is present.
 	status, _ := sdkStorageDomain.Status()
 	// It is OK for the storage domain external status to not be present if the status is present.
@@ -266,21 +525,41 @@ func convertSDKStorageDomain(sdkStorageDomain *ovirtsdk4.StorageDomain, client C
 		id:             id,
 		name:           name,
 		available:      uint64(available),
+		used:           uint64(used),
+		committed:      uint64(committed),
 		storageType:    StorageDomainType(storageType),
+		function:       StorageDomainFunction(function),
 		status:         StorageDomainStatus(status),
 		externalStatus: StorageDomainExternalStatus(externalStatus),
+		datacenterIDs:  convertSDKStorageDomainDatacenterIDs(sdkStorageDomain),
 	}, nil
 }
 
+func convertSDKStorageDomainDatacenterIDs(sdkStorageDomain *ovirtsdk4.StorageDomain) []string {
+	datacenterIDs := []string{}
+	if sdkDatacenters, ok := sdkStorageDomain.DataCenters(); ok {
+		for _, sdkDatacenter := range sdkDatacenters.Slice() {
+			if datacenterID, ok := sdkDatacenter.Id(); ok {
+				datacenterIDs = append(datacenterIDs, datacenterID)
+			}
+		}
+	}
+	return datacenterIDs
+}
+
 type storageDomain struct {
 	client Client
 
 	id             string
 	name           string
 	available      uint64
+	used           uint64
+	committed      uint64
 	storageType    StorageDomainType
+	function       StorageDomainFunction
 	status         StorageDomainStatus
 	externalStatus StorageDomainExternalStatus
+	datacenterIDs  []string
 }
 
 func (s storageDomain) ID() string {
@@ -295,10 +574,22 @@ func (s storageDomain) Available() uint64 {
 	return s.available
 }
 
+func (s storageDomain) Used() uint64 {
+	return s.used
+}
+
+func (s storageDomain) Committed() uint64 {
+	return s.committed
+}
+
 func (s storageDomain) StorageType() StorageDomainType {
 	return s.storageType
 }
 
+func (s storageDomain) Function() StorageDomainFunction {
+	return s.function
+}
+
 func (s storageDomain) Status() StorageDomainStatus {
 	return s.status
 }
@@ -307,6 +598,38 @@ func (s storageDomain) ExternalStatus() StorageDomainExternalStatus {
 	return s.externalStatus
 }
 
+func (s storageDomain) DatacenterIDs() []string {
+	return s.datacenterIDs
+}
+
+func (s storageDomain) Attach(datacenterID string, retries ...RetryStrategy) error {
+	return s.client.AttachStorageDomain(datacenterID, s.id, retries...)
+}
+
+func (s storageDomain) Detach(datacenterID string, retries ...RetryStrategy) error {
+	return s.client.DetachStorageDomain(datacenterID, s.id, retries...)
+}
+
+func (s storageDomain) Activate(datacenterID string, retries ...RetryStrategy) error {
+	return s.client.ActivateStorageDomain(datacenterID, s.id, retries...)
+}
+
+func (s storageDomain) Deactivate(datacenterID string, retries ...RetryStrategy) error {
+	return s.client.DeactivateStorageDomain(datacenterID, s.id, retries...)
+}
+
+func (s storageDomain) Remove(params RemoveStorageDomainParameters, retries ...RetryStrategy) error {
+	return s.client.RemoveStorageDomain(s.id, params, retries...)
+}
+
+// validateStorageDomainRemovalParameters checks that the engine has enough information to remove a storage domain.
+func validateStorageDomainRemovalParameters(params RemoveStorageDomainParameters) error {
+	if params == nil || (params.HostID() == "" && !params.Destroy()) {
+		return newError(EBadArgument, "either a host ID or destroy must be specified for removing a storage domain")
+	}
+	return nil
+}
+
 type storageDomainDiskWait struct {
 	client        *oVirtClient
 	disk          Disk
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ActivateStorageDomain(datacenterID string, id string, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeStorageDomain, id).with("datacenter_id", datacenterID),
+		fmt.Sprintf("activating storage domain %s in datacenter %s", id, datacenterID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				StorageDomainsService().
+				StorageDomainService(id).
+				Activate()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -0,0 +1,30 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) AttachStorageDomain(datacenterID string, id string, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeStorageDomain, id).with("datacenter_id", datacenterID),
+		fmt.Sprintf("attaching storage domain %s to datacenter %s", id, datacenterID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				DataCentersService().
+				DataCenterService(datacenterID).
+				StorageDomainsService().
+				Add()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(id).MustBuild()).Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetStorageDomain(id string, retries ...RetryStrategy) (result StorageDomain, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeStorageDomain, id),
 		fmt.Sprintf("getting storage domain %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().StorageDomainsService().StorageDomainService(id).Get().Send()
+			response, err := o.connection().SystemService().StorageDomainsService().StorageDomainService(id).Get().Send()
 			if err != nil {
 				return err
 			}
@@ -4,15 +4,15 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) GetDiskFromStorageDomain(id string, diskID string, retries ...RetryStrategy) (result Disk, err error) {
+func (o *oVirtClient) GetDiskFromStorageDomain(id string, diskID DiskID, retries ...RetryStrategy) (result Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeDisk, string(diskID)),
 		fmt.Sprintf("getting disk %s from storage domain %s", diskID, id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().StorageDomainsService().
-				StorageDomainService(id).DisksService().DiskService(diskID).Get().Send()
+			response, err := o.connection().SystemService().StorageDomainsService().
+				StorageDomainService(id).DisksService().DiskService(string(diskID)).Get().Send()
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,136 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// OptionalStorageDomainImportParameters are the optional parameters for importing a VM or template from an export
+// storage domain. Use ImportFromStorageDomainParams to obtain a builder.
+type OptionalStorageDomainImportParameters interface {
+	// Name returns the name of the imported VM or template, or nil if the name stored on the export domain should
+	// be used.
+	Name() *string
+	// CloneVMs returns true if the VM or template should be imported with new IDs. This is needed if the original
+	// still exists in the engine, or to import it multiple times. It returns nil to use the engine default (no
+	// cloning).
+	CloneVMs() *bool
+	// TargetStorageDomainID returns the ID of the data storage domain to import the disks to, or nil if the engine
+	// should choose it.
+	TargetStorageDomainID() *string
+}
+
+// BuildableStorageDomainImportParameters is a buildable version of OptionalStorageDomainImportParameters.
+type BuildableStorageDomainImportParameters interface {
+	OptionalStorageDomainImportParameters
+
+	// WithName sets the name of the imported VM or template. It returns an error if the name is empty.
+	WithName(name string) (BuildableStorageDomainImportParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableStorageDomainImportParameters
+	// WithCloneVMs sets if the VM or template should be imported with new IDs.
+	WithCloneVMs(clone bool) (BuildableStorageDomainImportParameters, error)
+	// MustWithCloneVMs is identical to WithCloneVMs, but panics instead of returning an error.
+	MustWithCloneVMs(clone bool) BuildableStorageDomainImportParameters
+	// WithTargetStorageDomainID sets the data storage domain to import the disks to. It returns an error if the ID is
+	// empty.
+	WithTargetStorageDomainID(storageDomainID string) (BuildableStorageDomainImportParameters, error)
+	// MustWithTargetStorageDomainID is identical to WithTargetStorageDomainID, but panics instead of returning an
+	// error.
+	MustWithTargetStorageDomainID(storageDomainID string) BuildableStorageDomainImportParameters
+}
+
+// ImportFromStorageDomainParams creates a builder for the optional parameters of ImportVMFromStorageDomain and
+// ImportTemplateFromStorageDomain.
+func ImportFromStorageDomainParams() BuildableStorageDomainImportParameters {
+	return &storageDomainImportParams{}
+}
+
+type storageDomainImportParams struct {
+	name                  *string
+	clone                 *bool
+	targetStorageDomainID *string
+}
+
+func (s *storageDomainImportParams) Name() *string {
+	return s.name
+}
+
+func (s *storageDomainImportParams) CloneVMs() *bool {
+	return s.clone
+}
+
+func (s *storageDomainImportParams) TargetStorageDomainID() *string {
+	return s.targetStorageDomainID
+}
+
+func (s *storageDomainImportParams) WithName(name string) (BuildableStorageDomainImportParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the name of the imported object must not be empty")
+	}
+	s.name = &name
+	return s, nil
+}
+
+func (s *storageDomainImportParams) MustWithName(name string) BuildableStorageDomainImportParameters {
+	builder, err := s.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainImportParams) WithCloneVMs(clone bool) (BuildableStorageDomainImportParameters, error) {
+	s.clone = &clone
+	return s, nil
+}
+
+func (s *storageDomainImportParams) MustWithCloneVMs(clone bool) BuildableStorageDomainImportParameters {
+	builder, err := s.WithCloneVMs(clone)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainImportParams) WithTargetStorageDomainID(storageDomainID string) (
+	BuildableStorageDomainImportParameters,
+	error,
+) {
+	if storageDomainID == "" {
+		return nil, newError(EBadArgument, "the target storage domain ID must not be empty")
+	}
+	s.targetStorageDomainID = &storageDomainID
+	return s, nil
+}
+
+func (s *storageDomainImportParams) MustWithTargetStorageDomainID(
+	storageDomainID string,
+) BuildableStorageDomainImportParameters {
+	builder, err := s.WithTargetStorageDomainID(storageDomainID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// storageDomainImportTarget returns the SDK objects for the cluster and the optional target storage domain of an
+// import or registration.
+func storageDomainImportTarget(
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+) (*ovirtsdk.Cluster, *ovirtsdk.StorageDomain) {
+	sdkCluster := ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()
+	if params == nil || params.TargetStorageDomainID() == nil {
+		return sdkCluster, nil
+	}
+	return sdkCluster, ovirtsdk.NewStorageDomainBuilder().Id(*params.TargetStorageDomainID()).MustBuild()
+}
+
+// storageDomainJobCorrelationID returns the correlation ID to track an import or export job with. These jobs run in
+// the background, so a correlation ID is always needed to wait for them.
+func (o *oVirtClient) storageDomainJobCorrelationID(prefix string, retries []RetryStrategy) string {
+	if correlationID := o.correlationIDFor(retries); correlationID != "" {
+		return correlationID
+	}
+	return prefix + "_" + generateRandomID(5, o.nonSecureRandom)
+}
@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListStorageDomains(retries ...RetryStrategy) (result []StorageDomain, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []StorageDomain{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeStorageDomain, ""),
 		"listing storage domains",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().StorageDomainsService().List().Send()
+			response, e := o.connection().SystemService().StorageDomainsService().List().Send()
 			if e != nil {
 				return e
 			}
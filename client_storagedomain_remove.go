@@ -0,0 +1,40 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveStorageDomain(
+	id string,
+	params RemoveStorageDomainParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	if err := validateStorageDomainRemovalParameters(params); err != nil {
+		return err
+	}
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeStorageDomain, id),
+		fmt.Sprintf("removing storage domain %s", id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().StorageDomainsService().StorageDomainService(id).Remove()
+			if hostID := params.HostID(); hostID != "" {
+				req.Host(hostID)
+			}
+			if params.Format() {
+				req.Format(true)
+			}
+			if params.Destroy() {
+				req.Destroy(true)
+			}
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
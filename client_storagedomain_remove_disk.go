@@ -4,15 +4,20 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveDiskFromStorageDomain(id string, diskID string, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) RemoveDiskFromStorageDomain(id string, diskID DiskID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeDisk, string(diskID)).with("storage_domain_id", id),
 		fmt.Sprintf("removing disk %s from storage domain %s", diskID, id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().StorageDomainsService().
-				StorageDomainService(id).DisksService().DiskService(diskID).Remove().Send()
+			req := o.connection().SystemService().StorageDomainsService().
+				StorageDomainService(id).DisksService().DiskService(string(diskID)).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			if err != nil {
 				o.logger.Infof("error removing disk..")
 				return err
@@ -20,5 +25,6 @@ func (o *oVirtClient) RemoveDiskFromStorageDomain(id string, diskID string, retr
 
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -0,0 +1,228 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+// StorageDomainSelectionParameters describes the requirements for SelectStorageDomain. Use SelectStorageDomainParams
+// to obtain a builder.
+type StorageDomainSelectionParameters interface {
+	// MinimumAvailable returns the number of bytes the storage domain must have available, typically the size of the
+	// disk to create.
+	MinimumAvailable() uint64
+	// StorageTypes returns the storage types the storage domain may have. If it is empty, all storage types are
+	// allowed.
+	StorageTypes() []StorageDomainType
+	// AllowedIDs returns the IDs of the storage domains that may be selected. If it is empty, all storage domains are
+	// allowed.
+	AllowedIDs() []string
+	// DeniedIDs returns the IDs of the storage domains that must not be selected.
+	DeniedIDs() []string
+}
+
+// BuildableStorageDomainSelectionParameters is a buildable version of StorageDomainSelectionParameters.
+type BuildableStorageDomainSelectionParameters interface {
+	StorageDomainSelectionParameters
+
+	// WithMinimumAvailable sets the number of bytes the storage domain must have available.
+	WithMinimumAvailable(bytes uint64) (BuildableStorageDomainSelectionParameters, error)
+	// MustWithMinimumAvailable is identical to WithMinimumAvailable, but panics instead of returning an error.
+	MustWithMinimumAvailable(bytes uint64) BuildableStorageDomainSelectionParameters
+	// WithStorageTypes sets the storage types the storage domain may have. It returns an error if a storage type is
+	// invalid.
+	WithStorageTypes(storageTypes ...StorageDomainType) (BuildableStorageDomainSelectionParameters, error)
+	// MustWithStorageTypes is identical to WithStorageTypes, but panics instead of returning an error.
+	MustWithStorageTypes(storageTypes ...StorageDomainType) BuildableStorageDomainSelectionParameters
+	// WithAllowedIDs sets the IDs of the storage domains that may be selected.
+	WithAllowedIDs(ids ...string) (BuildableStorageDomainSelectionParameters, error)
+	// MustWithAllowedIDs is identical to WithAllowedIDs, but panics instead of returning an error.
+	MustWithAllowedIDs(ids ...string) BuildableStorageDomainSelectionParameters
+	// WithDeniedIDs sets the IDs of the storage domains that must not be selected.
+	WithDeniedIDs(ids ...string) (BuildableStorageDomainSelectionParameters, error)
+	// MustWithDeniedIDs is identical to WithDeniedIDs, but panics instead of returning an error.
+	MustWithDeniedIDs(ids ...string) BuildableStorageDomainSelectionParameters
+}
+
+// SelectStorageDomainParams creates a builder for the parameters of SelectStorageDomain.
+func SelectStorageDomainParams() BuildableStorageDomainSelectionParameters {
+	return &storageDomainSelectionParams{}
+}
+
+type storageDomainSelectionParams struct {
+	minimumAvailable uint64
+	storageTypes     []StorageDomainType
+	allowedIDs       []string
+	deniedIDs        []string
+}
+
+func (s *storageDomainSelectionParams) MinimumAvailable() uint64 {
+	return s.minimumAvailable
+}
+
+func (s *storageDomainSelectionParams) StorageTypes() []StorageDomainType {
+	return s.storageTypes
+}
+
+func (s *storageDomainSelectionParams) AllowedIDs() []string {
+	return s.allowedIDs
+}
+
+func (s *storageDomainSelectionParams) DeniedIDs() []string {
+	return s.deniedIDs
+}
+
+func (s *storageDomainSelectionParams) WithMinimumAvailable(bytes uint64) (
+	BuildableStorageDomainSelectionParameters,
+	error,
+) {
+	s.minimumAvailable = bytes
+	return s, nil
+}
+
+func (s *storageDomainSelectionParams) MustWithMinimumAvailable(
+	bytes uint64,
+) BuildableStorageDomainSelectionParameters {
+	builder, err := s.WithMinimumAvailable(bytes)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainSelectionParams) WithStorageTypes(storageTypes ...StorageDomainType) (
+	BuildableStorageDomainSelectionParameters,
+	error,
+) {
+	for _, storageType := range storageTypes {
+		if err := storageType.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	s.storageTypes = storageTypes
+	return s, nil
+}
+
+func (s *storageDomainSelectionParams) MustWithStorageTypes(
+	storageTypes ...StorageDomainType,
+) BuildableStorageDomainSelectionParameters {
+	builder, err := s.WithStorageTypes(storageTypes...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainSelectionParams) WithAllowedIDs(ids ...string) (
+	BuildableStorageDomainSelectionParameters,
+	error,
+) {
+	s.allowedIDs = ids
+	return s, nil
+}
+
+func (s *storageDomainSelectionParams) MustWithAllowedIDs(ids ...string) BuildableStorageDomainSelectionParameters {
+	builder, err := s.WithAllowedIDs(ids...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *storageDomainSelectionParams) WithDeniedIDs(ids ...string) (
+	BuildableStorageDomainSelectionParameters,
+	error,
+) {
+	s.deniedIDs = ids
+	return s, nil
+}
+
+func (s *storageDomainSelectionParams) MustWithDeniedIDs(ids ...string) BuildableStorageDomainSelectionParameters {
+	builder, err := s.WithDeniedIDs(ids...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) SelectStorageDomain(
+	params StorageDomainSelectionParameters,
+	retries ...RetryStrategy,
+) (StorageDomain, error) {
+	return selectStorageDomain(o, params, retries...)
+}
+
+// selectStorageDomain implements SelectStorageDomain for both the real and the mock client.
+func selectStorageDomain(
+	client StorageDomainClient,
+	params StorageDomainSelectionParameters,
+	retries ...RetryStrategy,
+) (StorageDomain, error) {
+	if params == nil {
+		params = SelectStorageDomainParams()
+	}
+	storageDomains, err := client.ListStorageDomains(retries...)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]StorageDomain, 0, len(storageDomains))
+	for _, storageDomain := range storageDomains {
+		if storageDomainMatchesSelection(storageDomain, params) {
+			candidates = append(candidates, storageDomain)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, newError(
+			ENotFound,
+			"no active storage domain with at least %d bytes available matches the selection",
+			params.MinimumAvailable(),
+		)
+	}
+	// Prefer the storage domain with the most available space and use the ID as a tie breaker to make the selection
+	// stable.
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Available() != candidates[j].Available() {
+			return candidates[i].Available() > candidates[j].Available()
+		}
+		return candidates[i].ID() < candidates[j].ID()
+	})
+	return candidates[0], nil
+}
+
+func storageDomainMatchesSelection(storageDomain StorageDomain, params StorageDomainSelectionParameters) bool {
+	if storageDomain.Function() != StorageDomainFunctionData {
+		return false
+	}
+	if storageDomain.Status() != StorageDomainStatusActive &&
+		storageDomain.ExternalStatus() != StorageDomainExternalStatusOk {
+		return false
+	}
+	if storageDomain.Available() < params.MinimumAvailable() {
+		return false
+	}
+	if storageTypes := params.StorageTypes(); len(storageTypes) > 0 &&
+		!storageDomainTypeIn(storageDomain.StorageType(), storageTypes) {
+		return false
+	}
+	if allowedIDs := params.AllowedIDs(); len(allowedIDs) > 0 && !stringIn(storageDomain.ID(), allowedIDs) {
+		return false
+	}
+	return !stringIn(storageDomain.ID(), params.DeniedIDs())
+}
+
+func storageDomainTypeIn(storageType StorageDomainType, storageTypes []StorageDomainType) bool {
+	for _, t := range storageTypes {
+		if t == storageType {
+			return true
+		}
+	}
+	return false
+}
+
+func stringIn(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// StorageDomainTemplate is a template stored on a storage domain that can be brought into the engine, see
+// StorageDomainVM.
+type StorageDomainTemplate interface {
+	// ID returns the ID of the template as stored on the storage domain.
+	ID() TemplateID
+	// Name returns the name of the template as stored on the storage domain.
+	Name() string
+	// StorageDomainID returns the ID of the storage domain the template is stored on.
+	StorageDomainID() string
+
+	// Import imports the template from an export storage domain into the specified cluster.
+	Import(clusterID ClusterID, params OptionalStorageDomainImportParameters, retries ...RetryStrategy) error
+	// Register registers the template from a data storage domain in the specified cluster.
+	Register(clusterID ClusterID, retries ...RetryStrategy) error
+}
+
+func (o *oVirtClient) ListStorageDomainTemplates(id string, retries ...RetryStrategy) (
+	result []StorageDomainTemplate,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []StorageDomainTemplate{}
+	err = o.retry(
+		resourceOf(ResourceTypeStorageDomain, id),
+		fmt.Sprintf("listing templates on storage domain %s", id),
+		retries,
+		func() error {
+			storageDomainService := o.connection().SystemService().StorageDomainsService().StorageDomainService(id)
+			unregistered, e := o.storageDomainListsUnregistered(storageDomainService)
+			if e != nil {
+				return e
+			}
+			req := storageDomainService.TemplatesService().List()
+			if unregistered {
+				req.Unregistered(true)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Templates()
+			if !ok {
+				return nil
+			}
+			result = make([]StorageDomainTemplate, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKStorageDomainTemplate(sdkObject, id, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert template on storage domain %s during listing item #%d", id, i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) ImportTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("template_import", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeTemplate, string(templateID)).
+			with("storage_domain_id", storageDomainID).
+			with("cluster_id", clusterID),
+		fmt.Sprintf("importing template %s from storage domain %s", templateID, storageDomainID),
+		retries,
+		func() error {
+			sdkCluster, sdkStorageDomain := storageDomainImportTarget(clusterID, params)
+			req := o.connection().SystemService().
+				StorageDomainsService().
+				StorageDomainService(storageDomainID).
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Import().
+				Cluster(sdkCluster).
+				Query("correlation_id", correlationID)
+			if sdkStorageDomain != nil {
+				req.StorageDomain(sdkStorageDomain)
+			}
+			if params != nil {
+				if clone := params.CloneVMs(); clone != nil {
+					req.Clone(*clone)
+				}
+				if name := params.Name(); name != nil {
+					req.Template(ovirtsdk.NewTemplateBuilder().Name(*name).MustBuild())
+				}
+			}
+			_, err := req.Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
+
+func (o *oVirtClient) RegisterTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("template_register", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeTemplate, string(templateID)).
+			with("storage_domain_id", storageDomainID).
+			with("cluster_id", clusterID),
+		fmt.Sprintf("registering template %s from storage domain %s", templateID, storageDomainID),
+		retries,
+		func() error {
+			sdkCluster, _ := storageDomainImportTarget(clusterID, nil)
+			_, err := o.connection().SystemService().
+				StorageDomainsService().
+				StorageDomainService(storageDomainID).
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Register().
+				Cluster(sdkCluster).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
+
+func convertSDKStorageDomainTemplate(
+	sdkObject *ovirtsdk.Template,
+	storageDomainID string,
+	client Client,
+) (*storageDomainTemplate, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("template on storage domain", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("template on storage domain", "name")
+	}
+	return &storageDomainTemplate{
+		client:          client,
+		id:              TemplateID(id),
+		name:            name,
+		storageDomainID: storageDomainID,
+	}, nil
+}
+
+type storageDomainTemplate struct {
+	client Client
+
+	id              TemplateID
+	name            string
+	storageDomainID string
+}
+
+func (s *storageDomainTemplate) ID() TemplateID {
+	return s.id
+}
+
+func (s *storageDomainTemplate) Name() string {
+	return s.name
+}
+
+func (s *storageDomainTemplate) StorageDomainID() string {
+	return s.storageDomainID
+}
+
+func (s *storageDomainTemplate) Import(
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) error {
+	return s.client.ImportTemplateFromStorageDomain(s.storageDomainID, s.id, clusterID, params, retries...)
+}
+
+func (s *storageDomainTemplate) Register(clusterID ClusterID, retries ...RetryStrategy) error {
+	return s.client.RegisterTemplateFromStorageDomain(s.storageDomainID, s.id, clusterID, retries...)
+}
@@ -0,0 +1,184 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+// TestStorageDomainLifecycle runs against the mock only since detaching and removing storage domains would break the
+// test environment.
+func TestStorageDomainLifecycle(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	sd := storageDomains[0]
+	if len(sd.DatacenterIDs()) != 1 {
+		t.Fatalf("the test storage domain is not attached to exactly one datacenter (%v)", sd.DatacenterIDs())
+	}
+	datacenterID := sd.DatacenterIDs()[0]
+
+	if err := sd.Detach(datacenterID); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("detaching an active storage domain did not return a conflict error (%v)", err)
+	}
+	if err := sd.Deactivate(datacenterID); err != nil {
+		t.Fatalf("failed to deactivate storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusMaintenance)
+	if err := sd.Detach(datacenterID); err != nil {
+		t.Fatalf("failed to detach storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusUnattached)
+
+	if err := sd.Attach(datacenterID); err != nil {
+		t.Fatalf("failed to attach storage domain %s (%v)", sd.ID(), err)
+	}
+	assertStorageDomainStatus(t, client, sd.ID(), ovirtclient.StorageDomainStatusActive)
+	if err := sd.Deactivate(datacenterID); err != nil {
+		t.Fatalf("failed to deactivate storage domain %s (%v)", sd.ID(), err)
+	}
+	if err := sd.Detach(datacenterID); err != nil {
+		t.Fatalf("failed to detach storage domain %s (%v)", sd.ID(), err)
+	}
+
+	if err := sd.Remove(ovirtclient.RemoveStorageDomainParams()); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("removing a storage domain without a host did not return a bad argument error (%v)", err)
+	}
+	if err := sd.Remove(ovirtclient.RemoveStorageDomainParams().MustWithDestroy(true)); err != nil {
+		t.Fatalf("failed to remove storage domain %s (%v)", sd.ID(), err)
+	}
+	if _, err := client.GetStorageDomain(sd.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("the removed storage domain can still be fetched (%v)", err)
+	}
+}
+
+func assertStorageDomainStatus(
+	t *testing.T,
+	client ovirtclient.Client,
+	storageDomainID string,
+	expected ovirtclient.StorageDomainStatus,
+) {
+	storageDomain, err := client.GetStorageDomain(storageDomainID)
+	if err != nil {
+		t.Fatalf("failed to get storage domain %s (%v)", storageDomainID, err)
+	}
+	if storageDomain.Status() != expected {
+		t.Fatalf(
+			"incorrect status on storage domain %s (expected: %s, got: %s)",
+			storageDomainID,
+			expected,
+			storageDomain.Status(),
+		)
+	}
+}
+
+func TestSelectStorageDomain(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	storageDomainID := helper.GetStorageDomainID()
+
+	storageDomain, err := client.SelectStorageDomain(
+		ovirtclient.SelectStorageDomainParams().
+			MustWithMinimumAvailable(1024 * 1024).
+			MustWithAllowedIDs(storageDomainID),
+	)
+	if err != nil {
+		t.Fatalf("failed to select storage domain %s (%v)", storageDomainID, err)
+	}
+	if storageDomain.ID() != storageDomainID {
+		t.Fatalf("incorrect storage domain selected (expected: %s, got: %s)", storageDomainID, storageDomain.ID())
+	}
+
+	if _, err := client.SelectStorageDomain(
+		ovirtclient.SelectStorageDomainParams().
+			MustWithAllowedIDs(storageDomainID).
+			MustWithDeniedIDs(storageDomainID),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("selecting a denied storage domain did not return a not found error (%v)", err)
+	}
+	if _, err := client.SelectStorageDomain(
+		ovirtclient.SelectStorageDomainParams().MustWithMinimumAvailable(storageDomain.Available() + 1).
+			MustWithAllowedIDs(storageDomainID),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("selecting a storage domain without enough space did not return a not found error (%v)", err)
+	}
+	if _, err := ovirtclient.SelectStorageDomainParams().WithStorageTypes("invalid"); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("an invalid storage type did not return a bad argument error (%v)", err)
+	}
+}
+
+// TestStorageDomainVMExportAndImport runs against the mock only since the test environment may not have an export
+// storage domain.
+func TestStorageDomainVMExportAndImport(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	exportDomainID := findExportStorageDomainID(t, client)
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	vm, err := client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "export-test", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+
+	if err := vm.Export(exportDomainID); err != nil {
+		t.Fatalf("failed to export VM %s (%v)", vm.ID(), err)
+	}
+	if err := vm.Export(exportDomainID); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("exporting a VM twice did not return a conflict error (%v)", err)
+	}
+	exportedVMs, err := client.ListStorageDomainVMs(exportDomainID)
+	if err != nil {
+		t.Fatalf("failed to list VMs on storage domain %s (%v)", exportDomainID, err)
+	}
+	if len(exportedVMs) != 1 || exportedVMs[0].ID() != vm.ID() || exportedVMs[0].Name() != vm.Name() {
+		t.Fatalf("the exported VM is not listed on the export domain (%v)", exportedVMs)
+	}
+
+	if err := exportedVMs[0].Import(clusters[0].ID(), nil); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("importing an existing VM without cloning did not return a conflict error (%v)", err)
+	}
+	if err := exportedVMs[0].Import(
+		clusters[0].ID(),
+		ovirtclient.ImportFromStorageDomainParams().MustWithCloneVMs(true).MustWithName("export-test-clone"),
+	); err != nil {
+		t.Fatalf("failed to import a clone of VM %s (%v)", vm.ID(), err)
+	}
+	if err := vm.Remove(); err != nil {
+		t.Fatalf("failed to remove VM %s (%v)", vm.ID(), err)
+	}
+	if err := exportedVMs[0].Import(clusters[0].ID(), nil); err != nil {
+		t.Fatalf("failed to import VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetVM(vm.ID()); err != nil {
+		t.Fatalf("failed to get imported VM %s (%v)", vm.ID(), err)
+	}
+	if err := exportedVMs[0].Register(clusters[0].ID()); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("registering a VM from an export domain did not return a bad argument error (%v)", err)
+	}
+}
+
+func findExportStorageDomainID(t *testing.T, client ovirtclient.Client) string {
+	storageDomains, err := client.ListStorageDomains()
+	if err != nil {
+		t.Fatalf("failed to list storage domains (%v)", err)
+	}
+	for _, storageDomain := range storageDomains {
+		if storageDomain.Function() == ovirtclient.StorageDomainFunctionExport {
+			return storageDomain.ID()
+		}
+	}
+	t.Fatalf("no export storage domain found")
+	return ""
+}
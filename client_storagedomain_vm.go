@@ -0,0 +1,208 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// StorageDomainVM is a VM stored on a storage domain that can be brought into the engine. VMs on export storage
+// domains can be imported, unregistered VMs on data storage domains (for example on a data domain attached from
+// another engine) can be registered.
+type StorageDomainVM interface {
+	// ID returns the ID of the VM as stored on the storage domain.
+	ID() VMID
+	// Name returns the name of the VM as stored on the storage domain.
+	Name() string
+	// StorageDomainID returns the ID of the storage domain the VM is stored on.
+	StorageDomainID() string
+
+	// Import imports the VM from an export storage domain into the specified cluster.
+	Import(clusterID ClusterID, params OptionalStorageDomainImportParameters, retries ...RetryStrategy) error
+	// Register registers the VM from a data storage domain in the specified cluster.
+	Register(clusterID ClusterID, retries ...RetryStrategy) error
+}
+
+func (o *oVirtClient) ListStorageDomainVMs(id string, retries ...RetryStrategy) (
+	result []StorageDomainVM,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []StorageDomainVM{}
+	err = o.retry(
+		resourceOf(ResourceTypeStorageDomain, id),
+		fmt.Sprintf("listing VMs on storage domain %s", id),
+		retries,
+		func() error {
+			storageDomainService := o.connection().SystemService().StorageDomainsService().StorageDomainService(id)
+			unregistered, e := o.storageDomainListsUnregistered(storageDomainService)
+			if e != nil {
+				return e
+			}
+			req := storageDomainService.VmsService().List()
+			if unregistered {
+				req.Unregistered(true)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vm()
+			if !ok {
+				return nil
+			}
+			result = make([]StorageDomainVM, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKStorageDomainVM(sdkObject, id, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert VM on storage domain %s during listing item #%d", id, i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+// storageDomainListsUnregistered returns true if the VMs and templates of the storage domain must be listed as
+// unregistered. This is the case for data domains, which otherwise list the VMs and templates already registered in
+// the engine. Export domains always list all VMs and templates stored on them.
+func (o *oVirtClient) storageDomainListsUnregistered(service *ovirtsdk.StorageDomainService) (bool, error) {
+	response, err := service.Get().Send()
+	if err != nil {
+		return false, err
+	}
+	sdkStorageDomain, ok := response.StorageDomain()
+	if !ok {
+		return false, newError(ENotFound, "no storage domain returned")
+	}
+	function, _ := sdkStorageDomain.Type()
+	return StorageDomainFunction(function) == StorageDomainFunctionData, nil
+}
+
+func (o *oVirtClient) ImportVMFromStorageDomain(
+	storageDomainID string,
+	vmID VMID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("vm_import", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(vmID)).with("storage_domain_id", storageDomainID).with("cluster_id", clusterID),
+		fmt.Sprintf("importing VM %s from storage domain %s", vmID, storageDomainID),
+		retries,
+		func() error {
+			sdkCluster, sdkStorageDomain := storageDomainImportTarget(clusterID, params)
+			req := o.connection().SystemService().
+				StorageDomainsService().
+				StorageDomainService(storageDomainID).
+				VmsService().
+				VmService(string(vmID)).
+				Import().
+				Cluster(sdkCluster).
+				Query("correlation_id", correlationID)
+			if sdkStorageDomain != nil {
+				req.StorageDomain(sdkStorageDomain)
+			}
+			if params != nil {
+				if clone := params.CloneVMs(); clone != nil && *clone {
+					// The engine can only clone VMs with their snapshots collapsed.
+					req.Clone(true).CollapseSnapshots(true)
+				}
+				if name := params.Name(); name != nil {
+					req.Vm(ovirtsdk.NewVmBuilder().Name(*name).MustBuild())
+				}
+			}
+			_, err := req.Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
+
+func (o *oVirtClient) RegisterVMFromStorageDomain(
+	storageDomainID string,
+	vmID VMID,
+	clusterID ClusterID,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("vm_register", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(vmID)).with("storage_domain_id", storageDomainID).with("cluster_id", clusterID),
+		fmt.Sprintf("registering VM %s from storage domain %s", vmID, storageDomainID),
+		retries,
+		func() error {
+			sdkCluster, _ := storageDomainImportTarget(clusterID, nil)
+			_, err := o.connection().SystemService().
+				StorageDomainsService().
+				StorageDomainService(storageDomainID).
+				VmsService().
+				VmService(string(vmID)).
+				Register().
+				Cluster(sdkCluster).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
+
+func convertSDKStorageDomainVM(
+	sdkObject *ovirtsdk.Vm,
+	storageDomainID string,
+	client Client,
+) (*storageDomainVM, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("VM on storage domain", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("VM on storage domain", "name")
+	}
+	return &storageDomainVM{
+		client:          client,
+		id:              VMID(id),
+		name:            name,
+		storageDomainID: storageDomainID,
+	}, nil
+}
+
+type storageDomainVM struct {
+	client Client
+
+	id              VMID
+	name            string
+	storageDomainID string
+}
+
+func (s *storageDomainVM) ID() VMID {
+	return s.id
+}
+
+func (s *storageDomainVM) Name() string {
+	return s.name
+}
+
+func (s *storageDomainVM) StorageDomainID() string {
+	return s.storageDomainID
+}
+
+func (s *storageDomainVM) Import(
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) error {
+	return s.client.ImportVMFromStorageDomain(s.storageDomainID, s.id, clusterID, params, retries...)
+}
+
+func (s *storageDomainVM) Register(clusterID ClusterID, retries ...RetryStrategy) error {
+	return s.client.RegisterVMFromStorageDomain(s.storageDomainID, s.id, clusterID, retries...)
+}
@@ -4,37 +4,158 @@ import (
 	ovirtsdk4 "github.com/ovirt/go-ovirt"
 )
 
-//go:generate go run scripts/rest.go -i "Tag" -n "tag"
+//go:generate go run scripts/rest.go -i "Tag" -n "tag" -T "TagID"
+
+// TagID is an identifier for a tag. It has a special type so the compiler can catch errors when the tag ID is
+// erroneously passed elsewhere.
+type TagID string
 
 // TagClient describes the functions related to oVirt tags.
 type TagClient interface {
 	// GetTag returns a single tag based on its ID.
-	GetTag(id string, retries ...RetryStrategy) (Tag, error)
+	GetTag(id TagID, retries ...RetryStrategy) (Tag, error)
+	// GetTags returns the tags with the specified IDs in the same order. Multiple tags are fetched using a single
+	// ListTags call instead of one GetTag call per tag. If one of the tags does not exist, an ENotFound error is
+	// returned.
+	GetTags(ids []TagID, retries ...RetryStrategy) ([]Tag, error)
 	// ListTags returns all tags on the oVirt engine.
 	ListTags(retries ...RetryStrategy) ([]Tag, error)
-	// CreateTag creates a new tag with a name and description.
-	CreateTag(name string, description string, retries ...RetryStrategy) (result Tag, err error)
-	// RemoveTag removes the tag with the specified ID.
-	RemoveTag(tagID string, retries ...RetryStrategy) error
+	// CreateTag creates a new tag with a name and description. If parentID is not empty, the tag is created below
+	// the specified parent tag, otherwise it is created as a top-level tag. Tag names must be unique.
+	CreateTag(name string, description string, parentID TagID, retries ...RetryStrategy) (result Tag, err error)
+	// UpdateTag updates the name, description or parent of the tag with the specified ID. Use UpdateTagParams to
+	// obtain a builder for the parameters.
+	UpdateTag(id TagID, params UpdateTagParameters, retries ...RetryStrategy) (Tag, error)
+	// ListChildTags returns the tags directly below the tag with the specified ID. It returns an ENotFound error if
+	// the tag does not exist.
+	ListChildTags(tagID TagID, retries ...RetryStrategy) ([]Tag, error)
+	// RemoveTag removes the tag with the specified ID together with all tags below it.
+	RemoveTag(tagID TagID, retries ...RetryStrategy) error
 }
 
 // TagData is the core of Tag, providing only the data access functions, but not the client
 // functions.
 type TagData interface {
 	// ID returns the auto-generated identifier for this tag.
-	ID() string
+	ID() TagID
 	// Name returns the user-give name for this tag.
 	Name() string
 	// Description returns the user-give description for this tag.
 	Description() string
+	// ParentID returns the ID of the parent tag. The engine places top-level tags below its built-in root tag. It
+	// returns an empty string if the tag has no parent.
+	ParentID() TagID
 }
 
 // Tag is the interface defining the fields for tag.
 type Tag interface {
 	TagData
+	// Parent fetches the parent tag of this tag. It returns nil without an error if the tag has no parent.
+	Parent(retries ...RetryStrategy) (Tag, error)
+	// ListChildren returns the tags directly below this tag. See TagClient.ListChildTags for details.
+	ListChildren(retries ...RetryStrategy) ([]Tag, error)
+	// Update updates the tag. See TagClient.UpdateTag for details.
+	Update(params UpdateTagParameters, retries ...RetryStrategy) (Tag, error)
 	Remove(retries ...RetryStrategy) error
 }
 
+// UpdateTagParameters describes the changes to a tag. All parameters are optional, nil values leave the
+// corresponding field unchanged.
+type UpdateTagParameters interface {
+	// Name returns the new name of the tag, or nil to leave the name unchanged.
+	Name() *string
+	// Description returns the new description of the tag, or nil to leave the description unchanged.
+	Description() *string
+	// ParentID returns the ID of the new parent tag, or nil to leave the tag where it is.
+	ParentID() *TagID
+}
+
+// BuildableUpdateTagParameters is a buildable version of UpdateTagParameters.
+type BuildableUpdateTagParameters interface {
+	UpdateTagParameters
+
+	// WithName sets the new name of the tag. It returns an error if the name is empty.
+	WithName(name string) (BuildableUpdateTagParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateTagParameters
+	// WithDescription sets the new description of the tag.
+	WithDescription(description string) (BuildableUpdateTagParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableUpdateTagParameters
+	// WithParentID moves the tag below the specified parent tag. It returns an error if the parent ID is empty.
+	WithParentID(parentID TagID) (BuildableUpdateTagParameters, error)
+	// MustWithParentID is identical to WithParentID, but panics instead of returning an error.
+	MustWithParentID(parentID TagID) BuildableUpdateTagParameters
+}
+
+// UpdateTagParams creates a builder for the parameters of UpdateTag.
+func UpdateTagParams() BuildableUpdateTagParameters {
+	return &updateTagParams{}
+}
+
+type updateTagParams struct {
+	name        *string
+	description *string
+	parentID    *TagID
+}
+
+func (u *updateTagParams) Name() *string {
+	return u.name
+}
+
+func (u *updateTagParams) Description() *string {
+	return u.description
+}
+
+func (u *updateTagParams) ParentID() *TagID {
+	return u.parentID
+}
+
+func (u *updateTagParams) WithName(name string) (BuildableUpdateTagParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "tag name cannot be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithName(name string) BuildableUpdateTagParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTagParams) WithDescription(description string) (BuildableUpdateTagParameters, error) {
+	u.description = &description
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithDescription(description string) BuildableUpdateTagParameters {
+	builder, err := u.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateTagParams) WithParentID(parentID TagID) (BuildableUpdateTagParameters, error) {
+	if parentID == "" {
+		return nil, newError(EBadArgument, "parent tag ID cannot be empty")
+	}
+	u.parentID = &parentID
+	return u, nil
+}
+
+func (u *updateTagParams) MustWithParentID(parentID TagID) BuildableUpdateTagParameters {
+	builder, err := u.WithParentID(parentID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 func convertSDKTag(sdkObject *ovirtsdk4.Tag, client *oVirtClient) (Tag, error) {
 	id, ok := sdkObject.Id()
 	if !ok {
@@ -48,22 +169,30 @@ func convertSDKTag(sdkObject *ovirtsdk4.Tag, client *oVirtClient) (Tag, error) {
 	if !ok {
 		return nil, newFieldNotFound("tag", description)
 	}
+	// The root tag has no parent.
+	var parentID TagID
+	if sdkParent, ok := sdkObject.Parent(); ok {
+		id, _ := sdkParent.Id()
+		parentID = TagID(id)
+	}
 	return &tag{
 		client:      client,
-		id:          id,
+		id:          TagID(id),
 		name:        name,
 		description: description,
+		parentID:    parentID,
 	}, nil
 }
 
 type tag struct {
 	client      Client
-	id          string
+	id          TagID
 	name        string
 	description string
+	parentID    TagID
 }
 
-func (n tag) ID() string {
+func (n tag) ID() TagID {
 	return n.id
 }
 
@@ -75,6 +204,25 @@ func (n tag) Description() string {
 	return n.description
 }
 
+func (n tag) ParentID() TagID {
+	return n.parentID
+}
+
+func (n *tag) Parent(retries ...RetryStrategy) (Tag, error) {
+	if n.parentID == "" {
+		return nil, nil
+	}
+	return n.client.GetTag(n.parentID, retries...)
+}
+
+func (n *tag) ListChildren(retries ...RetryStrategy) ([]Tag, error) {
+	return n.client.ListChildTags(n.id, retries...)
+}
+
+func (n *tag) Update(params UpdateTagParameters, retries ...RetryStrategy) (Tag, error) {
+	return n.client.UpdateTag(n.id, params, retries...)
+}
+
 func (n *tag) Remove(retries ...RetryStrategy) error {
 	return n.client.RemoveTag(n.id, retries...)
 }
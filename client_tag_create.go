@@ -2,16 +2,29 @@ package ovirtclient
 
 import ovirtsdk "github.com/ovirt/go-ovirt"
 
-func (o *oVirtClient) CreateTag(name string, description string, retries ...RetryStrategy) (result Tag, err error) {
+func (o *oVirtClient) CreateTag(
+	name string,
+	description string,
+	parentID TagID,
+	retries ...RetryStrategy,
+) (result Tag, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
+	correlationID := o.correlationIDFor(retries)
 
-	err = retry(
+	err = o.mutate(
+		resourceOf(ResourceTypeTag, "").with("name", name).with("parent_id", parentID),
 		"creating tag",
-		o.logger,
 		retries,
 		func() error {
 			tagBuilder := ovirtsdk.NewTagBuilder().Name(name).Description(description)
-			response, e := o.conn.SystemService().TagsService().Add().Tag(tagBuilder.MustBuild()).Send()
+			if parentID != "" {
+				tagBuilder.Parent(ovirtsdk.NewTagBuilder().Id(string(parentID)).MustBuild())
+			}
+			req := o.connection().SystemService().TagsService().Add().Tag(tagBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
 			if e != nil {
 				return e
 			}
@@ -31,5 +44,6 @@ func (o *oVirtClient) CreateTag(name string, description string, retries ...Retr
 			}
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -6,14 +6,14 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) GetTag(id string, retries ...RetryStrategy) (result Tag, err error) {
+func (o *oVirtClient) GetTag(id TagID, retries ...RetryStrategy) (result Tag, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTag, string(id)),
 		fmt.Sprintf("getting tag %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TagsService().TagService(id).Get().Send()
+			response, err := o.connection().SystemService().TagsService().TagService(string(id)).Get().Send()
 			if err != nil {
 				return err
 			}
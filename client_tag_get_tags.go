@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) GetTags(ids []TagID, retries ...RetryStrategy) ([]Tag, error) {
+	return getTags(o, ids, retries)
+}
+
+// getTags implements GetTags on top of GetTag and ListTags. It is shared between the real, the mock and the caching
+// client.
+func getTags(client TagClient, ids []TagID, retries []RetryStrategy) ([]Tag, error) {
+	switch len(ids) {
+	case 0:
+		return []Tag{}, nil
+	case 1:
+		tag, err := client.GetTag(ids[0], retries...)
+		if err != nil {
+			return nil, err
+		}
+		return []Tag{tag}, nil
+	}
+	allTags, err := client.ListTags(retries...)
+	if err != nil {
+		return nil, err
+	}
+	tagsByID := make(map[TagID]Tag, len(allTags))
+	for _, tag := range allTags {
+		tagsByID[tag.ID()] = tag
+	}
+	result := make([]Tag, len(ids))
+	for i, id := range ids {
+		tag, ok := tagsByID[id]
+		if !ok {
+			return nil, newError(ENotFound, "tag with ID %s not found", id)
+		}
+		result[i] = tag
+	}
+	return result, nil
+}
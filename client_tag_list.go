@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListTags(retries ...RetryStrategy) (result []Tag, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Tag{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTag, ""),
 		"listing tags",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().TagsService().List().Send()
+			response, e := o.connection().SystemService().TagsService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListChildTags(tagID TagID, retries ...RetryStrategy) (result []Tag, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Tag{}
+	err = o.retry(
+		resourceOf(ResourceTypeTag, string(tagID)),
+		fmt.Sprintf("listing child tags of tag %s", tagID),
+		retries,
+		func() error {
+			// The engine has no sub-resource for the children of a tag, so we filter the full tag list.
+			response, e := o.connection().SystemService().TagsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Tags()
+			if !ok {
+				return newError(ENotFound, "tag with ID %s not found", tagID)
+			}
+			found := false
+			result = []Tag{}
+			for i, sdkObject := range sdkObjects.Slice() {
+				t, e := convertSDKTag(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert tag during listing item #%d", i)
+				}
+				if t.ID() == tagID {
+					found = true
+				}
+				if t.ParentID() == tagID {
+					result = append(result, t)
+				}
+			}
+			if !found {
+				return newError(ENotFound, "tag with ID %s not found", tagID)
+			}
+			return nil
+		})
+	return
+}
@@ -4,15 +4,21 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveTag(tagID string, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) RemoveTag(tagID TagID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeTag, string(tagID)),
 		fmt.Sprintf("removing tag %s", tagID),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().TagsService().TagService(tagID).Remove().Send()
+			req := o.connection().SystemService().TagsService().TagService(string(tagID)).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
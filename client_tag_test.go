@@ -58,7 +58,7 @@ func TestAddTagToVM(t *testing.T) {
 func assertCanGetTag(
 	t *testing.T,
 	helper ovirtclient.TestHelper,
-	tagID string,
+	tagID ovirtclient.TagID,
 ) ovirtclient.Tag {
 	client := helper.GetClient()
 	tag, err := client.GetTag(tagID)
@@ -80,6 +80,7 @@ func assertCanCreateTag(
 	tag, err := client.CreateTag(
 		name,
 		description,
+		"",
 	)
 	if err != nil {
 		t.Fatalf("Failed to create Tag (%v)", err)
@@ -96,3 +97,250 @@ func assertCanCreateTag(
 
 	return tag
 }
+
+func TestVMTags(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag1 := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	tag2 := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	for _, tag := range []ovirtclient.Tag{tag1, tag2} {
+		if err := client.AddTagToVM(vm.ID(), tag.ID()); err != nil {
+			t.Fatalf("failed to add tag %s to VM %s (%v)", tag.ID(), vm.ID(), err)
+		}
+	}
+	vm, err := client.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch VM %s (%v)", vm.ID(), err)
+	}
+
+	tags, err := vm.Tags()
+	if err != nil {
+		t.Fatalf("failed to list tags of VM %s (%v)", vm.ID(), err)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("incorrect number of tags returned (expected: 2, got: %d)", len(tags))
+	}
+	if tags[0].ID() != tag1.ID() || tags[1].ID() != tag2.ID() {
+		t.Fatalf("the tags were not returned in the order of the tag IDs of the VM")
+	}
+}
+
+func TestTemplateAndHostTagIDs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	tpl, err := client.GetTemplate(helper.GetBlankTemplateID())
+	if err != nil {
+		t.Fatalf("failed to fetch template %s (%v)", helper.GetBlankTemplateID(), err)
+	}
+	if err := tpl.AddTag(tag.ID()); err != nil {
+		t.Fatalf("failed to add tag %s to template %s (%v)", tag.ID(), tpl.ID(), err)
+	}
+	tpl, err = client.GetTemplate(tpl.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch template %s (%v)", tpl.ID(), err)
+	}
+	if !hasTagID(tpl.TagIDs(), tag.ID()) {
+		t.Fatalf("tag %s not found on template %s", tag.ID(), tpl.ID())
+	}
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	if err := hosts[0].AddTag(tag.ID()); err != nil {
+		t.Fatalf("failed to add tag %s to host %s (%v)", tag.ID(), hosts[0].ID(), err)
+	}
+	host, err := client.GetHost(hosts[0].ID())
+	if err != nil {
+		t.Fatalf("failed to fetch host %s (%v)", hosts[0].ID(), err)
+	}
+	if !hasTagID(host.TagIDs(), tag.ID()) {
+		t.Fatalf("tag %s not found on host %s", tag.ID(), host.ID())
+	}
+
+	if err := tag.Remove(); err != nil {
+		t.Fatalf("failed to remove tag %s (%v)", tag.ID(), err)
+	}
+	host, err = client.GetHost(host.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch host %s (%v)", hosts[0].ID(), err)
+	}
+	if hasTagID(host.TagIDs(), tag.ID()) {
+		t.Fatalf("removed tag %s is still assigned to host %s", tag.ID(), host.ID())
+	}
+}
+
+func TestAddTagToDiskUnsupported(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	disk := assertCanCreateDisk(t, helper)
+	if err := client.AddTagToDisk(disk.ID(), tag.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.EUnsupported) {
+		t.Fatalf("adding a tag to a disk did not return an unsupported error (%v)", err)
+	}
+}
+
+func hasTagID(tagIDs []ovirtclient.TagID, tagID ovirtclient.TagID) bool {
+	for _, id := range tagIDs {
+		if id == tagID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGetTagsNotFound(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	_, err := client.GetTags([]ovirtclient.TagID{tag.ID(), ovirtclient.TagID(helper.GenerateRandomID(10))})
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("getting a non-existent tag did not return an ENotFound error (%v)", err)
+	}
+}
+
+func TestTagHierarchyUpdate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	parent1 := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	parent2 := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	child, err := client.CreateTag(fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "", parent1.ID())
+	if err != nil {
+		t.Fatalf("failed to create child tag (%v)", err)
+	}
+	if child.ParentID() != parent1.ID() {
+		t.Fatalf("incorrect parent ID on child tag (%s != %s)", child.ParentID(), parent1.ID())
+	}
+
+	newName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	updatedChild, err := child.Update(
+		ovirtclient.UpdateTagParams().
+			MustWithName(newName).
+			MustWithDescription("moved").
+			MustWithParentID(parent2.ID()),
+	)
+	if err != nil {
+		t.Fatalf("failed to update tag %s (%v)", child.ID(), err)
+	}
+	fetchedChild := assertCanGetTag(t, helper, updatedChild.ID())
+	if fetchedChild.Name() != newName || fetchedChild.Description() != "moved" {
+		t.Fatalf(
+			"tag %s was not updated (name: %s, description: %s)",
+			child.ID(),
+			fetchedChild.Name(),
+			fetchedChild.Description(),
+		)
+	}
+	if fetchedChild.ParentID() != parent2.ID() {
+		t.Fatalf("tag %s was not moved (%s != %s)", child.ID(), fetchedChild.ParentID(), parent2.ID())
+	}
+
+	if err := parent2.Remove(); err != nil {
+		t.Fatalf("failed to remove tag %s (%v)", parent2.ID(), err)
+	}
+	if _, err := client.GetTag(child.ID()); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("child tag %s was not removed with its parent (%v)", child.ID(), err)
+	}
+}
+
+func TestTagHierarchyTraversal(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	parent := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	child, err := client.CreateTag(fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "", parent.ID())
+	if err != nil {
+		t.Fatalf("failed to create child tag (%v)", err)
+	}
+
+	children, err := parent.ListChildren()
+	if err != nil {
+		t.Fatalf("failed to list children of tag %s (%v)", parent.ID(), err)
+	}
+	if len(children) != 1 || children[0].ID() != child.ID() {
+		t.Fatalf("incorrect children returned for tag %s (%d children)", parent.ID(), len(children))
+	}
+	grandchildren, err := child.ListChildren()
+	if err != nil {
+		t.Fatalf("failed to list children of tag %s (%v)", child.ID(), err)
+	}
+	if len(grandchildren) != 0 {
+		t.Fatalf("tag %s has unexpected children (%d children)", child.ID(), len(grandchildren))
+	}
+
+	fetchedParent, err := child.Parent()
+	if err != nil {
+		t.Fatalf("failed to fetch parent of tag %s (%v)", child.ID(), err)
+	}
+	if fetchedParent == nil || fetchedParent.ID() != parent.ID() {
+		t.Fatalf("incorrect parent returned for tag %s", child.ID())
+	}
+
+	if _, err := client.ListChildTags(ovirtclient.TagID(helper.GenerateRandomID(5))); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.ENotFound,
+	) {
+		t.Fatalf("listing the children of a nonexistent tag did not return an ENotFound error (%v)", err)
+	}
+}
+
+func TestTagSearch(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	tagName := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	tag := assertCanCreateTag(t, helper, tagName, "")
+
+	if err := client.AddTagToTemplate(helper.GetBlankTemplateID(), tag.ID()); err != nil {
+		t.Fatalf("failed to add tag to template (%v)", err)
+	}
+	templates, err := client.SearchTemplates(ovirtclient.TemplateSearchParams().WithTag(tagName))
+	if err != nil {
+		t.Fatalf("failed to search for templates by tag (%v)", err)
+	}
+	if len(templates) != 1 || templates[0].ID() != helper.GetBlankTemplateID() {
+		t.Fatalf("incorrect templates returned when searching by tag (%d templates)", len(templates))
+	}
+
+	hosts, err := client.ListHosts()
+	if err != nil {
+		t.Fatalf("failed to list hosts (%v)", err)
+	}
+	if err := client.AddTagToHost(hosts[0].ID(), tag.ID()); err != nil {
+		t.Fatalf("failed to add tag to host (%v)", err)
+	}
+	taggedHosts, err := client.SearchHosts(ovirtclient.HostSearchParams().WithTag(tagName))
+	if err != nil {
+		t.Fatalf("failed to search for hosts by tag (%v)", err)
+	}
+	if len(taggedHosts) != 1 || taggedHosts[0].ID() != hosts[0].ID() {
+		t.Fatalf("incorrect hosts returned when searching by tag (%d hosts)", len(taggedHosts))
+	}
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	if err := client.AddTagToVM(vm.ID(), tag.ID()); err != nil {
+		t.Fatalf("failed to add tag to VM (%v)", err)
+	}
+	disks, err := client.SearchDisks(ovirtclient.DiskSearchParams().WithTag(tagName))
+	if err != nil {
+		t.Fatalf("failed to search for disks by tag (%v)", err)
+	}
+	if len(disks) != 1 || disks[0].ID() != disk.ID() {
+		t.Fatalf("incorrect disks returned when searching by tag (%d disks)", len(disks))
+	}
+}
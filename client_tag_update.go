@@ -0,0 +1,50 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateTag(id TagID, params UpdateTagParameters, retries ...RetryStrategy) (
+	result Tag,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	tagBuilder := ovirtsdk.NewTagBuilder()
+	if name := params.Name(); name != nil {
+		tagBuilder.Name(*name)
+	}
+	if description := params.Description(); description != nil {
+		tagBuilder.Description(*description)
+	}
+	if parentID := params.ParentID(); parentID != nil {
+		tagBuilder.Parent(ovirtsdk.NewTagBuilder().Id(string(*parentID)).MustBuild())
+	}
+	err = o.mutate(
+		resourceOf(ResourceTypeTag, string(id)),
+		fmt.Sprintf("updating tag %s", id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().TagsService().TagService(string(id)).Update().Tag(tagBuilder.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkTag, ok := response.Tag()
+			if !ok {
+				return newError(EFieldMissing, "missing tag in update response")
+			}
+			result, e = convertSDKTag(sdkTag, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert tag %s", id)
+			}
+			return nil
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
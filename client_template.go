@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"time"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -9,12 +11,17 @@ import (
 // TemplateClient represents the portion of the client that deals with VM templates.
 type TemplateClient interface {
 	// CreateTemplate creates a new template from an existing VM.
-	CreateTemplate(vmID string, name string, params OptionalTemplateCreateParameters, retries ...RetryStrategy) (
+	CreateTemplate(vmID VMID, name string, params OptionalTemplateCreateParameters, retries ...RetryStrategy) (
 		Template,
 		error,
 	)
 	// ListTemplates returns all templates stored in the oVirt engine.
 	ListTemplates(retries ...RetryStrategy) ([]Template, error)
+	// SearchTemplates lists the templates matching the criteria specified in params. The filtering is done by the
+	// engine, so large template lists don't need to be fetched.
+	SearchTemplates(params TemplateSearchParameters, retries ...RetryStrategy) ([]Template, error)
+	// AddTagToTemplate assigns the tag with the ID tagID to the template.
+	AddTagToTemplate(id TemplateID, tagID TagID, retries ...RetryStrategy) error
 	// GetTemplate returns a template by its ID.
 	GetTemplate(id TemplateID, retries ...RetryStrategy) (Template, error)
 	// GetBlankTemplate finds a blank template in the oVirt engine and returns it. If no blank template is present,
@@ -22,10 +29,13 @@ type TemplateClient interface {
 	GetBlankTemplate(retries ...RetryStrategy) (Template, error)
 	// RemoveTemplate removes the template with the specified ID.
 	RemoveTemplate(templateID TemplateID, retries ...RetryStrategy) error
+	// ExportTemplate exports the template with its disks to an export storage domain and waits for the export to
+	// finish. The export fails if the template already exists on the export domain.
+	ExportTemplate(templateID TemplateID, storageDomainID string, retries ...RetryStrategy) error
 	// WaitForTemplateStatus waits for a template to enter a specific status.
 	WaitForTemplateStatus(templateID TemplateID, status TemplateStatus, retries ...RetryStrategy) (Template, error)
 	// CopyTemplateDiskToStorageDomain copies template disk to the specified storage domain.
-	CopyTemplateDiskToStorageDomain(diskID string, storageDomainID string, retries ...RetryStrategy) (Disk, error)
+	CopyTemplateDiskToStorageDomain(diskID DiskID, storageDomainID string, retries ...RetryStrategy) (Disk, error)
 }
 
 // TemplateID is an identifier for a template. It has a special type so the compiler
@@ -42,8 +52,13 @@ type TemplateData interface {
 	Description() string
 	// Status returns the status of the template.
 	Status() TemplateStatus
+	// CreationTime returns the time the template was created. It returns the zero time if the engine did not report
+	// it.
+	CreationTime() time.Time
 	// CPU returns the CPU configuration of the template if any.
 	CPU() VMCPU
+	// TagIDs returns the IDs of the tags assigned to the template.
+	TagIDs() []TagID
 
 	// IsBlank returns true, if the template either has the ID of all zeroes, or if the template has no settings, disks,
 	// or other settings. This function only checks the details supported by go-ovirt-client.
@@ -62,6 +77,64 @@ type Template interface {
 	ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error)
 	// Remove removes the specified template.
 	Remove(retries ...RetryStrategy) error
+	// Export exports the template to an export storage domain.
+	Export(storageDomainID string, retries ...RetryStrategy) error
+	// AddPermission grants the role to the principal on the template.
+	AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (Permission, error)
+	// ListPermissions lists the permissions that apply to the template.
+	ListPermissions(retries ...RetryStrategy) ([]Permission, error)
+	// AddTag assigns the specified tag to the template. See TemplateClient.AddTagToTemplate for details.
+	AddTag(tagID TagID, retries ...RetryStrategy) error
+	// Clone returns a copy of the template that shares no data, such as the CPU settings, with the original.
+	Clone() Template
+}
+
+// TemplateSearchParameters declares the parameters that can be passed to SearchTemplates. Each parameter is declared as
+// a pointer, where a nil value means that the parameter is not searched for. All parameters are used together as an
+// AND filter.
+type TemplateSearchParameters interface {
+	// Name will match the name of the template exactly.
+	Name() *string
+	// Tag will match the name of a tag assigned to the template.
+	Tag() *string
+}
+
+// BuildableTemplateSearchParameters is a buildable version of TemplateSearchParameters.
+type BuildableTemplateSearchParameters interface {
+	TemplateSearchParameters
+
+	// WithName sets the name to search for.
+	WithName(name string) BuildableTemplateSearchParameters
+	// WithTag sets the tag name to search for.
+	WithTag(tag string) BuildableTemplateSearchParameters
+}
+
+// TemplateSearchParams creates a buildable set of search parameters for SearchTemplates.
+func TemplateSearchParams() BuildableTemplateSearchParameters {
+	return &templateSearchParams{}
+}
+
+type templateSearchParams struct {
+	name *string
+	tag  *string
+}
+
+func (p *templateSearchParams) Name() *string {
+	return p.name
+}
+
+func (p *templateSearchParams) Tag() *string {
+	return p.tag
+}
+
+func (p *templateSearchParams) WithName(name string) BuildableTemplateSearchParameters {
+	p.name = &name
+	return p
+}
+
+func (p *templateSearchParams) WithTag(tag string) BuildableTemplateSearchParameters {
+	p.tag = &tag
+	return p
 }
 
 // TemplateStatus represents the status the template is in.
@@ -135,17 +208,28 @@ func convertSDKTemplate(sdkTemplate *ovirtsdk.Template, client Client) (Template
 	if !ok {
 		return nil, newFieldNotFound("template", "status")
 	}
+	// The creation time is not reported by all engine versions, so we don't fail if it is missing.
+	creationTime, _ := sdkTemplate.CreationTime()
 	cpu, err := convertSDKTemplateCPU(sdkTemplate)
 	if err != nil {
 		return nil, err
 	}
+	var tagIDs []TagID
+	if sdkTags, ok := sdkTemplate.Tags(); ok {
+		for _, sdkTag := range sdkTags.Slice() {
+			tagID, _ := sdkTag.Id()
+			tagIDs = append(tagIDs, TagID(tagID))
+		}
+	}
 	return &template{
-		client:      client,
-		id:          TemplateID(id),
-		name:        name,
-		status:      TemplateStatus(status),
-		description: description,
-		cpu:         cpu,
+		client:       client,
+		id:           TemplateID(id),
+		name:         name,
+		status:       TemplateStatus(status),
+		description:  description,
+		creationTime: creationTime,
+		cpu:          cpu,
+		tagIDs:       tagIDs,
 	}, nil
 }
 
@@ -181,12 +265,14 @@ func convertSDKTemplateCPU(sdkObject *ovirtsdk.Template) (*vmCPU, error) {
 }
 
 type template struct {
-	client      Client
-	id          TemplateID
-	name        string
-	description string
-	status      TemplateStatus
-	cpu         *vmCPU
+	client       Client
+	id           TemplateID
+	name         string
+	description  string
+	status       TemplateStatus
+	creationTime time.Time
+	cpu          *vmCPU
+	tagIDs       []TagID
 }
 
 func (t template) ListDiskAttachments(retries ...RetryStrategy) ([]TemplateDiskAttachment, error) {
@@ -209,6 +295,21 @@ func (t template) Remove(retries ...RetryStrategy) error {
 	return t.client.RemoveTemplate(t.id, retries...)
 }
 
+func (t template) Export(storageDomainID string, retries ...RetryStrategy) error {
+	return t.client.ExportTemplate(t.id, storageDomainID, retries...)
+}
+
+func (t template) AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (
+	Permission,
+	error,
+) {
+	return t.client.AddTemplatePermission(t.id, principal, roleID, retries...)
+}
+
+func (t template) ListPermissions(retries ...RetryStrategy) ([]Permission, error) {
+	return t.client.ListPermissions(PermissionObjectTypeTemplate, string(t.id), retries...)
+}
+
 func (t template) IsBlank() bool {
 	if t.cpu.topo.sockets != 1 || t.cpu.topo.cores != 1 || t.cpu.topo.threads != 1 {
 		return false
@@ -225,6 +326,31 @@ func (t template) Name() string {
 	return t.name
 }
 
+func (t template) CreationTime() time.Time {
+	return t.creationTime
+}
+
 func (t template) Description() string {
 	return t.description
 }
+
+func (t template) Clone() Template {
+	return &template{
+		client:       t.client,
+		id:           t.id,
+		name:         t.name,
+		description:  t.description,
+		status:       t.status,
+		creationTime: t.creationTime,
+		cpu:          t.cpu.clone(),
+		tagIDs:       append([]TagID{}, t.tagIDs...),
+	}
+}
+
+func (t template) TagIDs() []TagID {
+	return t.tagIDs
+}
+
+func (t template) AddTag(tagID TagID, retries ...RetryStrategy) error {
+	return t.client.AddTagToTemplate(t.id, tagID, retries...)
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func (o *oVirtClient) CopyTemplateDiskToStorageDomain(
-	diskID string,
+	diskID DiskID,
 	storageDomainID string,
 	retries ...RetryStrategy) (result Disk, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
@@ -21,25 +21,25 @@ func (o *oVirtClient) CopyTemplateDiskToStorageDomain(
 }
 
 func (o *oVirtClient) StartCopyTemplateDiskToStorageDomain(
-	diskID string,
+	diskID DiskID,
 	storageDomainID string,
 	retries ...RetryStrategy) (DiskUpdate, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
 	correlationID := fmt.Sprintf("template_disk_copy_%s", generateRandomID(5, o.nonSecureRandom))
 	sdkStorageDomain := ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID)
-	sdkDisk := ovirtsdk.NewDiskBuilder().Id(diskID)
+	sdkDisk := ovirtsdk.NewDiskBuilder().Id(string(diskID))
 	storageDomain, _ := o.GetStorageDomain(storageDomainID)
 	disk, _ := o.GetDisk(diskID)
 
-	err := retry(
+	err := o.mutate(
+		resourceOf(ResourceTypeDisk, string(diskID)).with("storage_domain_id", storageDomainID),
 		fmt.Sprintf("copying disk %s to storage domain %s", diskID, storageDomainID),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.
+			_, err := o.connection().
 				SystemService().
 				DisksService().
-				DiskService(diskID).
+				DiskService(string(diskID)).
 				Copy().
 				StorageDomain(sdkStorageDomain.MustBuild()).
 				Disk(sdkDisk.MustBuild()).
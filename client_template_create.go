@@ -7,27 +7,31 @@ import (
 )
 
 func (o *oVirtClient) CreateTemplate(
-	vmID string,
+	vmID VMID,
 	name string,
 	params OptionalTemplateCreateParameters,
-	retries ...RetryStrategy,
-) (result Template, err error) {
+	retries ...RetryStrategy) (result Template, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
+	correlationID := o.correlationIDFor(retries)
 	if params == nil {
 		params = &templateCreateParameters{}
 	}
-	err = retry(
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(vmID)).with("name", name),
 		fmt.Sprintf("creating template from VM %s", vmID),
-		o.logger,
 		retries,
 		func() error {
 			tpl := ovirtsdk.NewTemplateBuilder()
-			tpl.VmBuilder(ovirtsdk.NewVmBuilder().Id(vmID))
+			tpl.VmBuilder(ovirtsdk.NewVmBuilder().Id(string(vmID)))
 			tpl.Name(name)
 			if desc := params.Description(); desc != nil {
 				tpl.Description(*desc)
 			}
-			response, err := o.conn.SystemService().TemplatesService().Add().Template(tpl.MustBuild()).Send()
+			req := o.connection().SystemService().TemplatesService().Add().Template(tpl.MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
 			if err != nil {
 				return err
 			}
@@ -50,5 +54,5 @@ func (o *oVirtClient) CreateTemplate(
 			}
 			return nil
 		})
-	return result, err
+	return result, withCorrelationID(err, correlationID)
 }
@@ -0,0 +1,34 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ExportTemplate(
+	templateID TemplateID,
+	storageDomainID string,
+	retries ...RetryStrategy,
+) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("template_export", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeTemplate, string(templateID)).with("storage_domain_id", storageDomainID),
+		fmt.Sprintf("exporting template %s to storage domain %s", templateID, storageDomainID),
+		retries,
+		func() error {
+			_, err := o.connection().SystemService().
+				TemplatesService().
+				TemplateService(string(templateID)).
+				Export().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
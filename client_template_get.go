@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetTemplate(id TemplateID, retries ...RetryStrategy) (result Template, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTemplate, string(id)),
 		fmt.Sprintf("getting template %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().TemplatesService().TemplateService(string(id)).Get().Send()
+			response, err := o.connection().SystemService().TemplatesService().TemplateService(string(id)).Get().Send()
 			if err != nil {
 				return err
 			}
@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListTemplates(retries ...RetryStrategy) (result []Template, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []Template{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTemplate, ""),
 		"listing templates",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().TemplatesService().List().Send()
+			response, e := o.connection().SystemService().TemplatesService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -9,13 +9,19 @@ func (o *oVirtClient) RemoveTemplate(
 	retries ...RetryStrategy,
 ) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeTemplate, string(templateID)),
 		fmt.Sprintf("removing template %s", templateID),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().TemplatesService().TemplateService(string(templateID)).Remove().Send()
+			req := o.connection().SystemService().TemplatesService().TemplateService(string(templateID)).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
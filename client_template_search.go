@@ -0,0 +1,36 @@
+package ovirtclient
+
+func (o *oVirtClient) SearchTemplates(
+	params TemplateSearchParameters,
+	retries ...RetryStrategy,
+) (result []Template, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []Template{}
+	qs, err := nameAndTagSearchCriteria("name", params.Name(), "tag", params.Tag())
+	if err != nil {
+		return nil, err
+	}
+	err = o.retry(
+		resourceOf(ResourceTypeTemplate, ""),
+		"searching for templates",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().TemplatesService().List().Search(qs).Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Templates()
+			if !ok {
+				return nil
+			}
+			result = make([]Template, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKTemplate(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert template during searching item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
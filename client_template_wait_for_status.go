@@ -10,9 +10,9 @@ func (o *oVirtClient) WaitForTemplateStatus(
 	retries ...RetryStrategy,
 ) (result Template, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTemplate, string(id)),
 		fmt.Sprintf("waiting for template %s to enter status \"%s\"", id, status),
-		o.logger,
 		retries,
 		func() error {
 			result, err = o.GetTemplate(id, retries...)
@@ -1,12 +1,26 @@
 package ovirtclient_test
 
 import (
+	"os"
 	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client"
 	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
 )
 
+// getHelper creates the test helper for a test. If OVIRT_VCR_MODE is set, the API calls of the test are recorded or
+// replayed, see NewVCRTestHelperFromEnv. If OVIRT_CHECK_RESOURCE_LEAKS is set, the test fails if it leaves behind any
+// VMs, disks or templates.
 func getHelper(t *testing.T) ovirtclient.TestHelper {
-	return ovirtclient.NewTestHelperFromEnv(ovirtclientlog.NewTestLogger(t))
+	logger := ovirtclientlog.NewTestLogger(t)
+	var helper ovirtclient.TestHelper
+	if os.Getenv("OVIRT_VCR_MODE") != "" {
+		helper = ovirtclient.NewVCRTestHelperFromEnv(t, logger)
+	} else {
+		helper = ovirtclient.NewTestHelperFromEnv(logger)
+	}
+	if os.Getenv("OVIRT_CHECK_RESOURCE_LEAKS") != "" {
+		helper.CheckResourceLeaks(t, false)
+	}
+	return helper
 }
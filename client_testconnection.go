@@ -8,12 +8,12 @@ type TestConnectionClient interface {
 
 func (o *oVirtClient) Test(retries ...RetryStrategy) error {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	return retry(
+	return o.retry(
+		resourceOf(ResourceTypeEngine, ""),
 		"testing oVirt engine connection",
-		o.logger,
 		retries,
 		func() error {
-			return o.conn.SystemService().Connection().Test()
+			return o.connection().SystemService().Connection().Test()
 		},
 	)
 }
@@ -0,0 +1,194 @@
+package ovirtclient
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenPlaceholderCredential is passed to the SDK as username and password for clients using a TokenProvider. It is
+// also the access token the SDK receives from the SSO service, since the real token is only added to the requests by
+// tokenTransport.
+const tokenPlaceholderCredential = "token"
+
+// tokenRefreshMargin is the time before the expiry of a token at which the client obtains a new token from the token
+// provider, so a token does not expire while a call is on its way to the engine.
+const tokenRefreshMargin = time.Minute
+
+// TokenProvider supplies the access tokens for a client created using NewWithTokenProvider, for example from an
+// external identity provider or a secret that is rotated regularly. The client sends the token in the
+// "Authorization: Bearer" header of each API call instead of logging in with a username and password.
+//
+// The token provider is called from multiple goroutines concurrently and must therefore be thread-safe.
+type TokenProvider interface {
+	// Token returns the access token for the oVirt Engine and the time it expires. The client keeps using the token
+	// until one minute before it expires and then calls Token again. If the expiry is the zero time, the client keeps
+	// using the token until the engine rejects it with an HTTP 401 response, so Token must return a fresh token if
+	// the previous one is no longer valid.
+	Token() (token string, expiresAt time.Time, err error)
+}
+
+// NewWithTokenProvider creates a new client that authenticates its API calls using the access tokens returned from
+// tokenProvider instead of logging in with a username and password. The other parameters are the same as for New.
+//
+// The client obtains a new token from the provider before the current one expires. If the engine still rejects a
+// token with an HTTP 401 response, the client obtains a new token and repeats the call once, as it logs in again for
+// clients created using New. The client never revokes the tokens it receives.
+//
+// If the extra settings contain an audit sink, the client looks up the name of the user the tokens belong to when it
+// is created, so the audit records contain the user as for clients created using New.
+func NewWithTokenProvider(
+	url string,
+	tokenProvider TokenProvider,
+	tls TLSProvider,
+	logger Logger,
+	extraSettings ExtraSettings,
+) (ClientWithLegacySupport, error) {
+	if tokenProvider == nil {
+		return nil, newError(EBadArgument, "the token provider must not be nil")
+	}
+	return newClient(
+		url,
+		credentials{tokens: &tokenSource{provider: tokenProvider, lock: &sync.Mutex{}}},
+		tls,
+		logger,
+		extraSettings,
+		testConnection,
+	)
+}
+
+// tokenSource caches the token of a TokenProvider until it is about to expire or the engine rejects it.
+type tokenSource struct {
+	provider TokenProvider
+	lock     *sync.Mutex
+	token    string
+	expiry   time.Time
+}
+
+// get returns the cached token, or obtains a new one from the provider if there is none or it is about to expire.
+func (t *tokenSource) get() (string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.token != "" && (t.expiry.IsZero() || time.Now().Add(tokenRefreshMargin).Before(t.expiry)) {
+		return t.token, nil
+	}
+	token, expiry, err := t.provider.Token()
+	if err != nil {
+		return "", wrap(err, EAccessDenied, "failed to obtain an access token from the token provider")
+	}
+	if token == "" {
+		return "", newError(EAccessDenied, "the token provider returned an empty access token")
+	}
+	t.token = token
+	t.expiry = expiry
+	return token, nil
+}
+
+// invalidate drops the cached token if it is the rejected token, so the next call to get obtains a new one.
+func (t *tokenSource) invalidate(rejected string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.token == rejected {
+		t.token = ""
+	}
+}
+
+// tokenTransport adds the token of a TokenProvider to the API requests of the SDK. The SDK has no option to use an
+// existing token, so the transport answers its SSO login with a placeholder token and its logout with an empty
+// response instead of sending them to the engine.
+type tokenTransport struct {
+	base   http.RoundTripper
+	tokens *tokenSource
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/ovirt-engine/sso/oauth/"):
+		return localJSONResponse(req, `{"access_token":"`+tokenPlaceholderCredential+`"}`), nil
+	case req.URL.Path == "/ovirt-engine/services/sso-logout":
+		return localJSONResponse(req, `{}`), nil
+	}
+	token, err := t.tokens.get()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.tokens.invalidate(token)
+	}
+	return resp, err
+}
+
+// localJSONResponse creates a successful response with a JSON body for a request that is not sent to the engine.
+func localJSONResponse(req *http.Request, body string) *http.Response {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// setTokenUser sets the user recorded in the audit records of a client using a token provider to the user the engine
+// has authenticated the client as. Clients logging in with a username record that username instead.
+func (o *oVirtClient) setTokenUser(creds credentials) {
+	if creds.tokens == nil || o.auditSink == nil {
+		return
+	}
+	username, err := o.authenticatedUserName()
+	if err != nil {
+		o.logger.Warningf("Failed to look up the user of the access token, audit records will not contain it. (%v)", err)
+		return
+	}
+	o.username = username
+}
+
+// authenticatedUserName returns the name of the user the engine has authenticated the client as, for example
+// admin@internal-authz.
+func (o *oVirtClient) authenticatedUserName(retries ...RetryStrategy) (result string, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	var userID string
+	err = o.retry(
+		resourceOf(ResourceTypeUser, ""),
+		"looking up the authenticated user",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().Get().Send()
+			if e != nil {
+				return e
+			}
+			api, ok := response.Api()
+			if !ok {
+				return newError(EFieldMissing, "no API information returned when looking up the authenticated user")
+			}
+			sdkUser, ok := api.AuthenticatedUser()
+			if !ok {
+				return newFieldNotFound("API", "authenticated user")
+			}
+			userID, ok = sdkUser.Id()
+			if !ok {
+				return newFieldNotFound("authenticated user", "ID")
+			}
+			return nil
+		})
+	if err != nil {
+		return "", err
+	}
+	user, err := o.GetUser(userID, retries...)
+	if err != nil {
+		return "", err
+	}
+	return user.UserName(), nil
+}
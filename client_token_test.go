@@ -0,0 +1,222 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// TestTokenProviderRefreshesRejectedToken tests that a client created using NewWithTokenProvider sends the token of
+// the provider as a bearer token, obtains a new token if the engine rejects it and never logs in using SSO.
+func TestTokenProviderRefreshesRejectedToken(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	logins := 0
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch r.URL.Path {
+		case "/ovirt-engine/sso/oauth/token":
+			logins++
+			w.WriteHeader(http.StatusForbidden)
+		case "/ovirt-engine/api":
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(`<api></api>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer engine.Close()
+
+	tokenProvider := &countingTokenProvider{lock: &sync.Mutex{}}
+	client, err := ovirtclient.NewWithTokenProvider(
+		engine.URL+"/ovirt-engine/api",
+		tokenProvider,
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("the call did not succeed with the refreshed token (%v)", err)
+	}
+
+	if calls := tokenProvider.getCalls(); calls != 2 {
+		t.Fatalf("incorrect number of token requests (expected: 2, got: %d)", calls)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if logins != 0 {
+		t.Fatalf("the client logged in using SSO despite the token provider (%d logins)", logins)
+	}
+}
+
+// TestTokenProviderRefreshesTokenBeforeExpiry tests that the client replaces a token that is about to expire before
+// sending it, so the engine does not reject the call and the call is not repeated.
+func TestTokenProviderRefreshesTokenBeforeExpiry(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	rejected := 0
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.URL.Path != "/ovirt-engine/api" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			rejected++
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(`<api></api>`))
+	}))
+	defer engine.Close()
+
+	tokenProvider := &countingTokenProvider{
+		lock: &sync.Mutex{},
+		expiry: func(call int) time.Time {
+			if call == 1 {
+				// Expires within the refresh margin of the client.
+				return time.Now().Add(10 * time.Second)
+			}
+			return time.Now().Add(time.Hour)
+		},
+	}
+	client, err := ovirtclient.NewWithTokenProvider(
+		engine.URL+"/ovirt-engine/api",
+		tokenProvider,
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("the call did not succeed with the refreshed token (%v)", err)
+	}
+
+	if calls := tokenProvider.getCalls(); calls != 2 {
+		t.Fatalf("incorrect number of token requests (expected: 2, got: %d)", calls)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if rejected != 0 {
+		t.Fatalf("the client sent the expiring token instead of refreshing it (%d rejected calls)", rejected)
+	}
+}
+
+// TestTokenProviderAuditUser tests that the audit records of a client created using NewWithTokenProvider contain the
+// user the engine has authenticated the client as.
+func TestTokenProviderAuditUser(t *testing.T) {
+	t.Parallel()
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		switch r.URL.Path {
+		case "/ovirt-engine/api":
+			_, _ = w.Write([]byte(`<api><authenticated_user href="/ovirt-engine/api/users/user1" id="user1"/></api>`))
+		case "/ovirt-engine/api/users/user1":
+			_, _ = w.Write([]byte(`<user id="user1"><user_name>operator@example-authz</user_name></user>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer engine.Close()
+
+	sink := &tokenAuditSink{lock: &sync.Mutex{}}
+	client, err := ovirtclient.NewWithTokenProvider(
+		engine.URL+"/ovirt-engine/api",
+		&countingTokenProvider{lock: &sync.Mutex{}},
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithAuditSink(sink),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.RemoveTag("tag", ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("removing a tag unknown to the engine did not fail")
+	}
+
+	records := sink.getRecords()
+	if len(records) != 1 {
+		t.Fatalf("incorrect number of audit records (expected: 1, got: %d)", len(records))
+	}
+	if user := records[0].User(); user != "operator@example-authz" {
+		t.Fatalf("incorrect user in the audit record (expected: operator@example-authz, got: %s)", user)
+	}
+}
+
+func TestTokenProviderError(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.NewWithTokenProvider(
+		"https://engine.example.com/ovirt-engine/api",
+		&countingTokenProvider{lock: &sync.Mutex{}, err: fmt.Errorf("identity provider unavailable")},
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EAccessDenied) {
+		t.Fatalf("a failing token provider returned an unexpected error (%v)", err)
+	}
+}
+
+// countingTokenProvider returns a new token on each call, numbered from token-1. The expiry function returns the
+// expiry of each token. If it is nil, the expiry is unknown.
+type countingTokenProvider struct {
+	lock   *sync.Mutex
+	calls  int
+	err    error
+	expiry func(call int) time.Time
+}
+
+func (c *countingTokenProvider) Token() (string, time.Time, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.err != nil {
+		return "", time.Time{}, c.err
+	}
+	c.calls++
+	var expiry time.Time
+	if c.expiry != nil {
+		expiry = c.expiry(c.calls)
+	}
+	return fmt.Sprintf("token-%d", c.calls), expiry, nil
+}
+
+func (c *countingTokenProvider) getCalls() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.calls
+}
+
+type tokenAuditSink struct {
+	lock    *sync.Mutex
+	records []ovirtclient.AuditRecord
+}
+
+func (s *tokenAuditSink) Record(record ovirtclient.AuditRecord) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.records = append(s.records, record)
+}
+
+func (s *tokenAuditSink) getRecords() []ovirtclient.AuditRecord {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]ovirtclient.AuditRecord{}, s.records...)
+}
@@ -0,0 +1,45 @@
+package ovirtclient
+
+import (
+	"context"
+)
+
+// Tracer creates spans around the calls the client makes to the oVirt Engine, so they appear in distributed traces.
+// An OpenTelemetry tracer is provided in the separate github.com/ovirt/go-ovirt-client/otel module. A tracer can be
+// passed to the client using ExtraSettingsV4.
+//
+// The tracer is called from multiple goroutines concurrently and must therefore be thread-safe.
+type Tracer interface {
+	// StartSpan is called before an API call is started, including all its retries. The ctx is the context passed to
+	// the call using ContextStrategy, or context.Background() if no context was passed, and can be used to find the
+	// parent span. The operation is the name of the client function making the call, for example "RemoveVM". The
+	// action is a human-readable description of the call including the IDs of the objects involved, for example
+	// "removing VM 1234". The resourceType and resourceID identify the object the call works on, see
+	// AuditRecord.ResourceType and AuditRecord.ResourceID. The resourceID is empty for calls listing or creating
+	// top-level objects, such as ListVMs.
+	StartSpan(
+		ctx context.Context,
+		operation string,
+		action string,
+		resourceType ResourceType,
+		resourceID string,
+	) TraceSpan
+}
+
+// TraceSpan is a span created by a Tracer.
+type TraceSpan interface {
+	// End is called when the API call has finished. The err is the error returned from the call, or nil if the call
+	// was successful.
+	End(err error)
+}
+
+// retryContext returns the first context passed along with the retries using ContextStrategy, or context.Background()
+// if none was passed.
+func retryContext(retries []RetryStrategy) context.Context {
+	for _, r := range retries {
+		if c, ok := r.Get().(contextRetryInstance); ok {
+			return c.Context()
+		}
+	}
+	return context.Background()
+}
@@ -0,0 +1,91 @@
+// This file contains tests for the internal tracing functionality. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"context"
+	"testing"
+)
+
+type testTracerKey struct{}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) StartSpan(
+	ctx context.Context,
+	operation string,
+	action string,
+	resourceType ResourceType,
+	resourceID string,
+) TraceSpan {
+	span := &testSpan{
+		parent:       ctx.Value(testTracerKey{}),
+		operation:    operation,
+		action:       action,
+		resourceType: resourceType,
+		resourceID:   resourceID,
+	}
+	t.spans = append(t.spans, span)
+	return span
+}
+
+type testSpan struct {
+	parent       interface{}
+	operation    string
+	action       string
+	resourceType ResourceType
+	resourceID   string
+	ended        bool
+	err          error
+}
+
+func (t *testSpan) End(err error) {
+	t.ended = true
+	t.err = err
+}
+
+func TestTracerCreatesSpanPerCall(t *testing.T) {
+	t.Parallel()
+	tracer := &testTracer{}
+	o := &oVirtClient{
+		logger: &noopLogger{},
+		tracer: tracer,
+	}
+	ctx := context.WithValue(context.Background(), testTracerKey{}, "parent")
+	err := o.retry(
+		resourceOf(ResourceTypeVM, "vm1"),
+		"testing tracing",
+		[]RetryStrategy{ContextStrategy(ctx), AutoRetry()},
+		func() error {
+			return newError(ENotFound, "test failure")
+		},
+	)
+	if err == nil {
+		t.Fatalf("retry on a failing call did not return with an error")
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("incorrect number of spans (expected: 1, got: %d)", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.parent != "parent" {
+		t.Fatalf("the span was not started with the context passed to the call")
+	}
+	if span.operation != "TestTracerCreatesSpanPerCall" {
+		t.Fatalf("incorrect operation on span: %s", span.operation)
+	}
+	if span.action != "testing tracing" {
+		t.Fatalf("incorrect action on span: %s", span.action)
+	}
+	if span.resourceType != ResourceTypeVM || span.resourceID != "vm1" {
+		t.Fatalf("incorrect resource on span: %s %s", span.resourceType, span.resourceID)
+	}
+	if !span.ended {
+		t.Fatalf("the span was not ended")
+	}
+	if !HasErrorCode(span.err, ENotFound) {
+		t.Fatalf("the span did not receive the error of the call (%v)", span.err)
+	}
+}
@@ -0,0 +1,185 @@
+package ovirtclient
+
+import (
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// UserClient contains the functions to look up the users known to the engine and the users of the directory services
+// (authorization domains) configured in the engine. A user must be known to the engine before permissions can be
+// granted to them.
+type UserClient interface {
+	// ListUsers lists the users known to the engine.
+	ListUsers(retries ...RetryStrategy) ([]User, error)
+	// GetUser returns the user with the specified ID.
+	GetUser(id string, retries ...RetryStrategy) (User, error)
+	// GetUserByName returns the user with the specified name. The name can be either the user name including the
+	// authorization domain, for example admin@internal-authz, or only the principal, for example admin. If only the
+	// principal is given and it exists in several authorization domains, an EConflict error is returned.
+	GetUserByName(name string, retries ...RetryStrategy) (User, error)
+	// ListDirectoryDomains lists the directory services (authorization domains) configured in the engine.
+	ListDirectoryDomains(retries ...RetryStrategy) ([]DirectoryDomain, error)
+	// ListDirectoryUsers lists the users in a directory service, including the ones not yet known to the engine. The
+	// search is passed to the directory service and supports wildcards, such as "usrname=adm*". An empty search lists
+	// all users.
+	ListDirectoryUsers(domainID string, search string, retries ...RetryStrategy) ([]UserData, error)
+}
+
+// UserData contains the data of a user.
+type UserData interface {
+	// ID returns the identifier of the user. For users only listed in a directory service this is the ID in the
+	// directory service.
+	ID() string
+	// UserName returns the name of the user including the authorization domain, for example admin@internal-authz.
+	UserName() string
+	// Principal returns the name of the user in the directory service, for example admin.
+	Principal() string
+	// Name returns the first name of the user.
+	Name() string
+	// LastName returns the last name of the user.
+	LastName() string
+	// Email returns the e-mail address of the user.
+	Email() string
+	// DomainName returns the name of the authorization domain the user belongs to.
+	DomainName() string
+}
+
+// User is a user known to the engine.
+type User interface {
+	UserData
+
+	// PermissionPrincipal returns the principal to use for granting permissions to the user.
+	PermissionPrincipal() PermissionPrincipal
+}
+
+// DirectoryDomain is a directory service (authorization domain) configured in the engine.
+type DirectoryDomain interface {
+	// ID returns the identifier of the directory service.
+	ID() string
+	// Name returns the name of the directory service, for example internal-authz.
+	Name() string
+}
+
+// findUserByName returns the user matching the name as described in UserClient.GetUserByName.
+func findUserByName(users []User, name string) (User, error) {
+	var result User
+	for _, u := range users {
+		if u.UserName() == name {
+			return u, nil
+		}
+		if strings.Contains(name, "@") || u.Principal() != name {
+			continue
+		}
+		if result != nil {
+			return nil, newError(
+				EConflict,
+				"multiple users with the principal %s found, please specify the authorization domain",
+				name,
+			)
+		}
+		result = u
+	}
+	if result == nil {
+		return nil, newError(ENotFound, "no user with the name %s found", name)
+	}
+	return result, nil
+}
+
+func convertSDKUser(sdkObject *ovirtsdk.User, client Client) (*user, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("user", "ID")
+	}
+	userName, ok := sdkObject.UserName()
+	if !ok {
+		return nil, newFieldNotFound("user", "user name")
+	}
+	principal, _ := sdkObject.Principal()
+	name, _ := sdkObject.Name()
+	lastName, _ := sdkObject.LastName()
+	email, _ := sdkObject.Email()
+	var domainName string
+	if sdkDomain, ok := sdkObject.Domain(); ok {
+		domainName, _ = sdkDomain.Name()
+	}
+	return &user{
+		client:     client,
+		id:         id,
+		userName:   userName,
+		principal:  principal,
+		name:       name,
+		lastName:   lastName,
+		email:      email,
+		domainName: domainName,
+	}, nil
+}
+
+func convertSDKDirectoryDomain(sdkObject *ovirtsdk.Domain) (*directoryDomain, error) {
+	id, ok := sdkObject.Id()
+	if !ok {
+		return nil, newFieldNotFound("directory domain", "ID")
+	}
+	name, ok := sdkObject.Name()
+	if !ok {
+		return nil, newFieldNotFound("directory domain", "name")
+	}
+	return &directoryDomain{id, name}, nil
+}
+
+type user struct {
+	client Client
+
+	id         string
+	userName   string
+	principal  string
+	name       string
+	lastName   string
+	email      string
+	domainName string
+}
+
+func (u *user) ID() string {
+	return u.id
+}
+
+func (u *user) UserName() string {
+	return u.userName
+}
+
+func (u *user) Principal() string {
+	return u.principal
+}
+
+func (u *user) Name() string {
+	return u.name
+}
+
+func (u *user) LastName() string {
+	return u.lastName
+}
+
+func (u *user) Email() string {
+	return u.email
+}
+
+func (u *user) DomainName() string {
+	return u.domainName
+}
+
+func (u *user) PermissionPrincipal() PermissionPrincipal {
+	return UserPrincipal(u.id)
+}
+
+type directoryDomain struct {
+	id   string
+	name string
+}
+
+func (d *directoryDomain) ID() string {
+	return d.id
+}
+
+func (d *directoryDomain) Name() string {
+	return d.name
+}
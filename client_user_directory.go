@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListDirectoryDomains(retries ...RetryStrategy) (result []DirectoryDomain, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []DirectoryDomain{}
+	err = o.retry(
+		resourceOf(ResourceTypeDirectoryDomain, ""),
+		"listing directory domains",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().DomainsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Domains()
+			if !ok {
+				return nil
+			}
+			result = make([]DirectoryDomain, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKDirectoryDomain(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert directory domain during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) ListDirectoryUsers(domainID string, search string, retries ...RetryStrategy) (
+	result []UserData,
+	err error,
+) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []UserData{}
+	err = o.retry(
+		resourceOf(ResourceTypeDirectoryDomain, domainID),
+		fmt.Sprintf("listing users of directory domain %s", domainID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().DomainsService().DomainService(domainID).UsersService().List()
+			if search != "" {
+				req.Search(search)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Users()
+			if !ok {
+				return nil
+			}
+			result = make([]UserData, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKUser(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert directory user during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
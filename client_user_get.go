@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetUser(id string, retries ...RetryStrategy) (result User, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeUser, id),
+		fmt.Sprintf("getting user %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().UsersService().UserService(id).Get().Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.User()
+			if !ok {
+				return newError(ENotFound, "no user returned when getting user ID %s", id)
+			}
+			result, e = convertSDKUser(sdkObject, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert user %s", id)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (o *oVirtClient) ListUsers(retries ...RetryStrategy) (result []User, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []User{}
+	err = o.retry(
+		resourceOf(ResourceTypeUser, ""),
+		"listing users",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().UsersService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Users()
+			if !ok {
+				return nil
+			}
+			result = make([]User, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKUser(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert user during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
+
+func (o *oVirtClient) GetUserByName(name string, retries ...RetryStrategy) (User, error) {
+	users, err := o.ListUsers(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findUserByName(users, name)
+}
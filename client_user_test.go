@@ -0,0 +1,76 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestGetUserByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	user, err := client.GetUserByName("admin")
+	if err != nil {
+		t.Fatalf("failed to find admin user (%v)", err)
+	}
+	if user.Principal() != "admin" {
+		t.Fatalf("incorrect principal for admin user: %s", user.Principal())
+	}
+	fetchedUser, err := client.GetUserByName(user.UserName())
+	if err != nil {
+		t.Fatalf("failed to find admin user by user name %s (%v)", user.UserName(), err)
+	}
+	if fetchedUser.ID() != user.ID() {
+		t.Fatalf("incorrect user returned for user name %s", user.UserName())
+	}
+	if _, err := client.GetUserByName("nonexistent"); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("finding a nonexistent user did not return a not found error (%v)", err)
+	}
+}
+
+func TestListDirectoryUsers(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	domains, err := client.ListDirectoryDomains()
+	if err != nil {
+		t.Fatalf("failed to list directory domains (%v)", err)
+	}
+	var internalDomain ovirtclient.DirectoryDomain
+	for _, domain := range domains {
+		if domain.Name() == "internal-authz" {
+			internalDomain = domain
+		}
+	}
+	if internalDomain == nil {
+		t.Skipf("the internal-authz directory domain is not configured")
+	}
+	users, err := client.ListDirectoryUsers(internalDomain.ID(), "usrname=adm*")
+	if err != nil {
+		t.Fatalf("failed to list directory users (%v)", err)
+	}
+	for _, user := range users {
+		if user.Principal() == "admin" {
+			return
+		}
+	}
+	t.Fatalf("the admin user was not found in the internal-authz directory domain")
+}
+
+func TestGetGroupByName(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	group, err := client.GetGroupByName("Everyone")
+	if err != nil {
+		t.Fatalf("failed to find the Everyone group (%v)", err)
+	}
+	fetchedGroup, err := client.GetGroup(group.ID())
+	if err != nil {
+		t.Fatalf("failed to fetch group %s (%v)", group.ID(), err)
+	}
+	if fetchedGroup.Name() != "Everyone" {
+		t.Fatalf("incorrect name for group %s: %s", group.ID(), fetchedGroup.Name())
+	}
+}
@@ -22,12 +22,17 @@ import (
 //         Query("correlation_id", correlationID).
 //         Send()
 func (o *oVirtClient) waitForJobFinished(correlationID string, retries []RetryStrategy) error {
-	return retry(
+	return o.retry(
+		resourceOf(ResourceTypeJob, ""),
 		fmt.Sprintf("waiting for job with correlation ID %s to finish", correlationID),
-		o.logger,
 		retries,
 		func() error {
-			jobResp, err := o.conn.SystemService().JobsService().List().Search(fmt.Sprintf("correlation_id=%s", correlationID)).Send()
+			jobResp, err := o.connection().
+				SystemService().
+				JobsService().
+				List().
+				Search(fmt.Sprintf("correlation_id=%s", correlationID)).
+				Send()
 			if err != nil {
 				return err
 			}
@@ -2,78 +2,256 @@ package ovirtclient
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
-//go:generate go run scripts/rest.go -i "Vm" -n "vm" -o "VM"
+//go:generate go run scripts/rest.go -i "Vm" -n "vm" -o "VM" -T "VMID"
+
+// VMID is an identifier for a virtual machine. It has a special type so the compiler can catch errors when the VM ID
+// is erroneously passed elsewhere.
+type VMID string
 
 // VMClient includes the methods required to deal with virtual machines.
 type VMClient interface {
 	// CreateVM creates a virtual machine.
 	CreateVM(
-		clusterID string,
+		clusterID ClusterID,
+		templateID TemplateID,
+		name string,
+		optional OptionalVMParameters,
+		retries ...RetryStrategy,
+	) (VM, error)
+	// EnsureVM returns the VM with the specified name if it exists, or creates it using CreateVM otherwise. This makes
+	// it easy to write idempotent controllers. If a VM with the name exists, but is in a different cluster or was
+	// created from a different template, an EConflict error is returned. The optional parameters are only used when
+	// creating the VM and are not compared to the existing VM.
+	EnsureVM(
+		clusterID ClusterID,
 		templateID TemplateID,
 		name string,
 		optional OptionalVMParameters,
 		retries ...RetryStrategy,
 	) (VM, error)
+	// ProvisionVM creates a VM from the blueprint together with its disks, NICs and tags, and optionally starts it.
+	// Use NewVMBlueprint to create the blueprint. If any step fails, the resources created so far are removed and the
+	// error of the failed step is returned. Failures during this rollback are only logged. If the VM is started, it
+	// is fetched again after starting it, so the returned VM reflects the start. Since the VM takes time to boot, use
+	// WaitForVMStatus to wait for it to come up.
+	ProvisionVM(blueprint VMBlueprint, retries ...RetryStrategy) (VM, error)
+	// ImportExternalVM imports a VM from an external hypervisor, such as VMware, KVM or Xen, into the specified
+	// cluster and storage domain using virt-v2v, waits for the import to finish and returns the imported VM. The
+	// conversion runs on a host of the cluster and can take a long time, so pass suitable retries. The params may be
+	// nil. Use ImportExternalVMParams to obtain a builder for the params.
+	ImportExternalVM(
+		provider ExternalVMProviderType,
+		url string,
+		sourceVMName string,
+		clusterID ClusterID,
+		storageDomainID string,
+		params ImportExternalVMOptionalParameters,
+		retries ...RetryStrategy,
+	) (VM, error)
+	// ValidateVMCreation checks the parameters of a CreateVM call without creating the VM. Apart from validating the
+	// parameters themselves, it verifies that the cluster and the template exist, that the template is not locked and
+	// that no VM with the same name exists. The returned error describes the first problem found, so it can be
+	// reported to the user before sending the create request.
+	ValidateVMCreation(
+		clusterID ClusterID,
+		templateID TemplateID,
+		name string,
+		optional OptionalVMParameters,
+		retries ...RetryStrategy,
+	) error
 	// GetVM returns a single virtual machine based on an ID.
-	GetVM(id string, retries ...RetryStrategy) (VM, error)
+	GetVM(id VMID, retries ...RetryStrategy) (VM, error)
+	// GetVMWithParams returns a single virtual machine based on an ID. The params can be used to embed
+	// sub-resources, such as NICs, in the returned VM. Use VMGetParams to obtain a builder for the params.
+	GetVMWithParams(id VMID, params VMGetParameters, retries ...RetryStrategy) (VM, error)
+	// ListVMDevices returns the devices of the VM known to the engine, such as disks, NICs, CD-ROM drives,
+	// watchdogs, graphics consoles and the memory balloon. The engine does not expose controllers through its API,
+	// so they are not listed.
+	ListVMDevices(id VMID, retries ...RetryStrategy) ([]VMDevice, error)
+	// ListVMNUMANodes returns the virtual NUMA nodes of the VM together with the host NUMA nodes they are pinned to.
+	// The virtual NUMA nodes are set using the NUMANodes parameter of CreateVM and UpdateVM.
+	ListVMNUMANodes(id VMID, retries ...RetryStrategy) ([]VMNUMANode, error)
+	// GetVMMemoryStatistics returns the current memory statistics of the VM, including the memory usage reported by
+	// the guest agent. The engine does not report the state of the memory balloon through its API.
+	GetVMMemoryStatistics(id VMID, retries ...RetryStrategy) (VMMemoryStatistics, error)
 	// UpdateVM updates the virtual machine with the given parameters.
 	// Use UpdateVMParams to obtain a builder for the params.
-	UpdateVM(id string, params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
-	// SetVMOptimizePinningSettings sets the CPU settings to optimized.
-	AutoOptimizeVMCPUPinningSettings(id string, optimize bool, retries ...RetryStrategy) error
+	UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
+	// SetVMOptimizePinningSettings sets the CPU settings to optimized. It returns an EUnsupported error if the engine
+	// does not support EngineFeatureAutoPinning.
+	AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, retries ...RetryStrategy) error
 	// StartVM triggers a VM start. The actual VM startup will take time and should be waited for via the
 	// WaitForVMStatus call.
-	StartVM(id string, retries ...RetryStrategy) error
+	StartVM(id VMID, retries ...RetryStrategy) error
+	// StartVMWithParams works like StartVM, but the params can be used to run the cloud-init or sysprep
+	// initialization configured on the VM on this boot, for example on the first start after creation. Use
+	// StartVMParams to obtain a builder for the params.
+	StartVMWithParams(id VMID, params StartVMOptionalParameters, retries ...RetryStrategy) error
 	// StopVM triggers a VM power-off. The actual VM stop will take time and should be waited for via the
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
-	StopVM(id string, force bool, retries ...RetryStrategy) error
+	StopVM(id VMID, force bool, retries ...RetryStrategy) error
 	// ShutdownVM triggers a VM shutdown. The actual VM shutdown will take time and should be waited for via the
 	// WaitForVMStatus call. The force parameter will cause the shutdown to proceed even if a backup is currently
 	// running.
-	ShutdownVM(id string, force bool, retries ...RetryStrategy) error
-	// WaitForVMStatus waits for the VM to reach the desired status.
-	WaitForVMStatus(id string, status VMStatus, retries ...RetryStrategy) (VM, error)
+	ShutdownVM(id VMID, force bool, retries ...RetryStrategy) error
+	// ShutdownVMGracefully triggers a VM shutdown and waits for the VM to go down. If the VM is not down within
+	// timeout, for example because the guest operating system ignores the ACPI shutdown request, the VM is powered
+	// off using StopVM with force. The VM is returned in the down state together with the method that brought it
+	// down: VMShutdownMethodNone if it was already down, VMShutdownMethodShutdown if it shut down within the timeout,
+	// or VMShutdownMethodPowerOff if it had to be powered off.
+	ShutdownVMGracefully(id VMID, timeout time.Duration, retries ...RetryStrategy) (VM, VMShutdownMethod, error)
+	// WaitForVMStatus waits for the VM to reach the desired status. Pass ContextStrategy to stop polling once a
+	// context has ended. A status query that is already in flight is finished first.
+	WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (VM, error)
+	// WaitForVM waits until the condition returns true for the VM and returns the VM. The condition is called with
+	// an up to date copy of the VM on each attempt. If the condition returns an error, waiting is aborted unless the
+	// error is retryable, see IsRetryable.
+	WaitForVM(id VMID, condition VMCondition, retries ...RetryStrategy) (VM, error)
+	// WaitForVMIPAddresses waits until the guest agent of the VM reports at least one usable IP address and returns
+	// the addresses grouped by the name of the guest network interface. Loopback, link-local and unspecified
+	// addresses are ignored. The filter can be used to ignore further interfaces, see ExcludeReportedDeviceNames. It
+	// may be nil to consider all interfaces.
+	WaitForVMIPAddresses(
+		id VMID,
+		filter ReportedDeviceFilter,
+		retries ...RetryStrategy,
+	) (map[string][]net.IP, error)
+	// WatchVM polls the VM every pollInterval and delivers a change on the returned watch when the VM is first
+	// fetched, when it changes and when it is removed. Failed polls are repeated with an increasing interval. The
+	// retries are used for each poll. The watch must be closed when no longer needed.
+	WatchVM(id VMID, pollInterval time.Duration, retries ...RetryStrategy) (VMWatch, error)
+	// WatchVMs works like WatchVM, but watches all VMs matching the search params. A VM that no longer matches the
+	// search is delivered as removed.
+	WatchVMs(params VMSearchParameters, pollInterval time.Duration, retries ...RetryStrategy) (VMWatch, error)
 	// ListVMs returns a list of all virtual machines.
 	ListVMs(retries ...RetryStrategy) ([]VM, error)
+	// ListVMsWithParams returns a list of all virtual machines. The params can be used to embed sub-resources, such as
+	// NICs, in the returned VMs in a single API call. Use VMGetParams to obtain a builder for the params.
+	ListVMsWithParams(params VMGetParameters, retries ...RetryStrategy) ([]VM, error)
+	// ListVMSummaries returns the ID, name and status of all virtual machines. It skips converting the remaining VM
+	// fields, which makes it considerably faster than ListVMs for dashboards listing thousands of VMs. The engine
+	// has no way to select fields, so the size of the response is the same as for ListVMs.
+	ListVMSummaries(retries ...RetryStrategy) ([]VMSummary, error)
+	// ListVMsPage returns a single page of virtual machines as specified in params. Use this function instead of
+	// ListVMs on large installations to avoid fetching all virtual machines at once.
+	ListVMsPage(params PageParameters, retries ...RetryStrategy) ([]VM, error)
+	// ListVMsIterator returns an iterator that fetches the virtual machines page by page as it advances, keeping
+	// at most one page of pageSize VMs in memory. If pageSize is 0, the engine default page size of 100 is used.
+	ListVMsIterator(pageSize uint, retries ...RetryStrategy) VMIterator
+	// ForEachVM calls the callback for each virtual machine, fetching them page by page like ListVMsIterator. If the
+	// callback returns an error, iteration stops and the error is returned.
+	ForEachVM(pageSize uint, callback func(vm VM) error, retries ...RetryStrategy) error
 	// SearchVMs lists all virtual machines matching a certain criteria specified in params.
 	SearchVMs(params VMSearchParameters, retries ...RetryStrategy) ([]VM, error)
 	// RemoveVM removes a virtual machine specified by id.
-	RemoveVM(id string, retries ...RetryStrategy) error
+	RemoveVM(id VMID, retries ...RetryStrategy) error
+	// RemoveVMWithParams removes a virtual machine specified by id. The params can be used to only detach the disks
+	// of the VM, so data disks survive the VM, for example when replacing it. Use RemoveVMParams to obtain a builder
+	// for the params.
+	RemoveVMWithParams(id VMID, params RemoveVMOptionalParameters, retries ...RetryStrategy) error
+	// ExportVM exports the VM with its disks to an export storage domain and waits for the export to finish. The VM
+	// must be down. The export fails if the VM already exists on the export domain.
+	ExportVM(id VMID, storageDomainID string, retries ...RetryStrategy) error
+	// StartDownloadVMDisks starts downloading all disks attached to the VM to files in targetDirectory, which must
+	// exist. Each disk is downloaded in its own format to a file named after the disk ID, with a .raw or .qcow2
+	// extension. Existing files are not overwritten. The disks are downloaded one after the other in the background,
+	// the progress can be tracked using the returned VMDisksDownloadProgress.
+	StartDownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) (VMDisksDownloadProgress, error)
+	// DownloadVMDisks runs StartDownloadVMDisks and waits for all disks to be downloaded.
+	DownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) ([]VMDiskFile, error)
 	// AddTagToVM Add tag specified by id to a VM.
-	AddTagToVM(id string, tagID string, retries ...RetryStrategy) error
-}
+	AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy) error
+	// RemoveTagFromVM removes the tag specified by tagID from a VM. It returns an ENotFound error if the tag is not
+	// assigned to the VM.
+	RemoveTagFromVM(id VMID, tagID TagID, retries ...RetryStrategy) error
+	// StartVMs calls StartVM for all VMs specified in ids in parallel. The params can be used to limit the number
+	// of parallel calls and may be nil. The returned map contains the result for each ID, with nil indicating
+	// success.
+	StartVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error
+	// StopVMs calls StopVM for all VMs specified in ids in parallel. The params can be used to limit the number
+	// of parallel calls and may be nil. The returned map contains the result for each ID, with nil indicating
+	// success.
+	StopVMs(ids []VMID, force bool, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error
+	// RemoveVMs calls RemoveVM for all VMs specified in ids in parallel. The params can be used to limit the number
+	// of parallel calls and may be nil. The returned map contains the result for each ID, with nil indicating
+	// success.
+	RemoveVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error
+	// AssignTagToVMs calls AddTagToVM for all VMs specified in ids in parallel. The params can be used to limit the
+	// number of parallel calls and may be nil. It returns nil if the tag was assigned to all VMs. Otherwise, the
+	// returned error lists each VM the call failed for together with its error, and wraps the error of the first of
+	// these VMs by ID.
+	AssignTagToVMs(tagID TagID, ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) error
+	// UnassignTagFromVMs calls RemoveTagFromVM for all VMs specified in ids in parallel. The params can be used to
+	// limit the number of parallel calls and may be nil. It returns nil if the tag was removed from all VMs.
+	// Otherwise, the returned error lists each VM the call failed for together with its error, and wraps the error of
+	// the first of these VMs by ID.
+	UnassignTagFromVMs(tagID TagID, ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) error
+}
+
+// VMCondition decides if a VM has reached the state WaitForVM is waiting for, for example if it has an IP address
+// reported by the guest agent.
+type VMCondition func(vm VM) (bool, error)
 
 // VMData is the core of VM providing only data access functions.
 type VMData interface {
 	// ID returns the unique identifier (UUID) of the current virtual machine.
-	ID() string
+	ID() VMID
 	// Name is the user-defined name of the virtual machine.
 	Name() string
 	// Comment is the comment added to the VM.
 	Comment() string
+	// Description is the description of the VM.
+	Description() string
 	// ClusterID returns the cluster this machine belongs to.
-	ClusterID() string
+	ClusterID() ClusterID
 	// TemplateID returns the ID of the base template for this machine.
 	TemplateID() TemplateID
+	// HostID returns the ID of the host the VM is running on, or nil if the VM is not running.
+	HostID() *string
 	// Status returns the current status of the VM.
 	Status() VMStatus
+	// CreationTime returns the time the VM was created. It returns the zero time if the engine did not report it.
+	CreationTime() time.Time
 	// CPU returns the CPU structure of a VM.
 	CPU() VMCPU
 	// TagIDS returns a list of tags for this VM.
-	TagIDs() []string
+	TagIDs() []TagID
 	// HugePages returns the hugepage settings for the VM, if any.
 	HugePages() *VMHugePages
 	// Initialization returns the virtual machine’s initialization configuration.
 	Initialization() Initialization
+	// QuotaID returns the ID of the quota the VM is assigned to, or an empty string if the engine did not report one.
+	QuotaID() QuotaID
+	// InstanceTypeID returns the ID of the instance type the VM was created with, or an empty string if the VM has
+	// no instance type.
+	InstanceTypeID() InstanceTypeID
+	// NextRunConfigurationExists returns true if the VM has configuration changes that only take effect after the
+	// next restart, for example after the compatibility version of its cluster was upgraded.
+	NextRunConfigurationExists() bool
+	// NUMATuneMode returns how the memory of the virtual NUMA nodes is allocated on the pinned host NUMA nodes. It
+	// returns an empty string if the engine did not report it.
+	NUMATuneMode() NUMATuneMode
+	// EmbeddedNICs returns the network interfaces of the VM if they were requested using VMFollowNICs, nil
+	// otherwise.
+	EmbeddedNICs() []NIC
+	// EmbeddedDiskAttachments returns the disk attachments of the VM if they were requested using
+	// VMFollowDiskAttachments, nil otherwise.
+	EmbeddedDiskAttachments() []DiskAttachment
+	// EmbeddedReportedDevices returns the devices reported by the guest agent if they were requested using
+	// VMFollowReportedDevices, nil otherwise.
+	EmbeddedReportedDevices() []ReportedDevice
 }
 
 // VMCPU is the CPU configuration of a VM.
@@ -148,6 +326,8 @@ func VMHugePagesValues() VMHugePagesList {
 type Initialization interface {
 	CustomScript() string
 	HostName() string
+	// NICConfigurations returns the network configuration of the guest network interfaces passed to cloud-init.
+	NICConfigurations() []NICConfiguration
 }
 
 // BuildableInitialization is a buildable version of Initialization.
@@ -155,14 +335,16 @@ type BuildableInitialization interface {
 	Initialization
 	WithCustomScript(customScript string) BuildableInitialization
 	WithHostname(hostname string) BuildableInitialization
+	WithNICConfigurations(nicConfigurations ...NICConfiguration) BuildableInitialization
 }
 
 // initialization defines to the virtual machine’s initialization configuration.
 // customScript - Cloud-init script which will be executed on Virtual Machine when deployed.
 // hostname - Hostname to be set to Virtual Machine when deployed.
 type initialization struct {
-	customScript string
-	hostname     string
+	customScript      string
+	hostname          string
+	nicConfigurations []NICConfiguration
 }
 
 // NewInitialization creates a new Initialization from the specified parameters.
@@ -181,6 +363,10 @@ func (i *initialization) HostName() string {
 	return i.hostname
 }
 
+func (i *initialization) NICConfigurations() []NICConfiguration {
+	return i.nicConfigurations
+}
+
 func (i *initialization) WithCustomScript(customScript string) BuildableInitialization {
 	i.customScript = customScript
 	return i
@@ -191,6 +377,27 @@ func (i *initialization) WithHostname(hostname string) BuildableInitialization {
 	return i
 }
 
+func (i *initialization) WithNICConfigurations(nicConfigurations ...NICConfiguration) BuildableInitialization {
+	i.nicConfigurations = nicConfigurations
+	return i
+}
+
+// clone creates a deep copy of the initialization, including the NIC configurations.
+func (i *initialization) clone() *initialization {
+	result := *i
+	if i.nicConfigurations != nil {
+		result.nicConfigurations = make([]NICConfiguration, len(i.nicConfigurations))
+		for j, config := range i.nicConfigurations {
+			if n, ok := config.(*nicConfiguration); ok && n != nil {
+				result.nicConfigurations[j] = n.clone()
+			} else {
+				result.nicConfigurations[j] = config
+			}
+		}
+	}
+	return &result
+}
+
 // convertSDKInitialization converts the initialization of a VM. We keep the error return in case we need it later
 // as errors may happen as we extend this function and we don't want to touch other functions.
 func convertSDKInitialization(sdkObject *ovirtsdk.Vm) (*initialization, error) { //nolint:unparam
@@ -209,6 +416,11 @@ func convertSDKInitialization(sdkObject *ovirtsdk.Vm) (*initialization, error) {
 	if ok {
 		init.hostname = hostname
 	}
+	if sdkNICConfigurations, ok := initializationSDK.NicConfigurations(); ok {
+		for _, sdkNICConfiguration := range sdkNICConfigurations.Slice() {
+			init.nicConfigurations = append(init.nicConfigurations, convertSDKNICConfiguration(sdkNICConfiguration))
+		}
+	}
 	return &init, nil
 }
 
@@ -221,6 +433,15 @@ type VM interface {
 	Update(params UpdateVMParameters, retries ...RetryStrategy) (VM, error)
 	// Remove removes the current VM. This involves an API call and may be slow.
 	Remove(retries ...RetryStrategy) error
+	// Export exports the VM to an export storage domain.
+	Export(storageDomainID string, retries ...RetryStrategy) error
+	// DownloadDisks downloads all disks attached to the VM to files in targetDirectory. See
+	// VMClient.DownloadVMDisks for details.
+	DownloadDisks(targetDirectory string, retries ...RetryStrategy) ([]VMDiskFile, error)
+	// AddPermission grants the role to the principal on the VM.
+	AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (Permission, error)
+	// ListPermissions lists the permissions that apply to the VM.
+	ListPermissions(retries ...RetryStrategy) ([]Permission, error)
 
 	// Start will cause a VM to start. The actual start process takes some time and should be checked via WaitForStatus.
 	Start(retries ...RetryStrategy) error
@@ -230,21 +451,31 @@ type VM interface {
 	// Shutdown will cause the VM to shut down. The force parameter will cause the VM to shut down even if a backup
 	// is currently running.
 	Shutdown(force bool, retries ...RetryStrategy) error
+	// ShutdownGracefully will cause the VM to shut down and wait for it to go down. If the VM is not down within
+	// timeout, it is powered off. The updated VM object is returned in the down state together with the method that
+	// brought it down.
+	ShutdownGracefully(timeout time.Duration, retries ...RetryStrategy) (VM, VMShutdownMethod, error)
 	// WaitForStatus will wait until the VM reaches the desired status. If the status is not reached within the
 	// specified amount of retries, an error will be returned. If the VM enters the desired state, an updated VM
 	// object will be returned.
 	WaitForStatus(status VMStatus, retries ...RetryStrategy) (VM, error)
+	// WaitForIPAddresses waits until the guest agent reports usable IP addresses for the VM. See
+	// VMClient.WaitForVMIPAddresses for details.
+	WaitForIPAddresses(filter ReportedDeviceFilter, retries ...RetryStrategy) (map[string][]net.IP, error)
 
 	// CreateNIC creates a network interface on the current VM. This involves an API call and may be slow.
 	CreateNIC(name string, vnicProfileID string, params OptionalNICParameters, retries ...RetryStrategy) (NIC, error)
 	// GetNIC fetches a NIC with a specific ID on the current VM. This involves an API call and may be slow.
-	GetNIC(id string, retries ...RetryStrategy) (NIC, error)
+	GetNIC(id NICID, retries ...RetryStrategy) (NIC, error)
 	// ListNICs fetches a list of network interfaces attached to this VM. This involves an API call and may be slow.
 	ListNICs(retries ...RetryStrategy) ([]NIC, error)
+	// RemoveNIC removes the network interface with the specified ID from the current VM. This involves an API call and
+	// may be slow.
+	RemoveNIC(id NICID, retries ...RetryStrategy) error
 
 	// AttachDisk attaches a disk to this VM.
 	AttachDisk(
-		diskID string,
+		diskID DiskID,
 		diskInterface DiskInterface,
 		params CreateDiskAttachmentOptionalParams,
 		retries ...RetryStrategy,
@@ -258,14 +489,31 @@ type VM interface {
 		diskAttachmentID string,
 		retries ...RetryStrategy,
 	) error
-	// Tags list all tags for the current VM
+	// Tags list all tags for the current VM. The tags are fetched using GetTags, so listing the tags of a VM takes at
+	// most one API call regardless of the number of tags.
 	Tags(retries ...RetryStrategy) ([]Tag, error)
+	// ListDevices lists the devices of the current VM. See VMClient.ListVMDevices for details.
+	ListDevices(retries ...RetryStrategy) ([]VMDevice, error)
+	// ListNUMANodes lists the virtual NUMA nodes of the current VM. See VMClient.ListVMNUMANodes for details.
+	ListNUMANodes(retries ...RetryStrategy) ([]VMNUMANode, error)
+	// ListErrata lists the Katello errata applicable to the current VM. See ErrataClient.ListVMErrata for details.
+	ListErrata(retries ...RetryStrategy) ([]Erratum, error)
+	// MemoryStatistics fetches the current memory statistics of the VM. See VMClient.GetVMMemoryStatistics for
+	// details.
+	MemoryStatistics(retries ...RetryStrategy) (VMMemoryStatistics, error)
+
+	// Clone returns a deep copy of the VM, including the embedded NICs, disk attachments and reported devices. The
+	// copy shares no data with the original, so it can be stored and changed independently.
+	Clone() VM
 }
 
 // VMSearchParameters declares the parameters that can be passed to a VM search. Each parameter
 // is declared as a pointer, where a nil value will mean that parameter will not be searched for.
-// All parameters are used together as an AND filter.
+// All parameters are used together as an AND filter. The embedded PageParameters can be used to fetch the results
+// page by page.
 type VMSearchParameters interface {
+	PageParameters
+
 	// Name will match the name of the virtual machine exactly.
 	Name() *string
 	// Tag will match the tag of the virtual machine.
@@ -274,6 +522,17 @@ type VMSearchParameters interface {
 	Statuses() *VMStatusList
 	// NotStatuses will return a list of not acceptable statuses for this VM search.
 	NotStatuses() *VMStatusList
+	// ClusterID will match the VMs in the specified cluster.
+	ClusterID() *ClusterID
+	// TemplateID will match the VMs created from the specified template.
+	TemplateID() *TemplateID
+	// HostID will match the VMs running on the specified host.
+	HostID() *string
+	// Description will match the description of the virtual machine exactly.
+	Description() *string
+	// RawQuery returns a query in the engine search syntax, for example "cluster=prod and memory>4096". It is
+	// combined with the other parameters using AND.
+	RawQuery() *string
 }
 
 // BuildableVMSearchParameters is a buildable version of VMSearchParameters.
@@ -292,6 +551,21 @@ type BuildableVMSearchParameters interface {
 	WithStatuses(list VMStatusList) BuildableVMSearchParameters
 	// WithNotStatuses will return the statuses the returned VMs should not be in.
 	WithNotStatuses(list VMStatusList) BuildableVMSearchParameters
+	// WithClusterID sets the cluster the returned VMs should be in.
+	WithClusterID(clusterID ClusterID) BuildableVMSearchParameters
+	// WithTemplateID sets the template the returned VMs should be created from.
+	WithTemplateID(templateID TemplateID) BuildableVMSearchParameters
+	// WithHostID sets the host the returned VMs should be running on.
+	WithHostID(hostID string) BuildableVMSearchParameters
+	// WithDescription sets the description to search for.
+	WithDescription(description string) BuildableVMSearchParameters
+	// WithRawQuery sets a query in the engine search syntax, for example "cluster=prod and memory>4096". This allows
+	// for using search criteria that are not yet supported by the typed parameters.
+	WithRawQuery(query string) BuildableVMSearchParameters
+	// WithMax sets the maximum number of VMs to return. It must be at least 1.
+	WithMax(max uint) BuildableVMSearchParameters
+	// WithPage sets the page of results to return, starting with 1.
+	WithPage(page uint) BuildableVMSearchParameters
 }
 
 // VMSearchParams creates a buildable set of search parameters for easier use.
@@ -308,6 +582,13 @@ type vmSearchParams struct {
 	tag         *string
 	statuses    *VMStatusList
 	notStatuses *VMStatusList
+	clusterID   *ClusterID
+	templateID  *TemplateID
+	hostID      *string
+	description *string
+	rawQuery    *string
+	max         *uint
+	page        *uint
 }
 
 func (v *vmSearchParams) WithStatus(status VMStatus) BuildableVMSearchParameters {
@@ -380,12 +661,106 @@ func (v *vmSearchParams) WithNotStatuses(list VMStatusList) BuildableVMSearchPar
 	return v
 }
 
+func (v *vmSearchParams) ClusterID() *ClusterID {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.clusterID
+}
+
+func (v *vmSearchParams) WithClusterID(clusterID ClusterID) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.clusterID = &clusterID
+	return v
+}
+
+func (v *vmSearchParams) TemplateID() *TemplateID {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.templateID
+}
+
+func (v *vmSearchParams) WithTemplateID(templateID TemplateID) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.templateID = &templateID
+	return v
+}
+
+func (v *vmSearchParams) HostID() *string {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.hostID
+}
+
+func (v *vmSearchParams) WithHostID(hostID string) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.hostID = &hostID
+	return v
+}
+
+func (v *vmSearchParams) Description() *string {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.description
+}
+
+func (v *vmSearchParams) WithDescription(description string) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.description = &description
+	return v
+}
+
+func (v *vmSearchParams) RawQuery() *string {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.rawQuery
+}
+
+func (v *vmSearchParams) WithRawQuery(query string) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.rawQuery = &query
+	return v
+}
+
+func (v *vmSearchParams) Max() *uint {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.max
+}
+
+func (v *vmSearchParams) Page() *uint {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.page
+}
+
+func (v *vmSearchParams) WithMax(max uint) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.max = &max
+	return v
+}
+
+func (v *vmSearchParams) WithPage(page uint) BuildableVMSearchParameters {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.page = &page
+	return v
+}
+
 // OptionalVMParameters are a list of parameters that can be, but must not necessarily be added on VM creation. This
 // interface is expected to be extended in the future.
 type OptionalVMParameters interface {
 	// Comment returns the comment for the VM.
 	Comment() string
 
+	// Description returns the description for the VM.
+	Description() string
+
 	// CPU contains the CPU topology, if any.
 	CPU() VMCPUTopo
 
@@ -394,6 +769,23 @@ type OptionalVMParameters interface {
 
 	// Initialization defines the virtual machine’s initialization configuration.
 	Initialization() Initialization
+
+	// QuotaID returns the ID of the quota the VM should be assigned to. An empty string leaves the assignment to
+	// the engine.
+	QuotaID() QuotaID
+
+	// InstanceTypeID returns the ID of the instance type to apply to the VM. The CPU topology and memory of the
+	// instance type are used unless set explicitly. An empty string creates the VM without an instance type.
+	InstanceTypeID() InstanceTypeID
+
+	// NUMATuneMode returns the NUMA tune mode of the VM. An empty string leaves the default to the engine.
+	NUMATuneMode() NUMATuneMode
+	// NUMANodes returns the virtual NUMA nodes to create on the VM. The nodes are added after the VM is created, so
+	// the VM exists even if adding the nodes fails.
+	NUMANodes() []VMNUMANode
+	// Disks returns the disks to create and attach to the VM after it is created from the template. If any of them
+	// cannot be created or attached, the VM is removed together with the disks created so far.
+	Disks() []VMBlueprintDisk
 }
 
 // BuildableVMParameters is a variant of OptionalVMParameters that can be changed using the supplied
@@ -406,6 +798,16 @@ type BuildableVMParameters interface {
 	// MustWithComment is identical to WithComment, but panics instead of returning an error.
 	MustWithComment(comment string) BuildableVMParameters
 
+	// WithDescription adds a description to the VM.
+	WithDescription(description string) (BuildableVMParameters, error)
+	// MustWithDescription is identical to WithDescription, but panics instead of returning an error.
+	MustWithDescription(description string) BuildableVMParameters
+
+	// WithDisks sets the data disks to create together with the VM. Use NewVMDisk to describe the disks.
+	WithDisks(disks []VMBlueprintDisk) (BuildableVMParameters, error)
+	// MustWithDisks is identical to WithDisks, but panics instead of returning an error.
+	MustWithDisks(disks []VMBlueprintDisk) BuildableVMParameters
+
 	// WithCPU adds a VMCPUTopo to the VM.
 	WithCPU(cpu VMCPUTopo) (BuildableVMParameters, error)
 	// MustWithCPU adds a VMCPUTopo and panics if an error happens.
@@ -428,6 +830,27 @@ type BuildableVMParameters interface {
 	MustWithInitialization(initialization Initialization) BuildableVMParameters
 	// MustWithInitializationParameters is a simplified function that calls MustNewInitialization and adds customScript
 	MustWithInitializationParameters(customScript, hostname string) BuildableVMParameters
+
+	// WithQuotaID sets the quota the VM should be assigned to.
+	WithQuotaID(quotaID QuotaID) (BuildableVMParameters, error)
+	// MustWithQuotaID is identical to WithQuotaID, but panics instead of returning an error.
+	MustWithQuotaID(quotaID QuotaID) BuildableVMParameters
+
+	// WithInstanceTypeID sets the instance type to apply to the VM. Use InstanceTypeClient.GetInstanceTypeByName to
+	// look up the ID of an instance type by its name.
+	WithInstanceTypeID(instanceTypeID InstanceTypeID) (BuildableVMParameters, error)
+	// MustWithInstanceTypeID is identical to WithInstanceTypeID, but panics instead of returning an error.
+	MustWithInstanceTypeID(instanceTypeID InstanceTypeID) BuildableVMParameters
+
+	// WithNUMATuneMode sets the NUMA tune mode of the VM.
+	WithNUMATuneMode(mode NUMATuneMode) (BuildableVMParameters, error)
+	// MustWithNUMATuneMode is identical to WithNUMATuneMode, but panics instead of returning an error.
+	MustWithNUMATuneMode(mode NUMATuneMode) BuildableVMParameters
+	// WithNUMANodes sets the virtual NUMA nodes of the VM. Use NewVMNUMANode to create the nodes. It returns an
+	// error if the node indexes do not start at 0 without gaps, or if a CPU is assigned to more than one node.
+	WithNUMANodes(nodes ...VMNUMANode) (BuildableVMParameters, error)
+	// MustWithNUMANodes is identical to WithNUMANodes, but panics instead of returning an error.
+	MustWithNUMANodes(nodes ...VMNUMANode) BuildableVMParameters
 }
 
 // UpdateVMParameters returns a set of parameters to change on a VM.
@@ -436,6 +859,11 @@ type UpdateVMParameters interface {
 	Name() *string
 	// Comment returns the comment for the VM. Return nil if the name should not be changed.
 	Comment() *string
+	// NUMATuneMode returns the NUMA tune mode for the VM. Return nil if the NUMA tune mode should not be changed.
+	NUMATuneMode() *NUMATuneMode
+	// NUMANodes returns the virtual NUMA nodes that replace the existing ones on the VM. Return nil if the NUMA
+	// nodes should not be changed, or an empty, non-nil list to remove all NUMA nodes.
+	NUMANodes() []VMNUMANode
 }
 
 // VMCPUTopo contains the CPU topology information about a VM.
@@ -508,7 +936,9 @@ func (v *vmCPUTopo) clone() *vmCPUTopo {
 type BuildableUpdateVMParameters interface {
 	UpdateVMParameters
 
-	// WithName adds an updated name to the request.
+	// WithName adds an updated name to the request. Only empty names are rejected here. The name is checked by the
+	// VM name validator of the client when the update is sent, and the engine may still reject names the validator
+	// accepts.
 	WithName(name string) (BuildableUpdateVMParameters, error)
 
 	// MustWithName is identical to WithName, but panics instead of returning an error
@@ -519,6 +949,16 @@ type BuildableUpdateVMParameters interface {
 
 	// MustWithComment is identical to WithComment, but panics instead of returning an error.
 	MustWithComment(comment string) BuildableUpdateVMParameters
+
+	// WithNUMATuneMode changes the NUMA tune mode of the VM.
+	WithNUMATuneMode(mode NUMATuneMode) (BuildableUpdateVMParameters, error)
+	// MustWithNUMATuneMode is identical to WithNUMATuneMode, but panics instead of returning an error.
+	MustWithNUMATuneMode(mode NUMATuneMode) BuildableUpdateVMParameters
+	// WithNUMANodes replaces the virtual NUMA nodes of the VM. Passing no nodes removes all NUMA nodes. See
+	// BuildableVMParameters.WithNUMANodes for the validation rules.
+	WithNUMANodes(nodes ...VMNUMANode) (BuildableUpdateVMParameters, error)
+	// MustWithNUMANodes is identical to WithNUMANodes, but panics instead of returning an error.
+	MustWithNUMANodes(nodes ...VMNUMANode) BuildableUpdateVMParameters
 }
 
 // UpdateVMParams returns a buildable set of update parameters.
@@ -527,8 +967,10 @@ func UpdateVMParams() BuildableUpdateVMParameters {
 }
 
 type updateVMParams struct {
-	name    *string
-	comment *string
+	name         *string
+	comment      *string
+	numaTuneMode *NUMATuneMode
+	numaNodes    []VMNUMANode
 }
 
 func (u *updateVMParams) MustWithName(name string) BuildableUpdateVMParameters {
@@ -555,9 +997,11 @@ func (u *updateVMParams) Comment() *string {
 	return u.comment
 }
 
+// WithName only rejects empty names, since the VM name validator is configured on the client. The name may therefore
+// be rejected later by the validator or by the engine.
 func (u *updateVMParams) WithName(name string) (BuildableUpdateVMParameters, error) {
-	if err := validateVMName(name); err != nil {
-		return nil, err
+	if name == "" {
+		return nil, newError(EBadArgument, "the VM name cannot be empty")
 	}
 	u.name = &name
 	return u, nil
@@ -568,6 +1012,46 @@ func (u *updateVMParams) WithComment(comment string) (BuildableUpdateVMParameter
 	return u, nil
 }
 
+func (u *updateVMParams) NUMATuneMode() *NUMATuneMode {
+	return u.numaTuneMode
+}
+
+func (u *updateVMParams) NUMANodes() []VMNUMANode {
+	return u.numaNodes
+}
+
+func (u *updateVMParams) WithNUMATuneMode(mode NUMATuneMode) (BuildableUpdateVMParameters, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	u.numaTuneMode = &mode
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithNUMATuneMode(mode NUMATuneMode) BuildableUpdateVMParameters {
+	builder, err := u.WithNUMATuneMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVMParams) WithNUMANodes(nodes ...VMNUMANode) (BuildableUpdateVMParameters, error) {
+	if err := validateVMNUMANodes(nodes); err != nil {
+		return nil, err
+	}
+	u.numaNodes = append([]VMNUMANode{}, nodes...)
+	return u, nil
+}
+
+func (u *updateVMParams) MustWithNUMANodes(nodes ...VMNUMANode) BuildableUpdateVMParameters {
+	builder, err := u.WithNUMANodes(nodes...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
 // CreateVMParams creates a set of BuildableVMParameters that can be used to construct the optional VM parameters.
 func CreateVMParams() BuildableVMParameters {
 	return &vmParams{
@@ -578,13 +1062,100 @@ func CreateVMParams() BuildableVMParameters {
 type vmParams struct {
 	lock *sync.Mutex
 
-	name    string
-	comment string
-	cpu     VMCPUTopo
+	name        string
+	comment     string
+	description string
+	cpu         VMCPUTopo
 
 	hugePages *VMHugePages
 
 	initialization Initialization
+
+	quotaID QuotaID
+
+	instanceTypeID InstanceTypeID
+
+	numaTuneMode NUMATuneMode
+	numaNodes    []VMNUMANode
+
+	disks []VMBlueprintDisk
+}
+
+func (v *vmParams) NUMATuneMode() NUMATuneMode {
+	return v.numaTuneMode
+}
+
+func (v *vmParams) WithNUMATuneMode(mode NUMATuneMode) (BuildableVMParameters, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	v.numaTuneMode = mode
+	return v, nil
+}
+
+func (v *vmParams) MustWithNUMATuneMode(mode NUMATuneMode) BuildableVMParameters {
+	builder, err := v.WithNUMATuneMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) NUMANodes() []VMNUMANode {
+	return v.numaNodes
+}
+
+func (v *vmParams) WithNUMANodes(nodes ...VMNUMANode) (BuildableVMParameters, error) {
+	if err := validateVMNUMANodes(nodes); err != nil {
+		return nil, err
+	}
+	v.numaNodes = append([]VMNUMANode{}, nodes...)
+	return v, nil
+}
+
+func (v *vmParams) MustWithNUMANodes(nodes ...VMNUMANode) BuildableVMParameters {
+	builder, err := v.WithNUMANodes(nodes...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) InstanceTypeID() InstanceTypeID {
+	return v.instanceTypeID
+}
+
+func (v *vmParams) WithInstanceTypeID(instanceTypeID InstanceTypeID) (BuildableVMParameters, error) {
+	if instanceTypeID == "" {
+		return nil, newError(EBadArgument, "instance type ID cannot be empty")
+	}
+	v.instanceTypeID = instanceTypeID
+	return v, nil
+}
+
+func (v *vmParams) MustWithInstanceTypeID(instanceTypeID InstanceTypeID) BuildableVMParameters {
+	builder, err := v.WithInstanceTypeID(instanceTypeID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) QuotaID() QuotaID {
+	return v.quotaID
+}
+
+func (v *vmParams) WithQuotaID(quotaID QuotaID) (BuildableVMParameters, error) {
+	v.quotaID = quotaID
+	return v, nil
+}
+
+func (v *vmParams) MustWithQuotaID(quotaID QuotaID) BuildableVMParameters {
+	builder, err := v.WithQuotaID(quotaID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
 }
 
 func (v *vmParams) HugePages() *VMHugePages {
@@ -674,9 +1245,11 @@ func (v *vmParams) MustWithComment(comment string) BuildableVMParameters {
 	return builder
 }
 
+// WithName only rejects empty names, since the VM name validator is configured on the client. The name may therefore
+// be rejected later by the validator or by the engine.
 func (v *vmParams) WithName(name string) (BuildableVMParameters, error) {
-	if err := validateVMName(name); err != nil {
-		return nil, err
+	if name == "" {
+		return nil, newError(EBadArgument, "the VM name cannot be empty")
 	}
 	v.name = name
 	return v, nil
@@ -695,25 +1268,91 @@ func (v vmParams) Comment() string {
 	return v.comment
 }
 
+func (v *vmParams) MustWithDescription(description string) BuildableVMParameters {
+	builder, err := v.WithDescription(description)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmParams) WithDescription(description string) (BuildableVMParameters, error) {
+	v.description = description
+	return v, nil
+}
+
+func (v vmParams) Description() string {
+	return v.description
+}
+
+func (v *vmParams) WithDisks(disks []VMBlueprintDisk) (BuildableVMParameters, error) {
+	for i, disk := range disks {
+		if disk == nil {
+			return nil, newError(EBadArgument, "disk %d cannot be nil", i)
+		}
+	}
+	v.disks = append([]VMBlueprintDisk{}, disks...)
+	return v, nil
+}
+
+func (v *vmParams) MustWithDisks(disks []VMBlueprintDisk) BuildableVMParameters {
+	builder, err := v.WithDisks(disks)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v vmParams) Disks() []VMBlueprintDisk {
+	return v.disks
+}
+
 type vm struct {
 	client Client
 
-	id             string
+	id             VMID
 	name           string
 	comment        string
-	clusterID      string
+	description    string
+	clusterID      ClusterID
 	templateID     TemplateID
+	hostID         *string
 	status         VMStatus
+	creationTime   time.Time
 	cpu            *vmCPU
-	tagIDs         []string
+	tagIDs         []TagID
 	hugePages      *VMHugePages
 	initialization Initialization
+	quotaID        QuotaID
+	instanceTypeID InstanceTypeID
+	nextRun        bool
+	numaTuneMode   NUMATuneMode
+
+	embeddedNICs            []NIC
+	embeddedDiskAttachments []DiskAttachment
+	embeddedReportedDevices []ReportedDevice
+}
+
+func (v *vm) CreationTime() time.Time {
+	return v.creationTime
 }
 
 func (v *vm) HugePages() *VMHugePages {
 	return v.hugePages
 }
 
+func (v *vm) EmbeddedNICs() []NIC {
+	return v.embeddedNICs
+}
+
+func (v *vm) EmbeddedDiskAttachments() []DiskAttachment {
+	return v.embeddedDiskAttachments
+}
+
+func (v *vm) EmbeddedReportedDevices() []ReportedDevice {
+	return v.embeddedReportedDevices
+}
+
 func (v *vm) Start(retries ...RetryStrategy) error {
 	return v.client.StartVM(v.id, retries...)
 }
@@ -726,10 +1365,18 @@ func (v *vm) Shutdown(force bool, retries ...RetryStrategy) error {
 	return v.client.ShutdownVM(v.id, force, retries...)
 }
 
+func (v *vm) ShutdownGracefully(timeout time.Duration, retries ...RetryStrategy) (VM, VMShutdownMethod, error) {
+	return v.client.ShutdownVMGracefully(v.id, timeout, retries...)
+}
+
 func (v *vm) WaitForStatus(status VMStatus, retries ...RetryStrategy) (VM, error) {
 	return v.client.WaitForVMStatus(v.id, status, retries...)
 }
 
+func (v *vm) WaitForIPAddresses(filter ReportedDeviceFilter, retries ...RetryStrategy) (map[string][]net.IP, error) {
+	return v.client.WaitForVMIPAddresses(v.id, filter, retries...)
+}
+
 func (v *vm) CPU() VMCPU {
 	return v.cpu
 }
@@ -738,18 +1385,41 @@ func (v *vm) Initialization() Initialization {
 	return v.initialization
 }
 
+func (v *vm) QuotaID() QuotaID {
+	return v.quotaID
+}
+
+func (v *vm) InstanceTypeID() InstanceTypeID {
+	return v.instanceTypeID
+}
+
+func (v *vm) NextRunConfigurationExists() bool {
+	return v.nextRun
+}
+
+func (v *vm) NUMATuneMode() NUMATuneMode {
+	return v.numaTuneMode
+}
+
 // withName returns a copy of the VM with the new name. It does not change the original copy to avoid
 // shared state issues.
 func (v *vm) withName(name string) *vm {
 	return &vm{
-		client:     v.client,
-		id:         v.id,
-		name:       name,
-		comment:    v.comment,
-		clusterID:  v.clusterID,
-		templateID: v.templateID,
-		status:     v.status,
-		cpu:        v.cpu,
+		client:         v.client,
+		id:             v.id,
+		name:           name,
+		comment:        v.comment,
+		description:    v.description,
+		clusterID:      v.clusterID,
+		templateID:     v.templateID,
+		hostID:         v.hostID,
+		status:         v.status,
+		creationTime:   v.creationTime,
+		cpu:            v.cpu,
+		quotaID:        v.quotaID,
+		instanceTypeID: v.instanceTypeID,
+		nextRun:        v.nextRun,
+		numaTuneMode:   v.numaTuneMode,
 	}
 }
 
@@ -757,14 +1427,21 @@ func (v *vm) withName(name string) *vm {
 // shared state issues.
 func (v *vm) withComment(comment string) *vm {
 	return &vm{
-		client:     v.client,
-		id:         v.id,
-		name:       v.name,
-		comment:    comment,
-		clusterID:  v.clusterID,
-		templateID: v.templateID,
-		status:     v.status,
-		cpu:        v.cpu,
+		client:         v.client,
+		id:             v.id,
+		name:           v.name,
+		comment:        comment,
+		description:    v.description,
+		clusterID:      v.clusterID,
+		templateID:     v.templateID,
+		hostID:         v.hostID,
+		status:         v.status,
+		creationTime:   v.creationTime,
+		cpu:            v.cpu,
+		quotaID:        v.quotaID,
+		instanceTypeID: v.instanceTypeID,
+		nextRun:        v.nextRun,
+		numaTuneMode:   v.numaTuneMode,
 	}
 }
 
@@ -777,11 +1454,10 @@ func (v *vm) Status() VMStatus {
 }
 
 func (v *vm) AttachDisk(
-	diskID string,
+	diskID DiskID,
 	diskInterface DiskInterface,
 	params CreateDiskAttachmentOptionalParams,
-	retries ...RetryStrategy,
-) (DiskAttachment, error) {
+	retries ...RetryStrategy) (DiskAttachment, error) {
 	return v.client.CreateDiskAttachment(v.id, diskID, diskInterface, params, retries...)
 }
 
@@ -793,6 +1469,22 @@ func (v *vm) ListDiskAttachments(retries ...RetryStrategy) ([]DiskAttachment, er
 	return v.client.ListDiskAttachments(v.id, retries...)
 }
 
+func (v *vm) ListDevices(retries ...RetryStrategy) ([]VMDevice, error) {
+	return v.client.ListVMDevices(v.id, retries...)
+}
+
+func (v *vm) ListNUMANodes(retries ...RetryStrategy) ([]VMNUMANode, error) {
+	return v.client.ListVMNUMANodes(v.id, retries...)
+}
+
+func (v *vm) ListErrata(retries ...RetryStrategy) ([]Erratum, error) {
+	return v.client.ListVMErrata(v.id, retries...)
+}
+
+func (v *vm) MemoryStatistics(retries ...RetryStrategy) (VMMemoryStatistics, error) {
+	return v.client.GetVMMemoryStatistics(v.id, retries...)
+}
+
 func (v *vm) DetachDisk(diskAttachmentID string, retries ...RetryStrategy) error {
 	return v.client.RemoveDiskAttachment(v.id, diskAttachmentID, retries...)
 }
@@ -801,11 +1493,30 @@ func (v *vm) Remove(retries ...RetryStrategy) error {
 	return v.client.RemoveVM(v.id, retries...)
 }
 
+func (v *vm) AddPermission(principal PermissionPrincipal, roleID string, retries ...RetryStrategy) (
+	Permission,
+	error,
+) {
+	return v.client.AddVMPermission(v.id, principal, roleID, retries...)
+}
+
+func (v *vm) ListPermissions(retries ...RetryStrategy) ([]Permission, error) {
+	return v.client.ListPermissions(PermissionObjectTypeVM, string(v.id), retries...)
+}
+
+func (v *vm) Export(storageDomainID string, retries ...RetryStrategy) error {
+	return v.client.ExportVM(v.id, storageDomainID, retries...)
+}
+
+func (v *vm) DownloadDisks(targetDirectory string, retries ...RetryStrategy) ([]VMDiskFile, error) {
+	return v.client.DownloadVMDisks(v.id, targetDirectory, retries...)
+}
+
 func (v *vm) CreateNIC(name string, vnicProfileID string, params OptionalNICParameters, retries ...RetryStrategy) (NIC, error) {
 	return v.client.CreateNIC(v.id, vnicProfileID, name, params, retries...)
 }
 
-func (v *vm) GetNIC(id string, retries ...RetryStrategy) (NIC, error) {
+func (v *vm) GetNIC(id NICID, retries ...RetryStrategy) (NIC, error) {
 	return v.client.GetNIC(v.id, id, retries...)
 }
 
@@ -813,11 +1524,15 @@ func (v *vm) ListNICs(retries ...RetryStrategy) ([]NIC, error) {
 	return v.client.ListNICs(v.id, retries...)
 }
 
+func (v *vm) RemoveNIC(id NICID, retries ...RetryStrategy) error {
+	return v.client.RemoveNIC(v.id, id, retries...)
+}
+
 func (v *vm) Comment() string {
 	return v.comment
 }
 
-func (v *vm) ClusterID() string {
+func (v *vm) ClusterID() ClusterID {
 	return v.clusterID
 }
 
@@ -825,7 +1540,15 @@ func (v *vm) TemplateID() TemplateID {
 	return v.templateID
 }
 
-func (v *vm) ID() string {
+func (v *vm) HostID() *string {
+	return v.hostID
+}
+
+func (v *vm) Description() string {
+	return v.description
+}
+
+func (v *vm) ID() VMID {
 	return v.id
 }
 
@@ -833,31 +1556,100 @@ func (v *vm) Name() string {
 	return v.name
 }
 
-func (v *vm) TagIDs() []string {
+func (v *vm) TagIDs() []TagID {
 	return v.tagIDs
 }
 
 func (v *vm) Tags(retries ...RetryStrategy) ([]Tag, error) {
-	tags := make([]Tag, len(v.tagIDs))
-	for i, id := range v.tagIDs {
-		tag, err := v.client.GetTag(id, retries...)
-		if err != nil {
-			return nil, err
-		}
-		tags[i] = tag
-	}
-	return tags, nil
+	return v.client.GetTags(v.tagIDs, retries...)
 }
 
-func (v *vm) AddTagToVM(tagID string, retries ...RetryStrategy) error {
+func (v *vm) AddTagToVM(tagID TagID, retries ...RetryStrategy) error {
 	return v.client.AddTagToVM(v.id, tagID, retries...)
 }
 
-var vmNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-.]*$`)
+func (v *vm) Clone() VM {
+	result := *v
+	result.cpu = v.cpu.clone()
+	if v.hostID != nil {
+		hostID := *v.hostID
+		result.hostID = &hostID
+	}
+	if v.tagIDs != nil {
+		result.tagIDs = append([]TagID{}, v.tagIDs...)
+	}
+	if v.hugePages != nil {
+		hugePages := *v.hugePages
+		result.hugePages = &hugePages
+	}
+	if init, ok := v.initialization.(*initialization); ok && init != nil {
+		result.initialization = init.clone()
+	}
+	if v.embeddedNICs != nil {
+		result.embeddedNICs = make([]NIC, len(v.embeddedNICs))
+		for i, n := range v.embeddedNICs {
+			result.embeddedNICs[i] = n.Clone()
+		}
+	}
+	if v.embeddedDiskAttachments != nil {
+		result.embeddedDiskAttachments = make([]DiskAttachment, len(v.embeddedDiskAttachments))
+		for i, a := range v.embeddedDiskAttachments {
+			result.embeddedDiskAttachments[i] = a.Clone()
+		}
+	}
+	if v.embeddedReportedDevices != nil {
+		result.embeddedReportedDevices = make([]ReportedDevice, len(v.embeddedReportedDevices))
+		for i, d := range v.embeddedReportedDevices {
+			result.embeddedReportedDevices[i] = d.Clone()
+		}
+	}
+	return &result
+}
+
+// VMNameValidator checks if a VM name is acceptable before a VM is created or renamed. It returns an error describing
+// the problem if it is not. A custom validator can be passed to the client using ExtraSettingsV13, for example to
+// enforce a naming convention or to allow names a newer engine accepts.
+type VMNameValidator func(name string) error
+
+// vmNameRegexp contains the characters the engine allows in VM names: letters of any language, digits, dots,
+// underscores and dashes.
+var vmNameRegexp = regexp.MustCompile(`^[\p{L}0-9._-]+$`)
 
-func validateVMName(name string) error {
+// vmNameMaxLength is the maximum length of a VM name the engine accepts, in characters.
+const vmNameMaxLength = 255
+
+// DefaultVMNameValidator checks a VM name against the rules of the engine. The name must not be empty, must be at
+// most 255 characters long and may only contain letters, digits, dots, underscores and dashes. The engine applies
+// further restrictions in some cases, for example for the hostname of Windows guests, which are not checked here.
+func DefaultVMNameValidator(name string) error {
+	if name == "" {
+		return newError(EBadArgument, "the VM name cannot be empty")
+	}
+	if length := utf8.RuneCountInString(name); length > vmNameMaxLength {
+		return newError(
+			EBadArgument,
+			"the VM name must be at most %d characters long (%d given)",
+			vmNameMaxLength,
+			length,
+		)
+	}
 	if !vmNameRegexp.MatchString(name) {
-		return newError(EBadArgument, "invalid VM name: %s", name)
+		return newError(
+			EBadArgument,
+			"the VM name %s may only contain letters, digits, dots, underscores and dashes",
+			name,
+		)
+	}
+	return nil
+}
+
+// validateVMName checks the name using the validator, or DefaultVMNameValidator if the validator is nil.
+func validateVMName(validator VMNameValidator, name string) error {
+	if validator == nil {
+		validator = DefaultVMNameValidator
+	}
+	if err := validator(name); err != nil {
+		return wrap(err, EBadArgument, "invalid VM name: %s", name)
 	}
 	return nil
 }
@@ -870,13 +1662,20 @@ func convertSDKVM(sdkObject *ovirtsdk.Vm, client Client) (VM, error) {
 		vmIDConverter,
 		vmNameConverter,
 		vmCommentConverter,
+		vmDescriptionConverter,
 		vmClusterConverter,
+		vmHostConverter,
 		vmStatusConverter,
+		vmCreationTimeConverter,
 		vmTemplateConverter,
 		vmCPUConverter,
 		vmHugePagesConverter,
 		vmTagsConverter,
 		vmInitializationConverter,
+		vmQuotaConverter,
+		vmInstanceTypeConverter,
+		vmNextRunConverter,
+		vmNUMATuneModeConverter,
 	}
 	for _, converter := range vmConverters {
 		if err := converter(sdkObject, vmObject); err != nil {
@@ -892,7 +1691,7 @@ func vmIDConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	if !ok {
 		return newError(EFieldMissing, "id field missing from VM object")
 	}
-	v.id = id
+	v.id = VMID(id)
 	return nil
 }
 
@@ -914,6 +1713,26 @@ func vmCommentConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	return nil
 }
 
+func vmDescriptionConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	// The engine leaves out the description if it is empty.
+	v.description, _ = sdkObject.Description()
+	return nil
+}
+
+func vmHostConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	// The host is only reported while the VM is running.
+	host, ok := sdkObject.Host()
+	if !ok {
+		return nil
+	}
+	hostID, ok := host.Id()
+	if !ok {
+		return newFieldNotFound("host in VM", "host ID")
+	}
+	v.hostID = &hostID
+	return nil
+}
+
 func vmClusterConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	cluster, ok := sdkObject.Cluster()
 	if !ok {
@@ -923,7 +1742,7 @@ func vmClusterConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	if !ok {
 		return newError(EFieldMissing, "ID field missing from cluster in VM object")
 	}
-	v.clusterID = clusterID
+	v.clusterID = ClusterID(clusterID)
 	return nil
 }
 
@@ -936,6 +1755,14 @@ func vmStatusConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	return nil
 }
 
+func vmCreationTimeConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	// The creation time is not reported by all engine versions, so we don't fail if it is missing.
+	if creationTime, ok := sdkObject.CreationTime(); ok {
+		v.creationTime = creationTime
+	}
+	return nil
+}
+
 func vmTemplateConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	template, ok := sdkObject.Template()
 	if !ok {
@@ -977,12 +1804,41 @@ func vmInitializationConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
 	return nil
 }
 
+func vmQuotaConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	if sdkQuota, ok := sdkObject.Quota(); ok {
+		quotaID, _ := sdkQuota.Id()
+		v.quotaID = QuotaID(quotaID)
+	}
+	return nil
+}
+
+func vmInstanceTypeConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	if sdkInstanceType, ok := sdkObject.InstanceType(); ok {
+		instanceTypeID, _ := sdkInstanceType.Id()
+		v.instanceTypeID = InstanceTypeID(instanceTypeID)
+	}
+	return nil
+}
+
+func vmNUMATuneModeConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	if mode, ok := sdkObject.NumaTuneMode(); ok {
+		v.numaTuneMode = NUMATuneMode(mode)
+	}
+	return nil
+}
+
+func vmNextRunConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
+	// Older engines don't report this field, so we treat it as false if it is missing.
+	v.nextRun, _ = sdkObject.NextRunConfigurationExists()
+	return nil
+}
+
 func vmTagsConverter(sdkObject *ovirtsdk.Vm, v *vm) error {
-	var tagIDs []string
+	var tagIDs []TagID
 	if sdkTags, ok := sdkObject.Tags(); ok {
 		for _, tag := range sdkTags.Slice() {
 			tagID, _ := tag.Id()
-			tagIDs = append(tagIDs, tagID)
+			tagIDs = append(tagIDs, TagID(tagID))
 		}
 	}
 	v.tagIDs = tagIDs
@@ -6,20 +6,26 @@ import (
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
-func (o *oVirtClient) AddTagToVM(id string, tagID string, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
-		fmt.Sprintf("removing VM %s", id),
-		o.logger,
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)).with("tag_id", tagID),
+		fmt.Sprintf("adding tag %s to VM %s", tagID, id),
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(id).TagsService().Add().
-				Tag(ovirtsdk.NewTagBuilder().Id(tagID).MustBuild()).Send()
+			req := o.connection().SystemService().VmsService().VmService(string(id)).TagsService().Add().
+				Tag(ovirtsdk.NewTagBuilder().Id(string(tagID)).MustBuild())
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -0,0 +1,346 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMBackupClient contains the functions to run incremental backups of VMs. A backup session exposes the disks of the
+// VM for download from the point in time the backup was started. The disk data itself is downloaded using image
+// transfers, this client only manages the backup session around them:
+//
+//	backup, err := client.StartVMBackup(vmID, diskIDs, nil)
+//	// Download the disks here.
+//	err = client.FinalizeVMBackup(vmID, backup.ID())
+//
+// Pass the ToCheckpointID of a finished backup to WithFromCheckpointID to only back up the changes since then.
+type VMBackupClient interface {
+	// StartVMBackup starts a backup of the specified disks of a VM and waits until the backup is ready for
+	// downloading the disks. If the backup fails an EBackupFailed error is returned. The params may be nil. It
+	// returns an EUnsupported error if the engine does not support EngineFeatureIncrementalBackup.
+	StartVMBackup(
+		vmID VMID,
+		diskIDs []DiskID,
+		params StartVMBackupOptionalParameters,
+		retries ...RetryStrategy,
+	) (VMBackup, error)
+	// GetVMBackup returns a single backup of a VM.
+	GetVMBackup(vmID VMID, id VMBackupID, retries ...RetryStrategy) (VMBackup, error)
+	// ListVMBackups lists the backups of a VM the engine still knows about.
+	ListVMBackups(vmID VMID, retries ...RetryStrategy) ([]VMBackup, error)
+	// WaitForVMBackupPhase waits until the backup reaches the specified phase. If the backup ends in a different
+	// phase an EBackupFailed error is returned.
+	WaitForVMBackupPhase(vmID VMID, id VMBackupID, phase VMBackupPhase, retries ...RetryStrategy) (VMBackup, error)
+	// FinalizeVMBackup ends the backup session once the disks have been downloaded and waits until the engine has
+	// cleaned up. Older engines remove the backup once it is finalized, this is also treated as success.
+	FinalizeVMBackup(vmID VMID, id VMBackupID, retries ...RetryStrategy) error
+
+	// ListVMCheckpoints lists the checkpoints of a VM. Use VMCheckpointData.ParentID to follow the backup chain.
+	ListVMCheckpoints(vmID VMID, retries ...RetryStrategy) ([]VMCheckpoint, error)
+	// RemoveVMCheckpoint removes a checkpoint of a VM. Only the oldest checkpoint, which has no parent, can be
+	// removed, so the backup chain is pruned from its start. Remove old checkpoints regularly, otherwise they keep
+	// growing on VMs that are backed up for a long time.
+	RemoveVMCheckpoint(vmID VMID, id VMCheckpointID, retries ...RetryStrategy) error
+}
+
+// VMBackupID is the identifier of a VM backup.
+type VMBackupID string
+
+// VMCheckpointID is the identifier of a VM checkpoint. The engine creates a checkpoint for each backup, incremental
+// backups only contain the changes since the checkpoint they are started from.
+type VMCheckpointID string
+
+// VMBackupPhase is the phase a VM backup is in.
+type VMBackupPhase string
+
+const (
+	// VMBackupPhaseInitializing means that the engine is preparing the backup.
+	VMBackupPhaseInitializing VMBackupPhase = "initializing"
+	// VMBackupPhaseStarting means that the host is starting the backup.
+	VMBackupPhaseStarting VMBackupPhase = "starting"
+	// VMBackupPhaseReady means that the disks can be downloaded.
+	VMBackupPhaseReady VMBackupPhase = "ready"
+	// VMBackupPhaseFinalizing means that the backup has been finalized and the engine is cleaning up.
+	VMBackupPhaseFinalizing VMBackupPhase = "finalizing"
+	// VMBackupPhaseSucceeded means that the backup has ended successfully.
+	VMBackupPhaseSucceeded VMBackupPhase = "succeeded"
+	// VMBackupPhaseFailed means that the backup has failed.
+	VMBackupPhaseFailed VMBackupPhase = "failed"
+)
+
+// Validate returns an error if the backup phase is not known.
+func (p VMBackupPhase) Validate() error {
+	for _, phase := range VMBackupPhaseValues() {
+		if phase == p {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VM backup phase: %s must be one of: %s",
+		p,
+		strings.Join(VMBackupPhaseValues().Strings(), ", "),
+	)
+}
+
+// Ended returns true if the backup can no longer change phases.
+func (p VMBackupPhase) Ended() bool {
+	return p == VMBackupPhaseSucceeded || p == VMBackupPhaseFailed
+}
+
+// VMBackupPhaseList is a list of VMBackupPhase values.
+type VMBackupPhaseList []VMBackupPhase
+
+// Strings creates a string list of the values.
+func (l VMBackupPhaseList) Strings() []string {
+	result := make([]string, len(l))
+	for i, phase := range l {
+		result[i] = string(phase)
+	}
+	return result
+}
+
+// VMBackupPhaseValues returns all possible VMBackupPhase values.
+func VMBackupPhaseValues() VMBackupPhaseList {
+	return []VMBackupPhase{
+		VMBackupPhaseInitializing,
+		VMBackupPhaseStarting,
+		VMBackupPhaseReady,
+		VMBackupPhaseFinalizing,
+		VMBackupPhaseSucceeded,
+		VMBackupPhaseFailed,
+	}
+}
+
+// StartVMBackupOptionalParameters are the optional parameters for StartVMBackup.
+type StartVMBackupOptionalParameters interface {
+	// FromCheckpointID returns the checkpoint an incremental backup is started from. If nil, a full backup is
+	// taken.
+	FromCheckpointID() *VMCheckpointID
+}
+
+// BuildableStartVMBackupParameters is a buildable version of StartVMBackupOptionalParameters.
+type BuildableStartVMBackupParameters interface {
+	StartVMBackupOptionalParameters
+
+	// WithFromCheckpointID sets the checkpoint to start an incremental backup from.
+	WithFromCheckpointID(checkpointID VMCheckpointID) (BuildableStartVMBackupParameters, error)
+	// MustWithFromCheckpointID is identical to WithFromCheckpointID, but panics instead of returning an error.
+	MustWithFromCheckpointID(checkpointID VMCheckpointID) BuildableStartVMBackupParameters
+}
+
+// StartVMBackupParams creates a buildable set of parameters for StartVMBackup.
+func StartVMBackupParams() BuildableStartVMBackupParameters {
+	return &startVMBackupParams{}
+}
+
+type startVMBackupParams struct {
+	fromCheckpointID *VMCheckpointID
+}
+
+func (s *startVMBackupParams) FromCheckpointID() *VMCheckpointID {
+	return s.fromCheckpointID
+}
+
+func (s *startVMBackupParams) WithFromCheckpointID(checkpointID VMCheckpointID) (
+	BuildableStartVMBackupParameters,
+	error,
+) {
+	if checkpointID == "" {
+		return nil, newError(EBadArgument, "checkpoint ID cannot be empty")
+	}
+	s.fromCheckpointID = &checkpointID
+	return s, nil
+}
+
+func (s *startVMBackupParams) MustWithFromCheckpointID(checkpointID VMCheckpointID) BuildableStartVMBackupParameters {
+	builder, err := s.WithFromCheckpointID(checkpointID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// VMBackupData is the core of VMBackup, providing only the data access functions.
+type VMBackupData interface {
+	// ID returns the unique identifier of the backup.
+	ID() VMBackupID
+	// VMID returns the ID of the VM the backup belongs to.
+	VMID() VMID
+	// Phase returns the phase the backup is in.
+	Phase() VMBackupPhase
+	// DiskIDs returns the IDs of the disks included in the backup.
+	DiskIDs() []DiskID
+	// FromCheckpointID returns the checkpoint the backup was started from, or nil for full backups.
+	FromCheckpointID() *VMCheckpointID
+	// ToCheckpointID returns the checkpoint created for this backup, or nil if the engine has not created it yet.
+	// Use it as the FromCheckpointID of the next incremental backup.
+	ToCheckpointID() *VMCheckpointID
+	// CreationDate returns the time the backup was started.
+	CreationDate() time.Time
+}
+
+// VMBackup is a backup session of a VM.
+type VMBackup interface {
+	VMBackupData
+
+	// WaitForPhase waits until the backup reaches the specified phase. See VMBackupClient.WaitForVMBackupPhase for
+	// details.
+	WaitForPhase(phase VMBackupPhase, retries ...RetryStrategy) (VMBackup, error)
+	// Finalize ends the backup session. See VMBackupClient.FinalizeVMBackup for details.
+	Finalize(retries ...RetryStrategy) error
+}
+
+type vmBackup struct {
+	client Client
+
+	id               VMBackupID
+	vmID             VMID
+	phase            VMBackupPhase
+	diskIDs          []DiskID
+	fromCheckpointID *VMCheckpointID
+	toCheckpointID   *VMCheckpointID
+	creationDate     time.Time
+}
+
+func (v *vmBackup) ID() VMBackupID {
+	return v.id
+}
+
+func (v *vmBackup) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmBackup) Phase() VMBackupPhase {
+	return v.phase
+}
+
+func (v *vmBackup) DiskIDs() []DiskID {
+	return append([]DiskID{}, v.diskIDs...)
+}
+
+func (v *vmBackup) FromCheckpointID() *VMCheckpointID {
+	return v.fromCheckpointID
+}
+
+func (v *vmBackup) ToCheckpointID() *VMCheckpointID {
+	return v.toCheckpointID
+}
+
+func (v *vmBackup) CreationDate() time.Time {
+	return v.creationDate
+}
+
+func (v *vmBackup) WaitForPhase(phase VMBackupPhase, retries ...RetryStrategy) (VMBackup, error) {
+	return v.client.WaitForVMBackupPhase(v.vmID, v.id, phase, retries...)
+}
+
+func (v *vmBackup) Finalize(retries ...RetryStrategy) error {
+	return v.client.FinalizeVMBackup(v.vmID, v.id, retries...)
+}
+
+func (v *vmBackup) clone() *vmBackup {
+	return &vmBackup{
+		client:           v.client,
+		id:               v.id,
+		vmID:             v.vmID,
+		phase:            v.phase,
+		diskIDs:          append([]DiskID{}, v.diskIDs...),
+		fromCheckpointID: v.fromCheckpointID,
+		toCheckpointID:   v.toCheckpointID,
+		creationDate:     v.creationDate,
+	}
+}
+
+func convertSDKVMBackup(object *ovirtsdk.Backup, vmID VMID, client Client) (VMBackup, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("VM backup", "id")
+	}
+	phase, ok := object.Phase()
+	if !ok {
+		return nil, newFieldNotFound("VM backup", "phase")
+	}
+	result := &vmBackup{
+		client:  client,
+		id:      VMBackupID(id),
+		vmID:    vmID,
+		phase:   VMBackupPhase(phase),
+		diskIDs: []DiskID{},
+	}
+	if vm, ok := object.Vm(); ok {
+		if id, ok := vm.Id(); ok {
+			result.vmID = VMID(id)
+		}
+	}
+	if disks, ok := object.Disks(); ok {
+		for _, disk := range disks.Slice() {
+			if diskID, ok := disk.Id(); ok {
+				result.diskIDs = append(result.diskIDs, DiskID(diskID))
+			}
+		}
+	}
+	if checkpointID, ok := object.FromCheckpointId(); ok && checkpointID != "" {
+		fromCheckpointID := VMCheckpointID(checkpointID)
+		result.fromCheckpointID = &fromCheckpointID
+	}
+	if checkpointID, ok := object.ToCheckpointId(); ok && checkpointID != "" {
+		toCheckpointID := VMCheckpointID(checkpointID)
+		result.toCheckpointID = &toCheckpointID
+	}
+	if creationDate, ok := object.CreationDate(); ok {
+		result.creationDate = creationDate
+	}
+	return result, nil
+}
+
+// validateStartVMBackup checks the arguments of StartVMBackup. It is shared between the real and the mock client.
+func validateStartVMBackup(diskIDs []DiskID, params StartVMBackupOptionalParameters) error {
+	if len(diskIDs) == 0 {
+		return newError(EBadArgument, "at least one disk must be included in the backup")
+	}
+	seen := map[DiskID]struct{}{}
+	for _, diskID := range diskIDs {
+		if diskID == "" {
+			return newError(EBadArgument, "disk IDs in the backup cannot be empty")
+		}
+		if _, ok := seen[diskID]; ok {
+			return newError(EBadArgument, "disk %s is listed more than once", diskID)
+		}
+		seen[diskID] = struct{}{}
+	}
+	if params != nil {
+		if checkpointID := params.FromCheckpointID(); checkpointID != nil && *checkpointID == "" {
+			return newError(EBadArgument, "checkpoint ID cannot be empty")
+		}
+	}
+	return nil
+}
+
+// checkVMBackupPhase returns nil if the backup is in the specified phase, an EPending error if it may still reach it
+// and an EBackupFailed error if the backup has ended in a different phase.
+func checkVMBackupPhase(backup VMBackup, phase VMBackupPhase) error {
+	if backup.Phase() == phase {
+		return nil
+	}
+	if backup.Phase().Ended() {
+		return newError(
+			EBackupFailed,
+			"backup %s of VM %s ended in phase %s instead of %s",
+			backup.ID(),
+			backup.VMID(),
+			backup.Phase(),
+			phase,
+		)
+	}
+	return newError(
+		EPending,
+		"backup %s of VM %s is in phase %s, not %s",
+		backup.ID(),
+		backup.VMID(),
+		backup.Phase(),
+		phase,
+	)
+}
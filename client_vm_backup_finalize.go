@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) FinalizeVMBackup(vmID VMID, id VMBackupID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	waitRetries := defaultRetries(retries, defaultLongTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeVMBackup, string(id)).with("vm_id", vmID),
+		fmt.Sprintf("finalizing backup %s of VM %s", id, vmID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(id)).
+				Finalize()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		},
+	)
+	if err != nil {
+		return withCorrelationID(err, correlationID)
+	}
+	_, err = o.WaitForVMBackupPhase(vmID, id, VMBackupPhaseSucceeded, waitRetries...)
+	if HasErrorCode(err, ENotFound) {
+		// Older engines remove the backup once it is finalized.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) GetVMBackup(vmID VMID, id VMBackupID, retries ...RetryStrategy) (result VMBackup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVMBackup, string(id)),
+		fmt.Sprintf("getting backup %s of VM %s", id, vmID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				BackupService(string(id)).
+				Get().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObject, ok := response.Backup()
+			if !ok {
+				return newError(ENotFound, "no backup returned when getting backup %s of VM %s", id, vmID)
+			}
+			result, e = convertSDKVMBackup(sdkObject, vmID, o)
+			if e != nil {
+				return wrap(e, EBug, "failed to convert backup %s of VM %s", id, vmID)
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMBackups(vmID VMID, retries ...RetryStrategy) (result []VMBackup, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VMBackup{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(vmID)),
+		fmt.Sprintf("listing backups of VM %s", vmID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				BackupsService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Backups()
+			if !ok {
+				return nil
+			}
+			result = make([]VMBackup, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVMBackup(sdkObject, vmID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert VM backup during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
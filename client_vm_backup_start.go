@@ -0,0 +1,68 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) StartVMBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	params StartVMBackupOptionalParameters,
+	retries ...RetryStrategy,
+) (VMBackup, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	waitRetries := defaultRetries(retries, defaultLongTimeouts())
+	if err := validateStartVMBackup(diskIDs, params); err != nil {
+		return nil, err
+	}
+	if err := o.requireFeature(EngineFeatureIncrementalBackup, retries); err != nil {
+		return nil, err
+	}
+	var result VMBackup
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeVM, string(vmID)).with("disk_ids", diskIDs),
+		fmt.Sprintf("starting backup of VM %s", vmID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().VmsService().VmService(string(vmID)).BackupsService().Add()
+			req.Backup(buildSDKVMBackup(diskIDs, params))
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to start backup of VM %s", vmID)
+			}
+			backup, ok := response.Backup()
+			if !ok {
+				return newFieldNotFound("backup response", "backup")
+			}
+			result, err = convertSDKVMBackup(backup, vmID, o)
+			if err != nil {
+				return wrap(err, EBug, "failed to convert VM backup")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, withCorrelationID(err, correlationID)
+	}
+	return o.WaitForVMBackupPhase(vmID, result.ID(), VMBackupPhaseReady, waitRetries...)
+}
+
+func buildSDKVMBackup(diskIDs []DiskID, params StartVMBackupOptionalParameters) *ovirtsdk.Backup {
+	disks := make([]*ovirtsdk.Disk, len(diskIDs))
+	for i, diskID := range diskIDs {
+		disks[i] = ovirtsdk.NewDiskBuilder().Id(string(diskID)).MustBuild()
+	}
+	builder := ovirtsdk.NewBackupBuilder().DisksOfAny(disks...)
+	if params != nil {
+		if checkpointID := params.FromCheckpointID(); checkpointID != nil {
+			builder.FromCheckpointId(string(*checkpointID))
+		}
+	}
+	return builder.MustBuild()
+}
@@ -0,0 +1,75 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMBackup(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	backup := assertCanStartVMBackup(t, client, vm, disk, nil)
+	if backup.FromCheckpointID() != nil {
+		t.Fatalf("Full backup %s has a from checkpoint ID set.", backup.ID())
+	}
+	if backup.ToCheckpointID() == nil {
+		t.Fatalf("Backup %s has no checkpoint ID.", backup.ID())
+	}
+	if err := backup.Finalize(); err != nil {
+		t.Fatalf("Failed to finalize backup %s (%v)", backup.ID(), err)
+	}
+
+	incremental := assertCanStartVMBackup(
+		t,
+		client,
+		vm,
+		disk,
+		ovirtclient.StartVMBackupParams().MustWithFromCheckpointID(*backup.ToCheckpointID()),
+	)
+	if id := incremental.FromCheckpointID(); id == nil || *id != *backup.ToCheckpointID() {
+		t.Fatalf("Incremental backup %s was not started from checkpoint %s.", incremental.ID(), *backup.ToCheckpointID())
+	}
+	if err := client.FinalizeVMBackup(vm.ID(), incremental.ID()); err != nil {
+		t.Fatalf("Failed to finalize backup %s (%v)", incremental.ID(), err)
+	}
+}
+
+func TestVMBackupWithoutDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	_, err := client.StartVMBackup(vm.ID(), nil, nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Starting a backup without disks did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func assertCanStartVMBackup(
+	t *testing.T,
+	client ovirtclient.Client,
+	vm ovirtclient.VM,
+	disk ovirtclient.Disk,
+	params ovirtclient.StartVMBackupOptionalParameters,
+) ovirtclient.VMBackup {
+	backup, err := client.StartVMBackup(vm.ID(), []ovirtclient.DiskID{disk.ID()}, params)
+	if err != nil {
+		t.Fatalf("Failed to start backup of VM %s (%v)", vm.ID(), err)
+	}
+	if backup.Phase() != ovirtclient.VMBackupPhaseReady {
+		t.Fatalf("Backup %s is in phase %s instead of %s.", backup.ID(), backup.Phase(), ovirtclient.VMBackupPhaseReady)
+	}
+	if diskIDs := backup.DiskIDs(); len(diskIDs) != 1 || diskIDs[0] != disk.ID() {
+		t.Fatalf("Backup %s does not include disk %s.", backup.ID(), disk.ID())
+	}
+	return backup
+}
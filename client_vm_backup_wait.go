@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForVMBackupPhase(
+	vmID VMID,
+	id VMBackupID,
+	phase VMBackupPhase,
+	retries ...RetryStrategy,
+) (result VMBackup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	if err := phase.Validate(); err != nil {
+		return nil, err
+	}
+	err = o.retry(
+		resourceOf(ResourceTypeVMBackup, string(id)),
+		fmt.Sprintf("waiting for backup %s of VM %s to reach phase %s", id, vmID, phase),
+		retries,
+		func() error {
+			result, err = o.GetVMBackup(vmID, id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMBackupPhase(result, phase)
+		})
+	return
+}
@@ -0,0 +1,169 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultBulkParallelism is the number of parallel calls made by bulk operations if no parallelism is specified.
+const defaultBulkParallelism uint = 10
+
+// BulkOptionalParameters are the optional parameters for bulk operations, such as VMClient.StartVMs.
+type BulkOptionalParameters interface {
+	// Parallelism returns the maximum number of calls made in parallel. If it returns 0, a default of 10 is used.
+	Parallelism() uint
+}
+
+// BuildableBulkParameters is a buildable version of BulkOptionalParameters.
+type BuildableBulkParameters interface {
+	BulkOptionalParameters
+
+	// WithParallelism sets the maximum number of calls made in parallel. It must be at least 1.
+	WithParallelism(parallelism uint) (BuildableBulkParameters, error)
+	// MustWithParallelism is the same as WithParallelism, but panics instead of returning an error.
+	MustWithParallelism(parallelism uint) BuildableBulkParameters
+}
+
+// BulkParams creates a buildable set of BulkOptionalParameters for use with the bulk operations, such as
+// VMClient.StartVMs.
+func BulkParams() BuildableBulkParameters {
+	return &bulkParams{}
+}
+
+type bulkParams struct {
+	parallelism uint
+}
+
+func (b *bulkParams) Parallelism() uint {
+	return b.parallelism
+}
+
+func (b *bulkParams) WithParallelism(parallelism uint) (BuildableBulkParameters, error) {
+	if parallelism == 0 {
+		return nil, newError(EBadArgument, "the parallelism of bulk operations must be at least 1")
+	}
+	b.parallelism = parallelism
+	return b, nil
+}
+
+func (b *bulkParams) MustWithParallelism(parallelism uint) BuildableBulkParameters {
+	builder, err := b.WithParallelism(parallelism)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// runBulk calls the action function for each unique ID, running at most as many calls in parallel as specified in
+// params. It returns the result of the action for each ID.
+func runBulk(ids []VMID, params BulkOptionalParameters, action func(id VMID) error) map[VMID]error {
+	parallelism := defaultBulkParallelism
+	if params != nil && params.Parallelism() > 0 {
+		parallelism = params.Parallelism()
+	}
+
+	results := make(map[VMID]error, len(ids))
+	seen := make(map[VMID]struct{}, len(ids))
+	lock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	slots := make(chan struct{}, parallelism)
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(id VMID) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			err := action(id)
+			lock.Lock()
+			defer lock.Unlock()
+			results[id] = err
+		}(id)
+	}
+	wg.Wait()
+	return results
+}
+
+// bulkError returns an error listing the VMs a bulk operation failed for together with their errors, or nil if it
+// succeeded for all VMs. The error wraps the error of the first failed VM by ID, so its code can be checked using
+// HasErrorCode.
+func bulkError(action string, results map[VMID]error) error {
+	var failed []VMID
+	for id, err := range results {
+		if err != nil {
+			failed = append(failed, id)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i] < failed[j] })
+	messages := make([]string, len(failed))
+	for i, id := range failed {
+		messages[i] = fmt.Sprintf("VM %s: %v", id, results[id])
+	}
+	return wrap(
+		results[failed[0]],
+		EUnidentified,
+		"failed to %s %d of %d VMs (%s), first error for VM %s",
+		action,
+		len(failed),
+		len(results),
+		strings.Join(messages, "; "),
+		failed[0],
+	)
+}
+
+func (o *oVirtClient) StartVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return o.StartVM(id, retries...)
+	})
+}
+
+func (o *oVirtClient) StopVMs(
+	ids []VMID,
+	force bool,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return o.StopVM(id, force, retries...)
+	})
+}
+
+func (o *oVirtClient) RemoveVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return o.RemoveVM(id, retries...)
+	})
+}
+
+func (o *oVirtClient) AssignTagToVMs(
+	tagID TagID,
+	ids []VMID,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) error {
+	results := runBulk(ids, params, func(id VMID) error {
+		return o.AddTagToVM(id, tagID, retries...)
+	})
+	return bulkError(fmt.Sprintf("assign tag %s to", tagID), results)
+}
+
+func (o *oVirtClient) UnassignTagFromVMs(
+	tagID TagID,
+	ids []VMID,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) error {
+	results := runBulk(ids, params, func(id VMID) error {
+		return o.RemoveTagFromVM(id, tagID, retries...)
+	})
+	return bulkError(fmt.Sprintf("remove tag %s from", tagID), results)
+}
@@ -0,0 +1,103 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestRemoveVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm1 := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	vm2 := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	nonexistentID := ovirtclient.VMID(helper.GenerateRandomID(10))
+
+	results := client.RemoveVMs(
+		[]ovirtclient.VMID{vm1.ID(), vm2.ID(), vm1.ID(), nonexistentID},
+		ovirtclient.BulkParams().MustWithParallelism(2),
+	)
+	if len(results) != 3 {
+		t.Fatalf("incorrect number of results (expected: 3, got: %d)", len(results))
+	}
+	for _, id := range []ovirtclient.VMID{vm1.ID(), vm2.ID()} {
+		if err := results[id]; err != nil {
+			t.Fatalf("failed to remove VM %s (%v)", id, err)
+		}
+		if _, err := client.GetVM(id); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("VM %s still exists after removal (%v)", id, err)
+		}
+	}
+	if !ovirtclient.HasErrorCode(results[nonexistentID], ovirtclient.ENotFound) {
+		t.Fatalf("removing a nonexistent VM did not return a not found error (%v)", results[nonexistentID])
+	}
+}
+
+func TestAssignTagToVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	vm1 := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	vm2 := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	ids := []ovirtclient.VMID{vm1.ID(), vm2.ID()}
+
+	if err := client.AssignTagToVMs(tag.ID(), ids, ovirtclient.BulkParams().MustWithParallelism(2)); err != nil {
+		t.Fatalf("failed to assign tag %s to VMs (%v)", tag.ID(), err)
+	}
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithTag(tag.Name()))
+	if err != nil {
+		t.Fatalf("failed to search for VMs by tag (%v)", err)
+	}
+	if len(vms) != 2 {
+		t.Fatalf("incorrect number of tagged VMs (expected: 2, got: %d)", len(vms))
+	}
+
+	if err := client.UnassignTagFromVMs(tag.ID(), ids, nil); err != nil {
+		t.Fatalf("failed to remove tag %s from VMs (%v)", tag.ID(), err)
+	}
+	vms, err = client.SearchVMs(ovirtclient.VMSearchParams().WithTag(tag.Name()))
+	if err != nil {
+		t.Fatalf("failed to search for VMs by tag (%v)", err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("tag %s is still assigned to %d VMs", tag.ID(), len(vms))
+	}
+}
+
+func TestAssignTagToVMsPartialFailure(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	tag := assertCanCreateTag(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), "")
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	nonexistentID := ovirtclient.VMID(helper.GenerateRandomID(10))
+
+	err := client.AssignTagToVMs(tag.ID(), []ovirtclient.VMID{vm.ID(), nonexistentID}, nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("assigning a tag to a nonexistent VM did not return a not found error (%v)", err)
+	}
+	if !strings.Contains(err.Error(), string(nonexistentID)) || strings.Contains(err.Error(), string(vm.ID())) {
+		t.Fatalf("the error does not list exactly the failed VM (%v)", err)
+	}
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithTag(tag.Name()))
+	if err != nil {
+		t.Fatalf("failed to search for VMs by tag (%v)", err)
+	}
+	if len(vms) != 1 || vms[0].ID() != vm.ID() {
+		t.Fatalf("the tag was not assigned to the existing VM")
+	}
+}
+
+func TestBulkParamsInvalidParallelism(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.BulkParams().WithParallelism(0); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("setting a parallelism of 0 did not return a bad argument error (%v)", err)
+	}
+}
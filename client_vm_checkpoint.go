@@ -0,0 +1,163 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMCheckpointState is the state of a VM checkpoint.
+type VMCheckpointState string
+
+const (
+	// VMCheckpointStateCreated means that the checkpoint can be used to start an incremental backup from.
+	VMCheckpointStateCreated VMCheckpointState = "created"
+	// VMCheckpointStateInvalid means that the checkpoint can no longer be used for incremental backups, for example
+	// because a disk was changed outside of the backup chain. A full backup is needed to start a new chain.
+	VMCheckpointStateInvalid VMCheckpointState = "invalid"
+)
+
+// Validate returns an error if the checkpoint state is not known.
+func (s VMCheckpointState) Validate() error {
+	for _, state := range VMCheckpointStateValues() {
+		if state == s {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VM checkpoint state: %s must be one of: %s",
+		s,
+		strings.Join(VMCheckpointStateValues().Strings(), ", "),
+	)
+}
+
+// VMCheckpointStateList is a list of VMCheckpointState values.
+type VMCheckpointStateList []VMCheckpointState
+
+// Strings creates a string list of the values.
+func (l VMCheckpointStateList) Strings() []string {
+	result := make([]string, len(l))
+	for i, state := range l {
+		result[i] = string(state)
+	}
+	return result
+}
+
+// VMCheckpointStateValues returns all possible VMCheckpointState values.
+func VMCheckpointStateValues() VMCheckpointStateList {
+	return []VMCheckpointState{
+		VMCheckpointStateCreated,
+		VMCheckpointStateInvalid,
+	}
+}
+
+// VMCheckpointData is the core of VMCheckpoint, providing only the data access functions.
+type VMCheckpointData interface {
+	// ID returns the unique identifier of the checkpoint.
+	ID() VMCheckpointID
+	// VMID returns the ID of the VM the checkpoint belongs to.
+	VMID() VMID
+	// ParentID returns the checkpoint this checkpoint follows in the backup chain, or nil if it is the first one.
+	ParentID() *VMCheckpointID
+	// State returns the state of the checkpoint.
+	State() VMCheckpointState
+	// DiskIDs returns the IDs of the disks the checkpoint tracks changes for.
+	DiskIDs() []DiskID
+	// CreationDate returns the time the checkpoint was created.
+	CreationDate() time.Time
+}
+
+// VMCheckpoint is a point in the backup chain of a VM. Each backup creates a checkpoint, see
+// VMBackupData.ToCheckpointID.
+type VMCheckpoint interface {
+	VMCheckpointData
+
+	// Remove removes the checkpoint. See VMBackupClient.RemoveVMCheckpoint for details.
+	Remove(retries ...RetryStrategy) error
+}
+
+type vmCheckpoint struct {
+	client Client
+
+	id           VMCheckpointID
+	vmID         VMID
+	parentID     *VMCheckpointID
+	state        VMCheckpointState
+	diskIDs      []DiskID
+	creationDate time.Time
+}
+
+func (v *vmCheckpoint) ID() VMCheckpointID {
+	return v.id
+}
+
+func (v *vmCheckpoint) VMID() VMID {
+	return v.vmID
+}
+
+func (v *vmCheckpoint) ParentID() *VMCheckpointID {
+	return v.parentID
+}
+
+func (v *vmCheckpoint) State() VMCheckpointState {
+	return v.state
+}
+
+func (v *vmCheckpoint) DiskIDs() []DiskID {
+	return append([]DiskID{}, v.diskIDs...)
+}
+
+func (v *vmCheckpoint) CreationDate() time.Time {
+	return v.creationDate
+}
+
+func (v *vmCheckpoint) Remove(retries ...RetryStrategy) error {
+	return v.client.RemoveVMCheckpoint(v.vmID, v.id, retries...)
+}
+
+func (v *vmCheckpoint) clone() *vmCheckpoint {
+	return &vmCheckpoint{
+		client:       v.client,
+		id:           v.id,
+		vmID:         v.vmID,
+		parentID:     v.parentID,
+		state:        v.state,
+		diskIDs:      append([]DiskID{}, v.diskIDs...),
+		creationDate: v.creationDate,
+	}
+}
+
+func convertSDKVMCheckpoint(object *ovirtsdk.Checkpoint, vmID VMID, client Client) (VMCheckpoint, error) {
+	id, ok := object.Id()
+	if !ok {
+		return nil, newFieldNotFound("VM checkpoint", "id")
+	}
+	state, ok := object.State()
+	if !ok {
+		return nil, newFieldNotFound("VM checkpoint", "state")
+	}
+	result := &vmCheckpoint{
+		client:  client,
+		id:      VMCheckpointID(id),
+		vmID:    vmID,
+		state:   VMCheckpointState(state),
+		diskIDs: []DiskID{},
+	}
+	if parentID, ok := object.ParentId(); ok && parentID != "" {
+		checkpointID := VMCheckpointID(parentID)
+		result.parentID = &checkpointID
+	}
+	if disks, ok := object.Disks(); ok {
+		for _, disk := range disks.Slice() {
+			if diskID, ok := disk.Id(); ok {
+				result.diskIDs = append(result.diskIDs, DiskID(diskID))
+			}
+		}
+	}
+	if creationDate, ok := object.CreationDate(); ok {
+		result.creationDate = creationDate
+	}
+	return result, nil
+}
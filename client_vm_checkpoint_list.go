@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMCheckpoints(vmID VMID, retries ...RetryStrategy) (result []VMCheckpoint, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VMCheckpoint{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(vmID)),
+		fmt.Sprintf("listing checkpoints of VM %s", vmID),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CheckpointsService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Checkpoints()
+			if !ok {
+				return nil
+			}
+			result = make([]VMCheckpoint, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVMCheckpoint(sdkObject, vmID, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert VM checkpoint during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveVMCheckpoint(vmID VMID, id VMCheckpointID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeVMCheckpoint, string(id)).with("vm_id", vmID),
+		fmt.Sprintf("removing checkpoint %s of VM %s", id, vmID),
+		retries,
+		func() error {
+			req := o.connection().SystemService().
+				VmsService().
+				VmService(string(vmID)).
+				CheckpointsService().
+				CheckpointService(string(id)).
+				Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		},
+	)
+	return withCorrelationID(err, correlationID)
+}
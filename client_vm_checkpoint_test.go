@@ -0,0 +1,71 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMCheckpointListAndRemove(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+
+	first := assertCanBackUpVM(t, client, vm, disk, nil)
+	second := assertCanBackUpVM(t, client, vm, disk, ovirtclient.StartVMBackupParams().MustWithFromCheckpointID(first))
+
+	checkpoints := assertCanListVMCheckpoints(t, client, vm, 2)
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ID() == second && (checkpoint.ParentID() == nil || *checkpoint.ParentID() != first) {
+			t.Fatalf("Checkpoint %s does not have %s as its parent.", second, first)
+		}
+	}
+	if err := client.RemoveVMCheckpoint(vm.ID(), second); err == nil {
+		t.Fatalf("Removing checkpoint %s before its parent did not fail.", second)
+	}
+	if err := client.RemoveVMCheckpoint(vm.ID(), first); err != nil {
+		t.Fatalf("Failed to remove checkpoint %s (%v)", first, err)
+	}
+	checkpoints = assertCanListVMCheckpoints(t, client, vm, 1)
+	if checkpoints[0].ID() != second || checkpoints[0].ParentID() != nil {
+		t.Fatalf("Checkpoint %s did not become the start of the backup chain.", second)
+	}
+}
+
+func assertCanBackUpVM(
+	t *testing.T,
+	client ovirtclient.Client,
+	vm ovirtclient.VM,
+	disk ovirtclient.Disk,
+	params ovirtclient.StartVMBackupOptionalParameters,
+) ovirtclient.VMCheckpointID {
+	backup := assertCanStartVMBackup(t, client, vm, disk, params)
+	if err := backup.Finalize(); err != nil {
+		t.Fatalf("Failed to finalize backup %s (%v)", backup.ID(), err)
+	}
+	if backup.ToCheckpointID() == nil {
+		t.Fatalf("Backup %s has no checkpoint ID.", backup.ID())
+	}
+	return *backup.ToCheckpointID()
+}
+
+func assertCanListVMCheckpoints(
+	t *testing.T,
+	client ovirtclient.Client,
+	vm ovirtclient.VM,
+	count int,
+) []ovirtclient.VMCheckpoint {
+	checkpoints, err := client.ListVMCheckpoints(vm.ID())
+	if err != nil {
+		t.Fatalf("Failed to list checkpoints of VM %s (%v)", vm.ID(), err)
+	}
+	if len(checkpoints) != count {
+		t.Fatalf("Incorrect number of checkpoints on VM %s (expected: %d, got: %d)", vm.ID(), count, len(checkpoints))
+	}
+	return checkpoints
+}
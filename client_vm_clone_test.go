@@ -0,0 +1,44 @@
+// This file contains tests for cloning the internal VM structure. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestVMCloneIsDeepEqual(t *testing.T) {
+	t.Parallel()
+	hostID := "host"
+	v := &vm{
+		id:     "vm",
+		name:   "test",
+		hostID: &hostID,
+		cpu:    &vmCPU{},
+		tagIDs: []TagID{"tag"},
+		initialization: &initialization{
+			customScript: "#cloud-config",
+			hostname:     "test",
+			nicConfigurations: []NICConfiguration{
+				MustNewDHCPNICConfiguration("eth0"),
+				MustNewStaticNICConfiguration(
+					"eth1",
+					&net.IPNet{IP: net.ParseIP("192.168.0.2"), Mask: net.CIDRMask(24, 32)},
+					net.ParseIP("192.168.0.1"),
+				),
+			},
+		},
+	}
+
+	clone := v.Clone().(*vm)
+	if !reflect.DeepEqual(v, clone) {
+		t.Fatalf("the cloned VM is not equal to the original")
+	}
+	cloneNICConfiguration := clone.initialization.NICConfigurations()[1].(*nicConfiguration)
+	cloneNICConfiguration.ip.IP[0] = 10
+	if v.initialization.NICConfigurations()[1].IP().IP[0] == 10 {
+		t.Fatalf("changing the NIC configuration of the clone affected the original VM")
+	}
+}
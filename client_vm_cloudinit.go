@@ -0,0 +1,525 @@
+package ovirtclient
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// CloudInitBuilder builds the cloud-config user data for the custom script of a VM Initialization, so the YAML does
+// not need to be assembled by hand. Use NewCloudInitBuilder to create one. The network configuration cannot be
+// passed in the user data, as cloud-init ignores it there. It is therefore sent to the engine as part of the
+// Initialization, and the engine passes it to cloud-init separately.
+type CloudInitBuilder interface {
+	// WithUser adds a user account. The default user of the guest operating system is kept.
+	WithUser(user CloudInitUser) (CloudInitBuilder, error)
+	// MustWithUser is identical to WithUser, but panics instead of returning an error.
+	MustWithUser(user CloudInitUser) CloudInitBuilder
+	// WithSSHAuthorizedKeys adds SSH public keys to the default user of the guest operating system.
+	WithSSHAuthorizedKeys(keys ...string) (CloudInitBuilder, error)
+	// MustWithSSHAuthorizedKeys is identical to WithSSHAuthorizedKeys, but panics instead of returning an error.
+	MustWithSSHAuthorizedKeys(keys ...string) CloudInitBuilder
+	// WithWriteFile adds a file that is written when the VM is first booted.
+	WithWriteFile(file CloudInitFile) (CloudInitBuilder, error)
+	// MustWithWriteFile is identical to WithWriteFile, but panics instead of returning an error.
+	MustWithWriteFile(file CloudInitFile) CloudInitBuilder
+	// WithRunCmd adds a command that is run at the end of the first boot. The command is executed directly, without
+	// a shell. Pass "sh", "-c" and the script to use shell features.
+	WithRunCmd(command ...string) (CloudInitBuilder, error)
+	// MustWithRunCmd is identical to WithRunCmd, but panics instead of returning an error.
+	MustWithRunCmd(command ...string) CloudInitBuilder
+	// WithNICConfiguration adds the network configuration of a guest network interface.
+	WithNICConfiguration(nicConfiguration NICConfiguration) (CloudInitBuilder, error)
+	// MustWithNICConfiguration is identical to WithNICConfiguration, but panics instead of returning an error.
+	MustWithNICConfiguration(nicConfiguration NICConfiguration) CloudInitBuilder
+
+	// UserData renders the cloud-config user data.
+	UserData() string
+	// Initialization returns an Initialization with the rendered user data as the custom script, the network
+	// configuration and the specified hostname, for use with WithInitialization. The hostname may be empty.
+	Initialization(hostname string) BuildableInitialization
+}
+
+// NewCloudInitBuilder creates an empty CloudInitBuilder.
+func NewCloudInitBuilder() CloudInitBuilder {
+	return &cloudInitBuilder{}
+}
+
+// CloudInitUser is a user account created by cloud-init.
+type CloudInitUser interface {
+	// Name returns the login name of the user.
+	Name() string
+	// Groups returns the supplementary groups of the user.
+	Groups() []string
+	// Shell returns the login shell of the user, or an empty string for the default shell.
+	Shell() string
+	// Sudo returns the sudoers rule for the user, for example ALL=(ALL) NOPASSWD:ALL, or an empty string if the user
+	// has no sudo access.
+	Sudo() string
+	// PasswordHash returns the hashed password of the user, or an empty string if password login is disabled.
+	PasswordHash() string
+	// SSHAuthorizedKeys returns the SSH public keys the user can log in with.
+	SSHAuthorizedKeys() []string
+}
+
+// BuildableCloudInitUser is a buildable version of CloudInitUser.
+type BuildableCloudInitUser interface {
+	CloudInitUser
+
+	// WithGroups sets the supplementary groups of the user.
+	WithGroups(groups ...string) (BuildableCloudInitUser, error)
+	// MustWithGroups is identical to WithGroups, but panics instead of returning an error.
+	MustWithGroups(groups ...string) BuildableCloudInitUser
+	// WithShell sets the login shell of the user. It must be an absolute path.
+	WithShell(shell string) (BuildableCloudInitUser, error)
+	// MustWithShell is identical to WithShell, but panics instead of returning an error.
+	MustWithShell(shell string) BuildableCloudInitUser
+	// WithSudo sets the sudoers rule for the user.
+	WithSudo(rule string) (BuildableCloudInitUser, error)
+	// MustWithSudo is identical to WithSudo, but panics instead of returning an error.
+	MustWithSudo(rule string) BuildableCloudInitUser
+	// WithPasswordHash sets the hashed password of the user and enables password login. The hash must be in the
+	// crypt format, for example as generated by mkpasswd.
+	WithPasswordHash(hash string) (BuildableCloudInitUser, error)
+	// MustWithPasswordHash is identical to WithPasswordHash, but panics instead of returning an error.
+	MustWithPasswordHash(hash string) BuildableCloudInitUser
+	// WithSSHAuthorizedKeys adds SSH public keys the user can log in with.
+	WithSSHAuthorizedKeys(keys ...string) (BuildableCloudInitUser, error)
+	// MustWithSSHAuthorizedKeys is identical to WithSSHAuthorizedKeys, but panics instead of returning an error.
+	MustWithSSHAuthorizedKeys(keys ...string) BuildableCloudInitUser
+}
+
+// NewCloudInitUser creates a user account with the specified login name for use with CloudInitBuilder.WithUser.
+func NewCloudInitUser(name string) (BuildableCloudInitUser, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the user name cannot be empty")
+	}
+	if name == "default" {
+		return nil, newError(EBadArgument, "the user name default is reserved for the default user of the guest")
+	}
+	return &cloudInitUser{name: name}, nil
+}
+
+// MustNewCloudInitUser is identical to NewCloudInitUser, but panics instead of returning an error.
+func MustNewCloudInitUser(name string) BuildableCloudInitUser {
+	user, err := NewCloudInitUser(name)
+	if err != nil {
+		panic(err)
+	}
+	return user
+}
+
+// CloudInitFile is a file written by cloud-init.
+type CloudInitFile interface {
+	// Path returns the absolute path of the file in the guest.
+	Path() string
+	// Content returns the content of the file.
+	Content() []byte
+	// Permissions returns the permissions of the file.
+	Permissions() os.FileMode
+	// Owner returns the owner of the file in the user:group format, or an empty string for root.
+	Owner() string
+}
+
+// BuildableCloudInitFile is a buildable version of CloudInitFile.
+type BuildableCloudInitFile interface {
+	CloudInitFile
+
+	// WithPermissions sets the permissions of the file. The default is 0644.
+	WithPermissions(permissions os.FileMode) (BuildableCloudInitFile, error)
+	// MustWithPermissions is identical to WithPermissions, but panics instead of returning an error.
+	MustWithPermissions(permissions os.FileMode) BuildableCloudInitFile
+	// WithOwner sets the owner of the file in the user:group format.
+	WithOwner(owner string) (BuildableCloudInitFile, error)
+	// MustWithOwner is identical to WithOwner, but panics instead of returning an error.
+	MustWithOwner(owner string) BuildableCloudInitFile
+}
+
+// NewCloudInitFile creates a file with the specified absolute path and content for use with
+// CloudInitBuilder.WithWriteFile. Content that is not valid UTF-8 is transferred base64-encoded.
+func NewCloudInitFile(path string, content []byte) (BuildableCloudInitFile, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, newError(EBadArgument, "the file path must be absolute (%s given)", path)
+	}
+	return &cloudInitFile{path: path, content: content, permissions: 0644}, nil
+}
+
+// MustNewCloudInitFile is identical to NewCloudInitFile, but panics instead of returning an error.
+func MustNewCloudInitFile(path string, content []byte) BuildableCloudInitFile {
+	file, err := NewCloudInitFile(path, content)
+	if err != nil {
+		panic(err)
+	}
+	return file
+}
+
+type cloudInitBuilder struct {
+	users             []CloudInitUser
+	sshAuthorizedKeys []string
+	writeFiles        []CloudInitFile
+	runCmd            [][]string
+	nicConfigurations []NICConfiguration
+}
+
+func (c *cloudInitBuilder) WithUser(user CloudInitUser) (CloudInitBuilder, error) {
+	if user == nil {
+		return nil, newError(EBadArgument, "the user cannot be nil")
+	}
+	for _, existingUser := range c.users {
+		if existingUser.Name() == user.Name() {
+			return nil, newError(EBadArgument, "duplicate user: %s", user.Name())
+		}
+	}
+	c.users = append(c.users, user)
+	return c, nil
+}
+
+func (c *cloudInitBuilder) MustWithUser(user CloudInitUser) CloudInitBuilder {
+	builder, err := c.WithUser(user)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitBuilder) WithSSHAuthorizedKeys(keys ...string) (CloudInitBuilder, error) {
+	if err := validateSSHAuthorizedKeys(keys); err != nil {
+		return nil, err
+	}
+	c.sshAuthorizedKeys = append(c.sshAuthorizedKeys, keys...)
+	return c, nil
+}
+
+func (c *cloudInitBuilder) MustWithSSHAuthorizedKeys(keys ...string) CloudInitBuilder {
+	builder, err := c.WithSSHAuthorizedKeys(keys...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitBuilder) WithWriteFile(file CloudInitFile) (CloudInitBuilder, error) {
+	if file == nil {
+		return nil, newError(EBadArgument, "the file cannot be nil")
+	}
+	c.writeFiles = append(c.writeFiles, file)
+	return c, nil
+}
+
+func (c *cloudInitBuilder) MustWithWriteFile(file CloudInitFile) CloudInitBuilder {
+	builder, err := c.WithWriteFile(file)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitBuilder) WithRunCmd(command ...string) (CloudInitBuilder, error) {
+	if len(command) == 0 || command[0] == "" {
+		return nil, newError(EBadArgument, "the command cannot be empty")
+	}
+	c.runCmd = append(c.runCmd, append([]string{}, command...))
+	return c, nil
+}
+
+func (c *cloudInitBuilder) MustWithRunCmd(command ...string) CloudInitBuilder {
+	builder, err := c.WithRunCmd(command...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitBuilder) WithNICConfiguration(nicConfiguration NICConfiguration) (CloudInitBuilder, error) {
+	if nicConfiguration == nil {
+		return nil, newError(EBadArgument, "the NIC configuration cannot be nil")
+	}
+	for _, existing := range c.nicConfigurations {
+		if existing.Name() == nicConfiguration.Name() {
+			return nil, newError(EBadArgument, "duplicate NIC configuration for interface %s", nicConfiguration.Name())
+		}
+	}
+	c.nicConfigurations = append(c.nicConfigurations, nicConfiguration)
+	return c, nil
+}
+
+func (c *cloudInitBuilder) MustWithNICConfiguration(nicConfiguration NICConfiguration) CloudInitBuilder {
+	builder, err := c.WithNICConfiguration(nicConfiguration)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// UserData renders the YAML by hand to avoid a dependency on a YAML library. All strings are rendered as JSON
+// strings, which YAML accepts as double-quoted scalars, so no value can break the structure of the document.
+func (c *cloudInitBuilder) UserData() string {
+	lines := []string{"#cloud-config"}
+	if len(c.users) > 0 {
+		lines = append(lines, "users:", "  - default")
+		for _, user := range c.users {
+			lines = append(lines, renderCloudInitUser(user)...)
+		}
+	}
+	if len(c.sshAuthorizedKeys) > 0 {
+		lines = append(lines, "ssh_authorized_keys:")
+		for _, key := range c.sshAuthorizedKeys {
+			lines = append(lines, "  - "+yamlString(key))
+		}
+	}
+	if len(c.writeFiles) > 0 {
+		lines = append(lines, "write_files:")
+		for _, file := range c.writeFiles {
+			lines = append(lines, renderCloudInitFile(file)...)
+		}
+	}
+	if len(c.runCmd) > 0 {
+		lines = append(lines, "runcmd:")
+		for _, command := range c.runCmd {
+			lines = append(lines, "  - "+yamlStringList(command))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (c *cloudInitBuilder) Initialization(hostname string) BuildableInitialization {
+	return &initialization{
+		customScript:      c.UserData(),
+		hostname:          hostname,
+		nicConfigurations: append([]NICConfiguration{}, c.nicConfigurations...),
+	}
+}
+
+func renderCloudInitUser(user CloudInitUser) []string {
+	lines := []string{"  - name: " + yamlString(user.Name())}
+	if len(user.Groups()) > 0 {
+		lines = append(lines, "    groups: "+yamlStringList(user.Groups()))
+	}
+	if user.Shell() != "" {
+		lines = append(lines, "    shell: "+yamlString(user.Shell()))
+	}
+	if user.Sudo() != "" {
+		lines = append(lines, "    sudo: "+yamlString(user.Sudo()))
+	}
+	if user.PasswordHash() != "" {
+		lines = append(lines, "    passwd: "+yamlString(user.PasswordHash()), "    lock_passwd: false")
+	}
+	if len(user.SSHAuthorizedKeys()) > 0 {
+		lines = append(lines, "    ssh_authorized_keys:")
+		for _, key := range user.SSHAuthorizedKeys() {
+			lines = append(lines, "      - "+yamlString(key))
+		}
+	}
+	return lines
+}
+
+func renderCloudInitFile(file CloudInitFile) []string {
+	lines := []string{"  - path: " + yamlString(file.Path())}
+	if utf8.Valid(file.Content()) {
+		lines = append(lines, "    content: "+yamlString(string(file.Content())))
+	} else {
+		lines = append(
+			lines,
+			"    encoding: b64",
+			"    content: "+yamlString(base64.StdEncoding.EncodeToString(file.Content())),
+		)
+	}
+	lines = append(lines, "    permissions: "+yamlString(fmt.Sprintf("%#o", file.Permissions().Perm())))
+	if file.Owner() != "" {
+		lines = append(lines, "    owner: "+yamlString(file.Owner()))
+	}
+	return lines
+}
+
+func yamlString(value string) string {
+	// Marshalling a string cannot fail.
+	result, _ := json.Marshal(value)
+	return string(result)
+}
+
+func yamlStringList(values []string) string {
+	result, _ := json.Marshal(values)
+	return string(result)
+}
+
+func validateSSHAuthorizedKeys(keys []string) error {
+	for _, key := range keys {
+		if strings.TrimSpace(key) == "" || strings.ContainsAny(key, "\r\n") {
+			return newError(EBadArgument, "SSH authorized keys must be a single non-empty line each")
+		}
+	}
+	return nil
+}
+
+type cloudInitUser struct {
+	name              string
+	groups            []string
+	shell             string
+	sudo              string
+	passwordHash      string
+	sshAuthorizedKeys []string
+}
+
+func (c *cloudInitUser) Name() string {
+	return c.name
+}
+
+func (c *cloudInitUser) Groups() []string {
+	return c.groups
+}
+
+func (c *cloudInitUser) Shell() string {
+	return c.shell
+}
+
+func (c *cloudInitUser) Sudo() string {
+	return c.sudo
+}
+
+func (c *cloudInitUser) PasswordHash() string {
+	return c.passwordHash
+}
+
+func (c *cloudInitUser) SSHAuthorizedKeys() []string {
+	return c.sshAuthorizedKeys
+}
+
+func (c *cloudInitUser) WithGroups(groups ...string) (BuildableCloudInitUser, error) {
+	for _, group := range groups {
+		if group == "" || strings.Contains(group, ",") {
+			return nil, newError(EBadArgument, "invalid group name for user %s: %q", c.name, group)
+		}
+	}
+	c.groups = append([]string{}, groups...)
+	return c, nil
+}
+
+func (c *cloudInitUser) MustWithGroups(groups ...string) BuildableCloudInitUser {
+	builder, err := c.WithGroups(groups...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitUser) WithShell(shell string) (BuildableCloudInitUser, error) {
+	if !strings.HasPrefix(shell, "/") {
+		return nil, newError(EBadArgument, "the shell of user %s must be an absolute path (%s given)", c.name, shell)
+	}
+	c.shell = shell
+	return c, nil
+}
+
+func (c *cloudInitUser) MustWithShell(shell string) BuildableCloudInitUser {
+	builder, err := c.WithShell(shell)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitUser) WithSudo(rule string) (BuildableCloudInitUser, error) {
+	if rule == "" {
+		return nil, newError(EBadArgument, "the sudo rule for user %s cannot be empty", c.name)
+	}
+	c.sudo = rule
+	return c, nil
+}
+
+func (c *cloudInitUser) MustWithSudo(rule string) BuildableCloudInitUser {
+	builder, err := c.WithSudo(rule)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitUser) WithPasswordHash(hash string) (BuildableCloudInitUser, error) {
+	if !strings.HasPrefix(hash, "$") {
+		return nil, newError(EBadArgument, "the password of user %s must be a crypt hash, not a plain text password", c.name)
+	}
+	c.passwordHash = hash
+	return c, nil
+}
+
+func (c *cloudInitUser) MustWithPasswordHash(hash string) BuildableCloudInitUser {
+	builder, err := c.WithPasswordHash(hash)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitUser) WithSSHAuthorizedKeys(keys ...string) (BuildableCloudInitUser, error) {
+	if err := validateSSHAuthorizedKeys(keys); err != nil {
+		return nil, err
+	}
+	c.sshAuthorizedKeys = append(c.sshAuthorizedKeys, keys...)
+	return c, nil
+}
+
+func (c *cloudInitUser) MustWithSSHAuthorizedKeys(keys ...string) BuildableCloudInitUser {
+	builder, err := c.WithSSHAuthorizedKeys(keys...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+type cloudInitFile struct {
+	path        string
+	content     []byte
+	permissions os.FileMode
+	owner       string
+}
+
+func (c *cloudInitFile) Path() string {
+	return c.path
+}
+
+func (c *cloudInitFile) Content() []byte {
+	return c.content
+}
+
+func (c *cloudInitFile) Permissions() os.FileMode {
+	return c.permissions
+}
+
+func (c *cloudInitFile) Owner() string {
+	return c.owner
+}
+
+func (c *cloudInitFile) WithPermissions(permissions os.FileMode) (BuildableCloudInitFile, error) {
+	if permissions&^os.ModePerm != 0 {
+		return nil, newError(EBadArgument, "only permission bits can be set on file %s (%s given)", c.path, permissions)
+	}
+	c.permissions = permissions
+	return c, nil
+}
+
+func (c *cloudInitFile) MustWithPermissions(permissions os.FileMode) BuildableCloudInitFile {
+	builder, err := c.WithPermissions(permissions)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (c *cloudInitFile) WithOwner(owner string) (BuildableCloudInitFile, error) {
+	if strings.Count(owner, ":") != 1 || strings.HasPrefix(owner, ":") || strings.HasSuffix(owner, ":") {
+		return nil, newError(EBadArgument, "the owner of file %s must be in the user:group format (%s given)", c.path, owner)
+	}
+	c.owner = owner
+	return c, nil
+}
+
+func (c *cloudInitFile) MustWithOwner(owner string) BuildableCloudInitFile {
+	builder, err := c.WithOwner(owner)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
@@ -0,0 +1,112 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestCloudInitBuilderUserData(t *testing.T) {
+	t.Parallel()
+	builder := ovirtclient.NewCloudInitBuilder().
+		MustWithUser(
+			ovirtclient.MustNewCloudInitUser("alice").
+				MustWithGroups("wheel", "adm").
+				MustWithShell("/bin/bash").
+				MustWithSudo("ALL=(ALL) NOPASSWD:ALL").
+				MustWithSSHAuthorizedKeys("ssh-ed25519 AAAA alice@example.com"),
+		).
+		MustWithSSHAuthorizedKeys("ssh-ed25519 BBBB admin@example.com").
+		MustWithWriteFile(
+			ovirtclient.MustNewCloudInitFile("/etc/motd", []byte("Hello: \"world\"\n")).MustWithOwner("root:root"),
+		).
+		MustWithWriteFile(ovirtclient.MustNewCloudInitFile("/opt/blob", []byte{0xff, 0x00}).MustWithPermissions(0600)).
+		MustWithRunCmd("systemctl", "enable", "--now", "nginx")
+
+	expected := `#cloud-config
+users:
+  - default
+  - name: "alice"
+    groups: ["wheel","adm"]
+    shell: "/bin/bash"
+    sudo: "ALL=(ALL) NOPASSWD:ALL"
+    ssh_authorized_keys:
+      - "ssh-ed25519 AAAA alice@example.com"
+ssh_authorized_keys:
+  - "ssh-ed25519 BBBB admin@example.com"
+write_files:
+  - path: "/etc/motd"
+    content: "Hello: \"world\"\n"
+    permissions: "0644"
+    owner: "root:root"
+  - path: "/opt/blob"
+    encoding: b64
+    content: "/wA="
+    permissions: "0600"
+runcmd:
+  - ["systemctl","enable","--now","nginx"]
+`
+	if userData := builder.UserData(); userData != expected {
+		t.Fatalf("incorrect user data rendered (expected:\n%s\ngot:\n%s)", expected, userData)
+	}
+}
+
+func TestCloudInitBuilderValidation(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.NewCloudInitUser("default"); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("creating a user named default did not fail with EBadArgument (%v)", err)
+	}
+	if _, err := ovirtclient.NewCloudInitFile("etc/motd", nil); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("creating a file with a relative path did not fail with EBadArgument (%v)", err)
+	}
+	if _, err := ovirtclient.NewCloudInitBuilder().WithSSHAuthorizedKeys(
+		"ssh-ed25519 AAAA\nruncmd: [reboot]",
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("adding a multi-line SSH key did not fail with EBadArgument (%v)", err)
+	}
+	if _, err := ovirtclient.MustNewCloudInitUser("alice").WithPasswordHash(
+		"secret",
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("setting a plain text password did not fail with EBadArgument (%v)", err)
+	}
+	_, ip, _ := net.ParseCIDR("2001:db8::1/64")
+	if _, err := ovirtclient.NewStaticNICConfiguration("eth0", ip, nil); !ovirtclient.HasErrorCode(
+		err,
+		ovirtclient.EBadArgument,
+	) {
+		t.Fatalf("creating a static IPv6 NIC configuration did not fail with EBadArgument (%v)", err)
+	}
+}
+
+func TestCreateVMWithCloudInit(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	ip := &net.IPNet{IP: net.IPv4(192, 0, 2, 10), Mask: net.CIDRMask(24, 32)}
+	builder := ovirtclient.NewCloudInitBuilder().
+		MustWithRunCmd("touch", "/tmp/provisioned").
+		MustWithNICConfiguration(ovirtclient.MustNewStaticNICConfiguration("eth0", ip, net.IPv4(192, 0, 2, 1)))
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().MustWithInitialization(builder.Initialization("cloud-init-test")),
+	)
+	init := vm.Initialization()
+	if init.CustomScript() != builder.UserData() {
+		t.Fatalf("incorrect custom script on VM (expected: %s, got: %s)", builder.UserData(), init.CustomScript())
+	}
+	nicConfigurations := init.NICConfigurations()
+	if len(nicConfigurations) != 1 {
+		t.Fatalf("incorrect number of NIC configurations (expected: 1, got: %d)", len(nicConfigurations))
+	}
+	nicConfiguration := nicConfigurations[0]
+	if nicConfiguration.Name() != "eth0" || nicConfiguration.BootProtocol() != ovirtclient.NICBootProtocolStatic {
+		t.Fatalf("incorrect NIC configuration returned")
+	}
+	if nicConfiguration.IP() == nil || nicConfiguration.IP().String() != ip.String() {
+		t.Fatalf("incorrect static IP address returned (expected: %s, got: %v)", ip, nicConfiguration.IP())
+	}
+}
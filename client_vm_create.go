@@ -15,6 +15,12 @@ func vmBuilderComment(params OptionalVMParameters, builder *ovirtsdk.VmBuilder)
 	}
 }
 
+func vmBuilderDescription(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if description := params.Description(); description != "" {
+		builder.Description(description)
+	}
+}
+
 func vmBuilderCPU(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
 	if cpu := params.CPU(); cpu != nil {
 		builder.CpuBuilder(
@@ -55,22 +61,59 @@ func vmBuilderInitialization(params OptionalVMParameters, builder *ovirtsdk.VmBu
 		if init.HostName() != "" {
 			initBuilder.HostName(init.HostName())
 		}
+		for _, nicConfiguration := range init.NICConfigurations() {
+			initBuilder.NicConfigurationsOfAny(convertNICConfigurationToSDK(nicConfiguration))
+		}
 		builder.InitializationBuilder(initBuilder)
 	}
 }
 
+func vmBuilderQuota(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if quotaID := params.QuotaID(); quotaID != "" {
+		builder.Quota(ovirtsdk.NewQuotaBuilder().Id(string(quotaID)).MustBuild())
+	}
+}
+
+func vmBuilderInstanceType(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if instanceTypeID := params.InstanceTypeID(); instanceTypeID != "" {
+		builder.InstanceType(ovirtsdk.NewInstanceTypeBuilder().Id(string(instanceTypeID)).MustBuild())
+	}
+}
+
+func vmBuilderNUMATuneMode(params OptionalVMParameters, builder *ovirtsdk.VmBuilder) {
+	if mode := params.NUMATuneMode(); mode != "" {
+		builder.NumaTuneMode(ovirtsdk.NumaTuneMode(mode))
+	}
+}
+
 func (o *oVirtClient) CreateVM(
-	clusterID string,
+	clusterID ClusterID,
 	templateID TemplateID,
 	name string,
 	params OptionalVMParameters,
-	retries ...RetryStrategy,
-) (result VM, err error) {
+	retries ...RetryStrategy) (VM, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
+	result, err := o.createVMWithoutDisks(clusterID, templateID, name, params, retries)
+	if err != nil {
+		return result, err
+	}
+	return createVMDisks(o, o.logger, result, params, retries)
+}
+
+func (o *oVirtClient) createVMWithoutDisks(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries []RetryStrategy) (result VM, err error) {
+	correlationID := o.correlationIDFor(retries)
 
 	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
 		return nil, err
 	}
+	if err := validateVMName(o.vmNameValidator, name); err != nil {
+		return nil, err
+	}
 
 	if params == nil {
 		params = &vmParams{}
@@ -82,12 +125,16 @@ func (o *oVirtClient) CreateVM(
 		return nil, err
 	}
 
-	err = retry(
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, "").with("cluster_id", clusterID).with("template_id", templateID).with("name", name),
 		message,
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().Add().Vm(vm).Send()
+			req := o.connection().SystemService().VmsService().Add().Vm(vm)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
 			if err != nil {
 				return err
 			}
@@ -106,24 +153,31 @@ func (o *oVirtClient) CreateVM(
 			return nil
 		},
 	)
-	return result, err
+	if err == nil && len(params.NUMANodes()) > 0 {
+		err = o.replaceVMNUMANodes(result.ID(), params.NUMANodes(), retries, correlationID)
+	}
+	return result, withCorrelationID(err, correlationID)
 }
 
 func createSDKVM(
-	clusterID string,
+	clusterID ClusterID,
 	templateID TemplateID,
 	name string,
 	params OptionalVMParameters,
 ) (*ovirtsdk.Vm, error) {
 	builder := ovirtsdk.NewVmBuilder()
-	builder.Cluster(ovirtsdk.NewClusterBuilder().Id(clusterID).MustBuild())
+	builder.Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild())
 	builder.Template(ovirtsdk.NewTemplateBuilder().Id(string(templateID)).MustBuild())
 	builder.Name(name)
 	parts := []vmBuilderComponent{
 		vmBuilderComment,
+		vmBuilderDescription,
 		vmBuilderCPU,
 		vmBuilderHugePages,
 		vmBuilderInitialization,
+		vmBuilderQuota,
+		vmBuilderInstanceType,
+		vmBuilderNUMATuneMode,
 	}
 
 	for _, part := range parts {
@@ -137,7 +191,12 @@ func createSDKVM(
 	return vm, nil
 }
 
-func validateVMCreationParameters(clusterID string, templateID TemplateID, name string, _ OptionalVMParameters) error {
+func validateVMCreationParameters(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+) error {
 	if name == "" {
 		return newError(EBadArgument, "name cannot be empty for VM creation")
 	}
@@ -147,5 +206,24 @@ func validateVMCreationParameters(clusterID string, templateID TemplateID, name
 	if templateID == "" {
 		return newError(EBadArgument, "template ID cannot be empty for VM creation")
 	}
+	if params == nil {
+		return nil
+	}
+	if hugePages := params.HugePages(); hugePages != nil {
+		if err := hugePages.Validate(); err != nil {
+			return wrap(err, EBadArgument, "invalid huge pages setting for VM creation")
+		}
+	}
+	if cpu := params.CPU(); cpu != nil && (cpu.Cores() == 0 || cpu.Threads() == 0 || cpu.Sockets() == 0) {
+		return newError(EBadArgument, "the number of CPU cores, threads and sockets must be positive for VM creation")
+	}
+	if mode := params.NUMATuneMode(); mode != "" {
+		if err := mode.Validate(); err != nil {
+			return wrap(err, EBadArgument, "invalid NUMA tune mode for VM creation")
+		}
+	}
+	if err := validateVMNUMANodes(params.NUMANodes()); err != nil {
+		return wrap(err, EBadArgument, "invalid virtual NUMA nodes for VM creation")
+	}
 	return nil
 }
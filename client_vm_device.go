@@ -0,0 +1,186 @@
+package ovirtclient
+
+import (
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMDeviceType is the kind of device returned by ListVMDevices.
+type VMDeviceType string
+
+const (
+	// VMDeviceTypeDisk is a disk attached to the VM. The ID is the ID of the disk attachment.
+	VMDeviceTypeDisk VMDeviceType = "disk"
+	// VMDeviceTypeNIC is a network interface of the VM.
+	VMDeviceTypeNIC VMDeviceType = "nic"
+	// VMDeviceTypeCDROM is a CD-ROM drive of the VM.
+	VMDeviceTypeCDROM VMDeviceType = "cdrom"
+	// VMDeviceTypeWatchdog is a watchdog device of the VM.
+	VMDeviceTypeWatchdog VMDeviceType = "watchdog"
+	// VMDeviceTypeGraphics is a graphics console (video device) of the VM.
+	VMDeviceTypeGraphics VMDeviceType = "graphics"
+	// VMDeviceTypeBalloon is the memory balloon device of the VM.
+	VMDeviceTypeBalloon VMDeviceType = "balloon"
+)
+
+// Validate returns an error if the device type is not known.
+func (t VMDeviceType) Validate() error {
+	for _, deviceType := range VMDeviceTypeValues() {
+		if deviceType == t {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VM device type: %s must be one of: %s",
+		t,
+		strings.Join(VMDeviceTypeValues().Strings(), ", "),
+	)
+}
+
+// VMDeviceTypeList is a list of VMDeviceType values.
+type VMDeviceTypeList []VMDeviceType
+
+// Strings creates a string list of the values.
+func (l VMDeviceTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, deviceType := range l {
+		result[i] = string(deviceType)
+	}
+	return result
+}
+
+// VMDeviceTypeValues returns all possible VMDeviceType values.
+func VMDeviceTypeValues() VMDeviceTypeList {
+	return []VMDeviceType{
+		VMDeviceTypeDisk,
+		VMDeviceTypeNIC,
+		VMDeviceTypeCDROM,
+		VMDeviceTypeWatchdog,
+		VMDeviceTypeGraphics,
+		VMDeviceTypeBalloon,
+	}
+}
+
+// VMDevice is a single device of a VM returned by ListVMDevices.
+type VMDevice interface {
+	// ID returns the identifier of the device. It is empty for devices the engine does not expose as separate
+	// resources, such as the memory balloon.
+	ID() string
+	// VMID returns the ID of the VM the device belongs to.
+	VMID() VMID
+	// Type returns the kind of the device.
+	Type() VMDeviceType
+	// Name returns the name of the device. It may be empty if the device has no name, for example for disk
+	// attachments.
+	Name() string
+	// Model returns the model or interface of the device, for example virtio_scsi for a disk, virtio for a NIC,
+	// i6300esb for a watchdog or spice for a graphics console. It is empty if the engine does not report it.
+	Model() string
+	// Address returns the address of the device, for example the logical name of a disk in the guest, the MAC
+	// address of a NIC or the address of a graphics console. It is empty if the engine does not report it, for
+	// example when the guest agent is not running.
+	Address() string
+}
+
+type vmDevice struct {
+	id         string
+	vmid       VMID
+	deviceType VMDeviceType
+	name       string
+	model      string
+	address    string
+}
+
+func (d vmDevice) ID() string {
+	return d.id
+}
+
+func (d vmDevice) VMID() VMID {
+	return d.vmid
+}
+
+func (d vmDevice) Type() VMDeviceType {
+	return d.deviceType
+}
+
+func (d vmDevice) Name() string {
+	return d.name
+}
+
+func (d vmDevice) Model() string {
+	return d.model
+}
+
+func (d vmDevice) Address() string {
+	return d.address
+}
+
+// vmDeviceFollow lists the sub-resources of the VM that are embedded to build the device list in one API call.
+var vmDeviceFollow = []string{"diskattachments", "nics", "cdroms", "watchdogs", "graphicsconsoles"}
+
+// convertSDKVMDevices builds the device list from a VM fetched with the sub-resources in vmDeviceFollow.
+func convertSDKVMDevices(sdkVM *ovirtsdk.Vm, vmid VMID) []VMDevice {
+	result := []VMDevice{}
+	if attachments, ok := sdkVM.DiskAttachments(); ok {
+		for _, attachment := range attachments.Slice() {
+			device := newSDKVMDevice(attachment, vmid, VMDeviceTypeDisk)
+			diskInterface, _ := attachment.Interface()
+			device.model = string(diskInterface)
+			device.address, _ = attachment.LogicalName()
+			result = append(result, device)
+		}
+	}
+	if nics, ok := sdkVM.Nics(); ok {
+		for _, nic := range nics.Slice() {
+			device := newSDKVMDevice(nic, vmid, VMDeviceTypeNIC)
+			nicInterface, _ := nic.Interface()
+			device.model = string(nicInterface)
+			if mac, ok := nic.Mac(); ok {
+				device.address, _ = mac.Address()
+			}
+			result = append(result, device)
+		}
+	}
+	if cdroms, ok := sdkVM.Cdroms(); ok {
+		for _, cdrom := range cdroms.Slice() {
+			result = append(result, newSDKVMDevice(cdrom, vmid, VMDeviceTypeCDROM))
+		}
+	}
+	if watchdogs, ok := sdkVM.Watchdogs(); ok {
+		for _, watchdog := range watchdogs.Slice() {
+			device := newSDKVMDevice(watchdog, vmid, VMDeviceTypeWatchdog)
+			model, _ := watchdog.Model()
+			device.model = string(model)
+			result = append(result, device)
+		}
+	}
+	if consoles, ok := sdkVM.GraphicsConsoles(); ok {
+		for _, console := range consoles.Slice() {
+			device := newSDKVMDevice(console, vmid, VMDeviceTypeGraphics)
+			protocol, _ := console.Protocol()
+			device.model = string(protocol)
+			device.address, _ = console.Address()
+			result = append(result, device)
+		}
+	}
+	if memoryPolicy, ok := sdkVM.MemoryPolicy(); ok {
+		if ballooning, ok := memoryPolicy.Ballooning(); ok && ballooning {
+			result = append(result, vmDevice{vmid: vmid, deviceType: VMDeviceTypeBalloon, model: "virtio"})
+		}
+	}
+	return result
+}
+
+// sdkIdentified is implemented by all SDK types that have an ID and a name.
+type sdkIdentified interface {
+	Id() (string, bool)
+	Name() (string, bool)
+}
+
+func newSDKVMDevice(sdkObject sdkIdentified, vmid VMID, deviceType VMDeviceType) vmDevice {
+	id, _ := sdkObject.Id()
+	name, _ := sdkObject.Name()
+	return vmDevice{id: id, vmid: vmid, deviceType: deviceType, name: name}
+}
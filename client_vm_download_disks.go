@@ -0,0 +1,236 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// VMDiskFile is a local file a disk of a VM is downloaded to by StartDownloadVMDisks.
+type VMDiskFile interface {
+	// DiskID returns the ID of the downloaded disk.
+	DiskID() DiskID
+	// Format returns the image format of the file.
+	Format() ImageFormat
+	// Path returns the path of the file.
+	Path() string
+}
+
+// VMDisksDownloadProgress is a tracker for the download of the disks of a VM happening in the background.
+type VMDisksDownloadProgress interface {
+	// Files returns the files the disks are downloaded to, in the order they are downloaded.
+	Files() []VMDiskFile
+	// CompletedFiles returns the number of files that have been downloaded completely.
+	CompletedFiles() int
+	// DownloadedBytes returns the number of bytes downloaded so far across all disks.
+	DownloadedBytes() uint64
+	// Err returns the error of the download once it is complete or errored.
+	Err() error
+	// Done returns a channel that will be closed when the download is complete.
+	Done() <-chan struct{}
+}
+
+type vmDiskFile struct {
+	diskID DiskID
+	format ImageFormat
+	path   string
+}
+
+func (v *vmDiskFile) DiskID() DiskID {
+	return v.diskID
+}
+
+func (v *vmDiskFile) Format() ImageFormat {
+	return v.format
+}
+
+func (v *vmDiskFile) Path() string {
+	return v.path
+}
+
+func (o *oVirtClient) StartDownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) (
+	VMDisksDownloadProgress,
+	error,
+) {
+	return startDownloadVMDisks(o, o.logger, id, targetDirectory, retries)
+}
+
+func (o *oVirtClient) DownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) (
+	[]VMDiskFile,
+	error,
+) {
+	return downloadVMDisks(o, o.logger, id, targetDirectory, retries)
+}
+
+// downloadVMDisks implements DownloadVMDisks. It is shared between the real and the mock client.
+func downloadVMDisks(
+	client Client,
+	logger Logger,
+	id VMID,
+	targetDirectory string,
+	retries []RetryStrategy,
+) ([]VMDiskFile, error) {
+	progress, err := startDownloadVMDisks(client, logger, id, targetDirectory, retries)
+	if err != nil {
+		return nil, err
+	}
+	<-progress.Done()
+	if err := progress.Err(); err != nil {
+		return nil, err
+	}
+	return progress.Files(), nil
+}
+
+// startDownloadVMDisks implements StartDownloadVMDisks on top of ListDiskAttachments and DownloadDisk. It is shared
+// between the real and the mock client.
+func startDownloadVMDisks(
+	client Client,
+	logger Logger,
+	id VMID,
+	targetDirectory string,
+	retries []RetryStrategy,
+) (VMDisksDownloadProgress, error) {
+	stat, err := os.Stat(targetDirectory)
+	if err != nil {
+		return nil, wrap(err, ELocalIO, "failed to access target directory %s", targetDirectory)
+	}
+	if !stat.IsDir() {
+		return nil, newError(EBadArgument, "the target %s is not a directory", targetDirectory)
+	}
+	attachments, err := client.ListDiskAttachments(id, retries...)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]VMDiskFile, len(attachments))
+	for i, attachment := range attachments {
+		disk, err := client.GetDisk(attachment.DiskID(), retries...)
+		if err != nil {
+			return nil, err
+		}
+		file := &vmDiskFile{
+			diskID: disk.ID(),
+			format: disk.Format(),
+			path:   filepath.Join(targetDirectory, vmDiskFileName(disk)),
+		}
+		if _, err := os.Stat(file.path); err == nil {
+			return nil, newError(EConflict, "the file %s for disk %s already exists", file.path, disk.ID())
+		}
+		files[i] = file
+	}
+	progress := &vmDisksDownloadProgress{
+		client:  client,
+		logger:  logger,
+		retries: retries,
+		files:   files,
+		lock:    &sync.Mutex{},
+		done:    make(chan struct{}),
+	}
+	go progress.run()
+	return progress, nil
+}
+
+func vmDiskFileName(disk Disk) string {
+	if disk.Format() == ImageFormatCow {
+		return fmt.Sprintf("%s.qcow2", disk.ID())
+	}
+	return fmt.Sprintf("%s.raw", disk.ID())
+}
+
+type vmDisksDownloadProgress struct {
+	client  Client
+	logger  Logger
+	retries []RetryStrategy
+	files   []VMDiskFile
+
+	lock            *sync.Mutex
+	completedFiles  int
+	downloadedBytes uint64
+	err             error
+	done            chan struct{}
+}
+
+func (v *vmDisksDownloadProgress) Files() []VMDiskFile {
+	return append([]VMDiskFile{}, v.files...)
+}
+
+func (v *vmDisksDownloadProgress) CompletedFiles() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.completedFiles
+}
+
+func (v *vmDisksDownloadProgress) DownloadedBytes() uint64 {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.downloadedBytes
+}
+
+func (v *vmDisksDownloadProgress) Err() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.err
+}
+
+func (v *vmDisksDownloadProgress) Done() <-chan struct{} {
+	return v.done
+}
+
+func (v *vmDisksDownloadProgress) run() {
+	defer close(v.done)
+	for _, file := range v.files {
+		v.logger.Infof("Downloading disk %s to %s...", file.DiskID(), file.Path())
+		if err := v.downloadFile(file); err != nil {
+			v.lock.Lock()
+			v.err = err
+			v.lock.Unlock()
+			return
+		}
+		v.lock.Lock()
+		v.completedFiles++
+		v.lock.Unlock()
+	}
+}
+
+// downloadFile downloads a single disk. If the download fails the partially written file is removed.
+func (v *vmDisksDownloadProgress) downloadFile(file VMDiskFile) error {
+	reader, err := v.client.DownloadDisk(file.DiskID(), file.Format(), v.retries...)
+	if err != nil {
+		return wrap(err, EUnidentified, "failed to start downloading disk %s", file.DiskID())
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			v.logger.Warningf("Failed to close download of disk %s (%v)", file.DiskID(), err)
+		}
+	}()
+	fh, err := os.OpenFile(file.Path(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return wrap(err, ELocalIO, "failed to create file %s for disk %s", file.Path(), file.DiskID())
+	}
+	_, err = io.Copy(fh, &vmDisksDownloadReader{reader: reader, progress: v})
+	if closeErr := fh.Close(); err == nil && closeErr != nil {
+		err = wrap(closeErr, ELocalIO, "failed to close file %s", file.Path())
+	}
+	if err != nil {
+		if removeErr := os.Remove(file.Path()); removeErr != nil {
+			v.logger.Warningf("Failed to remove partially downloaded file %s (%v)", file.Path(), removeErr)
+		}
+		return wrap(err, EUnidentified, "failed to download disk %s to %s", file.DiskID(), file.Path())
+	}
+	return nil
+}
+
+// vmDisksDownloadReader counts the bytes read from a disk download towards the overall progress.
+type vmDisksDownloadReader struct {
+	reader   io.Reader
+	progress *vmDisksDownloadProgress
+}
+
+func (v *vmDisksDownloadReader) Read(p []byte) (int, error) {
+	n, err := v.reader.Read(p)
+	v.progress.lock.Lock()
+	v.progress.downloadedBytes += uint64(n)
+	v.progress.lock.Unlock()
+	return n, err
+}
@@ -0,0 +1,63 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestDownloadVMDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	targetDirectory := createTempDirectory(t)
+
+	progress, err := client.StartDownloadVMDisks(vm.ID(), targetDirectory)
+	if err != nil {
+		t.Fatalf("Failed to start downloading the disks of VM %s (%v)", vm.ID(), err)
+	}
+	<-progress.Done()
+	if err := progress.Err(); err != nil {
+		t.Fatalf("Failed to download the disks of VM %s (%v)", vm.ID(), err)
+	}
+	files := progress.Files()
+	if len(files) != 1 || files[0].DiskID() != disk.ID() || progress.CompletedFiles() != 1 {
+		t.Fatalf("Disk %s was not downloaded.", disk.ID())
+	}
+	stat, err := os.Stat(files[0].Path())
+	if err != nil {
+		t.Fatalf("Failed to stat downloaded file %s (%v)", files[0].Path(), err)
+	}
+	if uint64(stat.Size()) != progress.DownloadedBytes() {
+		t.Fatalf(
+			"Incorrect file size for %s (expected: %d, got: %d)",
+			files[0].Path(),
+			progress.DownloadedBytes(),
+			stat.Size(),
+		)
+	}
+
+	if _, err := client.DownloadVMDisks(vm.ID(), targetDirectory); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("Downloading the disks again did not fail with EConflict (%v)", err)
+	}
+}
+
+func createTempDirectory(t *testing.T) string {
+	directory, err := ioutil.TempDir("", "go-ovirt-client-test-")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := os.RemoveAll(directory); err != nil {
+			t.Fatalf("Failed to remove temporary directory %s (%v)", directory, err)
+		}
+	})
+	return directory
+}
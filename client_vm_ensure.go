@@ -0,0 +1,82 @@
+package ovirtclient
+
+func (o *oVirtClient) EnsureVM(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	return ensureVM(o, clusterID, templateID, name, params, retries)
+}
+
+// ensureVM implements EnsureVM on top of SearchVMs and CreateVM. It is shared between the real and the mock client.
+func ensureVM(
+	client VMClient,
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries []RetryStrategy,
+) (VM, error) {
+	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
+		return nil, err
+	}
+	vm, err := findVMByName(client, name, retries)
+	if err != nil {
+		return nil, err
+	}
+	if vm == nil {
+		var createErr error
+		vm, createErr = client.CreateVM(clusterID, templateID, name, params, retries...)
+		if createErr == nil {
+			return vm, nil
+		}
+		if !HasErrorCode(createErr, EConflict) {
+			return nil, createErr
+		}
+		// The VM may have been created concurrently since we last looked, so we check again.
+		vm, err = findVMByName(client, name, retries)
+		if err != nil {
+			return nil, err
+		}
+		if vm == nil {
+			return nil, createErr
+		}
+	}
+	if vm.ClusterID() != clusterID {
+		return nil, newError(
+			EConflict,
+			"VM %s (%s) already exists, but is in cluster %s instead of %s",
+			name,
+			vm.ID(),
+			vm.ClusterID(),
+			clusterID,
+		)
+	}
+	if vm.TemplateID() != templateID {
+		return nil, newError(
+			EConflict,
+			"VM %s (%s) already exists, but was created from template %s instead of %s",
+			name,
+			vm.ID(),
+			vm.TemplateID(),
+			templateID,
+		)
+	}
+	return vm, nil
+}
+
+// findVMByName returns the VM with the specified name, or nil if no such VM exists.
+func findVMByName(client VMClient, name string, retries []RetryStrategy) (VM, error) {
+	vms, err := client.SearchVMs(VMSearchParams().WithName(name), retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to search for VM %s", name)
+	}
+	for _, vm := range vms {
+		if vm.Name() == name {
+			return vm, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestEnsureVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	vm, err := client.EnsureVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+	if err != nil {
+		t.Fatalf("failed to ensure VM %s exists (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+
+	existingVM, err := client.EnsureVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, nil)
+	if err != nil {
+		t.Fatalf("failed to ensure VM %s exists the second time (%v)", name, err)
+	}
+	if existingVM.ID() != vm.ID() {
+		t.Fatalf("EnsureVM created a new VM (expected: %s, got: %s)", vm.ID(), existingVM.ID())
+	}
+}
+
+func TestEnsureVMClusterMismatch(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	_, err := client.EnsureVM(
+		ovirtclient.ClusterID(helper.GenerateRandomID(10)),
+		helper.GetBlankTemplateID(),
+		vm.Name(),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("EnsureVM did not return an EConflict error for a VM in a different cluster (%v)", err)
+	}
+}
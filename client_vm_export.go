@@ -0,0 +1,30 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) ExportVM(id VMID, storageDomainID string, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.storageDomainJobCorrelationID("vm_export", retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)).with("storage_domain_id", storageDomainID),
+		fmt.Sprintf("exporting VM %s to storage domain %s", id, storageDomainID),
+		retries,
+		func() error {
+			_, err := o.connection().SystemService().
+				VmsService().
+				VmService(string(id)).
+				Export().
+				StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID).MustBuild()).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err == nil {
+		err = o.waitForJobFinished(correlationID, retries)
+	}
+	return withCorrelationID(err, correlationID)
+}
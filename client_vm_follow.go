@@ -0,0 +1,211 @@
+package ovirtclient
+
+import (
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMFollow is a sub-resource of a VM that can be embedded in the VM object when fetching it. This maps to the follow
+// parameter of the oVirt API and avoids fetching the sub-resources of each VM in a separate API call.
+type VMFollow string
+
+const (
+	// VMFollowNICs embeds the network interfaces of the VM. They can be accessed using EmbeddedNICs().
+	VMFollowNICs VMFollow = "nics"
+	// VMFollowDiskAttachments embeds the disk attachments of the VM. They can be accessed using
+	// EmbeddedDiskAttachments().
+	VMFollowDiskAttachments VMFollow = "diskattachments"
+	// VMFollowReportedDevices embeds the devices reported by the guest agent. They can be accessed using
+	// EmbeddedReportedDevices().
+	VMFollowReportedDevices VMFollow = "reporteddevices"
+)
+
+// Validate returns an error if the VMFollow value is not valid.
+func (f VMFollow) Validate() error {
+	for _, follow := range VMFollowValues() {
+		if follow == f {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VM follow value: %s must be one of: %s",
+		f,
+		strings.Join(VMFollowValues().Strings(), ", "),
+	)
+}
+
+// VMFollowList is a list of VMFollow values.
+type VMFollowList []VMFollow
+
+// Validate returns an error if any of the values in the list is invalid.
+func (l VMFollowList) Validate() error {
+	for _, f := range l {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Strings creates a string list of the values.
+func (l VMFollowList) Strings() []string {
+	result := make([]string, len(l))
+	for i, f := range l {
+		result[i] = string(f)
+	}
+	return result
+}
+
+// Contains returns true if the list contains the specified value.
+func (l VMFollowList) Contains(follow VMFollow) bool {
+	for _, f := range l {
+		if f == follow {
+			return true
+		}
+	}
+	return false
+}
+
+// VMFollowValues returns all possible VMFollow values.
+func VMFollowValues() VMFollowList {
+	return []VMFollow{
+		VMFollowNICs,
+		VMFollowDiskAttachments,
+		VMFollowReportedDevices,
+	}
+}
+
+// VMGetParameters describes the parameters that can be passed when fetching or listing VMs.
+type VMGetParameters interface {
+	// Follow returns the list of sub-resources to embed in the returned VM objects.
+	Follow() VMFollowList
+}
+
+// BuildableVMGetParameters is a buildable version of VMGetParameters.
+type BuildableVMGetParameters interface {
+	VMGetParameters
+
+	// WithFollow adds sub-resources to embed in the returned VM objects.
+	WithFollow(follow ...VMFollow) (BuildableVMGetParameters, error)
+	// MustWithFollow is identical to WithFollow, but panics instead of returning an error.
+	MustWithFollow(follow ...VMFollow) BuildableVMGetParameters
+}
+
+// VMGetParams creates a buildable set of parameters for fetching VMs.
+func VMGetParams() BuildableVMGetParameters {
+	return &vmGetParams{}
+}
+
+type vmGetParams struct {
+	follow VMFollowList
+}
+
+func (v *vmGetParams) Follow() VMFollowList {
+	return v.follow
+}
+
+func (v *vmGetParams) WithFollow(follow ...VMFollow) (BuildableVMGetParameters, error) {
+	if err := VMFollowList(follow).Validate(); err != nil {
+		return nil, err
+	}
+	for _, f := range follow {
+		if !v.follow.Contains(f) {
+			v.follow = append(v.follow, f)
+		}
+	}
+	return v, nil
+}
+
+func (v *vmGetParams) MustWithFollow(follow ...VMFollow) BuildableVMGetParameters {
+	builder, err := v.WithFollow(follow...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func vmFollowList(params VMGetParameters) (VMFollowList, error) {
+	if params == nil {
+		return nil, nil
+	}
+	follow := params.Follow()
+	if err := follow.Validate(); err != nil {
+		return nil, err
+	}
+	return follow, nil
+}
+
+// convertSDKVMWithFollow converts a VM and the sub-resources requested in follow.
+func convertSDKVMWithFollow(sdkObject *ovirtsdk.Vm, o *oVirtClient, follow VMFollowList) (VM, error) {
+	result, err := convertSDKVM(sdkObject, o)
+	if err != nil {
+		return nil, err
+	}
+	v := result.(*vm)
+	if follow.Contains(VMFollowNICs) {
+		if v.embeddedNICs, err = convertSDKVMEmbeddedNICs(sdkObject, o); err != nil {
+			return nil, err
+		}
+	}
+	if follow.Contains(VMFollowDiskAttachments) {
+		if v.embeddedDiskAttachments, err = convertSDKVMEmbeddedDiskAttachments(sdkObject, o); err != nil {
+			return nil, err
+		}
+	}
+	if follow.Contains(VMFollowReportedDevices) {
+		if v.embeddedReportedDevices, err = convertSDKVMEmbeddedReportedDevices(sdkObject); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func convertSDKVMEmbeddedNICs(sdkObject *ovirtsdk.Vm, o *oVirtClient) ([]NIC, error) {
+	result := []NIC{}
+	sdkNICs, ok := sdkObject.Nics()
+	if !ok {
+		return result, nil
+	}
+	for i, sdkNIC := range sdkNICs.Slice() {
+		nic, err := convertSDKNIC(sdkNIC, o)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert embedded NIC #%d", i)
+		}
+		result = append(result, nic)
+	}
+	return result, nil
+}
+
+func convertSDKVMEmbeddedDiskAttachments(sdkObject *ovirtsdk.Vm, o *oVirtClient) ([]DiskAttachment, error) {
+	result := []DiskAttachment{}
+	sdkAttachments, ok := sdkObject.DiskAttachments()
+	if !ok {
+		return result, nil
+	}
+	for i, sdkAttachment := range sdkAttachments.Slice() {
+		attachment, err := convertSDKDiskAttachment(sdkAttachment, o)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert embedded disk attachment #%d", i)
+		}
+		result = append(result, attachment)
+	}
+	return result, nil
+}
+
+func convertSDKVMEmbeddedReportedDevices(sdkObject *ovirtsdk.Vm) ([]ReportedDevice, error) {
+	result := []ReportedDevice{}
+	sdkDevices, ok := sdkObject.ReportedDevices()
+	if !ok {
+		return result, nil
+	}
+	for i, sdkDevice := range sdkDevices.Slice() {
+		device, err := convertSDKReportedDevice(sdkDevice)
+		if err != nil {
+			return nil, wrap(err, EBug, "failed to convert embedded reported device #%d", i)
+		}
+		result = append(result, device)
+	}
+	return result, nil
+}
@@ -6,14 +6,14 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) GetVM(id string, retries ...RetryStrategy) (result VM, err error) {
+func (o *oVirtClient) GetVM(id VMID, retries ...RetryStrategy) (result VM, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
 		fmt.Sprintf("getting vm %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(id).Get().Send()
+			response, err := o.connection().SystemService().VmsService().VmService(string(id)).Get().Send()
 			if err != nil {
 				return err
 			}
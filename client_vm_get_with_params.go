@@ -0,0 +1,50 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (o *oVirtClient) GetVMWithParams(
+	id VMID,
+	params VMGetParameters,
+	retries ...RetryStrategy) (result VM, err error) {
+	follow, err := vmFollowList(params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("getting vm %s", id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().VmsService().VmService(string(id)).Get()
+			if len(follow) > 0 {
+				req.Follow(strings.Join(follow.Strings(), ","))
+			}
+			response, err := req.Send()
+			if err != nil {
+				return err
+			}
+			sdkObject, ok := response.Vm()
+			if !ok {
+				return newError(
+					ENotFound,
+					"no vm returned when getting vm ID %s",
+					id,
+				)
+			}
+			result, err = convertSDKVMWithFollow(sdkObject, o, follow)
+			if err != nil {
+				return wrap(
+					err,
+					EBug,
+					"failed to convert vm %s",
+					id,
+				)
+			}
+			return nil
+		})
+	return
+}
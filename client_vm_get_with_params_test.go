@@ -0,0 +1,40 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMGetWithFollowNICs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)), nil)
+	nic := assertCanCreateNIC(t, helper, vm, "test", ovirtclient.CreateNICParams())
+
+	fetchedVM, err := client.GetVMWithParams(vm.ID(), ovirtclient.VMGetParams().MustWithFollow(ovirtclient.VMFollowNICs))
+	if err != nil {
+		t.Fatalf("failed to fetch VM with embedded NICs (%v)", err)
+	}
+	nics := fetchedVM.EmbeddedNICs()
+	if len(nics) != 1 {
+		t.Fatalf("incorrect number of embedded NICs returned (%d)", len(nics))
+	}
+	if nics[0].ID() != nic.ID() {
+		t.Fatalf("incorrect embedded NIC returned (%s != %s)", nics[0].ID(), nic.ID())
+	}
+	if fetchedVM.EmbeddedDiskAttachments() != nil {
+		t.Fatalf("disk attachments were embedded without being requested")
+	}
+}
+
+func TestVMGetWithInvalidFollow(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ovirtclient.VMGetParams().WithFollow("invalid"); err == nil {
+		t.Fatalf("setting an invalid follow value did not result in an error")
+	}
+}
@@ -0,0 +1,333 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// ExternalVMProviderType is the type of hypervisor ImportExternalVM imports a VM from.
+type ExternalVMProviderType string
+
+const (
+	// ExternalVMProviderTypeVMware imports from VMware vCenter or ESXi. The URL has the form
+	// vpx://user@vcenter/datacenter/cluster/host?no_verify=1.
+	ExternalVMProviderTypeVMware ExternalVMProviderType = "vmware"
+	// ExternalVMProviderTypeKVM imports from a libvirt managed KVM host. The URL has the form
+	// qemu+ssh://root@host/system.
+	ExternalVMProviderTypeKVM ExternalVMProviderType = "kvm"
+	// ExternalVMProviderTypeXen imports from a libvirt managed Xen host. The URL has the form xen+ssh://root@host.
+	ExternalVMProviderTypeXen ExternalVMProviderType = "xen"
+)
+
+// Validate returns an error if the provider type is not known.
+func (e ExternalVMProviderType) Validate() error {
+	for _, providerType := range ExternalVMProviderTypeValues() {
+		if providerType == e {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid external VM provider type: %s must be one of: %s",
+		e,
+		strings.Join(ExternalVMProviderTypeValues().Strings(), ", "),
+	)
+}
+
+// ExternalVMProviderTypeList is a list of ExternalVMProviderType values.
+type ExternalVMProviderTypeList []ExternalVMProviderType
+
+// Strings creates a string list of the values.
+func (l ExternalVMProviderTypeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, providerType := range l {
+		result[i] = string(providerType)
+	}
+	return result
+}
+
+// ExternalVMProviderTypeValues returns all possible ExternalVMProviderType values.
+func ExternalVMProviderTypeValues() ExternalVMProviderTypeList {
+	return []ExternalVMProviderType{
+		ExternalVMProviderTypeVMware,
+		ExternalVMProviderTypeKVM,
+		ExternalVMProviderTypeXen,
+	}
+}
+
+// ImportExternalVMOptionalParameters are the optional parameters for ImportExternalVM.
+type ImportExternalVMOptionalParameters interface {
+	// Name returns the name of the imported VM, or nil if the name of the source VM should be used.
+	Name() *string
+	// Username returns the user to log in to the external hypervisor with, or nil if the URL already contains it.
+	Username() *string
+	// Password returns the password to log in to the external hypervisor with, or nil if none is needed.
+	Password() *string
+	// Sparse returns true if the disks of the imported VM should be thin provisioned, or nil to use the engine
+	// default.
+	Sparse() *bool
+	// ProxyHostID returns the host that converts the VM, or nil if the engine should choose it.
+	ProxyHostID() *string
+}
+
+// BuildableImportExternalVMParameters is a buildable version of ImportExternalVMOptionalParameters.
+type BuildableImportExternalVMParameters interface {
+	ImportExternalVMOptionalParameters
+
+	// WithName sets the name of the imported VM.
+	WithName(name string) (BuildableImportExternalVMParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableImportExternalVMParameters
+	// WithCredentials sets the user and password to log in to the external hypervisor with.
+	WithCredentials(username string, password string) (BuildableImportExternalVMParameters, error)
+	// MustWithCredentials is identical to WithCredentials, but panics instead of returning an error.
+	MustWithCredentials(username string, password string) BuildableImportExternalVMParameters
+	// WithSparse sets whether the disks of the imported VM are thin provisioned.
+	WithSparse(sparse bool) (BuildableImportExternalVMParameters, error)
+	// MustWithSparse is identical to WithSparse, but panics instead of returning an error.
+	MustWithSparse(sparse bool) BuildableImportExternalVMParameters
+	// WithProxyHostID sets the host that converts the VM.
+	WithProxyHostID(hostID string) (BuildableImportExternalVMParameters, error)
+	// MustWithProxyHostID is identical to WithProxyHostID, but panics instead of returning an error.
+	MustWithProxyHostID(hostID string) BuildableImportExternalVMParameters
+}
+
+// ImportExternalVMParams creates a buildable set of parameters for ImportExternalVM.
+func ImportExternalVMParams() BuildableImportExternalVMParameters {
+	return &importExternalVMParams{}
+}
+
+type importExternalVMParams struct {
+	name        *string
+	username    *string
+	password    *string
+	sparse      *bool
+	proxyHostID *string
+}
+
+func (i *importExternalVMParams) Name() *string {
+	return i.name
+}
+
+func (i *importExternalVMParams) Username() *string {
+	return i.username
+}
+
+func (i *importExternalVMParams) Password() *string {
+	return i.password
+}
+
+func (i *importExternalVMParams) Sparse() *bool {
+	return i.sparse
+}
+
+func (i *importExternalVMParams) ProxyHostID() *string {
+	return i.proxyHostID
+}
+
+func (i *importExternalVMParams) WithName(name string) (BuildableImportExternalVMParameters, error) {
+	if err := validateVMName(DefaultVMNameValidator, name); err != nil {
+		return nil, err
+	}
+	i.name = &name
+	return i, nil
+}
+
+func (i *importExternalVMParams) MustWithName(name string) BuildableImportExternalVMParameters {
+	builder, err := i.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *importExternalVMParams) WithCredentials(username string, password string) (
+	BuildableImportExternalVMParameters,
+	error,
+) {
+	if username == "" {
+		return nil, newError(EBadArgument, "the username for the external hypervisor cannot be empty")
+	}
+	i.username = &username
+	i.password = &password
+	return i, nil
+}
+
+func (i *importExternalVMParams) MustWithCredentials(
+	username string,
+	password string,
+) BuildableImportExternalVMParameters {
+	builder, err := i.WithCredentials(username, password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *importExternalVMParams) WithSparse(sparse bool) (BuildableImportExternalVMParameters, error) {
+	i.sparse = &sparse
+	return i, nil
+}
+
+func (i *importExternalVMParams) MustWithSparse(sparse bool) BuildableImportExternalVMParameters {
+	builder, err := i.WithSparse(sparse)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (i *importExternalVMParams) WithProxyHostID(hostID string) (BuildableImportExternalVMParameters, error) {
+	if hostID == "" {
+		return nil, newError(EBadArgument, "the proxy host ID cannot be empty")
+	}
+	i.proxyHostID = &hostID
+	return i, nil
+}
+
+func (i *importExternalVMParams) MustWithProxyHostID(hostID string) BuildableImportExternalVMParameters {
+	builder, err := i.WithProxyHostID(hostID)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateImportExternalVM checks the arguments of ImportExternalVM and returns the name of the imported VM. It is
+// shared between the real and the mock client.
+func validateImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+) (string, error) {
+	if err := provider.Validate(); err != nil {
+		return "", err
+	}
+	if url == "" {
+		return "", newError(EBadArgument, "the URL of the external hypervisor cannot be empty")
+	}
+	if sourceVMName == "" {
+		return "", newError(EBadArgument, "the name of the source VM cannot be empty")
+	}
+	if clusterID == "" {
+		return "", newError(EBadArgument, "cluster ID cannot be empty")
+	}
+	if storageDomainID == "" {
+		return "", newError(EBadArgument, "storage domain ID cannot be empty")
+	}
+	if params != nil && params.Name() != nil {
+		return *params.Name(), nil
+	}
+	return sourceVMName, nil
+}
+
+func (o *oVirtClient) ImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	name, err := validateImportExternalVM(provider, url, sourceVMName, clusterID, storageDomainID, params)
+	if err != nil {
+		return nil, err
+	}
+	correlationID := o.storageDomainJobCorrelationID("external_vm_import", retries)
+	err = o.mutate(
+		importExternalVMResource(provider, url, sourceVMName, clusterID, storageDomainID, params),
+		fmt.Sprintf("importing VM %s from %s", sourceVMName, provider),
+		retries,
+		func() error {
+			_, err := o.connection().SystemService().
+				ExternalVmImportsService().
+				Add().
+				Import(buildSDKExternalVMImport(provider, url, sourceVMName, name, clusterID, storageDomainID, params)).
+				Query("correlation_id", correlationID).
+				Send()
+			return err
+		})
+	if err != nil {
+		return nil, withCorrelationID(err, correlationID)
+	}
+	if err := o.waitForJobFinished(correlationID, retries); err != nil {
+		return nil, withCorrelationID(err, correlationID)
+	}
+	vms, err := o.SearchVMs(VMSearchParams().WithName(name), retries...)
+	if err != nil {
+		return nil, err
+	}
+	if len(vms) != 1 {
+		return nil, newError(ENotFound, "the imported VM %s was not found", name)
+	}
+	return vms[0], nil
+}
+
+// importExternalVMResource returns the resource of an external VM import. The credentials of the external hypervisor
+// are included, so the audit records show which user logged in, the password is redacted in the records.
+func importExternalVMResource(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+) callResource {
+	resource := resourceOf(ResourceTypeVM, "").
+		with("provider", provider).
+		with("url", url).
+		with("source_vm_name", sourceVMName).
+		with("cluster_id", clusterID).
+		with("storage_domain_id", storageDomainID)
+	if params == nil {
+		return resource
+	}
+	if username := params.Username(); username != nil {
+		resource = resource.with("username", *username)
+	}
+	if password := params.Password(); password != nil {
+		resource = resource.with("password", *password)
+	}
+	return resource
+}
+
+func buildSDKExternalVMImport(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	name string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+) *ovirtsdk.ExternalVmImport {
+	builder := ovirtsdk.NewExternalVmImportBuilder().
+		Provider(ovirtsdk.ExternalVmProviderType(provider)).
+		Url(url).
+		Name(sourceVMName).
+		Vm(ovirtsdk.NewVmBuilder().Name(name).MustBuild()).
+		Cluster(ovirtsdk.NewClusterBuilder().Id(string(clusterID)).MustBuild()).
+		StorageDomain(ovirtsdk.NewStorageDomainBuilder().Id(storageDomainID).MustBuild())
+	if params == nil {
+		return builder.MustBuild()
+	}
+	if username := params.Username(); username != nil {
+		builder.Username(*username)
+	}
+	if password := params.Password(); password != nil {
+		builder.Password(*password)
+	}
+	if sparse := params.Sparse(); sparse != nil {
+		builder.Sparse(*sparse)
+	}
+	if hostID := params.ProxyHostID(); hostID != nil {
+		builder.Host(ovirtsdk.NewHostBuilder().Id(*hostID).MustBuild())
+	}
+	return builder.MustBuild()
+}
@@ -0,0 +1,56 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestImportExternalVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	if _, ok := client.(ovirtclient.MockClient); !ok {
+		t.Skipf("Importing external VMs needs an external hypervisor, skipping test.")
+	}
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	vm, err := client.ImportExternalVM(
+		ovirtclient.ExternalVMProviderTypeKVM,
+		"qemu+ssh://root@kvm.example.com/system",
+		"source-vm",
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+		ovirtclient.ImportExternalVMParams().MustWithName(name).MustWithSparse(true),
+	)
+	if err != nil {
+		t.Fatalf("Failed to import external VM (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("Failed to remove imported VM %s (%v)", vm.ID(), err)
+		}
+	})
+	if vm.Name() != name || vm.ClusterID() != helper.GetClusterID() {
+		t.Fatalf("The imported VM %s does not have the requested name and cluster.", vm.ID())
+	}
+}
+
+func TestImportExternalVMInvalidProvider(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.ImportExternalVM(
+		"hyperv",
+		"hyperv://host",
+		"source-vm",
+		helper.GetClusterID(),
+		helper.GetStorageDomainID(),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Importing from an invalid provider type did not fail with EBadArgument (%v)", err)
+	}
+}
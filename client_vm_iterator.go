@@ -0,0 +1,111 @@
+package ovirtclient
+
+// VMIterator iterates over virtual machines fetched page by page. It is used like this:
+//
+//	iterator := client.ListVMsIterator(0)
+//	for iterator.Next() {
+//	    vm := iterator.VM()
+//	    // Do something with the VM.
+//	}
+//	if err := iterator.Err(); err != nil {
+//	    // Handle the error.
+//	}
+//
+// VMs created or removed while iterating may shift the pages, so a VM may be skipped or returned twice. The iterator
+// is not safe for concurrent use.
+type VMIterator interface {
+	// Next advances the iterator to the next VM, fetching the next page if required. It returns false when there are
+	// no more VMs or fetching a page failed. Check Err to tell the two apart.
+	Next() bool
+	// VM returns the VM the iterator is currently at. It returns nil before the first call to Next and after Next
+	// returned false.
+	VM() VM
+	// Err returns the error that stopped the iteration, if any.
+	Err() error
+}
+
+func (o *oVirtClient) ListVMsIterator(pageSize uint, retries ...RetryStrategy) VMIterator {
+	return newVMIterator(o, pageSize, retries)
+}
+
+func (o *oVirtClient) ForEachVM(pageSize uint, callback func(vm VM) error, retries ...RetryStrategy) error {
+	return forEachVM(o, pageSize, callback, retries)
+}
+
+// newVMIterator creates a VMIterator on top of ListVMsPage. It is shared between the real and the mock client.
+func newVMIterator(client VMClient, pageSize uint, retries []RetryStrategy) *vmIterator {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	return &vmIterator{
+		client:   client,
+		pageSize: pageSize,
+		retries:  retries,
+	}
+}
+
+// forEachVM implements ForEachVM on top of a VMIterator. It is shared between the real and the mock client.
+func forEachVM(client VMClient, pageSize uint, callback func(vm VM) error, retries []RetryStrategy) error {
+	iterator := newVMIterator(client, pageSize, retries)
+	for iterator.Next() {
+		if err := callback(iterator.VM()); err != nil {
+			return err
+		}
+	}
+	return iterator.Err()
+}
+
+type vmIterator struct {
+	client   VMClient
+	pageSize uint
+	retries  []RetryStrategy
+
+	// page is the number of the last fetched page, starting with 1.
+	page  uint
+	items []VM
+	index int
+	// done is true once a page with fewer than pageSize items has been fetched.
+	done    bool
+	current VM
+	err     error
+}
+
+func (i *vmIterator) Next() bool {
+	i.current = nil
+	if i.err != nil {
+		return false
+	}
+	for i.index >= len(i.items) {
+		if i.done {
+			return false
+		}
+		if err := i.fetchNextPage(); err != nil {
+			i.err = err
+			return false
+		}
+	}
+	i.current = i.items[i.index]
+	i.index++
+	return true
+}
+
+func (i *vmIterator) fetchNextPage() error {
+	params := PageParams().MustWithMax(i.pageSize).MustWithPage(i.page + 1)
+	items, err := i.client.ListVMsPage(params, i.retries...)
+	if err != nil {
+		return wrap(err, EUnidentified, "failed to fetch page %d of VMs", i.page+1)
+	}
+	i.page++
+	i.items = items
+	i.index = 0
+	i.done = uint(len(items)) < i.pageSize
+	return nil
+}
+
+func (i *vmIterator) VM() VM {
+	return i.current
+}
+
+func (i *vmIterator) Err() error {
+	return i.err
+}
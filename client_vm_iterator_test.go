@@ -0,0 +1,63 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestListVMsIterator(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	expected := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		vm := assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+		expected[string(vm.ID())] = true
+	}
+
+	seen := map[string]bool{}
+	iterator := client.ListVMsIterator(2)
+	for iterator.Next() {
+		id := string(iterator.VM().ID())
+		if seen[id] {
+			t.Fatalf("the iterator returned VM %s twice", id)
+		}
+		seen[id] = true
+	}
+	if err := iterator.Err(); err != nil {
+		t.Fatalf("failed to iterate over VMs (%v)", err)
+	}
+	if iterator.VM() != nil {
+		t.Fatalf("the iterator returned a VM after the iteration finished")
+	}
+	for id := range expected {
+		if !seen[id] {
+			t.Fatalf("the iterator did not return VM %s", id)
+		}
+	}
+}
+
+func TestForEachVMStopsOnError(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_ = assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+	_ = assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+
+	callbackErr := errors.New("stop iterating")
+	calls := 0
+	err := client.ForEachVM(1, func(_ ovirtclient.VM) error {
+		calls++
+		return callbackErr
+	})
+	if !errors.Is(err, callbackErr) {
+		t.Fatalf("the error of the callback was not returned (%v)", err)
+	}
+	if calls != 1 {
+		t.Fatalf("incorrect number of callback calls (expected: 1, got: %d)", calls)
+	}
+}
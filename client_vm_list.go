@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListVMs(retries ...RetryStrategy) (result []VM, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []VM{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, ""),
 		"listing vms",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().List().Send()
+			response, e := o.connection().SystemService().VmsService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -0,0 +1,32 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (o *oVirtClient) ListVMDevices(id VMID, retries ...RetryStrategy) (result []VMDevice, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("listing devices of VM %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(id)).
+				Get().
+				Follow(strings.Join(vmDeviceFollow, ",")).
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkVM, ok := response.Vm()
+			if !ok {
+				return newError(ENotFound, "no vm returned when listing devices of VM ID %s", id)
+			}
+			result = convertSDKVMDevices(sdkVM, id)
+			return nil
+		})
+	return
+}
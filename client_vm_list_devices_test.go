@@ -0,0 +1,63 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMListDevices(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("device_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	disk := assertCanCreateDisk(t, helper)
+	attachment := assertCanAttachDisk(t, vm, disk)
+	nic := assertCanCreateNIC(
+		t,
+		helper,
+		vm,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateNICParams(),
+	)
+
+	devices, err := vm.ListDevices()
+	if err != nil {
+		t.Fatalf("failed to list devices of VM %s (%v)", vm.ID(), err)
+	}
+	foundDisk := false
+	foundNIC := false
+	for _, device := range devices {
+		if err := device.Type().Validate(); err != nil {
+			t.Fatalf("invalid device type on VM %s (%v)", vm.ID(), err)
+		}
+		if device.VMID() != vm.ID() {
+			t.Fatalf("VM ID mismatch on device %s (%s != %s)", device.ID(), device.VMID(), vm.ID())
+		}
+		switch {
+		case device.Type() == ovirtclient.VMDeviceTypeDisk && device.ID() == attachment.ID():
+			if device.Model() != string(attachment.DiskInterface()) {
+				t.Fatalf("incorrect disk device model (%s != %s)", device.Model(), attachment.DiskInterface())
+			}
+			foundDisk = true
+		case device.Type() == ovirtclient.VMDeviceTypeNIC && device.ID() == string(nic.ID()):
+			if device.Name() != nic.Name() {
+				t.Fatalf("incorrect NIC device name (%s != %s)", device.Name(), nic.Name())
+			}
+			foundNIC = true
+		}
+	}
+	if !foundDisk || !foundNIC {
+		t.Fatalf("disk or NIC missing from the devices of VM %s (%d devices)", vm.ID(), len(devices))
+	}
+
+	if _, err := helper.GetClient().ListVMDevices(ovirtclient.VMID(helper.GenerateRandomID(5))); err == nil {
+		t.Fatalf("listing the devices of a nonexistent VM did not fail")
+	}
+}
@@ -0,0 +1,38 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) ListVMNUMANodes(id VMID, retries ...RetryStrategy) (result []VMNUMANode, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VMNUMANode{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("listing virtual NUMA nodes of VM %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(id)).
+				NumaNodesService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Nodes()
+			if !ok {
+				return nil
+			}
+			result = make([]VMNUMANode, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVMNUMANode(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert virtual NUMA node during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,42 @@
+package ovirtclient
+
+func (o *oVirtClient) ListVMsPage(params PageParameters, retries ...RetryStrategy) (result []VM, err error) {
+	if err := validatePageParameters(params); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = PageParams()
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VM{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"listing a page of VMs",
+		retries,
+		func() error {
+			req := o.connection().SystemService().VmsService().List()
+			if max := params.Max(); max != nil {
+				req.Max(int64(*max))
+			}
+			if qs := pageSearchCriteria(params); qs != "" {
+				req.Search(qs)
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			result = make([]VM, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVM(sdkObject, o)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert VM during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,48 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMListPage(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_ = assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+	_ = assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+
+	firstPage, err := client.ListVMsPage(ovirtclient.PageParams().MustWithMax(1).MustWithPage(1))
+	if err != nil {
+		t.Fatalf("failed to list first page of VMs (%v)", err)
+	}
+	if len(firstPage) != 1 {
+		t.Fatalf("incorrect number of VMs returned on the first page (%d)", len(firstPage))
+	}
+	secondPage, err := client.ListVMsPage(ovirtclient.PageParams().MustWithMax(1).MustWithPage(2))
+	if err != nil {
+		t.Fatalf("failed to list second page of VMs (%v)", err)
+	}
+	if len(secondPage) != 1 {
+		t.Fatalf("incorrect number of VMs returned on the second page (%d)", len(secondPage))
+	}
+	if firstPage[0].ID() == secondPage[0].ID() {
+		t.Fatalf("the first and second page returned the same VM (%s)", firstPage[0].ID())
+	}
+}
+
+func TestVMSearchInvalidPage(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	_, err := client.SearchVMs(ovirtclient.VMSearchParams().WithName(helper.GenerateRandomID(5)).WithPage(0))
+	if err == nil {
+		t.Fatalf("searching for VMs with page 0 did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("searching for VMs with page 0 returned an unexpected error (%v)", err)
+	}
+}
@@ -0,0 +1,77 @@
+package ovirtclient
+
+import (
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMSummary contains the identity fields of a virtual machine as returned by ListVMSummaries.
+type VMSummary interface {
+	// ID returns the unique identifier (UUID) of the virtual machine.
+	ID() VMID
+	// Name is the user-defined name of the virtual machine.
+	Name() string
+	// Status returns the current status of the VM.
+	Status() VMStatus
+}
+
+type vmSummary struct {
+	id     VMID
+	name   string
+	status VMStatus
+}
+
+func (v vmSummary) ID() VMID {
+	return v.id
+}
+
+func (v vmSummary) Name() string {
+	return v.name
+}
+
+func (v vmSummary) Status() VMStatus {
+	return v.status
+}
+
+// convertSDKVMSummary converts only the identity fields of a VM, skipping the converters for the CPU,
+// initialization and other details.
+func convertSDKVMSummary(sdkObject *ovirtsdk.Vm) (VMSummary, error) {
+	v := &vm{}
+	for _, converter := range []func(sdkObject *ovirtsdk.Vm, vm *vm) error{
+		vmIDConverter,
+		vmNameConverter,
+		vmStatusConverter,
+	} {
+		if err := converter(sdkObject, v); err != nil {
+			return nil, err
+		}
+	}
+	return vmSummary{id: v.id, name: v.name, status: v.status}, nil
+}
+
+func (o *oVirtClient) ListVMSummaries(retries ...RetryStrategy) (result []VMSummary, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VMSummary{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"listing vm summaries",
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().VmsService().List().Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			result = make([]VMSummary, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVMSummary(sdkObject)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert vm summary during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
@@ -0,0 +1,31 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestListVMSummaries(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	summaries, err := client.ListVMSummaries()
+	if err != nil {
+		t.Fatalf("Failed to list VM summaries (%v)", err)
+	}
+	for _, summary := range summaries {
+		if summary.ID() != vm.ID() {
+			continue
+		}
+		if summary.Name() != vm.Name() {
+			t.Fatalf("Incorrect VM name in summary (expected: %s, got: %s)", vm.Name(), summary.Name())
+		}
+		if err := summary.Status().Validate(); err != nil {
+			t.Fatalf("Invalid VM status in summary (%v)", err)
+		}
+		return
+	}
+	t.Fatalf("The VM %s was not found in the VM summaries.", vm.ID())
+}
@@ -0,0 +1,41 @@
+package ovirtclient
+
+import (
+	"strings"
+)
+
+func (o *oVirtClient) ListVMsWithParams(params VMGetParameters, retries ...RetryStrategy) (result []VM, err error) {
+	follow, err := vmFollowList(params)
+	if err != nil {
+		return nil, err
+	}
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	result = []VM{}
+	err = o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"listing vms",
+		retries,
+		func() error {
+			req := o.connection().SystemService().VmsService().List()
+			if len(follow) > 0 {
+				req.Follow(strings.Join(follow.Strings(), ","))
+			}
+			response, e := req.Send()
+			if e != nil {
+				return e
+			}
+			sdkObjects, ok := response.Vms()
+			if !ok {
+				return nil
+			}
+			result = make([]VM, len(sdkObjects.Slice()))
+			for i, sdkObject := range sdkObjects.Slice() {
+				result[i], e = convertSDKVMWithFollow(sdkObject, o, follow)
+				if e != nil {
+					return wrap(e, EBug, "failed to convert vm during listing item #%d", i)
+				}
+			}
+			return nil
+		})
+	return
+}
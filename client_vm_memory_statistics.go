@@ -0,0 +1,110 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// VMMemoryStatistics contains the memory statistics of a VM as reported by the engine. All values are in bytes. The
+// values describing the memory usage inside the guest are only reported for running VMs with a guest agent and are 0
+// otherwise.
+type VMMemoryStatistics interface {
+	// Installed returns the memory configured for the VM.
+	Installed() uint64
+	// Used returns the memory used inside the guest.
+	Used() uint64
+	// Free returns the memory the guest reports as free.
+	Free() uint64
+	// Unused returns the memory the guest reports as unused, excluding buffers and caches.
+	Unused() uint64
+	// Buffered returns the memory the guest uses for buffers.
+	Buffered() uint64
+	// Cached returns the memory the guest uses for the page cache.
+	Cached() uint64
+}
+
+func (o *oVirtClient) GetVMMemoryStatistics(id VMID, retries ...RetryStrategy) (result VMMemoryStatistics, err error) {
+	retries = defaultRetries(retries, defaultReadTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("getting memory statistics of VM %s", id),
+		retries,
+		func() error {
+			response, e := o.connection().SystemService().
+				VmsService().
+				VmService(string(id)).
+				StatisticsService().
+				List().
+				Send()
+			if e != nil {
+				return e
+			}
+			result = &vmMemoryStatistics{}
+			if sdkObjects, ok := response.Statistics(); ok {
+				result = convertSDKVMMemoryStatistics(sdkObjects.Slice())
+			}
+			return nil
+		})
+	return
+}
+
+// convertSDKVMMemoryStatistics extracts the memory statistics from the statistics of a VM. Other statistics, such as
+// the CPU usage, are ignored.
+func convertSDKVMMemoryStatistics(sdkObjects []*ovirtsdk.Statistic) *vmMemoryStatistics {
+	result := &vmMemoryStatistics{}
+	fields := map[string]*uint64{
+		"memory.installed": &result.installed,
+		"memory.used":      &result.used,
+		"memory.free":      &result.free,
+		"memory.unused":    &result.unused,
+		"memory.buffered":  &result.buffered,
+		"memory.cached":    &result.cached,
+	}
+	for _, sdkObject := range sdkObjects {
+		name, _ := sdkObject.Name()
+		field, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if sdkValues, ok := sdkObject.Values(); ok && len(sdkValues.Slice()) > 0 {
+			if datum, ok := sdkValues.Slice()[0].Datum(); ok {
+				*field = uint64(datum)
+			}
+		}
+	}
+	return result
+}
+
+type vmMemoryStatistics struct {
+	installed uint64
+	used      uint64
+	free      uint64
+	unused    uint64
+	buffered  uint64
+	cached    uint64
+}
+
+func (v *vmMemoryStatistics) Installed() uint64 {
+	return v.installed
+}
+
+func (v *vmMemoryStatistics) Used() uint64 {
+	return v.used
+}
+
+func (v *vmMemoryStatistics) Free() uint64 {
+	return v.free
+}
+
+func (v *vmMemoryStatistics) Unused() uint64 {
+	return v.unused
+}
+
+func (v *vmMemoryStatistics) Buffered() uint64 {
+	return v.buffered
+}
+
+func (v *vmMemoryStatistics) Cached() uint64 {
+	return v.cached
+}
@@ -0,0 +1,66 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestVMMemoryStatistics(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("memory_stats_test_%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams(),
+	)
+	stats, err := vm.MemoryStatistics()
+	if err != nil {
+		t.Fatalf("failed to get memory statistics of VM %s (%v)", vm.ID(), err)
+	}
+	if stats.Installed() == 0 {
+		t.Fatalf("no installed memory reported for VM %s", vm.ID())
+	}
+	if stats.Used() != 0 {
+		t.Fatalf("VM %s reports used memory while it is down (%d bytes)", vm.ID(), stats.Used())
+	}
+}
+
+// TestRunningVMMemoryStatistics runs against the mock only to avoid depending on a guest agent in the test VM.
+func TestRunningVMMemoryStatistics(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	vm, err := client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "memory_stats_test", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	if err := vm.Start(); err != nil {
+		t.Fatalf("failed to start VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusUp); err != nil {
+		t.Fatalf("VM %s did not start (%v)", vm.ID(), err)
+	}
+	stats, err := vm.MemoryStatistics()
+	if err != nil {
+		t.Fatalf("failed to get memory statistics of VM %s (%v)", vm.ID(), err)
+	}
+	if stats.Used() == 0 || stats.Used()+stats.Free() != stats.Installed() {
+		t.Fatalf(
+			"inconsistent memory statistics for VM %s (installed: %d, used: %d, free: %d)",
+			vm.ID(),
+			stats.Installed(),
+			stats.Used(),
+			stats.Free(),
+		)
+	}
+	if stats.Unused() > stats.Free() {
+		t.Fatalf("more unused than free memory reported for VM %s", vm.ID())
+	}
+}
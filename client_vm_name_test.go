@@ -0,0 +1,122 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestDefaultVMNameValidator(t *testing.T) {
+	t.Parallel()
+	for name, valid := range map[string]bool{
+		"test-vm_1.0":            true,
+		"tëst-ünïcode":           true,
+		strings.Repeat("a", 255): true,
+		strings.Repeat("a", 256): false,
+		"":                       false,
+		"with space":             false,
+		"semi;colon":             false,
+	} {
+		err := ovirtclient.DefaultVMNameValidator(name)
+		if valid && err != nil {
+			t.Fatalf("the valid VM name %q was rejected (%v)", name, err)
+		}
+		if !valid && !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+			t.Fatalf("the invalid VM name %q was not rejected with EBadArgument (%v)", name, err)
+		}
+	}
+}
+
+func TestCustomVMNameValidator(t *testing.T) {
+	t.Parallel()
+	// The client runs in dry-run mode, so no VM is created on the fake engine.
+	server := httptest.NewServer(newDryRunEngine())
+	defer server.Close()
+	client, err := ovirtclient.NewWithVerify(
+		server.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&vmNameExtraSettings{
+			validator: func(name string) error {
+				if !strings.HasPrefix(name, "ci-") {
+					return fmt.Errorf("VM names must start with ci-")
+				}
+				return nil
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+
+	_, err = client.CreateVM("cluster", ovirtclient.DefaultBlankTemplateID, "test", nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("the custom VM name validator was not applied on creation (%v)", err)
+	}
+	_, err = client.CreateVM("cluster", ovirtclient.DefaultBlankTemplateID, "ci-test", nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EDryRun) {
+		t.Fatalf("a VM name accepted by the custom validator was rejected (%v)", err)
+	}
+	_, err = client.UpdateVM("vm", ovirtclient.UpdateVMParams().MustWithName("test"))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("the custom VM name validator was not applied on update (%v)", err)
+	}
+}
+
+type vmNameExtraSettings struct {
+	userAgentExtraSettings
+
+	validator ovirtclient.VMNameValidator
+}
+
+func (v *vmNameExtraSettings) DryRun() bool {
+	return true
+}
+
+func (v *vmNameExtraSettings) AuditSink() ovirtclient.AuditSink {
+	return nil
+}
+
+func (v *vmNameExtraSettings) VMNameValidator() ovirtclient.VMNameValidator {
+	return v.validator
+}
+
+func TestMockCustomVMNameValidator(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMockWithExtraSettings(
+		ovirtclientlog.NewTestLogger(t),
+		&vmNameExtraSettings{
+			validator: func(name string) error {
+				if !strings.HasPrefix(name, "ci-") {
+					return fmt.Errorf("VM names must start with ci-")
+				}
+				return nil
+			},
+		},
+	)
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+
+	_, err = client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "test", nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("the custom VM name validator was not applied on creation in the mock (%v)", err)
+	}
+	// The default rules reject spaces, so this checks the custom validator replaces them.
+	vm, err := client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "ci-with space", nil)
+	if err != nil {
+		t.Fatalf("a VM name accepted by the custom validator was rejected by the mock (%v)", err)
+	}
+	_, err = client.UpdateVM(vm.ID(), ovirtclient.UpdateVMParams().MustWithName("test"))
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("the custom VM name validator was not applied on update in the mock (%v)", err)
+	}
+}
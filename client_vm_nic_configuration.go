@@ -0,0 +1,216 @@
+package ovirtclient
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// NICBootProtocol describes how a guest network interface obtains its IP address.
+type NICBootProtocol string
+
+const (
+	// NICBootProtocolDHCP obtains the IP address using DHCP.
+	NICBootProtocolDHCP NICBootProtocol = "dhcp"
+	// NICBootProtocolStatic uses a statically configured IP address.
+	NICBootProtocolStatic NICBootProtocol = "static"
+	// NICBootProtocolNone leaves the interface unconfigured.
+	NICBootProtocolNone NICBootProtocol = "none"
+)
+
+// Validate returns an error if the boot protocol is not known.
+func (b NICBootProtocol) Validate() error {
+	for _, protocol := range NICBootProtocolValues() {
+		if protocol == b {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid NIC boot protocol: %s must be one of: %s",
+		b,
+		strings.Join(NICBootProtocolValues().Strings(), ", "),
+	)
+}
+
+// NICBootProtocolList is a list of NICBootProtocol values.
+type NICBootProtocolList []NICBootProtocol
+
+// Strings creates a string list of the values.
+func (l NICBootProtocolList) Strings() []string {
+	result := make([]string, len(l))
+	for i, protocol := range l {
+		result[i] = string(protocol)
+	}
+	return result
+}
+
+// NICBootProtocolValues returns all possible NICBootProtocol values.
+func NICBootProtocolValues() NICBootProtocolList {
+	return []NICBootProtocol{
+		NICBootProtocolDHCP,
+		NICBootProtocolStatic,
+		NICBootProtocolNone,
+	}
+}
+
+// NICConfiguration is the IPv4 configuration of a guest network interface. The engine passes it to cloud-init as
+// network configuration when the VM is initialized, since cloud-init ignores network settings in the user data.
+type NICConfiguration interface {
+	// Name returns the name of the network interface inside the guest, for example eth0.
+	Name() string
+	// BootProtocol returns how the interface obtains its IP address.
+	BootProtocol() NICBootProtocol
+	// IP returns the static IP address and netmask of the interface, or nil if the boot protocol is not static.
+	IP() *net.IPNet
+	// Gateway returns the default gateway of the interface, or nil if no gateway is set.
+	Gateway() net.IP
+}
+
+// NewDHCPNICConfiguration creates a NICConfiguration for a guest network interface that obtains its IP address using
+// DHCP.
+func NewDHCPNICConfiguration(name string) (NICConfiguration, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the network interface name cannot be empty")
+	}
+	return &nicConfiguration{name: name, bootProtocol: NICBootProtocolDHCP}, nil
+}
+
+// MustNewDHCPNICConfiguration is identical to NewDHCPNICConfiguration, but panics instead of returning an error.
+func MustNewDHCPNICConfiguration(name string) NICConfiguration {
+	nicConfiguration, err := NewDHCPNICConfiguration(name)
+	if err != nil {
+		panic(err)
+	}
+	return nicConfiguration
+}
+
+// NewStaticNICConfiguration creates a NICConfiguration for a guest network interface with a static IPv4 address. The
+// gateway may be nil.
+func NewStaticNICConfiguration(name string, ip *net.IPNet, gateway net.IP) (NICConfiguration, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "the network interface name cannot be empty")
+	}
+	if ip == nil || ip.IP.To4() == nil {
+		return nil, newError(EBadArgument, "the static IP address of interface %s must be an IPv4 address", name)
+	}
+	if ones, bits := ip.Mask.Size(); bits != 8*net.IPv4len || ones == 0 {
+		return nil, newError(EBadArgument, "invalid netmask for the static IP address of interface %s", name)
+	}
+	if gateway != nil && gateway.To4() == nil {
+		return nil, newError(EBadArgument, "the gateway of interface %s must be an IPv4 address", name)
+	}
+	return &nicConfiguration{
+		name:         name,
+		bootProtocol: NICBootProtocolStatic,
+		ip:           &net.IPNet{IP: ip.IP.To4(), Mask: ip.Mask},
+		gateway:      gateway,
+	}, nil
+}
+
+// MustNewStaticNICConfiguration is identical to NewStaticNICConfiguration, but panics instead of returning an error.
+func MustNewStaticNICConfiguration(name string, ip *net.IPNet, gateway net.IP) NICConfiguration {
+	nicConfiguration, err := NewStaticNICConfiguration(name, ip, gateway)
+	if err != nil {
+		panic(err)
+	}
+	return nicConfiguration
+}
+
+type nicConfiguration struct {
+	name         string
+	bootProtocol NICBootProtocol
+	ip           *net.IPNet
+	gateway      net.IP
+}
+
+// clone creates a deep copy of the NIC configuration.
+func (n *nicConfiguration) clone() *nicConfiguration {
+	result := *n
+	if n.ip != nil {
+		result.ip = &net.IPNet{
+			IP:   append(net.IP(nil), n.ip.IP...),
+			Mask: append(net.IPMask(nil), n.ip.Mask...),
+		}
+	}
+	if n.gateway != nil {
+		result.gateway = append(net.IP(nil), n.gateway...)
+	}
+	return &result
+}
+
+func (n *nicConfiguration) Name() string {
+	return n.name
+}
+
+func (n *nicConfiguration) BootProtocol() NICBootProtocol {
+	return n.bootProtocol
+}
+
+func (n *nicConfiguration) IP() *net.IPNet {
+	return n.ip
+}
+
+func (n *nicConfiguration) Gateway() net.IP {
+	return n.gateway
+}
+
+func convertNICConfigurationToSDK(nicConfiguration NICConfiguration) *ovirtsdk.NicConfiguration {
+	builder := ovirtsdk.NewNicConfigurationBuilder().
+		Name(nicConfiguration.Name()).
+		BootProtocol(ovirtsdk.BootProtocol(nicConfiguration.BootProtocol())).
+		OnBoot(true)
+	if ip := nicConfiguration.IP(); ip != nil {
+		ipBuilder := ovirtsdk.NewIpBuilder().
+			Address(ip.IP.String()).
+			Netmask(net.IP(ip.Mask).String()).
+			Version(ovirtsdk.IPVERSION_V4)
+		if gateway := nicConfiguration.Gateway(); gateway != nil {
+			ipBuilder.Gateway(gateway.String())
+		}
+		builder.IpBuilder(ipBuilder)
+	}
+	return builder.MustBuild()
+}
+
+func convertSDKNICConfiguration(sdkObject *ovirtsdk.NicConfiguration) NICConfiguration {
+	result := &nicConfiguration{}
+	result.name, _ = sdkObject.Name()
+	bootProtocol, _ := sdkObject.BootProtocol()
+	result.bootProtocol = NICBootProtocol(bootProtocol)
+	sdkIP, ok := sdkObject.Ip()
+	if !ok {
+		return result
+	}
+	if address, ok := sdkIP.Address(); ok {
+		if ip := net.ParseIP(address); ip != nil {
+			netmask, _ := sdkIP.Netmask()
+			result.ip = &net.IPNet{IP: ip, Mask: parseNetmask(netmask, ip)}
+		}
+	}
+	if gateway, ok := sdkIP.Gateway(); ok {
+		result.gateway = net.ParseIP(gateway)
+	}
+	return result
+}
+
+// parseNetmask parses a netmask the engine reports either in dotted notation or as a prefix length.
+func parseNetmask(netmask string, ip net.IP) net.IPMask {
+	if maskIP := net.ParseIP(netmask); maskIP != nil {
+		if maskIP4 := maskIP.To4(); maskIP4 != nil {
+			return net.IPMask(maskIP4)
+		}
+		return net.IPMask(maskIP)
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		bits = 8 * net.IPv4len
+	}
+	prefix, err := strconv.Atoi(netmask)
+	if err != nil || prefix < 0 || prefix > bits {
+		return nil
+	}
+	return net.CIDRMask(prefix, bits)
+}
@@ -0,0 +1,253 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"strings"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+// NUMATuneMode describes how the memory of a VM with virtual NUMA nodes is allocated on the host NUMA nodes it is
+// pinned to.
+type NUMATuneMode string
+
+const (
+	// NUMATuneModeStrict only allocates memory on the pinned host NUMA nodes. The allocation fails if the pinned
+	// nodes run out of memory.
+	NUMATuneModeStrict NUMATuneMode = "strict"
+	// NUMATuneModeInterleave allocates memory round-robin on the pinned host NUMA nodes.
+	NUMATuneModeInterleave NUMATuneMode = "interleave"
+	// NUMATuneModePreferred allocates memory on the preferred host NUMA node and falls back to other nodes if it
+	// runs out of memory.
+	NUMATuneModePreferred NUMATuneMode = "preferred"
+)
+
+// Validate returns an error if the NUMA tune mode is not known.
+func (n NUMATuneMode) Validate() error {
+	for _, mode := range NUMATuneModeValues() {
+		if mode == n {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid NUMA tune mode: %s must be one of: %s",
+		n,
+		strings.Join(NUMATuneModeValues().Strings(), ", "),
+	)
+}
+
+// NUMATuneModeList is a list of NUMATuneMode values.
+type NUMATuneModeList []NUMATuneMode
+
+// Strings creates a string list of the values.
+func (l NUMATuneModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// NUMATuneModeValues returns all possible NUMATuneMode values.
+func NUMATuneModeValues() NUMATuneModeList {
+	return []NUMATuneMode{
+		NUMATuneModeStrict,
+		NUMATuneModeInterleave,
+		NUMATuneModePreferred,
+	}
+}
+
+// VMNUMANode is a virtual NUMA node of a VM. Pinning it to host NUMA nodes requires the VM to be pinned to a host,
+// otherwise the engine rejects the pinning.
+type VMNUMANode interface {
+	// Index returns the index of the virtual NUMA node, starting with 0.
+	Index() uint
+	// CPUs returns the indexes of the virtual CPUs belonging to the NUMA node.
+	CPUs() []uint
+	// Memory returns the memory of the NUMA node in bytes.
+	Memory() uint64
+	// HostNUMANodeIndexes returns the indexes of the host NUMA nodes the virtual NUMA node is pinned to. See
+	// HostNUMANode.Index for the host side. The list is empty if the node is not pinned.
+	HostNUMANodeIndexes() []uint
+}
+
+// NewVMNUMANode creates a virtual NUMA node for use with WithNUMANodes. The memory is specified in bytes and must be
+// a positive multiple of 1 MiB. The hostNUMANodeIndexes may be empty to leave the node unpinned.
+func NewVMNUMANode(index uint, cpus []uint, memory uint64, hostNUMANodeIndexes []uint) (VMNUMANode, error) {
+	if len(cpus) == 0 {
+		return nil, newError(EBadArgument, "virtual NUMA node %d must have at least one CPU", index)
+	}
+	if memory == 0 || memory%(1024*1024) != 0 {
+		return nil, newError(
+			EBadArgument,
+			"the memory of virtual NUMA node %d must be a positive multiple of 1 MiB (%d given)",
+			index,
+			memory,
+		)
+	}
+	return &vmNUMANode{
+		index:               index,
+		cpus:                append([]uint{}, cpus...),
+		memory:              memory,
+		hostNUMANodeIndexes: append([]uint{}, hostNUMANodeIndexes...),
+	}, nil
+}
+
+// MustNewVMNUMANode is identical to NewVMNUMANode, but panics instead of returning an error.
+func MustNewVMNUMANode(index uint, cpus []uint, memory uint64, hostNUMANodeIndexes []uint) VMNUMANode {
+	node, err := NewVMNUMANode(index, cpus, memory, hostNUMANodeIndexes)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+type vmNUMANode struct {
+	index               uint
+	cpus                []uint
+	memory              uint64
+	hostNUMANodeIndexes []uint
+}
+
+func (v *vmNUMANode) Index() uint {
+	return v.index
+}
+
+func (v *vmNUMANode) CPUs() []uint {
+	return v.cpus
+}
+
+func (v *vmNUMANode) Memory() uint64 {
+	return v.memory
+}
+
+func (v *vmNUMANode) HostNUMANodeIndexes() []uint {
+	return v.hostNUMANodeIndexes
+}
+
+// validateVMNUMANodes checks that the virtual NUMA nodes are numbered from 0 without gaps and that no virtual CPU is
+// assigned to more than one node, like the engine does.
+func validateVMNUMANodes(nodes []VMNUMANode) error {
+	indexes := map[uint]bool{}
+	cpus := map[uint]uint{}
+	for _, node := range nodes {
+		if indexes[node.Index()] {
+			return newError(EBadArgument, "duplicate virtual NUMA node index %d", node.Index())
+		}
+		indexes[node.Index()] = true
+		for _, cpu := range node.CPUs() {
+			if otherIndex, ok := cpus[cpu]; ok {
+				return newError(
+					EBadArgument,
+					"CPU %d is assigned to both virtual NUMA node %d and %d",
+					cpu,
+					otherIndex,
+					node.Index(),
+				)
+			}
+			cpus[cpu] = node.Index()
+		}
+	}
+	for i := range nodes {
+		if !indexes[uint(i)] {
+			return newError(EBadArgument, "virtual NUMA node indexes must start at 0 without gaps (%d missing)", i)
+		}
+	}
+	return nil
+}
+
+func convertSDKVMNUMANode(sdkObject *ovirtsdk.VirtualNumaNode) (VMNUMANode, error) {
+	index, ok := sdkObject.Index()
+	if !ok {
+		return nil, newFieldNotFound("virtual NUMA node", "index")
+	}
+	// The engine reports the memory of NUMA nodes in MiB.
+	memory, _ := sdkObject.Memory()
+	result := &vmNUMANode{
+		index:               uint(index),
+		cpus:                []uint{},
+		memory:              uint64(memory) * 1024 * 1024,
+		hostNUMANodeIndexes: []uint{},
+	}
+	if sdkCPU, ok := sdkObject.Cpu(); ok {
+		if sdkCores, ok := sdkCPU.Cores(); ok {
+			for _, sdkCore := range sdkCores.Slice() {
+				if coreIndex, ok := sdkCore.Index(); ok {
+					result.cpus = append(result.cpus, uint(coreIndex))
+				}
+			}
+		}
+	}
+	if sdkPins, ok := sdkObject.NumaNodePins(); ok {
+		for _, sdkPin := range sdkPins.Slice() {
+			if pinIndex, ok := sdkPin.Index(); ok {
+				result.hostNUMANodeIndexes = append(result.hostNUMANodeIndexes, uint(pinIndex))
+			}
+		}
+	}
+	return result, nil
+}
+
+func convertVMNUMANodeToSDK(node VMNUMANode) *ovirtsdk.VirtualNumaNode {
+	cores := make([]*ovirtsdk.Core, len(node.CPUs()))
+	for i, cpu := range node.CPUs() {
+		cores[i] = ovirtsdk.NewCoreBuilder().Index(int64(cpu)).MustBuild()
+	}
+	pins := make([]*ovirtsdk.NumaNodePin, len(node.HostNUMANodeIndexes()))
+	for i, hostIndex := range node.HostNUMANodeIndexes() {
+		pins[i] = ovirtsdk.NewNumaNodePinBuilder().Index(int64(hostIndex)).MustBuild()
+	}
+	return ovirtsdk.NewVirtualNumaNodeBuilder().
+		Index(int64(node.Index())).
+		Memory(int64(node.Memory() / 1024 / 1024)).
+		Cpu(ovirtsdk.NewCpuBuilder().CoresOfAny(cores...).MustBuild()).
+		NumaNodePinsOfAny(pins...).
+		MustBuild()
+}
+
+// replaceVMNUMANodes removes the existing virtual NUMA nodes of the VM and adds the specified ones. The engine has no
+// call to replace all nodes at once, so this takes one API call per node.
+func (o *oVirtClient) replaceVMNUMANodes(
+	id VMID,
+	nodes []VMNUMANode,
+	retries []RetryStrategy,
+	correlationID string,
+) error {
+	return o.mutate(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("setting virtual NUMA nodes of VM %s", id),
+		retries,
+		func() error {
+			nodesService := o.connection().SystemService().VmsService().VmService(string(id)).NumaNodesService()
+			response, e := nodesService.List().Send()
+			if e != nil {
+				return e
+			}
+			if sdkNodes, ok := response.Nodes(); ok {
+				for _, sdkNode := range sdkNodes.Slice() {
+					nodeID, ok := sdkNode.Id()
+					if !ok {
+						return newFieldNotFound("virtual NUMA node", "id")
+					}
+					req := nodesService.NodeService(nodeID).Remove()
+					if correlationID != "" {
+						req.Query("correlation_id", correlationID)
+					}
+					if _, e := req.Send(); e != nil {
+						return e
+					}
+				}
+			}
+			for _, node := range nodes {
+				req := nodesService.Add().Node(convertVMNUMANodeToSDK(node))
+				if correlationID != "" {
+					req.Query("correlation_id", correlationID)
+				}
+				if _, e := req.Send(); e != nil {
+					return e
+				}
+			}
+			return nil
+		})
+}
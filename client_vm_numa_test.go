@@ -0,0 +1,89 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+// TestVMNUMANodePinning runs against the mock only since pinning virtual NUMA nodes requires a VM pinned to a host
+// with multiple NUMA nodes.
+func TestVMNUMANodePinning(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+
+	vm, err := client.CreateVM(
+		clusters[0].ID(),
+		ovirtclient.DefaultBlankTemplateID,
+		"numa_test",
+		ovirtclient.CreateVMParams().
+			MustWithCPUParameters(2, 1, 1).
+			MustWithNUMATuneMode(ovirtclient.NUMATuneModeStrict).
+			MustWithNUMANodes(
+				ovirtclient.MustNewVMNUMANode(0, []uint{0}, 512*1024*1024, []uint{0}),
+				ovirtclient.MustNewVMNUMANode(1, []uint{1}, 512*1024*1024, []uint{1}),
+			),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VM with NUMA nodes (%v)", err)
+	}
+	if vm.NUMATuneMode() != ovirtclient.NUMATuneModeStrict {
+		t.Fatalf("incorrect NUMA tune mode after creation (%s)", vm.NUMATuneMode())
+	}
+	nodes, err := vm.ListNUMANodes()
+	if err != nil {
+		t.Fatalf("failed to list NUMA nodes of VM %s (%v)", vm.ID(), err)
+	}
+	if len(nodes) != 2 || len(nodes[1].HostNUMANodeIndexes()) != 1 || nodes[1].HostNUMANodeIndexes()[0] != 1 {
+		t.Fatalf("incorrect NUMA nodes after creation (%d nodes)", len(nodes))
+	}
+
+	if _, err := vm.Update(
+		ovirtclient.UpdateVMParams().MustWithNUMANodes(
+			ovirtclient.MustNewVMNUMANode(0, []uint{0, 2}, 1024*1024*1024, nil),
+		),
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("pinning a CPU the VM does not have did not fail (%v)", err)
+	}
+	updatedVM, err := vm.Update(
+		ovirtclient.UpdateVMParams().
+			MustWithNUMATuneMode(ovirtclient.NUMATuneModeInterleave).
+			MustWithNUMANodes(ovirtclient.MustNewVMNUMANode(0, []uint{0, 1}, 1024*1024*1024, []uint{0, 1})),
+	)
+	if err != nil {
+		t.Fatalf("failed to update NUMA settings of VM %s (%v)", vm.ID(), err)
+	}
+	if updatedVM.NUMATuneMode() != ovirtclient.NUMATuneModeInterleave {
+		t.Fatalf("incorrect NUMA tune mode after update (%s)", updatedVM.NUMATuneMode())
+	}
+	nodes, err = vm.ListNUMANodes()
+	if err != nil {
+		t.Fatalf("failed to list NUMA nodes of VM %s (%v)", vm.ID(), err)
+	}
+	if len(nodes) != 1 || len(nodes[0].CPUs()) != 2 {
+		t.Fatalf("incorrect NUMA nodes after update (%d nodes)", len(nodes))
+	}
+}
+
+func TestVMNUMANodeValidation(t *testing.T) {
+	t.Parallel()
+	if _, err := ovirtclient.CreateVMParams().WithNUMANodes(
+		ovirtclient.MustNewVMNUMANode(0, []uint{0}, 512*1024*1024, nil),
+		ovirtclient.MustNewVMNUMANode(2, []uint{1}, 512*1024*1024, nil),
+	); err == nil {
+		t.Fatalf("creating virtual NUMA nodes with a gap in the indexes did not fail")
+	}
+	if _, err := ovirtclient.CreateVMParams().WithNUMANodes(
+		ovirtclient.MustNewVMNUMANode(0, []uint{0, 1}, 512*1024*1024, nil),
+		ovirtclient.MustNewVMNUMANode(1, []uint{1}, 512*1024*1024, nil),
+	); err == nil {
+		t.Fatalf("assigning a CPU to two virtual NUMA nodes did not fail")
+	}
+	if _, err := ovirtclient.NewVMNUMANode(0, []uint{0}, 1000, nil); err == nil {
+		t.Fatalf("creating a virtual NUMA node with memory that is not a multiple of 1 MiB did not fail")
+	}
+}
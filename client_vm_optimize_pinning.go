@@ -2,18 +2,26 @@ package ovirtclient
 
 import "fmt"
 
-func (o *oVirtClient) AutoOptimizeVMCPUPinningSettings(id string, optimize bool, retries ...RetryStrategy) error {
-	return retry(
+func (o *oVirtClient) AutoOptimizeVMCPUPinningSettings(id VMID, optimize bool, retries ...RetryStrategy) error {
+	if err := o.requireFeature(EngineFeatureAutoPinning, retries); err != nil {
+		return err
+	}
+	correlationID := o.correlationIDFor(retries)
+	err := o.mutate(
+		resourceOf(ResourceTypeVM, string(id)).with("optimize", optimize),
 		fmt.Sprintf("optimizing CPU pinning settings for VM %s", id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().
+			req := o.connection().SystemService().
 				VmsService().
-				VmService(id).
+				VmService(string(id)).
 				AutoPinCpuAndNumaNodes().
-				OptimizeCpuSettings(optimize).
-				Send()
+				OptimizeCpuSettings(optimize)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		})
+	return withCorrelationID(err, correlationID)
 }
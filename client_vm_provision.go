@@ -0,0 +1,525 @@
+package ovirtclient
+
+// VMBlueprint describes a VM together with the disks, NICs and tags ProvisionVM creates for it.
+type VMBlueprint interface {
+	// ClusterID returns the cluster the VM is created in.
+	ClusterID() ClusterID
+	// TemplateID returns the template the VM is created from.
+	TemplateID() TemplateID
+	// Name returns the name of the VM.
+	Name() string
+	// VMParameters returns the optional parameters passed to CreateVM. It may be nil.
+	VMParameters() OptionalVMParameters
+	// Disks returns the disks created and attached to the VM, in order.
+	Disks() []VMBlueprintDisk
+	// NICs returns the NICs created on the VM, in order.
+	NICs() []VMBlueprintNIC
+	// TagIDs returns the tags added to the VM.
+	TagIDs() []TagID
+	// Start returns true if the VM should be started once all resources are created.
+	Start() bool
+}
+
+// VMBlueprintDisk is a disk in a VMBlueprint.
+type VMBlueprintDisk interface {
+	// StorageDomainID returns the storage domain the disk is created on.
+	StorageDomainID() string
+	// Format returns the image format of the disk.
+	Format() ImageFormat
+	// Size returns the size of the disk in bytes.
+	Size() uint64
+	// Interface returns the interface the disk is attached to the VM with.
+	Interface() DiskInterface
+	// Params returns the optional parameters passed to CreateDisk. It may be nil.
+	Params() CreateDiskOptionalParameters
+	// AttachmentParams returns the optional parameters passed to CreateDiskAttachment. It may be nil.
+	AttachmentParams() CreateDiskAttachmentOptionalParams
+}
+
+// VMBlueprintNIC is a NIC in a VMBlueprint.
+type VMBlueprintNIC interface {
+	// Name returns the name of the NIC.
+	Name() string
+	// VNICProfileID returns the VNIC profile the NIC is created with.
+	VNICProfileID() string
+	// Params returns the optional parameters passed to CreateNIC. It may be nil.
+	Params() OptionalNICParameters
+}
+
+// BuildableVMBlueprint is a buildable version of VMBlueprint.
+type BuildableVMBlueprint interface {
+	VMBlueprint
+
+	// WithVMParameters sets the optional parameters passed to CreateVM.
+	WithVMParameters(params OptionalVMParameters) (BuildableVMBlueprint, error)
+	// MustWithVMParameters is identical to WithVMParameters, but panics instead of returning an error.
+	MustWithVMParameters(params OptionalVMParameters) BuildableVMBlueprint
+	// WithDisk adds a disk that is created on the specified storage domain and attached to the VM. The format
+	// selects between raw and cow (qcow2) images. Use CreateDiskParams to set the sparse flag and the disk profile of
+	// the disk. The params and attachmentParams may be nil.
+	WithDisk(
+		storageDomainID string,
+		format ImageFormat,
+		size uint64,
+		diskInterface DiskInterface,
+		params CreateDiskOptionalParameters,
+		attachmentParams CreateDiskAttachmentOptionalParams,
+	) (BuildableVMBlueprint, error)
+	// MustWithDisk is identical to WithDisk, but panics instead of returning an error.
+	MustWithDisk(
+		storageDomainID string,
+		format ImageFormat,
+		size uint64,
+		diskInterface DiskInterface,
+		params CreateDiskOptionalParameters,
+		attachmentParams CreateDiskAttachmentOptionalParams,
+	) BuildableVMBlueprint
+	// WithNIC adds a NIC with the specified name and VNIC profile. The params may be nil.
+	WithNIC(name string, vnicProfileID string, params OptionalNICParameters) (BuildableVMBlueprint, error)
+	// MustWithNIC is identical to WithNIC, but panics instead of returning an error.
+	MustWithNIC(name string, vnicProfileID string, params OptionalNICParameters) BuildableVMBlueprint
+	// WithTagIDs sets the tags added to the VM.
+	WithTagIDs(tagIDs ...TagID) (BuildableVMBlueprint, error)
+	// MustWithTagIDs is identical to WithTagIDs, but panics instead of returning an error.
+	MustWithTagIDs(tagIDs ...TagID) BuildableVMBlueprint
+	// WithStart sets whether the VM is started once all resources are created.
+	WithStart(start bool) (BuildableVMBlueprint, error)
+	// MustWithStart is identical to WithStart, but panics instead of returning an error.
+	MustWithStart(start bool) BuildableVMBlueprint
+}
+
+// NewVMBlueprint creates a blueprint for use with ProvisionVM.
+func NewVMBlueprint(clusterID ClusterID, templateID TemplateID, name string) (BuildableVMBlueprint, error) {
+	if clusterID == "" {
+		return nil, newError(EBadArgument, "cluster ID cannot be empty")
+	}
+	if templateID == "" {
+		return nil, newError(EBadArgument, "template ID cannot be empty")
+	}
+	if name == "" {
+		return nil, newError(EBadArgument, "name cannot be empty")
+	}
+	return &vmBlueprint{
+		clusterID:  clusterID,
+		templateID: templateID,
+		name:       name,
+		disks:      []VMBlueprintDisk{},
+		nics:       []VMBlueprintNIC{},
+		tagIDs:     []TagID{},
+	}, nil
+}
+
+// MustNewVMBlueprint is identical to NewVMBlueprint, but panics instead of returning an error.
+func MustNewVMBlueprint(clusterID ClusterID, templateID TemplateID, name string) BuildableVMBlueprint {
+	blueprint, err := NewVMBlueprint(clusterID, templateID, name)
+	if err != nil {
+		panic(err)
+	}
+	return blueprint
+}
+
+type vmBlueprint struct {
+	clusterID    ClusterID
+	templateID   TemplateID
+	name         string
+	vmParameters OptionalVMParameters
+	disks        []VMBlueprintDisk
+	nics         []VMBlueprintNIC
+	tagIDs       []TagID
+	start        bool
+}
+
+func (v *vmBlueprint) ClusterID() ClusterID {
+	return v.clusterID
+}
+
+func (v *vmBlueprint) TemplateID() TemplateID {
+	return v.templateID
+}
+
+func (v *vmBlueprint) Name() string {
+	return v.name
+}
+
+func (v *vmBlueprint) VMParameters() OptionalVMParameters {
+	return v.vmParameters
+}
+
+func (v *vmBlueprint) Disks() []VMBlueprintDisk {
+	return v.disks
+}
+
+func (v *vmBlueprint) NICs() []VMBlueprintNIC {
+	return v.nics
+}
+
+func (v *vmBlueprint) TagIDs() []TagID {
+	return v.tagIDs
+}
+
+func (v *vmBlueprint) Start() bool {
+	return v.start
+}
+
+func (v *vmBlueprint) WithVMParameters(params OptionalVMParameters) (BuildableVMBlueprint, error) {
+	v.vmParameters = params
+	return v, nil
+}
+
+func (v *vmBlueprint) MustWithVMParameters(params OptionalVMParameters) BuildableVMBlueprint {
+	builder, err := v.WithVMParameters(params)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmBlueprint) WithDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	diskInterface DiskInterface,
+	params CreateDiskOptionalParameters,
+	attachmentParams CreateDiskAttachmentOptionalParams,
+) (BuildableVMBlueprint, error) {
+	disk, err := NewVMDisk(storageDomainID, format, size, diskInterface, params, attachmentParams)
+	if err != nil {
+		return nil, err
+	}
+	v.disks = append(v.disks, disk)
+	return v, nil
+}
+
+func (v *vmBlueprint) MustWithDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	diskInterface DiskInterface,
+	params CreateDiskOptionalParameters,
+	attachmentParams CreateDiskAttachmentOptionalParams,
+) BuildableVMBlueprint {
+	builder, err := v.WithDisk(storageDomainID, format, size, diskInterface, params, attachmentParams)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmBlueprint) WithNIC(
+	name string,
+	vnicProfileID string,
+	params OptionalNICParameters,
+) (BuildableVMBlueprint, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "NIC name cannot be empty")
+	}
+	if vnicProfileID == "" {
+		return nil, newError(EBadArgument, "VNIC profile ID cannot be empty")
+	}
+	for _, nic := range v.nics {
+		if nic.Name() == name {
+			return nil, newError(EBadArgument, "duplicate NIC name: %s", name)
+		}
+	}
+	v.nics = append(v.nics, &vmBlueprintNIC{name: name, vnicProfileID: vnicProfileID, params: params})
+	return v, nil
+}
+
+func (v *vmBlueprint) MustWithNIC(
+	name string,
+	vnicProfileID string,
+	params OptionalNICParameters,
+) BuildableVMBlueprint {
+	builder, err := v.WithNIC(name, vnicProfileID, params)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmBlueprint) WithTagIDs(tagIDs ...TagID) (BuildableVMBlueprint, error) {
+	for _, tagID := range tagIDs {
+		if tagID == "" {
+			return nil, newError(EBadArgument, "tag ID cannot be empty")
+		}
+	}
+	v.tagIDs = append([]TagID{}, tagIDs...)
+	return v, nil
+}
+
+func (v *vmBlueprint) MustWithTagIDs(tagIDs ...TagID) BuildableVMBlueprint {
+	builder, err := v.WithTagIDs(tagIDs...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vmBlueprint) WithStart(start bool) (BuildableVMBlueprint, error) {
+	v.start = start
+	return v, nil
+}
+
+func (v *vmBlueprint) MustWithStart(start bool) BuildableVMBlueprint {
+	builder, err := v.WithStart(start)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// NewVMDisk describes a disk that is created on the specified storage domain and attached to a VM. It can be passed
+// to CreateVM using WithDisks. The params and attachmentParams may be nil.
+func NewVMDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	diskInterface DiskInterface,
+	params CreateDiskOptionalParameters,
+	attachmentParams CreateDiskAttachmentOptionalParams,
+) (VMBlueprintDisk, error) {
+	if storageDomainID == "" {
+		return nil, newError(EBadArgument, "storage domain ID cannot be empty")
+	}
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, newError(EBadArgument, "disk size must be positive")
+	}
+	if err := diskInterface.Validate(); err != nil {
+		return nil, err
+	}
+	return &vmBlueprintDisk{
+		storageDomainID:  storageDomainID,
+		format:           format,
+		size:             size,
+		diskInterface:    diskInterface,
+		params:           params,
+		attachmentParams: attachmentParams,
+	}, nil
+}
+
+// MustNewVMDisk is identical to NewVMDisk, but panics instead of returning an error.
+func MustNewVMDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	diskInterface DiskInterface,
+	params CreateDiskOptionalParameters,
+	attachmentParams CreateDiskAttachmentOptionalParams,
+) VMBlueprintDisk {
+	disk, err := NewVMDisk(storageDomainID, format, size, diskInterface, params, attachmentParams)
+	if err != nil {
+		panic(err)
+	}
+	return disk
+}
+
+type vmBlueprintDisk struct {
+	storageDomainID  string
+	format           ImageFormat
+	size             uint64
+	diskInterface    DiskInterface
+	params           CreateDiskOptionalParameters
+	attachmentParams CreateDiskAttachmentOptionalParams
+}
+
+func (v *vmBlueprintDisk) StorageDomainID() string {
+	return v.storageDomainID
+}
+
+func (v *vmBlueprintDisk) Format() ImageFormat {
+	return v.format
+}
+
+func (v *vmBlueprintDisk) Size() uint64 {
+	return v.size
+}
+
+func (v *vmBlueprintDisk) Interface() DiskInterface {
+	return v.diskInterface
+}
+
+func (v *vmBlueprintDisk) Params() CreateDiskOptionalParameters {
+	return v.params
+}
+
+func (v *vmBlueprintDisk) AttachmentParams() CreateDiskAttachmentOptionalParams {
+	return v.attachmentParams
+}
+
+type vmBlueprintNIC struct {
+	name          string
+	vnicProfileID string
+	params        OptionalNICParameters
+}
+
+func (v *vmBlueprintNIC) Name() string {
+	return v.name
+}
+
+func (v *vmBlueprintNIC) VNICProfileID() string {
+	return v.vnicProfileID
+}
+
+func (v *vmBlueprintNIC) Params() OptionalNICParameters {
+	return v.params
+}
+
+func (o *oVirtClient) ProvisionVM(blueprint VMBlueprint, retries ...RetryStrategy) (VM, error) {
+	return provisionVM(o, o.logger, blueprint, retries)
+}
+
+// provisionVM implements ProvisionVM on top of the individual create calls. It is shared between the real and the
+// mock client.
+func provisionVM(client Client, logger Logger, blueprint VMBlueprint, retries []RetryStrategy) (VM, error) {
+	if blueprint == nil {
+		return nil, newError(EBadArgument, "the VM blueprint cannot be nil")
+	}
+	p := &vmProvisioning{client: client, logger: logger, blueprint: blueprint, retries: retries}
+	vm, err := client.CreateVM(
+		blueprint.ClusterID(),
+		blueprint.TemplateID(),
+		blueprint.Name(),
+		blueprint.VMParameters(),
+		retries...,
+	)
+	if vm != nil {
+		p.vmID = vm.ID()
+	}
+	if err != nil {
+		return nil, p.rollback(wrap(err, EUnidentified, "failed to create VM %s", blueprint.Name()))
+	}
+	if err := p.createDisks(blueprint.Disks()); err != nil {
+		return nil, p.rollback(err)
+	}
+	if err := p.createNICs(); err != nil {
+		return nil, p.rollback(err)
+	}
+	for _, tagID := range blueprint.TagIDs() {
+		if err := client.AddTagToVM(p.vmID, tagID, retries...); err != nil {
+			return nil, p.rollback(wrap(err, EUnidentified, "failed to add tag %s to VM %s", tagID, p.vmID))
+		}
+	}
+	if !blueprint.Start() {
+		vm, err = client.GetVM(p.vmID, retries...)
+		if err != nil {
+			return nil, p.rollback(wrap(err, EUnidentified, "failed to fetch provisioned VM %s", p.vmID))
+		}
+		return vm, nil
+	}
+	if err := client.StartVM(p.vmID, retries...); err != nil {
+		return nil, p.rollback(wrap(err, EUnidentified, "failed to start VM %s", p.vmID))
+	}
+	// The VM is fetched again after starting it so the returned VM reflects the start. The VM is running at this
+	// point, so it is not rolled back if fetching it fails.
+	vm, err = client.GetVM(p.vmID, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "VM %s was provisioned and started, but fetching it failed", p.vmID)
+	}
+	return vm, nil
+}
+
+// createVMDisks creates and attaches the disks requested in the params of CreateVM to the newly created VM. If this
+// fails, the VM is removed together with the disks created so far. It is shared between the real and the mock client.
+func createVMDisks(
+	client Client,
+	logger Logger,
+	vm VM,
+	params OptionalVMParameters,
+	retries []RetryStrategy,
+) (VM, error) {
+	if vm == nil || params == nil || len(params.Disks()) == 0 {
+		return vm, nil
+	}
+	p := &vmProvisioning{client: client, logger: logger, retries: retries, vmID: vm.ID()}
+	if err := p.createDisks(params.Disks()); err != nil {
+		return nil, p.rollback(err)
+	}
+	return vm, nil
+}
+
+// vmProvisioning tracks the resources created by provisionVM so they can be removed if a later step fails.
+type vmProvisioning struct {
+	client    Client
+	logger    Logger
+	blueprint VMBlueprint
+	retries   []RetryStrategy
+	vmID      VMID
+	// detachedDisks holds the disks that have been created, but not yet attached to the VM. Attached disks are
+	// removed together with the VM.
+	detachedDisks []DiskID
+}
+
+func (p *vmProvisioning) createDisks(disks []VMBlueprintDisk) error {
+	for i, blueprintDisk := range disks {
+		disk, err := p.client.CreateDisk(
+			blueprintDisk.StorageDomainID(),
+			blueprintDisk.Format(),
+			blueprintDisk.Size(),
+			blueprintDisk.Params(),
+			p.retries...,
+		)
+		if disk != nil {
+			p.detachedDisks = append(p.detachedDisks, disk.ID())
+		}
+		if err != nil {
+			return wrap(err, EUnidentified, "failed to create disk %d for VM %s", i, p.vmID)
+		}
+		attachmentParams := blueprintDisk.AttachmentParams()
+		if attachmentParams == nil {
+			attachmentParams = CreateDiskAttachmentParams()
+		}
+		if _, err := p.client.CreateDiskAttachment(
+			p.vmID,
+			disk.ID(),
+			blueprintDisk.Interface(),
+			attachmentParams,
+			p.retries...,
+		); err != nil {
+			return wrap(err, EUnidentified, "failed to attach disk %s to VM %s", disk.ID(), p.vmID)
+		}
+		p.detachedDisks = p.detachedDisks[:len(p.detachedDisks)-1]
+	}
+	return nil
+}
+
+func (p *vmProvisioning) createNICs() error {
+	for _, blueprintNIC := range p.blueprint.NICs() {
+		params := blueprintNIC.Params()
+		if params == nil {
+			params = CreateNICParams()
+		}
+		if _, err := p.client.CreateNIC(
+			p.vmID,
+			blueprintNIC.VNICProfileID(),
+			blueprintNIC.Name(),
+			params,
+			p.retries...,
+		); err != nil {
+			return wrap(err, EUnidentified, "failed to create NIC %s on VM %s", blueprintNIC.Name(), p.vmID)
+		}
+	}
+	return nil
+}
+
+// rollback removes the resources created so far and returns cause. Failures during the rollback are logged, but not
+// returned, so the caller sees the error that caused the rollback.
+func (p *vmProvisioning) rollback(cause error) error {
+	for _, diskID := range p.detachedDisks {
+		// A disk returned together with an error is most likely still locked and cannot be removed until it is ready.
+		if _, err := p.client.WaitForDiskOK(diskID, p.retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			p.logger.Warningf("Failed to wait for disk %s to become ready during rollback (%v).", diskID, err)
+		}
+		if err := p.client.RemoveDisk(diskID, p.retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			p.logger.Warningf("Failed to remove disk %s during rollback, please remove it manually (%v).", diskID, err)
+		}
+	}
+	if p.vmID != "" {
+		if err := p.client.RemoveVM(p.vmID, p.retries...); err != nil && !HasErrorCode(err, ENotFound) {
+			p.logger.Warningf("Failed to remove VM %s during rollback, please remove it manually (%v).", p.vmID, err)
+		}
+	}
+	return cause
+}
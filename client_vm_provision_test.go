@@ -0,0 +1,166 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestProvisionVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	blueprint := ovirtclient.MustNewVMBlueprint(helper.GetClusterID(), helper.GetBlankTemplateID(), name).
+		MustWithDisk(
+			helper.GetStorageDomainID(),
+			ovirtclient.ImageFormatRaw,
+			512,
+			ovirtclient.DiskInterfaceVirtIO,
+			nil,
+			ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true),
+		).
+		MustWithNIC("eth0", helper.GetVNICProfileID(), nil)
+	vm, err := client.ProvisionVM(blueprint)
+	if err != nil {
+		t.Fatalf("failed to provision VM %s (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+
+	attachments, err := client.ListDiskAttachments(vm.ID())
+	if err != nil {
+		t.Fatalf("failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("incorrect number of disk attachments on provisioned VM (expected: 1, got: %d)", len(attachments))
+	}
+	nics, err := vm.ListNICs()
+	if err != nil {
+		t.Fatalf("failed to list NICs of VM %s (%v)", vm.ID(), err)
+	}
+	if len(nics) != 1 || nics[0].Name() != "eth0" {
+		t.Fatalf("the provisioned VM does not have exactly one NIC named eth0")
+	}
+}
+
+func TestProvisionVMRollback(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	diskAlias := fmt.Sprintf("%s-disk", name)
+
+	blueprint := ovirtclient.MustNewVMBlueprint(helper.GetClusterID(), helper.GetBlankTemplateID(), name).
+		MustWithDisk(
+			helper.GetStorageDomainID(),
+			ovirtclient.ImageFormatRaw,
+			512,
+			ovirtclient.DiskInterfaceVirtIO,
+			ovirtclient.CreateDiskParams().MustWithAlias(diskAlias),
+			nil,
+		).
+		MustWithNIC("eth0", helper.GetVNICProfileID(), nil).
+		MustWithTagIDs(ovirtclient.TagID(helper.GenerateRandomID(10)))
+	if _, err := client.ProvisionVM(blueprint); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("provisioning a VM with a non-existent tag did not fail with ENotFound (%v)", err)
+	}
+
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithName(name))
+	if err != nil {
+		t.Fatalf("failed to search for VM %s (%v)", name, err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("the VM %s was not removed after the failed provisioning", name)
+	}
+	disks, err := client.SearchDisks(ovirtclient.DiskSearchParams().WithAlias(diskAlias))
+	if err != nil {
+		t.Fatalf("failed to search for disk %s (%v)", diskAlias, err)
+	}
+	if len(disks) != 0 {
+		t.Fatalf("the disk %s was not removed after the failed provisioning", diskAlias)
+	}
+}
+
+func TestProvisionVMDiskStorageParameters(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	blueprint := ovirtclient.MustNewVMBlueprint(helper.GetClusterID(), helper.GetBlankTemplateID(), name).
+		MustWithDisk(
+			helper.GetStorageDomainID(),
+			ovirtclient.ImageFormatCow,
+			1024*1024,
+			ovirtclient.DiskInterfaceVirtIO,
+			ovirtclient.CreateDiskParams().MustWithSparse(true),
+			nil,
+		)
+	vm, err := client.ProvisionVM(blueprint)
+	if err != nil {
+		t.Fatalf("failed to provision VM %s (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+
+	attachments, err := client.ListDiskAttachments(vm.ID())
+	if err != nil || len(attachments) != 1 {
+		t.Fatalf("failed to list the disk attachment of VM %s (%v)", vm.ID(), err)
+	}
+	disk, err := client.GetDisk(attachments[0].DiskID())
+	if err != nil {
+		t.Fatalf("failed to get disk %s (%v)", attachments[0].DiskID(), err)
+	}
+	if disk.Format() != ovirtclient.ImageFormatCow {
+		t.Fatalf("incorrect disk format (expected: %s, got: %s)", ovirtclient.ImageFormatCow, disk.Format())
+	}
+	if !disk.Sparse() {
+		t.Fatalf("the disk was not created sparse")
+	}
+}
+
+func TestProvisionVMStart(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	blueprint := ovirtclient.MustNewVMBlueprint(helper.GetClusterID(), helper.GetBlankTemplateID(), name).
+		MustWithDisk(
+			helper.GetStorageDomainID(),
+			ovirtclient.ImageFormatRaw,
+			512,
+			ovirtclient.DiskInterfaceVirtIO,
+			nil,
+			ovirtclient.CreateDiskAttachmentParams().MustWithBootable(true),
+		).
+		MustWithStart(true)
+	vm, err := client.ProvisionVM(blueprint)
+	if err != nil {
+		t.Fatalf("failed to provision VM %s (%v)", name, err)
+	}
+	t.Cleanup(func() {
+		if err := client.StopVM(vm.ID(), true); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to stop test VM %s (%v)", vm.ID(), err)
+		}
+		if _, err := client.WaitForVMStatus(vm.ID(), ovirtclient.VMStatusDown); err != nil {
+			t.Fatalf("failed to wait for test VM %s to stop (%v)", vm.ID(), err)
+		}
+		if err := vm.Remove(); err != nil && !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+			t.Fatalf("failed to remove test VM %s (%v)", vm.ID(), err)
+		}
+	})
+
+	if vm.Status() == ovirtclient.VMStatusDown {
+		t.Fatalf("the VM returned after provisioning with start does not reflect the start")
+	}
+}
@@ -4,18 +4,81 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) RemoveVM(id string, retries ...RetryStrategy) (err error) {
+// RemoveVMOptionalParameters are the optional parameters for removing a VM.
+type RemoveVMOptionalParameters interface {
+	// DetachOnly returns true if the disks attached to the VM should only be detached instead of being removed
+	// together with the VM.
+	DetachOnly() bool
+}
+
+// BuildableRemoveVMParameters is a buildable version of RemoveVMOptionalParameters.
+type BuildableRemoveVMParameters interface {
+	RemoveVMOptionalParameters
+
+	// WithDetachOnly sets if the disks attached to the VM should only be detached, so they survive the VM.
+	WithDetachOnly(detachOnly bool) (BuildableRemoveVMParameters, error)
+	// MustWithDetachOnly is identical to WithDetachOnly, but panics instead of returning an error.
+	MustWithDetachOnly(detachOnly bool) BuildableRemoveVMParameters
+}
+
+// RemoveVMParams creates a buildable set of optional parameters for RemoveVMWithParams.
+func RemoveVMParams() BuildableRemoveVMParameters {
+	return &removeVMParams{}
+}
+
+type removeVMParams struct {
+	detachOnly bool
+}
+
+func (r *removeVMParams) DetachOnly() bool {
+	return r.detachOnly
+}
+
+func (r *removeVMParams) WithDetachOnly(detachOnly bool) (BuildableRemoveVMParameters, error) {
+	r.detachOnly = detachOnly
+	return r, nil
+}
+
+func (r *removeVMParams) MustWithDetachOnly(detachOnly bool) BuildableRemoveVMParameters {
+	builder, err := r.WithDetachOnly(detachOnly)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (o *oVirtClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
+	return o.RemoveVMWithParams(id, nil, retries...)
+}
+
+func (o *oVirtClient) RemoveVMWithParams(
+	id VMID,
+	params RemoveVMOptionalParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	if params == nil {
+		params = RemoveVMParams()
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)),
 		fmt.Sprintf("removing VM %s", id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(id).Remove().Send()
+			req := o.connection().SystemService().VmsService().VmService(string(id)).Remove()
+			if params.DetachOnly() {
+				req.DetachOnly(true)
+			}
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -0,0 +1,25 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) RemoveTagFromVM(id VMID, tagID TagID, retries ...RetryStrategy) (err error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)).with("tag_id", tagID),
+		fmt.Sprintf("removing tag %s from VM %s", tagID, id),
+		retries,
+		func() error {
+			req := o.connection().SystemService().VmsService().VmService(string(id)).TagsService().
+				TagService(string(tagID)).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
+			return err
+		})
+	err = withCorrelationID(err, correlationID)
+	return
+}
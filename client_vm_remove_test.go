@@ -24,3 +24,25 @@ func TestVMRemovalShouldRemoveAttachedDisks(t *testing.T) {
 		t.Fatalf("Getting disk after VM removal did not result in a non found error (%v).", err)
 	}
 }
+
+func TestVMRemovalWithDetachOnlyShouldKeepAttachedDisks(t *testing.T) {
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("%s-%s", t.Name(), helper.GenerateRandomID(5)), nil)
+	disk := assertCanCreateDisk(t, helper)
+	assertCanAttachDisk(t, vm, disk)
+	if err := client.RemoveVMWithParams(vm.ID(), ovirtclient.RemoveVMParams().MustWithDetachOnly(true)); err != nil {
+		t.Fatalf("Cannot remove test VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := client.GetDisk(disk.ID()); err != nil {
+		t.Fatalf("Getting disk after VM removal with detach only failed (%v).", err)
+	}
+	attachedDisks, err := client.SearchDisks(ovirtclient.DiskSearchParams().WithAlias(disk.Alias()).WithAttached(true))
+	if err != nil {
+		t.Fatalf("Failed to search for disk %s (%v)", disk.ID(), err)
+	}
+	if len(attachedDisks) != 0 {
+		t.Fatalf("Disk %s is still attached after the VM has been removed.", disk.ID())
+	}
+}
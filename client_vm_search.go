@@ -5,7 +5,7 @@ import (
 	"strings"
 )
 
-func (o *oVirtClient) vmSearchCriteria(params VMSearchParameters) (string, error) {
+func (o *oVirtClient) vmSearchCriteria(params VMSearchParameters, retries []RetryStrategy) (string, error) {
 	var criteria []string
 	var err error
 
@@ -22,10 +22,93 @@ func (o *oVirtClient) vmSearchCriteria(params VMSearchParameters) (string, error
 	if criteria, err = o.vmNotStatusCriteria(params, criteria); err != nil {
 		return "", err
 	}
-	if len(criteria) == 0 {
+	if criteria, err = o.vmReferenceCriteria(params, criteria, retries); err != nil {
+		return "", err
+	}
+	if criteria, err = o.vmDescriptionCriteria(params, criteria); err != nil {
+		return "", err
+	}
+	if criteria, err = o.vmRawQueryCriteria(params, criteria); err != nil {
+		return "", err
+	}
+	if err = validatePageParameters(params); err != nil {
+		return "", err
+	}
+	if len(criteria) == 0 && params.Max() == nil && params.Page() == nil {
 		return "", newError(EBadArgument, "at least one search parameter must be specified")
 	}
-	return strings.Join(criteria, " AND "), nil
+	qs := strings.Join(criteria, " AND ")
+	if page := pageSearchCriteria(params); page != "" {
+		qs = strings.TrimSpace(fmt.Sprintf("%s %s", qs, page))
+	}
+	return qs, nil
+}
+
+// vmReferenceCriteria adds the cluster, template and host filters to the criteria. The engine search syntax matches
+// these by name, so the names are looked up from the IDs first. If an object does not exist, an ENotFound error is
+// returned.
+func (o *oVirtClient) vmReferenceCriteria(
+	params VMSearchParameters,
+	criteria []string,
+	retries []RetryStrategy,
+) ([]string, error) {
+	if clusterID := params.ClusterID(); clusterID != nil {
+		cluster, err := o.GetCluster(*clusterID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if criteria, err = vmNameReferenceCriteria(criteria, "cluster", cluster.Name()); err != nil {
+			return nil, err
+		}
+	}
+	if templateID := params.TemplateID(); templateID != nil {
+		template, err := o.GetTemplate(*templateID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if criteria, err = vmNameReferenceCriteria(criteria, "Templates.name", template.Name()); err != nil {
+			return nil, err
+		}
+	}
+	if hostID := params.HostID(); hostID != nil {
+		host, err := o.GetHost(*hostID, retries...)
+		if err != nil {
+			return nil, err
+		}
+		if criteria, err = vmNameReferenceCriteria(criteria, "Hosts.name", host.Name()); err != nil {
+			return nil, err
+		}
+	}
+	return criteria, nil
+}
+
+func vmNameReferenceCriteria(criteria []string, field string, name string) ([]string, error) {
+	quotedName, err := quoteSearchString(name)
+	if err != nil {
+		return nil, newError(EBadArgument, "invalid %s search string: %s", field, name)
+	}
+	return append(criteria, fmt.Sprintf("%s = %s", field, quotedName)), nil
+}
+
+func (o *oVirtClient) vmDescriptionCriteria(params VMSearchParameters, criteria []string) ([]string, error) {
+	if description := params.Description(); description != nil {
+		quotedDescription, err := quoteSearchString(*description)
+		if err != nil {
+			return nil, newError(EBadArgument, "invalid description search string: %s", *description)
+		}
+		criteria = append(criteria, fmt.Sprintf("description = %s", quotedDescription))
+	}
+	return criteria, nil
+}
+
+func (o *oVirtClient) vmRawQueryCriteria(params VMSearchParameters, criteria []string) ([]string, error) {
+	if rawQuery := params.RawQuery(); rawQuery != nil {
+		if strings.TrimSpace(*rawQuery) == "" {
+			return nil, newError(EBadArgument, "the raw search query must not be empty")
+		}
+		criteria = append(criteria, fmt.Sprintf("(%s)", *rawQuery))
+	}
+	return criteria, nil
 }
 
 func (o *oVirtClient) vmNotStatusCriteria(params VMSearchParameters, criteria []string) (
@@ -84,16 +167,27 @@ func (o *oVirtClient) vmTagCriteria(params VMSearchParameters, criteria []string
 func (o *oVirtClient) SearchVMs(params VMSearchParameters, retries ...RetryStrategy) (result []VM, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []VM{}
-	qs, err := o.vmSearchCriteria(params)
+	qs, err := o.vmSearchCriteria(params, retries)
 	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			// The cluster, template or host to filter for does not exist, so no VM can match.
+			return []VM{}, nil
+		}
 		return nil, err
 	}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, ""),
 		"searching for VMs",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VmsService().List().Search(qs).Send()
+			req := o.connection().SystemService().VmsService().List()
+			if qs != "" {
+				req.Search(qs)
+			}
+			if max := params.Max(); max != nil {
+				req.Max(int64(*max))
+			}
+			response, e := req.Send()
 			if e != nil {
 				return e
 			}
@@ -1,6 +1,7 @@
 package ovirtclient_test
 
 import (
+	"fmt"
 	"testing"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client"
@@ -26,3 +27,97 @@ func TestVMSearch(t *testing.T) {
 		t.Fatalf("Incorrect VM returned: %s", vms[0].ID())
 	}
 }
+
+func TestVMSearchRawQuery(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	name1 := helper.GenerateRandomID(5)
+	name2 := helper.GenerateRandomID(5)
+	vm1 := assertCanCreateVM(t, helper, name1, nil)
+	_ = assertCanCreateVM(t, helper, name2, nil)
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithRawQuery(fmt.Sprintf("name=%s", name1)))
+	if err != nil {
+		t.Fatalf("Failed to search for VM (%v)", err)
+	}
+	if len(vms) != 1 {
+		t.Fatalf("Incorrect number of VMs returned (%d)", len(vms))
+	}
+	if vms[0].ID() != vm1.ID() {
+		t.Fatalf("Incorrect VM returned: %s", vms[0].ID())
+	}
+}
+
+func TestVMSearchByClusterTemplateAndDescription(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	description := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+	vm1 := assertCanCreateVM(
+		t,
+		helper,
+		helper.GenerateRandomID(5),
+		ovirtclient.CreateVMParams().MustWithDescription(description),
+	)
+	_ = assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+	vms, err := client.SearchVMs(
+		ovirtclient.VMSearchParams().
+			WithClusterID(helper.GetClusterID()).
+			WithTemplateID(helper.GetBlankTemplateID()).
+			WithDescription(description),
+	)
+	if err != nil {
+		t.Fatalf("Failed to search for VM (%v)", err)
+	}
+	if len(vms) != 1 {
+		t.Fatalf("Incorrect number of VMs returned (%d)", len(vms))
+	}
+	if vms[0].ID() != vm1.ID() {
+		t.Fatalf("Incorrect VM returned: %s", vms[0].ID())
+	}
+	if vms[0].Description() != description {
+		t.Fatalf("Incorrect description on VM (expected: %s, got: %s)", description, vms[0].Description())
+	}
+}
+
+func TestVMSearchByHost(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, helper.GenerateRandomID(5), nil)
+	assertCanStartVM(t, vm)
+	vm, err := vm.WaitForStatus(ovirtclient.VMStatusUp)
+	if err != nil {
+		t.Fatalf("Failed to wait for VM status to reach \"up\". (%v)", err)
+	}
+	hostID := vm.HostID()
+	if hostID == nil {
+		t.Fatalf("The running VM %s does not report a host.", vm.ID())
+	}
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithHostID(*hostID).WithName(vm.Name()))
+	if err != nil {
+		t.Fatalf("Failed to search for VM (%v)", err)
+	}
+	if len(vms) != 1 || vms[0].ID() != vm.ID() {
+		t.Fatalf("The VM %s was not found on host %s.", vm.ID(), *hostID)
+	}
+}
+
+func TestVMSearchNonExistentCluster(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vms, err := client.SearchVMs(
+		ovirtclient.VMSearchParams().WithClusterID(ovirtclient.ClusterID(helper.GenerateRandomID(10))),
+	)
+	if err != nil {
+		t.Fatalf("Failed to search for VMs in a non-existent cluster (%v)", err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("VMs returned for a non-existent cluster (%d)", len(vms))
+	}
+}
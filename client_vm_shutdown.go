@@ -4,15 +4,21 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) ShutdownVM(id string, force bool, retries ...RetryStrategy) (err error) {
+func (o *oVirtClient) ShutdownVM(id VMID, force bool, retries ...RetryStrategy) (err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)).with("force", force),
 		fmt.Sprintf("shutting down VM %s", id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(id).Shutdown().Force(force).Send()
+			req := o.connection().SystemService().VmsService().VmService(string(id)).Shutdown().Force(force)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			return err
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -0,0 +1,105 @@
+package ovirtclient
+
+import (
+	"strings"
+	"time"
+)
+
+// VMShutdownMethod describes how ShutdownVMGracefully brought a VM down.
+type VMShutdownMethod string
+
+const (
+	// VMShutdownMethodNone means that the VM was already down, so no action was taken.
+	VMShutdownMethodNone VMShutdownMethod = "none"
+	// VMShutdownMethodShutdown means that the guest operating system shut down the VM within the timeout.
+	VMShutdownMethodShutdown VMShutdownMethod = "shutdown"
+	// VMShutdownMethodPowerOff means that the VM did not shut down within the timeout and was powered off.
+	VMShutdownMethodPowerOff VMShutdownMethod = "power_off"
+)
+
+// Validate returns an error if the shutdown method is not known.
+func (m VMShutdownMethod) Validate() error {
+	for _, method := range VMShutdownMethodValues() {
+		if method == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VM shutdown method: %s must be one of: %s",
+		m,
+		strings.Join(VMShutdownMethodValues().Strings(), ", "),
+	)
+}
+
+// VMShutdownMethodList is a list of VMShutdownMethod values.
+type VMShutdownMethodList []VMShutdownMethod
+
+// Strings creates a string list of the values.
+func (l VMShutdownMethodList) Strings() []string {
+	result := make([]string, len(l))
+	for i, method := range l {
+		result[i] = string(method)
+	}
+	return result
+}
+
+// VMShutdownMethodValues returns all possible VMShutdownMethod values.
+func VMShutdownMethodValues() VMShutdownMethodList {
+	return []VMShutdownMethod{
+		VMShutdownMethodNone,
+		VMShutdownMethodShutdown,
+		VMShutdownMethodPowerOff,
+	}
+}
+
+func (o *oVirtClient) ShutdownVMGracefully(id VMID, timeout time.Duration, retries ...RetryStrategy) (
+	VM,
+	VMShutdownMethod,
+	error,
+) {
+	return shutdownVMGracefully(o, o.logger, id, timeout, retries)
+}
+
+// shutdownVMGracefully implements ShutdownVMGracefully on top of ShutdownVM, StopVM and
+// WaitForVMStatus. It is shared between the real and the mock client.
+func shutdownVMGracefully(
+	client VMClient,
+	logger Logger,
+	id VMID,
+	timeout time.Duration,
+	retries []RetryStrategy,
+) (VM, VMShutdownMethod, error) {
+	if timeout <= 0 {
+		return nil, "", newError(EBadArgument, "the shutdown timeout must be positive")
+	}
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, "", err
+	}
+	if vm.Status() == VMStatusDown {
+		return vm, VMShutdownMethodNone, nil
+	}
+	if err := client.ShutdownVM(id, false, retries...); err != nil {
+		return nil, "", err
+	}
+	// The timeout is added in front of the passed retries so the wait for the shutdown is bounded even if the
+	// caller passed a longer timeout.
+	shutdownRetries := append([]RetryStrategy{Timeout(timeout)}, retries...)
+	vm, err = client.WaitForVMStatus(id, VMStatusDown, shutdownRetries...)
+	if err == nil {
+		return vm, VMShutdownMethodShutdown, nil
+	}
+	if !HasErrorCode(err, ETimeout) {
+		return nil, "", err
+	}
+	logger.Infof("VM %s did not shut down within %s, powering it off...", id, timeout)
+	if err := client.StopVM(id, true, retries...); err != nil {
+		return nil, "", wrap(err, EUnidentified, "failed to power off VM %s after the shutdown timed out", id)
+	}
+	vm, err = client.WaitForVMStatus(id, VMStatusDown, retries...)
+	if err != nil {
+		return nil, "", err
+	}
+	return vm, VMShutdownMethodPowerOff, nil
+}
@@ -0,0 +1,82 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestShutdownVMGracefully(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanStartVM(t, vm)
+	assertVMWillStart(t, vm)
+
+	vm, method, err := vm.ShutdownGracefully(5 * time.Minute)
+	if err != nil {
+		t.Fatalf("failed to shut down VM gracefully (%v)", err)
+	}
+	if method != ovirtclient.VMShutdownMethodShutdown {
+		t.Fatalf("incorrect shutdown method (expected: %s, got: %s)", ovirtclient.VMShutdownMethodShutdown, method)
+	}
+	if vm.Status() != ovirtclient.VMStatusDown {
+		t.Fatalf("incorrect VM status after graceful shutdown (expected: %s, got: %s)", ovirtclient.VMStatusDown, vm.Status())
+	}
+}
+
+func TestShutdownVMGracefullyFallsBackToPowerOff(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	assertCanStartVM(t, vm)
+	assertVMWillStart(t, vm)
+
+	// The timeout is too short for the shutdown to finish, so the VM must be powered off.
+	vm, method, err := client.ShutdownVMGracefully(vm.ID(), time.Nanosecond)
+	if err != nil {
+		t.Fatalf("failed to shut down VM with power-off fallback (%v)", err)
+	}
+	if method != ovirtclient.VMShutdownMethodPowerOff {
+		t.Fatalf("incorrect shutdown method (expected: %s, got: %s)", ovirtclient.VMShutdownMethodPowerOff, method)
+	}
+	if vm.Status() != ovirtclient.VMStatusDown {
+		t.Fatalf("incorrect VM status after power-off (expected: %s, got: %s)", ovirtclient.VMStatusDown, vm.Status())
+	}
+}
+
+func TestShutdownVMGracefullyInvalidTimeout(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	_, _, err := client.ShutdownVMGracefully(vm.ID(), 0)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("shutting down a VM with a zero timeout did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func TestShutdownVMGracefullyAlreadyDown(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+	_, method, err := client.ShutdownVMGracefully(vm.ID(), time.Minute)
+	if err != nil {
+		t.Fatalf("failed to shut down VM that is already down (%v)", err)
+	}
+	if method != ovirtclient.VMShutdownMethodNone {
+		t.Fatalf(
+			"incorrect shutdown method for a VM that is down (expected: %s, got: %s)",
+			ovirtclient.VMShutdownMethodNone,
+			method,
+		)
+	}
+}
@@ -4,15 +4,123 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) StartVM(id string, retries ...RetryStrategy) (err error) {
+// StartVMOptionalParameters are the optional parameters for starting a VM.
+type StartVMOptionalParameters interface {
+	// UseCloudInit returns true if the cloud-init initialization configured on the VM should be run on this boot.
+	UseCloudInit() bool
+	// UseSysprep returns true if the sysprep initialization configured on the VM should be run on this boot.
+	UseSysprep() bool
+}
+
+// BuildableStartVMParameters is a buildable version of StartVMOptionalParameters.
+type BuildableStartVMParameters interface {
+	StartVMOptionalParameters
+
+	// WithUseCloudInit sets if cloud-init should be run on this boot. It cannot be combined with sysprep.
+	WithUseCloudInit(useCloudInit bool) (BuildableStartVMParameters, error)
+	// MustWithUseCloudInit is identical to WithUseCloudInit, but panics instead of returning an error.
+	MustWithUseCloudInit(useCloudInit bool) BuildableStartVMParameters
+	// WithUseSysprep sets if sysprep should be run on this boot. It cannot be combined with cloud-init.
+	WithUseSysprep(useSysprep bool) (BuildableStartVMParameters, error)
+	// MustWithUseSysprep is identical to WithUseSysprep, but panics instead of returning an error.
+	MustWithUseSysprep(useSysprep bool) BuildableStartVMParameters
+}
+
+// StartVMParams creates a buildable set of optional parameters for StartVMWithParams.
+func StartVMParams() BuildableStartVMParameters {
+	return &startVMParams{}
+}
+
+type startVMParams struct {
+	useCloudInit bool
+	useSysprep   bool
+}
+
+func (s *startVMParams) UseCloudInit() bool {
+	return s.useCloudInit
+}
+
+func (s *startVMParams) UseSysprep() bool {
+	return s.useSysprep
+}
+
+func (s *startVMParams) WithUseCloudInit(useCloudInit bool) (BuildableStartVMParameters, error) {
+	if useCloudInit && s.useSysprep {
+		return nil, newError(EBadArgument, "cloud-init cannot be used together with sysprep")
+	}
+	s.useCloudInit = useCloudInit
+	return s, nil
+}
+
+func (s *startVMParams) MustWithUseCloudInit(useCloudInit bool) BuildableStartVMParameters {
+	builder, err := s.WithUseCloudInit(useCloudInit)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (s *startVMParams) WithUseSysprep(useSysprep bool) (BuildableStartVMParameters, error) {
+	if useSysprep && s.useCloudInit {
+		return nil, newError(EBadArgument, "sysprep cannot be used together with cloud-init")
+	}
+	s.useSysprep = useSysprep
+	return s, nil
+}
+
+func (s *startVMParams) MustWithUseSysprep(useSysprep bool) BuildableStartVMParameters {
+	builder, err := s.WithUseSysprep(useSysprep)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// validateStartVMParams returns the default parameters if params is nil, and an error if the parameters are
+// contradictory.
+func validateStartVMParams(params StartVMOptionalParameters) (StartVMOptionalParameters, error) {
+	if params == nil {
+		return StartVMParams(), nil
+	}
+	if params.UseCloudInit() && params.UseSysprep() {
+		return nil, newError(EBadArgument, "cloud-init cannot be used together with sysprep")
+	}
+	return params, nil
+}
+
+func (o *oVirtClient) StartVM(id VMID, retries ...RetryStrategy) error {
+	return o.StartVMWithParams(id, nil, retries...)
+}
+
+func (o *oVirtClient) StartVMWithParams(
+	id VMID,
+	params StartVMOptionalParameters,
+	retries ...RetryStrategy,
+) (err error) {
+	params, err = validateStartVMParams(params)
+	if err != nil {
+		return err
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)),
 		fmt.Sprintf("starting VM %s", id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VmsService().VmService(id).Start().Send()
+			req := o.connection().SystemService().VmsService().VmService(string(id)).Start()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			if params.UseCloudInit() {
+				req.UseCloudInit(true)
+			}
+			if params.UseSysprep() {
+				req.UseSysprep(true)
+			}
+			_, err := req.Send()
 			return err
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
@@ -0,0 +1,39 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestStartVMWithCloudInit(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	init := ovirtclient.NewInitialization("#cloud-config\n", "test-vm")
+	vm := assertCanCreateVM(
+		t,
+		helper,
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		ovirtclient.CreateVMParams().MustWithInitialization(init),
+	)
+	if err := client.StartVMWithParams(vm.ID(), ovirtclient.StartVMParams().MustWithUseCloudInit(true)); err != nil {
+		t.Fatalf("Failed to start VM with cloud-init (%v)", err)
+	}
+	t.Cleanup(func() {
+		if err := vm.Stop(true); err != nil {
+			t.Fatalf("Failed to stop VM %s after test (%v)", vm.ID(), err)
+		}
+	})
+	assertVMWillStart(t, vm)
+}
+
+func TestStartVMParamsCloudInitAndSysprep(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.StartVMParams().MustWithUseCloudInit(true).WithUseSysprep(true)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("Combining cloud-init and sysprep did not fail with EBadArgument (%v)", err)
+	}
+}
@@ -293,3 +293,55 @@ func assertVMWillStop(t *testing.T, vm ovirtclient.VM) {
 		t.Fatalf("Failed to wait for VM status to reach \"down\". (%v)", err)
 	}
 }
+
+func TestVMCreationWithDisks(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	params := ovirtclient.CreateVMParams().MustWithDisks([]ovirtclient.VMBlueprintDisk{
+		ovirtclient.MustNewVMDisk(
+			helper.GetStorageDomainID(),
+			ovirtclient.ImageFormatRaw,
+			512,
+			ovirtclient.DiskInterfaceVirtIO,
+			nil,
+			nil,
+		),
+	})
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test_%s", helper.GenerateRandomID(5)), params)
+	attachments, err := vm.ListDiskAttachments()
+	if err != nil {
+		t.Fatalf("Failed to list disk attachments of VM %s (%v)", vm.ID(), err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("Incorrect number of disk attachments on VM (expected: 1, got: %d)", len(attachments))
+	}
+}
+
+func TestVMCreationWithDisksRollback(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test_%s", helper.GenerateRandomID(5))
+
+	params := ovirtclient.CreateVMParams().MustWithDisks([]ovirtclient.VMBlueprintDisk{
+		ovirtclient.MustNewVMDisk(
+			helper.GenerateRandomID(10),
+			ovirtclient.ImageFormatRaw,
+			512,
+			ovirtclient.DiskInterfaceVirtIO,
+			nil,
+			nil,
+		),
+	})
+	if _, err := client.CreateVM(helper.GetClusterID(), helper.GetBlankTemplateID(), name, params); err == nil {
+		t.Fatalf("Creating a VM with a disk on a non-existent storage domain did not fail.")
+	}
+	vms, err := client.SearchVMs(ovirtclient.VMSearchParams().WithName(name))
+	if err != nil {
+		t.Fatalf("Failed to search for VM %s (%v)", name, err)
+	}
+	if len(vms) != 0 {
+		t.Fatalf("The VM %s was not removed after the disk creation failed.", name)
+	}
+}
@@ -7,30 +7,40 @@ import (
 )
 
 func (o *oVirtClient) UpdateVM(
-	id string,
+	id VMID,
 	params UpdateVMParameters,
-	retries ...RetryStrategy,
-) (result VM, err error) {
+	retries ...RetryStrategy) (result VM, err error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
 
 	vm := &ovirtsdk.Vm{}
-	vm.SetId(id)
+	vm.SetId(string(id))
 	if name := params.Name(); name != nil {
-		if *name == "" {
-			return nil, newError(EBadArgument, "name must not be empty for VM update")
+		if err := validateVMName(o.vmNameValidator, *name); err != nil {
+			return nil, err
 		}
 		vm.SetName(*name)
 	}
 	if comment := params.Comment(); comment != nil {
 		vm.SetComment(*comment)
 	}
+	if mode := params.NUMATuneMode(); mode != nil {
+		if err := mode.Validate(); err != nil {
+			return nil, err
+		}
+		vm.SetNumaTuneMode(ovirtsdk.NumaTuneMode(*mode))
+	}
 
-	err = retry(
+	err = o.mutate(
+		resourceOf(ResourceTypeVM, string(id)),
 		fmt.Sprintf("updating vm %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VmsService().VmService(id).Update().Vm(vm).Send()
+			req := o.connection().SystemService().VmsService().VmService(string(id)).Update().Vm(vm)
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			response, err := req.Send()
 			if err != nil {
 				return wrap(err, EUnidentified, "failed to update VM")
 			}
@@ -48,5 +58,8 @@ func (o *oVirtClient) UpdateVM(
 			}
 			return nil
 		})
-	return result, err
+	if err == nil && params.NUMANodes() != nil {
+		err = o.replaceVMNUMANodes(id, params.NUMANodes(), retries, correlationID)
+	}
+	return result, withCorrelationID(err, correlationID)
 }
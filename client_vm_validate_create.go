@@ -0,0 +1,63 @@
+package ovirtclient
+
+func (o *oVirtClient) ValidateVMCreation(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries ...RetryStrategy,
+) error {
+	if err := validateVMName(o.vmNameValidator, name); err != nil {
+		return err
+	}
+	return validateVMCreation(o, clusterID, templateID, name, params, defaultRetries(retries, defaultReadTimeouts()))
+}
+
+// validateVMCreation contains the pre-flight checks for VM creation shared between the oVirt and the mock client.
+func validateVMCreation(
+	client Client,
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries []RetryStrategy,
+) error {
+	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
+		return err
+	}
+	if _, err := client.GetCluster(clusterID, retries...); err != nil {
+		if HasErrorCode(err, ENotFound) {
+			return wrap(err, ENotFound, "cluster %s does not exist, please check the cluster ID", clusterID)
+		}
+		return wrap(err, EUnidentified, "failed to check if cluster %s exists", clusterID)
+	}
+	tpl, err := client.GetTemplate(templateID, retries...)
+	if err != nil {
+		if HasErrorCode(err, ENotFound) {
+			return wrap(err, ENotFound, "template %s does not exist, please check the template ID", templateID)
+		}
+		return wrap(err, EUnidentified, "failed to check if template %s exists", templateID)
+	}
+	if status := tpl.Status(); status != TemplateStatusOK {
+		return newError(
+			EConflict,
+			"template %s is in status \"%s\", please wait for it to become \"%s\" using WaitForTemplateStatus",
+			templateID,
+			status,
+			TemplateStatusOK,
+		)
+	}
+	vm, err := findVMByName(client, name, retries)
+	if err != nil {
+		return err
+	}
+	if vm != nil {
+		return newError(
+			EConflict,
+			"a VM named \"%s\" already exists with the ID %s, please choose a different name",
+			name,
+			vm.ID(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestValidateVMCreation(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	name := fmt.Sprintf("test-%s", helper.GenerateRandomID(5))
+
+	if err := client.ValidateVMCreation(
+		helper.GetClusterID(),
+		helper.GetBlankTemplateID(),
+		name,
+		nil,
+	); err != nil {
+		t.Fatalf("validation failed for valid parameters (%v)", err)
+	}
+}
+
+func TestValidateVMCreationNonExistentCluster(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	err := client.ValidateVMCreation(
+		ovirtclient.ClusterID(helper.GenerateRandomID(10)),
+		helper.GetBlankTemplateID(),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("validation did not return an ENotFound error for a non-existent cluster (%v)", err)
+	}
+}
+
+func TestValidateVMCreationNonExistentTemplate(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	err := client.ValidateVMCreation(
+		helper.GetClusterID(),
+		ovirtclient.TemplateID(helper.GenerateRandomID(10)),
+		fmt.Sprintf("test-%s", helper.GenerateRandomID(5)),
+		nil,
+	)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("validation did not return an ENotFound error for a non-existent template (%v)", err)
+	}
+}
+
+func TestValidateVMCreationNameCollision(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	err := client.ValidateVMCreation(helper.GetClusterID(), helper.GetBlankTemplateID(), vm.Name(), nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("validation did not return an EConflict error for an existing VM name (%v)", err)
+	}
+}
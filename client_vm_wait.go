@@ -0,0 +1,33 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (o *oVirtClient) WaitForVM(id VMID, condition VMCondition, retries ...RetryStrategy) (vm VM, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("waiting for VM %s to meet the condition", id),
+		retries,
+		func() error {
+			vm, err = o.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMCondition(vm, condition)
+		})
+	return
+}
+
+// checkVMCondition returns an EPending error if the VM does not meet the condition yet.
+func checkVMCondition(vm VM, condition VMCondition) error {
+	met, err := condition(vm)
+	if err != nil {
+		return err
+	}
+	if !met {
+		return newError(EPending, "VM %s does not meet the condition yet", vm.ID())
+	}
+	return nil
+}
@@ -4,11 +4,11 @@ import (
 	"fmt"
 )
 
-func (o *oVirtClient) WaitForVMStatus(id string, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
+func (o *oVirtClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
 		fmt.Sprintf("waiting for VM %s status %s", id, status),
-		o.logger,
 		retries,
 		func() error {
 			vm, err = o.GetVM(id, retries...)
@@ -0,0 +1,31 @@
+package ovirtclient_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestWaitForVMStatusContextCancel(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	startTime := time.Now()
+	// The VM is never started, so the wait can only end by the context timing out.
+	_, err := vm.WaitForStatus(ovirtclient.VMStatusUp, ovirtclient.ContextStrategy(ctx))
+	if err == nil {
+		t.Fatalf("waiting for a VM status that is never reached did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETimeout) {
+		t.Fatalf("waiting for a VM status returned an unexpected error (%v)", err)
+	}
+	if elapsed := time.Since(startTime); elapsed > 10*time.Second {
+		t.Fatalf("the wait was not aborted when the context timed out (took %s)", elapsed)
+	}
+}
@@ -0,0 +1,67 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+)
+
+// ReportedDeviceFilter selects the guest network interfaces WaitForVMIPAddresses takes into account, for example to
+// ignore container bridges. It returns true if the device should be considered.
+type ReportedDeviceFilter func(device ReportedDevice) bool
+
+// ExcludeReportedDeviceNames returns a ReportedDeviceFilter that ignores the guest network interfaces with the
+// specified names, for example lo or docker0.
+func ExcludeReportedDeviceNames(names ...string) ReportedDeviceFilter {
+	excluded := make(map[string]bool, len(names))
+	for _, name := range names {
+		excluded[name] = true
+	}
+	return func(device ReportedDevice) bool {
+		return !excluded[device.Name()]
+	}
+}
+
+var vmIPAddressesGetParams = VMGetParams().MustWithFollow(VMFollowReportedDevices)
+
+func (o *oVirtClient) WaitForVMIPAddresses(
+	id VMID,
+	filter ReportedDeviceFilter,
+	retries ...RetryStrategy,
+) (result map[string][]net.IP, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = o.retry(
+		resourceOf(ResourceTypeVM, string(id)),
+		fmt.Sprintf("waiting for IP addresses on VM %s", id),
+		retries,
+		func() error {
+			vm, err := o.GetVMWithParams(id, vmIPAddressesGetParams, retries...)
+			if err != nil {
+				return err
+			}
+			result, err = findVMIPAddresses(vm, filter)
+			return err
+		})
+	return
+}
+
+// findVMIPAddresses collects the usable IP addresses from the reported devices embedded in the VM, grouped by the
+// name of the guest network interface. Loopback, link-local and unspecified addresses are ignored, as they cannot be
+// used to reach the VM. If no address is found, an EPending error is returned.
+func findVMIPAddresses(vm VM, filter ReportedDeviceFilter) (map[string][]net.IP, error) {
+	result := map[string][]net.IP{}
+	for _, device := range vm.EmbeddedReportedDevices() {
+		if filter != nil && !filter(device) {
+			continue
+		}
+		for _, ip := range device.IPAddresses() {
+			if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+				continue
+			}
+			result[device.Name()] = append(result[device.Name()], ip)
+		}
+	}
+	if len(result) == 0 {
+		return nil, newError(EPending, "no IP addresses reported for VM %s yet", vm.ID())
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package ovirtclient_test
+
+import (
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestWaitForVMIPAddresses(t *testing.T) {
+	t.Parallel()
+	vm := assertCanStartMockVMWithNIC(t, "eth0")
+
+	ips, err := vm.WaitForIPAddresses(nil)
+	if err != nil {
+		t.Fatalf("failed to wait for IP addresses of VM %s (%v)", vm.ID(), err)
+	}
+	if len(ips) != 1 {
+		t.Fatalf("incorrect number of guest interfaces with IP addresses (expected: 1, got: %d)", len(ips))
+	}
+	for name, addresses := range ips {
+		if len(addresses) == 0 {
+			t.Fatalf("no IP addresses returned for guest interface %s", name)
+		}
+	}
+}
+
+func TestWaitForVMIPAddressesFilter(t *testing.T) {
+	t.Parallel()
+	vm := assertCanStartMockVMWithNIC(t, "eth0")
+
+	ips, err := vm.WaitForIPAddresses(nil)
+	if err != nil {
+		t.Fatalf("failed to wait for IP addresses of VM %s (%v)", vm.ID(), err)
+	}
+	names := make([]string, 0, len(ips))
+	for name := range ips {
+		names = append(names, name)
+	}
+	_, err = vm.WaitForIPAddresses(
+		ovirtclient.ExcludeReportedDeviceNames(names...),
+		ovirtclient.ExponentialBackoff(1),
+		ovirtclient.Timeout(time.Second),
+	)
+	if err == nil {
+		t.Fatalf("waiting for IP addresses on excluded guest interfaces did not fail")
+	}
+}
+
+func assertCanStartMockVMWithNIC(t *testing.T, nicName string) ovirtclient.VM {
+	client := ovirtclient.NewMock()
+	clusters, err := client.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	profiles, err := client.ListVNICProfiles()
+	if err != nil {
+		t.Fatalf("failed to list VNIC profiles (%v)", err)
+	}
+	vm, err := client.CreateVM(clusters[0].ID(), ovirtclient.DefaultBlankTemplateID, "ip_test", nil)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	if _, err := vm.CreateNIC(nicName, profiles[0].ID(), nil); err != nil {
+		t.Fatalf("failed to create NIC on VM %s (%v)", vm.ID(), err)
+	}
+	if err := vm.Start(); err != nil {
+		t.Fatalf("failed to start VM %s (%v)", vm.ID(), err)
+	}
+	if _, err := vm.WaitForStatus(ovirtclient.VMStatusUp); err != nil {
+		t.Fatalf("VM %s did not start (%v)", vm.ID(), err)
+	}
+	return vm
+}
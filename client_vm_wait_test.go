@@ -0,0 +1,57 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestWaitForVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	calls := 0
+	result, err := client.WaitForVM(
+		vm.ID(),
+		func(vm ovirtclient.VM) (bool, error) {
+			calls++
+			return calls > 1 && vm.Status() == ovirtclient.VMStatusDown, nil
+		},
+		ovirtclient.ExponentialBackoff(1),
+		ovirtclient.Timeout(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("failed to wait for VM condition (%v)", err)
+	}
+	if result.ID() != vm.ID() {
+		t.Fatalf("incorrect VM returned (expected: %s, got: %s)", vm.ID(), result.ID())
+	}
+	if calls != 2 {
+		t.Fatalf("incorrect number of condition calls (expected: 2, got: %d)", calls)
+	}
+}
+
+func TestWaitForVMConditionError(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	conditionErr := errors.New("the condition failed")
+	_, err := client.WaitForVM(
+		vm.ID(),
+		func(_ ovirtclient.VM) (bool, error) {
+			return false, conditionErr
+		},
+		ovirtclient.ExponentialBackoff(1),
+		ovirtclient.Timeout(time.Minute),
+	)
+	if !errors.Is(err, conditionErr) {
+		t.Fatalf("the error of the condition was not returned (%v)", err)
+	}
+}
@@ -0,0 +1,237 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// vmWatchMaxBackoffFactor is the maximum factor the poll interval is multiplied with after failed polls.
+const vmWatchMaxBackoffFactor = 16
+
+// VMWatch is a running watch on one or more VMs created by WatchVM or WatchVMs.
+type VMWatch interface {
+	// Changes returns the channel the changes of the watched VMs are delivered on. The channel is closed when the
+	// watch is closed.
+	Changes() <-chan VMChange
+	// Errors returns the channel errors are delivered on if polling fails. Polling continues after an error. The
+	// channel is closed when the watch is closed.
+	Errors() <-chan error
+	// Close stops polling and closes the channels. It is safe to call Close multiple times.
+	Close()
+}
+
+// VMChange is a change of a VM observed by a VMWatch.
+type VMChange interface {
+	// ID returns the ID of the changed VM.
+	ID() VMID
+	// VM returns the current state of the VM. It returns nil if the VM was removed or no longer matches the
+	// search of the watch.
+	VM() VM
+}
+
+func (o *oVirtClient) WatchVM(id VMID, pollInterval time.Duration, retries ...RetryStrategy) (VMWatch, error) {
+	return watchVM(o, o.logger, id, pollInterval, retries)
+}
+
+func (o *oVirtClient) WatchVMs(
+	params VMSearchParameters,
+	pollInterval time.Duration,
+	retries ...RetryStrategy,
+) (VMWatch, error) {
+	return watchVMs(o, o.logger, params, pollInterval, retries)
+}
+
+// watchVM implements WatchVM on top of GetVM. It is shared between the real and the mock client.
+func watchVM(
+	client VMClient,
+	logger Logger,
+	id VMID,
+	pollInterval time.Duration,
+	retries []RetryStrategy,
+) (VMWatch, error) {
+	if pollInterval <= 0 {
+		return nil, newError(EBadArgument, "the poll interval must be positive")
+	}
+	vm, err := client.GetVM(id, retries...)
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to fetch VM %s to start watching it", id)
+	}
+	fetch := func() ([]VM, error) {
+		vm, err := client.GetVM(id, retries...)
+		if err != nil {
+			if HasErrorCode(err, ENotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []VM{vm}, nil
+	}
+	return startVMWatch(logger, fmt.Sprintf("VM %s", id), []VM{vm}, fetch, pollInterval), nil
+}
+
+// watchVMs implements WatchVMs on top of SearchVMs. It is shared between the real and the mock client.
+func watchVMs(
+	client VMClient,
+	logger Logger,
+	params VMSearchParameters,
+	pollInterval time.Duration,
+	retries []RetryStrategy,
+) (VMWatch, error) {
+	if pollInterval <= 0 {
+		return nil, newError(EBadArgument, "the poll interval must be positive")
+	}
+	fetch := func() ([]VM, error) {
+		return client.SearchVMs(params, retries...)
+	}
+	vms, err := fetch()
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to search VMs to start watching them")
+	}
+	return startVMWatch(logger, "VMs", vms, fetch, pollInterval), nil
+}
+
+func startVMWatch(
+	logger Logger,
+	what string,
+	initial []VM,
+	fetch func() ([]VM, error),
+	pollInterval time.Duration,
+) VMWatch {
+	watch := &vmWatch{
+		logger:       logger,
+		what:         what,
+		fetch:        fetch,
+		pollInterval: pollInterval,
+		known:        map[VMID]VM{},
+		changes:      make(chan VMChange),
+		errors:       make(chan error, 1),
+		done:         make(chan struct{}),
+	}
+	go watch.run(initial)
+	return watch
+}
+
+type vmWatch struct {
+	logger       Logger
+	what         string
+	fetch        func() ([]VM, error)
+	pollInterval time.Duration
+	// known contains a copy of the last delivered state of each VM.
+	known     map[VMID]VM
+	changes   chan VMChange
+	errors    chan error
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (w *vmWatch) Changes() <-chan VMChange {
+	return w.changes
+}
+
+func (w *vmWatch) Errors() <-chan error {
+	return w.errors
+}
+
+func (w *vmWatch) Close() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+	})
+}
+
+func (w *vmWatch) run(initial []VM) {
+	defer close(w.changes)
+	defer close(w.errors)
+	if !w.deliver(initial) {
+		return
+	}
+	failures := 0
+	for {
+		timer := time.NewTimer(w.nextPoll(failures))
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		vms, err := w.fetch()
+		if err != nil {
+			failures++
+			w.logger.Debugf("Failed to poll %s, retrying in %s. (%v)", w.what, w.nextPoll(failures), err)
+			select {
+			case w.errors <- err:
+			default:
+				// The consumer has not yet read the previous error, drop this one.
+			}
+			continue
+		}
+		failures = 0
+		if !w.deliver(vms) {
+			return
+		}
+	}
+}
+
+// nextPoll returns the time to wait before the next poll. The poll interval is doubled for each failed poll, up to
+// vmWatchMaxBackoffFactor times the poll interval.
+func (w *vmWatch) nextPoll(failures int) time.Duration {
+	wait := w.pollInterval
+	for i := 0; i < failures && wait < w.pollInterval*vmWatchMaxBackoffFactor; i++ {
+		wait *= 2
+	}
+	if wait > w.pollInterval*vmWatchMaxBackoffFactor {
+		wait = w.pollInterval * vmWatchMaxBackoffFactor
+	}
+	return wait
+}
+
+// deliver sends a change for each VM that is new or changed since the last poll, and for each VM that is no longer
+// present. It returns false if the watch has been closed.
+func (w *vmWatch) deliver(vms []VM) bool {
+	present := make(map[VMID]struct{}, len(vms))
+	for _, vm := range vms {
+		present[vm.ID()] = struct{}{}
+		if previous, ok := w.known[vm.ID()]; ok && reflect.DeepEqual(previous, vm) {
+			continue
+		}
+		current := vm.Clone()
+		if !w.send(&vmChange{id: vm.ID(), vm: current}) {
+			return false
+		}
+		w.known[vm.ID()] = current
+	}
+	for id := range w.known {
+		if _, ok := present[id]; ok {
+			continue
+		}
+		if !w.send(&vmChange{id: id}) {
+			return false
+		}
+		delete(w.known, id)
+	}
+	return true
+}
+
+// send delivers a change. It returns false if the watch has been closed.
+func (w *vmWatch) send(change VMChange) bool {
+	select {
+	case <-w.done:
+		return false
+	case w.changes <- change:
+		return true
+	}
+}
+
+type vmChange struct {
+	id VMID
+	vm VM
+}
+
+func (v *vmChange) ID() VMID {
+	return v.id
+}
+
+func (v *vmChange) VM() VM {
+	return v.vm
+}
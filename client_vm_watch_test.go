@@ -0,0 +1,84 @@
+package ovirtclient_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestWatchVM(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	watch, err := client.WatchVM(vm.ID(), 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch VM (%v)", err)
+	}
+	defer watch.Close()
+
+	change := assertVMChange(t, watch, vm.ID())
+	if change.VM() == nil || change.VM().Comment() != vm.Comment() {
+		t.Fatalf("the initial change does not contain the VM")
+	}
+
+	if _, err := client.UpdateVM(vm.ID(), ovirtclient.UpdateVMParams().MustWithComment("changed")); err != nil {
+		t.Fatalf("failed to update VM (%v)", err)
+	}
+	change = assertVMChange(t, watch, vm.ID())
+	if change.VM() == nil || change.VM().Comment() != "changed" {
+		t.Fatalf("the change after the update does not contain the updated VM")
+	}
+
+	if err := client.RemoveVM(vm.ID()); err != nil {
+		t.Fatalf("failed to remove VM (%v)", err)
+	}
+	change = assertVMChange(t, watch, vm.ID())
+	if change.VM() != nil {
+		t.Fatalf("the change after the removal contains a VM")
+	}
+}
+
+func TestWatchVMs(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+	vm := assertCanCreateVM(t, helper, fmt.Sprintf("test-%s", helper.GenerateRandomID(5)), nil)
+
+	watch, err := client.WatchVMs(ovirtclient.VMSearchParams().WithName(vm.Name()), 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to watch VMs (%v)", err)
+	}
+	defer watch.Close()
+
+	if change := assertVMChange(t, watch, vm.ID()); change.VM() == nil {
+		t.Fatalf("the initial change does not contain the VM")
+	}
+}
+
+func TestWatchVMInvalidInterval(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	if _, err := helper.GetClient().WatchVM("nonexistent", 0); !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("watching with an invalid poll interval did not return an EBadArgument error (%v)", err)
+	}
+}
+
+func assertVMChange(t *testing.T, watch ovirtclient.VMWatch, id ovirtclient.VMID) ovirtclient.VMChange {
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case change := <-watch.Changes():
+			if change.ID() == id {
+				return change
+			}
+		case err := <-watch.Errors():
+			t.Fatalf("failed to poll VM %s (%v)", id, err)
+		case <-timeout:
+			t.Fatalf("timeout while waiting for a change of VM %s", id)
+		}
+	}
+}
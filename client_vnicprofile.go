@@ -1,6 +1,8 @@
 package ovirtclient
 
 import (
+	"strings"
+
 	ovirtsdk "github.com/ovirt/go-ovirt"
 )
 
@@ -16,14 +18,36 @@ type VNICProfileClient interface {
 	ListVNICProfiles(retries ...RetryStrategy) ([]VNICProfile, error)
 	// RemoveVNICProfile removes a VNIC profile
 	RemoveVNICProfile(id string, retries ...RetryStrategy) error
+	// UpdateVNICProfile updates the VNIC profile specified in id with the specified parameters. Use
+	// UpdateVNICProfileParams to obtain a builder for the parameters.
+	UpdateVNICProfile(id string, params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error)
 }
 
 // OptionalVNICProfileParameters is a set of parameters for creating VNICProfiles that are optional.
-type OptionalVNICProfileParameters interface{}
+type OptionalVNICProfileParameters interface {
+	// PortMirroring returns true if port mirroring should be enabled on the VNIC profile. Returns nil if the engine
+	// default should be used.
+	PortMirroring() *bool
+	// PassThroughMode returns the pass-through mode of the VNIC profile. Returns nil if the engine default should be
+	// used.
+	PassThroughMode() *VNICPassThroughMode
+}
 
 // BuildableVNICProfileParameters is a buildable version of OptionalVNICProfileParameters.
 type BuildableVNICProfileParameters interface {
 	OptionalVNICProfileParameters
+
+	// WithPortMirroring enables or disables port mirroring on the VNIC profile. Port mirroring copies all traffic
+	// on the network to the NICs using this profile, which is useful for traffic analysis VMs.
+	WithPortMirroring(portMirroring bool) (BuildableVNICProfileParameters, error)
+	// MustWithPortMirroring is identical to WithPortMirroring, but panics instead of returning an error.
+	MustWithPortMirroring(portMirroring bool) BuildableVNICProfileParameters
+
+	// WithPassThroughMode sets the pass-through mode of the VNIC profile. Enabling pass-through connects NICs using
+	// this profile directly to an SR-IOV virtual function on the host.
+	WithPassThroughMode(mode VNICPassThroughMode) (BuildableVNICProfileParameters, error)
+	// MustWithPassThroughMode is identical to WithPassThroughMode, but panics instead of returning an error.
+	MustWithPassThroughMode(mode VNICPassThroughMode) BuildableVNICProfileParameters
 }
 
 // CreateVNICProfileParams creats a buildable set of optional parameters for VNICProfile creation.
@@ -31,7 +55,118 @@ func CreateVNICProfileParams() BuildableVNICProfileParameters {
 	return &vnicProfileParams{}
 }
 
-type vnicProfileParams struct{}
+type vnicProfileParams struct {
+	portMirroring   *bool
+	passThroughMode *VNICPassThroughMode
+}
+
+func (v *vnicProfileParams) PassThroughMode() *VNICPassThroughMode {
+	return v.passThroughMode
+}
+
+func (v *vnicProfileParams) WithPassThroughMode(mode VNICPassThroughMode) (BuildableVNICProfileParameters, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	v.passThroughMode = &mode
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithPassThroughMode(mode VNICPassThroughMode) BuildableVNICProfileParameters {
+	builder, err := v.WithPassThroughMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (v *vnicProfileParams) PortMirroring() *bool {
+	return v.portMirroring
+}
+
+func (v *vnicProfileParams) WithPortMirroring(portMirroring bool) (BuildableVNICProfileParameters, error) {
+	v.portMirroring = &portMirroring
+	return v, nil
+}
+
+func (v *vnicProfileParams) MustWithPortMirroring(portMirroring bool) BuildableVNICProfileParameters {
+	builder, err := v.WithPortMirroring(portMirroring)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// UpdateVNICProfileParameters is a set of parameters to change on a VNIC profile. Each method can return nil to
+// leave an attribute unchanged.
+type UpdateVNICProfileParameters interface {
+	// Name potentially returns a changed name for the VNIC profile.
+	Name() *string
+	// PortMirroring potentially returns a changed port mirroring setting for the VNIC profile.
+	PortMirroring() *bool
+}
+
+// BuildableUpdateVNICProfileParameters is a buildable version of UpdateVNICProfileParameters.
+type BuildableUpdateVNICProfileParameters interface {
+	UpdateVNICProfileParameters
+
+	// WithName sets the new name of the VNIC profile.
+	WithName(name string) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithName is identical to WithName, but panics instead of returning an error.
+	MustWithName(name string) BuildableUpdateVNICProfileParameters
+
+	// WithPortMirroring enables or disables port mirroring on the VNIC profile.
+	WithPortMirroring(portMirroring bool) (BuildableUpdateVNICProfileParameters, error)
+	// MustWithPortMirroring is identical to WithPortMirroring, but panics instead of returning an error.
+	MustWithPortMirroring(portMirroring bool) BuildableUpdateVNICProfileParameters
+}
+
+// UpdateVNICProfileParams creates a buildable set of parameters for updating a VNIC profile.
+func UpdateVNICProfileParams() BuildableUpdateVNICProfileParameters {
+	return &updateVNICProfileParams{}
+}
+
+type updateVNICProfileParams struct {
+	name          *string
+	portMirroring *bool
+}
+
+func (u *updateVNICProfileParams) Name() *string {
+	return u.name
+}
+
+func (u *updateVNICProfileParams) PortMirroring() *bool {
+	return u.portMirroring
+}
+
+func (u *updateVNICProfileParams) WithName(name string) (BuildableUpdateVNICProfileParameters, error) {
+	if name == "" {
+		return nil, newError(EBadArgument, "VNIC profile name cannot be empty")
+	}
+	u.name = &name
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithName(name string) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithName(name)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (u *updateVNICProfileParams) WithPortMirroring(portMirroring bool) (BuildableUpdateVNICProfileParameters, error) {
+	u.portMirroring = &portMirroring
+	return u, nil
+}
+
+func (u *updateVNICProfileParams) MustWithPortMirroring(portMirroring bool) BuildableUpdateVNICProfileParameters {
+	builder, err := u.WithPortMirroring(portMirroring)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
 
 // VNICProfileData is the core of VNICProfile, providing only data access functions.
 type VNICProfileData interface {
@@ -41,6 +176,56 @@ type VNICProfileData interface {
 	Name() string
 	// NetworkID returns the network ID the VNICProfile is attached to.
 	NetworkID() string
+	// PortMirroring returns true if port mirroring is enabled on this VNIC profile.
+	PortMirroring() bool
+	// PassThroughMode returns the pass-through mode of this VNIC profile. If pass-through is enabled NICs using this
+	// profile are connected directly to an SR-IOV virtual function on the host.
+	PassThroughMode() VNICPassThroughMode
+}
+
+// VNICPassThroughMode describes if a VNIC profile connects NICs directly to an SR-IOV virtual function.
+type VNICPassThroughMode string
+
+const (
+	// VNICPassThroughModeEnabled connects NICs directly to an SR-IOV virtual function of a host NIC.
+	VNICPassThroughModeEnabled VNICPassThroughMode = "enabled"
+	// VNICPassThroughModeDisabled connects NICs via the regular virtual network.
+	VNICPassThroughModeDisabled VNICPassThroughMode = "disabled"
+)
+
+// Validate returns an error if the pass-through mode is not one of the supported values.
+func (m VNICPassThroughMode) Validate() error {
+	for _, mode := range VNICPassThroughModeValues() {
+		if mode == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VNIC pass-through mode: %s must be one of: %s",
+		m,
+		strings.Join(VNICPassThroughModeValues().Strings(), ", "),
+	)
+}
+
+// VNICPassThroughModeList is a list of VNICPassThroughMode values.
+type VNICPassThroughModeList []VNICPassThroughMode
+
+// VNICPassThroughModeValues returns all possible VNICPassThroughMode values.
+func VNICPassThroughModeValues() VNICPassThroughModeList {
+	return []VNICPassThroughMode{
+		VNICPassThroughModeEnabled,
+		VNICPassThroughModeDisabled,
+	}
+}
+
+// Strings creates a string list of the values.
+func (l VNICPassThroughModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
 }
 
 // VNICProfile is a collection of settings that can be applied to individual virtual network interface cards in the
@@ -52,6 +237,9 @@ type VNICProfile interface {
 	Network(retries ...RetryStrategy) (Network, error)
 	// Remove removes the current VNIC profile.
 	Remove(retries ...RetryStrategy) error
+	// Update updates the current VNIC profile with the specified parameters and returns the updated profile. Use
+	// UpdateVNICProfileParams to obtain a builder for the parameters.
+	Update(params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error)
 }
 
 func convertSDKVNICProfile(sdkObject *ovirtsdk.VnicProfile, client Client) (VNICProfile, error) {
@@ -71,22 +259,45 @@ func convertSDKVNICProfile(sdkObject *ovirtsdk.VnicProfile, client Client) (VNIC
 	if !ok {
 		return nil, newFieldNotFound("Network on VNICProfile", "ID")
 	}
+	portMirroring, _ := sdkObject.PortMirroring()
+	passThroughMode := VNICPassThroughModeDisabled
+	if passThrough, ok := sdkObject.PassThrough(); ok {
+		if mode, ok := passThrough.Mode(); ok {
+			passThroughMode = VNICPassThroughMode(mode)
+		}
+	}
 
 	return &vnicProfile{
 		client: client,
 
-		id:        id,
-		name:      name,
-		networkID: networkID,
+		id:              id,
+		name:            name,
+		networkID:       networkID,
+		portMirroring:   portMirroring,
+		passThroughMode: passThroughMode,
 	}, nil
 }
 
 type vnicProfile struct {
 	client Client
 
-	id        string
-	networkID string
-	name      string
+	id              string
+	networkID       string
+	name            string
+	portMirroring   bool
+	passThroughMode VNICPassThroughMode
+}
+
+func (v vnicProfile) PassThroughMode() VNICPassThroughMode {
+	return v.passThroughMode
+}
+
+func (v vnicProfile) Update(params UpdateVNICProfileParameters, retries ...RetryStrategy) (VNICProfile, error) {
+	return v.client.UpdateVNICProfile(v.id, params, retries...)
+}
+
+func (v vnicProfile) PortMirroring() bool {
+	return v.portMirroring
 }
 
 func (v vnicProfile) Remove(retries ...RetryStrategy) error {
@@ -108,3 +319,25 @@ func (v vnicProfile) NetworkID() string {
 func (v vnicProfile) ID() string {
 	return v.id
 }
+
+func (v vnicProfile) withName(name string) *vnicProfile {
+	return &vnicProfile{
+		client:          v.client,
+		id:              v.id,
+		networkID:       v.networkID,
+		name:            name,
+		portMirroring:   v.portMirroring,
+		passThroughMode: v.passThroughMode,
+	}
+}
+
+func (v vnicProfile) withPortMirroring(portMirroring bool) *vnicProfile {
+	return &vnicProfile{
+		client:          v.client,
+		id:              v.id,
+		networkID:       v.networkID,
+		name:            v.name,
+		portMirroring:   portMirroring,
+		passThroughMode: v.passThroughMode,
+	}
+}
@@ -18,16 +18,31 @@ func (o *oVirtClient) CreateVNICProfile(
 		return nil, err
 	}
 
-	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeNetwork, networkID).with("name", name),
 		fmt.Sprintf("creating VNIC profile %s", name),
-		o.logger,
 		retries,
 		func() error {
 			profileBuilder := ovirtsdk.NewVnicProfileBuilder()
 			profileBuilder.Name(name)
 			profileBuilder.Network(ovirtsdk.NewNetworkBuilder().Id(networkID).MustBuild())
-			req := o.conn.SystemService().VnicProfilesService().Add()
+			if params != nil {
+				if portMirroring := params.PortMirroring(); portMirroring != nil {
+					profileBuilder.PortMirroring(*portMirroring)
+				}
+				if passThroughMode := params.PassThroughMode(); passThroughMode != nil {
+					profileBuilder.PassThrough(
+						ovirtsdk.NewVnicPassThroughBuilder().
+							Mode(ovirtsdk.VnicPassThroughMode(*passThroughMode)).
+							MustBuild(),
+					)
+				}
+			}
+			req := o.connection().SystemService().VnicProfilesService().Add()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
 			response, err := req.Profile(profileBuilder.MustBuild()).Send()
 			if err != nil {
 				return err
@@ -39,15 +54,23 @@ func (o *oVirtClient) CreateVNICProfile(
 			result, err = convertSDKVNICProfile(profile, o)
 			return err
 		})
-	return result, err
+	return result, withCorrelationID(err, correlationID)
 }
 
-func validateVNICProfileCreationParameters(name string, networkID string, _ OptionalVNICProfileParameters) error {
+func validateVNICProfileCreationParameters(name string, networkID string, params OptionalVNICProfileParameters) error {
 	if name == "" {
 		return newError(EBadArgument, "name cannot be empty for VNIC profile creation")
 	}
 	if networkID == "" {
 		return newError(EBadArgument, "network ID cannot be empty for VNIC profile creation")
 	}
+	if params != nil {
+		portMirroring := params.PortMirroring()
+		passThroughMode := params.PassThroughMode()
+		if portMirroring != nil && *portMirroring &&
+			passThroughMode != nil && *passThroughMode == VNICPassThroughModeEnabled {
+			return newError(EBadArgument, "port mirroring cannot be enabled on a pass-through VNIC profile")
+		}
+	}
 	return nil
 }
@@ -8,12 +8,12 @@ import (
 
 func (o *oVirtClient) GetVNICProfile(id string, retries ...RetryStrategy) (result VNICProfile, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVNICProfile, id),
 		fmt.Sprintf("getting VNIC profile %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().VnicProfilesService().ProfileService(id).Get().Send()
+			response, err := o.connection().SystemService().VnicProfilesService().ProfileService(id).Get().Send()
 			if err != nil {
 				return err
 			}
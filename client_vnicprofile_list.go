@@ -5,12 +5,12 @@ package ovirtclient
 func (o *oVirtClient) ListVNICProfiles(retries ...RetryStrategy) (result []VNICProfile, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []VNICProfile{}
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeVNICProfile, ""),
 		"listing VNIC profiles",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().VnicProfilesService().List().Send()
+			response, e := o.connection().SystemService().VnicProfilesService().List().Send()
 			if e != nil {
 				return e
 			}
@@ -5,17 +5,23 @@ import (
 )
 
 func (o *oVirtClient) RemoveVNICProfile(id string, retries ...RetryStrategy) (err error) {
-	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	err = o.mutate(
+		resourceOf(ResourceTypeVNICProfile, id),
 		fmt.Sprintf("removing VNIC profile %s", id),
-		o.logger,
 		retries,
 		func() error {
-			_, err := o.conn.SystemService().VnicProfilesService().ProfileService(id).Remove().Send()
+			req := o.connection().SystemService().VnicProfilesService().ProfileService(id).Remove()
+			if correlationID != "" {
+				req.Query("correlation_id", correlationID)
+			}
+			_, err := req.Send()
 			if err != nil {
 				return err
 			}
 			return nil
 		})
+	err = withCorrelationID(err, correlationID)
 	return
 }
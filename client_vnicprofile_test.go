@@ -33,6 +33,97 @@ func TestVNICProfile(t *testing.T) {
 	}
 }
 
+func TestVNICProfilePortMirroring(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	testVNICProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+
+	vnicProfile, err := client.CreateVNICProfile(
+		fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)),
+		testVNICProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().MustWithPortMirroring(true),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VNIC profile with port mirroring (%v)", err)
+	}
+	t.Cleanup(
+		func() {
+			if err := vnicProfile.Remove(); err != nil {
+				t.Fatalf("failed to clean up test VNIC profile ID %s (%v)", vnicProfile.ID(), err)
+			}
+		})
+	if !vnicProfile.PortMirroring() {
+		t.Fatalf("port mirroring not enabled on newly created VNIC profile")
+	}
+
+	updatedVNICProfile, err := vnicProfile.Update(ovirtclient.UpdateVNICProfileParams().MustWithPortMirroring(false))
+	if err != nil {
+		t.Fatalf("failed to update VNIC profile (%v)", err)
+	}
+	if updatedVNICProfile.PortMirroring() {
+		t.Fatalf("port mirroring still enabled after update")
+	}
+}
+
+func TestVNICProfilePassThrough(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	testVNICProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+
+	vnicProfile, err := client.CreateVNICProfile(
+		fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)),
+		testVNICProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().MustWithPassThroughMode(ovirtclient.VNICPassThroughModeEnabled),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VNIC profile with pass-through (%v)", err)
+	}
+	t.Cleanup(
+		func() {
+			if err := vnicProfile.Remove(); err != nil {
+				t.Fatalf("failed to clean up test VNIC profile ID %s (%v)", vnicProfile.ID(), err)
+			}
+		})
+	if vnicProfile.PassThroughMode() != ovirtclient.VNICPassThroughModeEnabled {
+		t.Fatalf("pass-through not enabled on newly created VNIC profile")
+	}
+}
+
+func TestVNICProfilePassThroughWithPortMirroring(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+	client := helper.GetClient()
+
+	testVNICProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
+	if err != nil {
+		t.Fatalf("failed to fetch test VNIC profile (%v)", err)
+	}
+
+	_, err = client.CreateVNICProfile(
+		fmt.Sprintf("client_test_%s", helper.GenerateRandomID(5)),
+		testVNICProfile.NetworkID(),
+		ovirtclient.CreateVNICProfileParams().
+			MustWithPassThroughMode(ovirtclient.VNICPassThroughModeEnabled).
+			MustWithPortMirroring(true),
+	)
+	if err == nil {
+		t.Fatalf("creating a pass-through VNIC profile with port mirroring did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("creating a pass-through VNIC profile with port mirroring returned an unexpected error (%v)", err)
+	}
+}
+
 func assertCanCreateVNICProfile(t *testing.T, helper ovirtclient.TestHelper) ovirtclient.VNICProfile {
 	client := helper.GetClient()
 	vnicProfile, err := client.GetVNICProfile(helper.GetVNICProfileID())
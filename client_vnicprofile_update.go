@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"fmt"
+
+	ovirtsdk "github.com/ovirt/go-ovirt"
+)
+
+func (o *oVirtClient) UpdateVNICProfile(
+	id string,
+	params UpdateVNICProfileParameters,
+	retries ...RetryStrategy,
+) (result VNICProfile, err error) {
+	req := o.connection().SystemService().VnicProfilesService().ProfileService(id).Update()
+
+	profileBuilder := ovirtsdk.NewVnicProfileBuilder().Id(id)
+	if name := params.Name(); name != nil {
+		profileBuilder.Name(*name)
+	}
+	if portMirroring := params.PortMirroring(); portMirroring != nil {
+		profileBuilder.PortMirroring(*portMirroring)
+	}
+
+	req.Profile(profileBuilder.MustBuild())
+
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	correlationID := o.correlationIDFor(retries)
+	if correlationID != "" {
+		req.Query("correlation_id", correlationID)
+	}
+	err = o.mutate(
+		resourceOf(ResourceTypeVNICProfile, id),
+		fmt.Sprintf("updating VNIC profile %s", id),
+		retries,
+		func() error {
+			update, err := req.Send()
+			if err != nil {
+				return wrap(err, EUnidentified, "failed to update VNIC profile %s", id)
+			}
+			sdkProfile, ok := update.Profile()
+			if !ok {
+				return newFieldNotFound("VNIC profile update response", "profile")
+			}
+			profile, err := convertSDKVNICProfile(sdkProfile, o)
+			if err != nil {
+				return err
+			}
+			result = profile
+			return nil
+		})
+	return result, withCorrelationID(err, correlationID)
+}
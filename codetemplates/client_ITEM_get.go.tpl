@@ -8,12 +8,11 @@ import (
 
 func (o *oVirtClient) Get{{ .Object }}(id {{ .IDType }}, retries ...RetryStrategy) (result {{ .Object }}, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
 		fmt.Sprintf("getting {{ .Name }} %s", id),
-		o.logger,
 		retries,
 		func() error {
-			response, err := o.conn.SystemService().{{ .ID }}sService().{{ .SecondaryID }}Service({{ if eq .IDType "string" }}id{{ else }}string(id){{ end }}).Get().Send()
+			response, err := o.connection().SystemService().{{ .ID }}sService().{{ .SecondaryID }}Service({{ if eq .IDType "string" }}id{{ else }}string(id){{ end }}).Get().Send()
 			if err != nil {
 				return err
 			}
@@ -5,12 +5,11 @@ package ovirtclient
 func (o *oVirtClient) List{{ .Object }}s(retries ...RetryStrategy) (result []{{ .Object }}, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
 	result = []{{ .Object }}{}
-	err = retry(
+	err = o.retry(
 		"listing {{ .Name }}s",
-		o.logger,
 		retries,
 		func() error {
-			response, e := o.conn.SystemService().{{ .ID }}sService().List().Send()
+			response, e := o.connection().SystemService().{{ .ID }}sService().List().Send()
 			if e != nil {
 				return e
 			}
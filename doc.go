@@ -88,6 +88,11 @@ Disable certificate verification if set. Not recommended.
 
 The cluster to use for testing. Will be automatically chosen if not provided.
 
+  OVIRT_SECONDARY_CLUSTER_ID
+
+A second cluster to use for cross-cluster tests. Will be automatically chosen if not provided. Tests needing it are
+skipped if no second cluster with a working host is available.
+
   OVIRT_BLANK_TEMPLATE_ID
 
 ID of the blank template. Will be automatically chosen if not provided.
@@ -96,6 +101,11 @@ ID of the blank template. Will be automatically chosen if not provided.
 
 Storage domain to use for testing. Will be automatically chosen if not provided.
 
+  OVIRT_SECONDARY_STORAGE_DOMAIN_ID
+
+A second storage domain to use for disk copy and move tests. Will be automatically chosen if not provided. Tests
+needing it are skipped if no second storage domain is available.
+
   OVIRT_VNIC_PROFILE_ID
 
 VNIC profile to use for testing. Will be automatically chosen if not provided.
@@ -201,6 +211,12 @@ to the client.
     // names.
     tls.CACertsFromDir("/path/to/certs", regexp.MustCompile(`\.pem`))
 
+If your engine requires mutual TLS, you can add a client certificate and key in PEM format, either from memory or
+from files:
+
+    tls.ClientCertFromMemory(certPEM, keyPEM)
+    tls.ClientCertFromFile("/path/to/client.pem", "/path/to/client.key")
+
 Finally, you can also disable certificate verification. Do we need to say that this is a very, very bad idea?
 
     tls.Insecure()
@@ -215,7 +231,9 @@ supported:
 
     ovirtclient.ContextStrategy(ctx)
 
-This strategy will stop retries when the context parameter is canceled.
+This strategy will stop retries when the context parameter is canceled. It does not cancel API calls: the oVirt SDK
+does not accept a context, so an API call that is already in flight is finished first and only further attempts are
+prevented. Use the request timeout of HTTPTransportParams to bound the duration of a single API call.
 
     ovirtclient.ExponentialBackoff(factor)
 
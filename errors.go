@@ -78,6 +78,32 @@ const ELocalIO ErrorCode = "local_io_error"
 // conflicting way. For example, you tried to attach a disk that is already attached.
 const EConflict ErrorCode = "conflict"
 
+// EJobFailed indicates that an engine job has failed or was aborted.
+const EJobFailed ErrorCode = "job_failed"
+
+// EBackupFailed indicates that a VM backup has failed or ended before reaching the expected phase.
+const EBackupFailed ErrorCode = "backup_failed"
+
+// EServiceUnavailable indicates that the engine, or a proxy in front of it, is temporarily unavailable. This
+// typically happens while the engine is restarting.
+const EServiceUnavailable ErrorCode = "service_unavailable"
+
+// EReadOnly indicates that a create, update or delete call was rejected because the client is in read-only mode.
+const EReadOnly ErrorCode = "read_only"
+
+// EDryRun indicates that a create, update or delete call was not sent to the oVirt Engine because the client is in
+// dry-run mode. The request the call would have sent is logged instead.
+const EDryRun ErrorCode = "dry_run"
+
+// Error returns the error code as a string. This allows using error codes as the target of errors.Is, for example:
+//
+//   if errors.Is(err, ovirtclient.ENotFound) {
+//       // deal with the missing resource
+//   }
+func (e ErrorCode) Error() string {
+	return string(e)
+}
+
 // CanAutoRetry returns false if the given error code is permanent and an automatic retry should not be attempted.
 func (e ErrorCode) CanAutoRetry() bool {
 	switch e {
@@ -99,6 +125,14 @@ func (e ErrorCode) CanAutoRetry() bool {
 		return false
 	case EUnexpectedDiskStatus:
 		return false
+	case EJobFailed:
+		return false
+	case EBackupFailed:
+		return false
+	case EReadOnly:
+		return false
+	case EDryRun:
+		return false
 	default:
 		return true
 	}
@@ -116,6 +150,9 @@ func (e ErrorCode) CanAutoRetry() bool {
 //          // deal with other errors
 //     }
 //   }
+//
+// Engine errors keep the error they were created from, so errors.As can also be used to access the underlying oVirt
+// SDK error. To check for a specific error code, pass the error code to errors.Is.
 type EngineError interface {
 	error
 
@@ -131,20 +168,57 @@ type EngineError interface {
 	CanAutoRetry() bool
 }
 
+// Classify returns the error code of the specified error. If the error is not an EngineError, this function attempts
+// to identify it from the underlying oVirt SDK error. If this fails, EUnidentified is returned. For nil errors, an
+// empty string is returned.
+func Classify(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	var e EngineError
+	if errors.As(err, &e) {
+		return e.Code()
+	}
+	if e := realIdentify(err); e != nil {
+		return e.Code()
+	}
+	return EUnidentified
+}
+
+// IsRetryable returns true if the specified error is transient and the failed call can be retried, for example a
+// conflict with another operation, a locked disk, or a temporarily unavailable engine. Errors that are not an
+// EngineError and cannot be identified are considered permanent. The AutoRetry strategy uses this function to decide
+// if a call should be retried.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e EngineError
+	if errors.As(err, &e) {
+		return e.CanAutoRetry()
+	}
+	identifiedError := realIdentify(err)
+	return identifiedError != nil && identifiedError.CanAutoRetry()
+}
+
 // HasErrorCode returns true if the specified error has the specified error code.
 func HasErrorCode(err error, code ErrorCode) bool {
+	if err == nil {
+		return false
+	}
 	var e EngineError
 	if errors.As(err, &e) {
 		return e.HasCode(code)
 	}
-	e = realIdentify(err)
-	return e.HasCode(code)
+	identifiedError := realIdentify(err)
+	return identifiedError != nil && identifiedError.HasCode(code)
 }
 
 type engineError struct {
-	message string
-	code    ErrorCode
-	cause   error
+	message       string
+	code          ErrorCode
+	cause         error
+	correlationID string
 }
 
 func (e *engineError) HasCode(code ErrorCode) bool {
@@ -176,6 +250,15 @@ func (e *engineError) Unwrap() error {
 	return e.cause
 }
 
+// Is returns true if the target is an ErrorCode and the current error, or any preceding error has this code.
+func (e *engineError) Is(target error) bool {
+	var code ErrorCode
+	if !errors.As(target, &code) {
+		return false
+	}
+	return e.HasCode(code)
+}
+
 func (e *engineError) CanAutoRetry() bool {
 	return e.code.CanAutoRetry()
 }
@@ -236,6 +319,12 @@ func realIdentify(err error) EngineError {
 		return wrap(err, ERelatedOperationInProgress, "a related operation is in progress")
 	case strings.Contains(err.Error(), "409 Conflict"):
 		return wrap(err, EConflict, "conflicting operations")
+	case strings.Contains(err.Error(), "HTTP response code is \"502\""):
+		fallthrough
+	case strings.Contains(err.Error(), "HTTP response code is \"503\""):
+		fallthrough
+	case strings.Contains(err.Error(), "HTTP response code is \"504\""):
+		return wrap(err, EServiceUnavailable, "the oVirt Engine is temporarily unavailable")
 	case errors.As(err, &authErr):
 		fallthrough
 	case strings.Contains(err.Error(), "access_denied"):
@@ -0,0 +1,92 @@
+package ovirtclient_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name      string
+		err       error
+		code      ovirtclient.ErrorCode
+		retryable bool
+	}{
+		{
+			"unavailable",
+			errors.New(`HTTP response code is "503". HTTP response message is "503 Service Unavailable".`),
+			ovirtclient.EServiceUnavailable,
+			true,
+		},
+		{
+			"conflict",
+			errors.New(`HTTP response code is "409". HTTP response message is "409 Conflict".`),
+			ovirtclient.EConflict,
+			true,
+		},
+		{
+			"locked",
+			errors.New(`Fault reason is "Operation Failed". Fault detail is "[Disk is locked]".`),
+			ovirtclient.EDiskLocked,
+			true,
+		},
+		{
+			"unidentified",
+			errors.New("something went wrong"),
+			ovirtclient.EUnidentified,
+			false,
+		},
+		{
+			"wrapped",
+			fmt.Errorf("failed to remove VM (%w)", errors.New(`HTTP response code is "504".`)),
+			ovirtclient.EServiceUnavailable,
+			true,
+		},
+	}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			if code := ovirtclient.Classify(testCase.err); code != testCase.code {
+				t.Fatalf("incorrect error code (expected: %s, got: %s)", testCase.code, code)
+			}
+			if retryable := ovirtclient.IsRetryable(testCase.err); retryable != testCase.retryable {
+				t.Fatalf("incorrect retryable result (expected: %t, got: %t)", testCase.retryable, retryable)
+			}
+		})
+	}
+}
+
+func TestClassifyNil(t *testing.T) {
+	t.Parallel()
+	if code := ovirtclient.Classify(nil); code != "" {
+		t.Fatalf("incorrect error code for nil error: %s", code)
+	}
+	if ovirtclient.IsRetryable(nil) {
+		t.Fatalf("a nil error was considered retryable")
+	}
+}
+
+func TestErrorsIs(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	_, err := client.GetVM("nonexistent")
+	if err == nil {
+		t.Fatalf("fetching a nonexistent VM did not result in an error")
+	}
+	wrappedErr := fmt.Errorf("failed to reconcile VM (%w)", err)
+	if !errors.Is(wrappedErr, ovirtclient.ENotFound) {
+		t.Fatalf("errors.Is did not match the ENotFound error code (%v)", err)
+	}
+	if errors.Is(wrappedErr, ovirtclient.EConflict) {
+		t.Fatalf("errors.Is incorrectly matched the EConflict error code (%v)", err)
+	}
+	var engineErr ovirtclient.EngineError
+	if !errors.As(wrappedErr, &engineErr) || engineErr.Code() != ovirtclient.ENotFound {
+		t.Fatalf("errors.As did not return the engine error (%v)", err)
+	}
+}
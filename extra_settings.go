@@ -0,0 +1,228 @@
+package ovirtclient
+
+// BuildableExtraSettings is a buildable version of the extra settings implementing the newest ExtraSettings interface.
+// Use NewExtraSettings to obtain a copy. New settings are added to this builder as well as to a new version of the
+// ExtraSettings interface, so code using the builder does not have to implement the functions of all older versions.
+type BuildableExtraSettings interface {
+	ExtraSettingsV13
+
+	// WithExtraHeaders sets the headers sent with each request. See ExtraSettings.
+	WithExtraHeaders(headers map[string]string) BuildableExtraSettings
+	// WithCompression enables the compression of the HTTP responses. See ExtraSettings.
+	WithCompression() BuildableExtraSettings
+	// WithCorrelationID sets the default correlation ID of create, update and delete calls. See ExtraSettingsV2.
+	WithCorrelationID(correlationID string) BuildableExtraSettings
+	// WithMetricsCollector sets the collector of the measurements about the API calls. See ExtraSettingsV3.
+	WithMetricsCollector(collector MetricsCollector) BuildableExtraSettings
+	// WithTracer sets the tracer creating spans around the API calls. See ExtraSettingsV4.
+	WithTracer(tracer Tracer) BuildableExtraSettings
+
+	// WithRateLimit sets the maximum average number of API calls per second and the number of calls that can be made
+	// at once. See ExtraSettingsV5.
+	WithRateLimit(requestsPerSecond float64, burst uint) (BuildableExtraSettings, error)
+	// MustWithRateLimit is identical to WithRateLimit, but panics instead of returning an error.
+	MustWithRateLimit(requestsPerSecond float64, burst uint) BuildableExtraSettings
+
+	// WithHTTPTransport sets the tuning options for the HTTP connections. See ExtraSettingsV6.
+	WithHTTPTransport(params HTTPTransportParameters) BuildableExtraSettings
+	// WithProxy sets the proxy settings. See ExtraSettingsV7.
+	WithProxy(proxy ProxyParameters) BuildableExtraSettings
+	// WithDryRun enables the dry-run mode. See ExtraSettingsV8.
+	WithDryRun() BuildableExtraSettings
+	// WithReadOnly enables the read-only mode. See ExtraSettingsV9.
+	WithReadOnly() BuildableExtraSettings
+
+	// WithFailoverURLs sets the URLs of the standby engines. The URLs must start with http:// or https://. See
+	// ExtraSettingsV10.
+	WithFailoverURLs(urls ...string) (BuildableExtraSettings, error)
+	// MustWithFailoverURLs is identical to WithFailoverURLs, but panics instead of returning an error.
+	MustWithFailoverURLs(urls ...string) BuildableExtraSettings
+
+	// WithUserAgentSuffix sets the text appended to the User-Agent header. See ExtraSettingsV11.
+	WithUserAgentSuffix(suffix string) BuildableExtraSettings
+	// WithAuditSink sets the sink recording the calls changing the engine. See ExtraSettingsV12.
+	WithAuditSink(sink AuditSink) BuildableExtraSettings
+	// WithVMNameValidator sets the validator of VM names. See ExtraSettingsV13.
+	WithVMNameValidator(validator VMNameValidator) BuildableExtraSettings
+}
+
+// NewExtraSettings creates a buildable set of extra settings with all settings disabled.
+func NewExtraSettings() BuildableExtraSettings {
+	return &extraSettings{}
+}
+
+type extraSettings struct {
+	headers          map[string]string
+	compression      bool
+	correlationID    string
+	metricsCollector MetricsCollector
+	tracer           Tracer
+	rateLimit        float64
+	rateLimitBurst   uint
+	httpTransport    HTTPTransportParameters
+	proxy            ProxyParameters
+	dryRun           bool
+	readOnly         bool
+	failoverURLs     []string
+	userAgentSuffix  string
+	auditSink        AuditSink
+	vmNameValidator  VMNameValidator
+}
+
+func (e *extraSettings) ExtraHeaders() map[string]string {
+	return e.headers
+}
+
+func (e *extraSettings) Compression() bool {
+	return e.compression
+}
+
+func (e *extraSettings) CorrelationID() string {
+	return e.correlationID
+}
+
+func (e *extraSettings) MetricsCollector() MetricsCollector {
+	return e.metricsCollector
+}
+
+func (e *extraSettings) Tracer() Tracer {
+	return e.tracer
+}
+
+func (e *extraSettings) RateLimit() float64 {
+	return e.rateLimit
+}
+
+func (e *extraSettings) RateLimitBurst() uint {
+	return e.rateLimitBurst
+}
+
+func (e *extraSettings) HTTPTransport() HTTPTransportParameters {
+	return e.httpTransport
+}
+
+func (e *extraSettings) Proxy() ProxyParameters {
+	return e.proxy
+}
+
+func (e *extraSettings) DryRun() bool {
+	return e.dryRun
+}
+
+func (e *extraSettings) ReadOnly() bool {
+	return e.readOnly
+}
+
+func (e *extraSettings) FailoverURLs() []string {
+	return e.failoverURLs
+}
+
+func (e *extraSettings) UserAgentSuffix() string {
+	return e.userAgentSuffix
+}
+
+func (e *extraSettings) AuditSink() AuditSink {
+	return e.auditSink
+}
+
+func (e *extraSettings) VMNameValidator() VMNameValidator {
+	return e.vmNameValidator
+}
+
+func (e *extraSettings) WithExtraHeaders(headers map[string]string) BuildableExtraSettings {
+	e.headers = make(map[string]string, len(headers))
+	for name, value := range headers {
+		e.headers[name] = value
+	}
+	return e
+}
+
+func (e *extraSettings) WithCompression() BuildableExtraSettings {
+	e.compression = true
+	return e
+}
+
+func (e *extraSettings) WithCorrelationID(correlationID string) BuildableExtraSettings {
+	e.correlationID = correlationID
+	return e
+}
+
+func (e *extraSettings) WithMetricsCollector(collector MetricsCollector) BuildableExtraSettings {
+	e.metricsCollector = collector
+	return e
+}
+
+func (e *extraSettings) WithTracer(tracer Tracer) BuildableExtraSettings {
+	e.tracer = tracer
+	return e
+}
+
+func (e *extraSettings) WithRateLimit(requestsPerSecond float64, burst uint) (BuildableExtraSettings, error) {
+	if requestsPerSecond < 0 {
+		return nil, newError(EBadArgument, "the rate limit must not be negative (%f)", requestsPerSecond)
+	}
+	e.rateLimit = requestsPerSecond
+	e.rateLimitBurst = burst
+	return e, nil
+}
+
+func (e *extraSettings) MustWithRateLimit(requestsPerSecond float64, burst uint) BuildableExtraSettings {
+	builder, err := e.WithRateLimit(requestsPerSecond, burst)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *extraSettings) WithHTTPTransport(params HTTPTransportParameters) BuildableExtraSettings {
+	e.httpTransport = params
+	return e
+}
+
+func (e *extraSettings) WithProxy(proxy ProxyParameters) BuildableExtraSettings {
+	e.proxy = proxy
+	return e
+}
+
+func (e *extraSettings) WithDryRun() BuildableExtraSettings {
+	e.dryRun = true
+	return e
+}
+
+func (e *extraSettings) WithReadOnly() BuildableExtraSettings {
+	e.readOnly = true
+	return e
+}
+
+func (e *extraSettings) WithFailoverURLs(urls ...string) (BuildableExtraSettings, error) {
+	for _, u := range urls {
+		if err := validateURL(u); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid failover URL: %s", u)
+		}
+	}
+	e.failoverURLs = append([]string{}, urls...)
+	return e, nil
+}
+
+func (e *extraSettings) MustWithFailoverURLs(urls ...string) BuildableExtraSettings {
+	builder, err := e.WithFailoverURLs(urls...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (e *extraSettings) WithUserAgentSuffix(suffix string) BuildableExtraSettings {
+	e.userAgentSuffix = suffix
+	return e
+}
+
+func (e *extraSettings) WithAuditSink(sink AuditSink) BuildableExtraSettings {
+	e.auditSink = sink
+	return e
+}
+
+func (e *extraSettings) WithVMNameValidator(validator VMNameValidator) BuildableExtraSettings {
+	e.vmNameValidator = validator
+	return e
+}
@@ -0,0 +1,49 @@
+package ovirtclient_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// TestExtraSettingsBuilder tests that the settings of the builder returned from NewExtraSettings are applied by the
+// client.
+func TestExtraSettingsBuilder(t *testing.T) {
+	t.Parallel()
+	engine := httptest.NewServer(newDryRunEngine())
+	defer engine.Close()
+
+	client, err := ovirtclient.NewWithVerify(
+		engine.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		ovirtclient.NewExtraSettings().WithReadOnly(),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.RemoveTag("tag"); !ovirtclient.HasErrorCode(err, ovirtclient.EReadOnly) {
+		t.Fatalf("the read-only setting of the builder was not applied (%v)", err)
+	}
+}
+
+func TestExtraSettingsBuilderValidation(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.NewExtraSettings().WithRateLimit(-1, 0)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("a negative rate limit was not rejected (%v)", err)
+	}
+	_, err = ovirtclient.NewExtraSettings().WithFailoverURLs("engine.example.com")
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("a failover URL without a scheme was not rejected (%v)", err)
+	}
+	settings := ovirtclient.NewExtraSettings().MustWithFailoverURLs("https://standby.example.com/ovirt-engine/api")
+	if urls := settings.FailoverURLs(); len(urls) != 1 || urls[0] != "https://standby.example.com/ovirt-engine/api" {
+		t.Fatalf("incorrect failover URLs (%v)", urls)
+	}
+}
@@ -4,7 +4,7 @@ go 1.14
 
 require (
 	github.com/google/uuid v1.3.0
-	github.com/ovirt/go-ovirt v0.0.0-20210809163552-d4276e35d3db
+	github.com/ovirt/go-ovirt v0.0.0-20210809163552-d4276e35d3db // Pinned: sdk_connection.go sets unexported fields of this version.
 	github.com/ovirt/go-ovirt-client-log/v2 v2.2.0
 	github.com/stretchr/testify v1.7.0 // indirect
 )
@@ -0,0 +1,71 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// userAgentHeader is the name of the User-Agent HTTP header.
+const userAgentHeader = "User-Agent"
+
+// requestHeaders returns the headers to send with each request based on the extra settings. This includes the
+// extra headers and, if ExtraSettingsV11 is implemented with a non-empty suffix, the User-Agent header. It returns
+// nil if no headers need to be sent.
+func requestHeaders(extraSettings ExtraSettings) map[string]string {
+	if extraSettings == nil {
+		return nil
+	}
+	headers := map[string]string{}
+	for name, value := range extraSettings.ExtraHeaders() {
+		headers[name] = value
+	}
+	if extraSettingsV11, ok := extraSettings.(ExtraSettingsV11); ok {
+		if suffix := extraSettingsV11.UserAgentSuffix(); suffix != "" {
+			for name := range headers {
+				if strings.EqualFold(name, userAgentHeader) {
+					delete(headers, name)
+				}
+			}
+			headers[userAgentHeader] = fmt.Sprintf("GoSDK/%s %s", ovirtsdk4.SDK_VERSION, suffix)
+		}
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+// newHeaderTransport returns a transport that adds the headers to each request sent through the base transport,
+// unless the request already has the header. If there are no headers, the base transport is returned as is.
+//
+// The oVirt SDK adds the configured headers before its own User-Agent header, and Go only sends the first User-Agent,
+// so the headers don't need special treatment for the API calls. This transport applies them to the HTTP client
+// used for image transfers and returned from GetHTTPClient.
+func newHeaderTransport(base http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if len(headers) == 0 {
+		return base
+	}
+	return &headerTransport{
+		base:    base,
+		headers: headers,
+	}
+}
+
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (h *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The round tripper must not modify the original request.
+	req = req.Clone(req.Context())
+	for name, value := range h.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+	return h.base.RoundTrip(req)
+}
@@ -0,0 +1,82 @@
+package ovirtclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestUserAgentAndExtraHeaders(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	var apiHeaders http.Header
+	var transferHeaders http.Header
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		switch r.URL.Path {
+		case "/ovirt-engine/api":
+			apiHeaders = r.Header.Clone()
+		case "/transfer":
+			transferHeaders = r.Header.Clone()
+		}
+		lock.Unlock()
+		serveStandbyEngine(w, r)
+	}))
+	defer engine.Close()
+
+	client, err := ovirtclient.NewWithVerify(
+		engine.URL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&userAgentExtraSettings{
+			headers:         map[string]string{"X-Gateway-Auth": "secret"},
+			userAgentSuffix: "myproduct/1.2.3",
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("failed to call the fake engine (%v)", err)
+	}
+	httpClient := client.GetHTTPClient()
+	response, err := httpClient.Get(engine.URL + "/transfer")
+	if err != nil {
+		t.Fatalf("failed to send request using the HTTP client (%v)", err)
+	}
+	_ = response.Body.Close()
+
+	lock.Lock()
+	defer lock.Unlock()
+	for name, headers := range map[string]http.Header{"API call": apiHeaders, "HTTP client": transferHeaders} {
+		if userAgent := headers.Get("User-Agent"); !strings.HasSuffix(userAgent, " myproduct/1.2.3") {
+			t.Fatalf("incorrect User-Agent sent with the %s (%s)", name, userAgent)
+		}
+		if value := headers.Get("X-Gateway-Auth"); value != "secret" {
+			t.Fatalf("the extra header was not sent with the %s (%s)", name, value)
+		}
+	}
+}
+
+type userAgentExtraSettings struct {
+	failoverExtraSettings
+
+	headers         map[string]string
+	userAgentSuffix string
+}
+
+func (u *userAgentExtraSettings) ExtraHeaders() map[string]string {
+	return u.headers
+}
+
+func (u *userAgentExtraSettings) UserAgentSuffix() string {
+	return u.userAgentSuffix
+}
@@ -0,0 +1,235 @@
+package ovirtclient
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// HTTPTransportParameters contains the tuning options for the HTTP connections of the client. They can be passed to
+// the client using ExtraSettingsV6. Zero values leave the Go defaults in place.
+//
+// The settings apply to the API calls as well as to the HTTP client used for image transfers, which is also returned
+// from GetHTTPClient. Unlike the oVirt SDK on its own, the client keeps connections open between API calls, so the
+// connection pool settings limit the number of connections to the engine.
+type HTTPTransportParameters interface {
+	// RequestTimeout returns the overall timeout of a single API call, including reading the response.
+	RequestTimeout() time.Duration
+	// MaxIdleConnections returns the maximum number of idle connections kept open across all hosts.
+	MaxIdleConnections() uint
+	// MaxIdleConnectionsPerHost returns the maximum number of idle connections kept open to a single host.
+	MaxIdleConnectionsPerHost() uint
+	// MaxConnectionsPerHost returns the maximum number of connections to a single host, including those in use.
+	MaxConnectionsPerHost() uint
+	// IdleConnectionTimeout returns the time after which idle connections are closed.
+	IdleConnectionTimeout() time.Duration
+	// TCPKeepAlive returns the interval of the TCP keepalive probes.
+	TCPKeepAlive() time.Duration
+}
+
+// BuildableHTTPTransportParameters is a buildable version of HTTPTransportParameters.
+type BuildableHTTPTransportParameters interface {
+	HTTPTransportParameters
+
+	// WithRequestTimeout sets the overall timeout of a single API call.
+	WithRequestTimeout(timeout time.Duration) (BuildableHTTPTransportParameters, error)
+	// MustWithRequestTimeout is identical to WithRequestTimeout, but panics instead of returning an error.
+	MustWithRequestTimeout(timeout time.Duration) BuildableHTTPTransportParameters
+
+	// WithMaxIdleConnections sets the maximum number of idle connections kept open across all hosts.
+	WithMaxIdleConnections(max uint) (BuildableHTTPTransportParameters, error)
+	// MustWithMaxIdleConnections is identical to WithMaxIdleConnections, but panics instead of returning an error.
+	MustWithMaxIdleConnections(max uint) BuildableHTTPTransportParameters
+
+	// WithMaxIdleConnectionsPerHost sets the maximum number of idle connections kept open to a single host.
+	WithMaxIdleConnectionsPerHost(max uint) (BuildableHTTPTransportParameters, error)
+	// MustWithMaxIdleConnectionsPerHost is identical to WithMaxIdleConnectionsPerHost, but panics instead of returning
+	// an error.
+	MustWithMaxIdleConnectionsPerHost(max uint) BuildableHTTPTransportParameters
+
+	// WithMaxConnectionsPerHost sets the maximum number of connections to a single host.
+	WithMaxConnectionsPerHost(max uint) (BuildableHTTPTransportParameters, error)
+	// MustWithMaxConnectionsPerHost is identical to WithMaxConnectionsPerHost, but panics instead of returning an
+	// error.
+	MustWithMaxConnectionsPerHost(max uint) BuildableHTTPTransportParameters
+
+	// WithIdleConnectionTimeout sets the time after which idle connections are closed.
+	WithIdleConnectionTimeout(timeout time.Duration) (BuildableHTTPTransportParameters, error)
+	// MustWithIdleConnectionTimeout is identical to WithIdleConnectionTimeout, but panics instead of returning an
+	// error.
+	MustWithIdleConnectionTimeout(timeout time.Duration) BuildableHTTPTransportParameters
+
+	// WithTCPKeepAlive sets the interval of the TCP keepalive probes.
+	WithTCPKeepAlive(interval time.Duration) (BuildableHTTPTransportParameters, error)
+	// MustWithTCPKeepAlive is identical to WithTCPKeepAlive, but panics instead of returning an error.
+	MustWithTCPKeepAlive(interval time.Duration) BuildableHTTPTransportParameters
+}
+
+// HTTPTransportParams creates a buildable set of HTTP transport tuning options.
+func HTTPTransportParams() BuildableHTTPTransportParameters {
+	return &httpTransportParams{}
+}
+
+type httpTransportParams struct {
+	requestTimeout            time.Duration
+	maxIdleConnections        uint
+	maxIdleConnectionsPerHost uint
+	maxConnectionsPerHost     uint
+	idleConnectionTimeout     time.Duration
+	tcpKeepAlive              time.Duration
+}
+
+func (h *httpTransportParams) RequestTimeout() time.Duration {
+	return h.requestTimeout
+}
+
+func (h *httpTransportParams) MaxIdleConnections() uint {
+	return h.maxIdleConnections
+}
+
+func (h *httpTransportParams) MaxIdleConnectionsPerHost() uint {
+	return h.maxIdleConnectionsPerHost
+}
+
+func (h *httpTransportParams) MaxConnectionsPerHost() uint {
+	return h.maxConnectionsPerHost
+}
+
+func (h *httpTransportParams) IdleConnectionTimeout() time.Duration {
+	return h.idleConnectionTimeout
+}
+
+func (h *httpTransportParams) TCPKeepAlive() time.Duration {
+	return h.tcpKeepAlive
+}
+
+func (h *httpTransportParams) WithRequestTimeout(timeout time.Duration) (BuildableHTTPTransportParameters, error) {
+	if timeout < 0 {
+		return nil, newError(EBadArgument, "the request timeout must not be negative")
+	}
+	h.requestTimeout = timeout
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithRequestTimeout(timeout time.Duration) BuildableHTTPTransportParameters {
+	builder, err := h.WithRequestTimeout(timeout)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *httpTransportParams) WithMaxIdleConnections(max uint) (BuildableHTTPTransportParameters, error) {
+	h.maxIdleConnections = max
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithMaxIdleConnections(max uint) BuildableHTTPTransportParameters {
+	builder, err := h.WithMaxIdleConnections(max)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *httpTransportParams) WithMaxIdleConnectionsPerHost(max uint) (BuildableHTTPTransportParameters, error) {
+	h.maxIdleConnectionsPerHost = max
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithMaxIdleConnectionsPerHost(max uint) BuildableHTTPTransportParameters {
+	builder, err := h.WithMaxIdleConnectionsPerHost(max)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *httpTransportParams) WithMaxConnectionsPerHost(max uint) (BuildableHTTPTransportParameters, error) {
+	h.maxConnectionsPerHost = max
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithMaxConnectionsPerHost(max uint) BuildableHTTPTransportParameters {
+	builder, err := h.WithMaxConnectionsPerHost(max)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *httpTransportParams) WithIdleConnectionTimeout(
+	timeout time.Duration,
+) (BuildableHTTPTransportParameters, error) {
+	if timeout < 0 {
+		return nil, newError(EBadArgument, "the idle connection timeout must not be negative")
+	}
+	h.idleConnectionTimeout = timeout
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithIdleConnectionTimeout(timeout time.Duration) BuildableHTTPTransportParameters {
+	builder, err := h.WithIdleConnectionTimeout(timeout)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (h *httpTransportParams) WithTCPKeepAlive(interval time.Duration) (BuildableHTTPTransportParameters, error) {
+	if interval < 0 {
+		return nil, newError(EBadArgument, "the TCP keepalive interval must not be negative")
+	}
+	h.tcpKeepAlive = interval
+	return h, nil
+}
+
+func (h *httpTransportParams) MustWithTCPKeepAlive(interval time.Duration) BuildableHTTPTransportParameters {
+	builder, err := h.WithTCPKeepAlive(interval)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// newHTTPTransport creates the transport for the HTTP client of the oVirt client using the tuning options in params
+// and the proxy settings in proxy. Both params and proxy may be nil.
+func newHTTPTransport(tlsConfig *tls.Config, params HTTPTransportParameters, proxy ProxyParameters) *http.Transport {
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           newProxyFunc(proxy),
+	}
+	if params == nil {
+		return transport
+	}
+	transport.MaxIdleConns = int(params.MaxIdleConnections())
+	transport.MaxIdleConnsPerHost = int(params.MaxIdleConnectionsPerHost())
+	transport.MaxConnsPerHost = int(params.MaxConnectionsPerHost())
+	transport.IdleConnTimeout = params.IdleConnectionTimeout()
+	if keepAlive := params.TCPKeepAlive(); keepAlive != 0 {
+		transport.DialContext = (&net.Dialer{
+			KeepAlive: keepAlive,
+		}).DialContext
+	}
+	return transport
+}
+
+// newAPIHTTPClient creates the HTTP client the SDK connection sends the API calls with. It is created once per client
+// and shared by all connections the client builds, so connections to the engine stay open across reconnects.
+func newAPIHTTPClient(
+	tlsConfig *tls.Config,
+	params HTTPTransportParameters,
+	proxy ProxyParameters,
+	compress bool,
+) *http.Client {
+	transport := newHTTPTransport(tlsConfig, params, proxy)
+	transport.DisableCompression = !compress
+	client := &http.Client{
+		Transport: transport,
+	}
+	if params != nil {
+		client.Timeout = params.RequestTimeout()
+	}
+	return client
+}
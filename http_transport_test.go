@@ -0,0 +1,141 @@
+package ovirtclient_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestHTTPTransportParams(t *testing.T) {
+	t.Parallel()
+	params := ovirtclient.HTTPTransportParams().
+		MustWithRequestTimeout(time.Minute).
+		MustWithMaxIdleConnections(10).
+		MustWithMaxIdleConnectionsPerHost(5).
+		MustWithMaxConnectionsPerHost(20).
+		MustWithIdleConnectionTimeout(30 * time.Second).
+		MustWithTCPKeepAlive(15 * time.Second)
+	if params.RequestTimeout() != time.Minute {
+		t.Fatalf("incorrect request timeout: %s", params.RequestTimeout())
+	}
+	if params.MaxIdleConnections() != 10 {
+		t.Fatalf("incorrect maximum idle connections: %d", params.MaxIdleConnections())
+	}
+	if params.MaxIdleConnectionsPerHost() != 5 {
+		t.Fatalf("incorrect maximum idle connections per host: %d", params.MaxIdleConnectionsPerHost())
+	}
+	if params.MaxConnectionsPerHost() != 20 {
+		t.Fatalf("incorrect maximum connections per host: %d", params.MaxConnectionsPerHost())
+	}
+	if params.IdleConnectionTimeout() != 30*time.Second {
+		t.Fatalf("incorrect idle connection timeout: %s", params.IdleConnectionTimeout())
+	}
+	if params.TCPKeepAlive() != 15*time.Second {
+		t.Fatalf("incorrect TCP keepalive: %s", params.TCPKeepAlive())
+	}
+}
+
+func TestHTTPTransportParamsNegativeTimeout(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.HTTPTransportParams().WithRequestTimeout(-time.Second)
+	if err == nil {
+		t.Fatalf("setting a negative request timeout did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("setting a negative request timeout returned an unexpected error (%v)", err)
+	}
+}
+
+// TestAPICallsReuseConnections tests that the API calls keep the connection to the engine open instead of opening a
+// new connection for each call.
+func TestAPICallsReuseConnections(t *testing.T) {
+	t.Parallel()
+	lock := &sync.Mutex{}
+	connections := 0
+	engine := httptest.NewUnstartedServer(http.HandlerFunc(serveStandbyEngine))
+	engine.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			lock.Lock()
+			connections++
+			lock.Unlock()
+		}
+	}
+	engine.Start()
+	defer engine.Close()
+
+	client := newTransportTestClient(t, engine.URL, ovirtclient.HTTPTransportParams().MustWithMaxIdleConnections(1))
+	for i := 0; i < 5; i++ {
+		if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+			t.Fatalf("failed to call the fake engine (%v)", err)
+		}
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if connections != 1 {
+		t.Fatalf("incorrect number of connections to the engine (expected: 1, got: %d)", connections)
+	}
+}
+
+// TestAPICallsUseRequestTimeout tests that an API call is aborted once the request timeout has passed.
+func TestAPICallsUseRequestTimeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ovirt-engine/api" {
+			<-release
+		}
+		serveStandbyEngine(w, r)
+	}))
+	defer engine.Close()
+	defer close(release)
+
+	client := newTransportTestClient(
+		t,
+		engine.URL,
+		ovirtclient.HTTPTransportParams().MustWithRequestTimeout(100*time.Millisecond),
+	)
+	start := time.Now()
+	if err := client.Test(ovirtclient.MaxTries(1)); err == nil {
+		t.Fatalf("the call to the blocked engine did not fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("the call did not time out after the request timeout (took %s)", elapsed)
+	}
+}
+
+func newTransportTestClient(
+	t *testing.T,
+	engineURL string,
+	transport ovirtclient.HTTPTransportParameters,
+) ovirtclient.Client {
+	client, err := ovirtclient.NewWithVerify(
+		engineURL+"/ovirt-engine/api",
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		ovirtclientlog.NewTestLogger(t),
+		&transportExtraSettings{transport: transport},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	return client
+}
+
+type transportExtraSettings struct {
+	failoverExtraSettings
+
+	transport ovirtclient.HTTPTransportParameters
+}
+
+func (e *transportExtraSettings) HTTPTransport() ovirtclient.HTTPTransportParameters {
+	return e.transport
+}
@@ -0,0 +1,17 @@
+module github.com/ovirt/go-ovirt-client/klog
+
+go 1.20
+
+require (
+	github.com/ovirt/go-ovirt-client v0.0.0
+	k8s.io/klog/v2 v2.120.1
+)
+
+require (
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/ovirt/go-ovirt v0.0.0-20210809163552-d4276e35d3db // indirect
+	github.com/ovirt/go-ovirt-client-log/v2 v2.2.0 // indirect
+)
+
+replace github.com/ovirt/go-ovirt-client => ../
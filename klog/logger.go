@@ -0,0 +1,64 @@
+// Package ovirtclientklog provides a logger for go-ovirt-client writing to klog.
+package ovirtclientklog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	"k8s.io/klog/v2"
+)
+
+// New creates an ovirtclient.FieldLogger writing to klog. Debug messages are logged at the passed verbosity, so they
+// only appear if klog runs with -v set to at least this value. The fields the client attaches to its messages, such
+// as the operation being performed, are appended to the messages as key="value" pairs sorted by key.
+func New(debugVerbosity klog.Level) ovirtclient.FieldLogger {
+	return &klogLogger{
+		debugVerbosity: debugVerbosity,
+	}
+}
+
+type klogLogger struct {
+	debugVerbosity klog.Level
+	fields         string
+}
+
+func (k *klogLogger) Debugf(format string, args ...interface{}) {
+	if v := klog.V(k.debugVerbosity); v.Enabled() {
+		klog.InfoDepth(1, k.message(format, args))
+	}
+}
+
+func (k *klogLogger) Infof(format string, args ...interface{}) {
+	klog.InfoDepth(1, k.message(format, args))
+}
+
+func (k *klogLogger) Warningf(format string, args ...interface{}) {
+	klog.WarningDepth(1, k.message(format, args))
+}
+
+func (k *klogLogger) Errorf(format string, args ...interface{}) {
+	klog.ErrorDepth(1, k.message(format, args))
+}
+
+func (k *klogLogger) WithFields(fields map[string]interface{}) ovirtclient.Logger {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	formattedFields := strings.Builder{}
+	formattedFields.WriteString(k.fields)
+	for _, key := range keys {
+		formattedFields.WriteString(fmt.Sprintf(" %s=%q", key, fmt.Sprint(fields[key])))
+	}
+	return &klogLogger{
+		debugVerbosity: k.debugVerbosity,
+		fields:         formattedFields.String(),
+	}
+}
+
+func (k *klogLogger) message(format string, args []interface{}) string {
+	return fmt.Sprintf(format, args...) + k.fields
+}
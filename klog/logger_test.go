@@ -0,0 +1,49 @@
+package ovirtclientklog_test
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+
+	ovirtclientklog "github.com/ovirt/go-ovirt-client/klog"
+	"k8s.io/klog/v2"
+)
+
+func TestLogger(t *testing.T) {
+	flags := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	for name, value := range map[string]string{"logtostderr": "false", "skip_headers": "true", "v": "4"} {
+		if err := flags.Set(name, value); err != nil {
+			t.Fatalf("failed to set klog flag %s (%v)", name, err)
+		}
+	}
+	output := &bytes.Buffer{}
+	klog.SetOutput(output)
+	t.Cleanup(func() {
+		klog.SetOutput(nil)
+	})
+
+	ovirtclientklog.New(5).Debugf("hidden %d", 0)
+	logger := ovirtclientklog.New(4)
+	logger.Debugf("debug %d", 1)
+	logger.Infof("info %d", 2)
+	logger.Warningf("warning %d", 3)
+	logger.WithFields(map[string]interface{}{"operation": "GetVM", "action": "getting VM 1234"}).Errorf("error %d", 4)
+	klog.Flush()
+
+	logged := output.String()
+	if strings.Contains(logged, "hidden") {
+		t.Fatalf("debug message above the configured verbosity was logged:\n%s", logged)
+	}
+	for _, expected := range []string{
+		"debug 1\n",
+		"info 2\n",
+		"warning 3\n",
+		"error 4 action=\"getting VM 1234\" operation=\"GetVM\"\n",
+	} {
+		if !strings.Contains(logged, expected) {
+			t.Fatalf("expected message %q not found in the log output:\n%s", expected, logged)
+		}
+	}
+}
@@ -8,3 +8,13 @@ import (
 type Logger interface {
 	ovirtclientlog.Logger
 }
+
+// FieldLogger is a Logger that supports structured fields. If the logger passed to the client implements this
+// interface, the client attaches fields, such as the operation being performed, to the messages it logs. Adapters
+// for zap, logrus and klog are provided in the zap, logrus and klog modules of this repository.
+type FieldLogger interface {
+	Logger
+
+	// WithFields returns a logger that adds the specified fields to each message it logs.
+	WithFields(fields map[string]interface{}) Logger
+}
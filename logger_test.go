@@ -0,0 +1,100 @@
+// This file contains tests for the internal logging functionality. It is therefore excluded from the testpackage
+// check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type testFieldLogger struct {
+	lock     *sync.Mutex
+	fields   map[string]interface{}
+	messages *[]string
+}
+
+func (t *testFieldLogger) log(format string, args ...interface{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	*t.messages = append(*t.messages, fmt.Sprintf("%s %v", fmt.Sprintf(format, args...), t.fields))
+}
+
+func (t *testFieldLogger) Debugf(format string, args ...interface{}) {
+	t.log(format, args...)
+}
+
+func (t *testFieldLogger) Infof(format string, args ...interface{}) {
+	t.log(format, args...)
+}
+
+func (t *testFieldLogger) Warningf(format string, args ...interface{}) {
+	t.log(format, args...)
+}
+
+func (t *testFieldLogger) Errorf(format string, args ...interface{}) {
+	t.log(format, args...)
+}
+
+func (t *testFieldLogger) WithFields(fields map[string]interface{}) Logger {
+	newFields := make(map[string]interface{}, len(t.fields)+len(fields))
+	for k, v := range t.fields {
+		newFields[k] = v
+	}
+	for k, v := range fields {
+		newFields[k] = v
+	}
+	return &testFieldLogger{
+		lock:     t.lock,
+		fields:   newFields,
+		messages: t.messages,
+	}
+}
+
+func TestFieldLoggerReceivesRetryFields(t *testing.T) {
+	t.Parallel()
+	var messages []string
+	logger := &testFieldLogger{
+		lock:     &sync.Mutex{},
+		messages: &messages,
+	}
+	o := &oVirtClient{
+		logger: logger,
+	}
+	tries := 0
+	err := o.retry(
+		resourceOf(ResourceTypeVM, ""),
+		"testing logging",
+		[]RetryStrategy{ExponentialBackoff(1), MaxTries(3)},
+		func() error {
+			tries++
+			if tries < 2 {
+				return newError(EConnection, "test failure")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("retry failed (%v)", err)
+	}
+	expected := []string{
+		"Testing logging... map[action:testing logging operation:TestFieldLoggerReceivesRetryFields]",
+		"Failed testing logging on attempt 1, retrying... (connection: test failure) " +
+			"map[action:testing logging operation:TestFieldLoggerReceivesRetryFields]",
+		"Waiting before retrying testing logging... " +
+			"map[action:testing logging operation:TestFieldLoggerReceivesRetryFields]",
+		"Retrying testing logging (attempt 2)... " +
+			"map[action:testing logging operation:TestFieldLoggerReceivesRetryFields]",
+		"Completed testing logging after 2 attempt(s). " +
+			"map[action:testing logging operation:TestFieldLoggerReceivesRetryFields]",
+	}
+	if len(messages) != len(expected) {
+		t.Fatalf("incorrect number of log messages (expected: %d, got: %d)\n%v", len(expected), len(messages), messages)
+	}
+	for i, message := range messages {
+		if message != expected[i] {
+			t.Fatalf("incorrect log message #%d (expected: %s, got: %s)", i, expected[i], message)
+		}
+	}
+}
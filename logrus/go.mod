@@ -0,0 +1,17 @@
+module github.com/ovirt/go-ovirt-client/logrus
+
+go 1.20
+
+require (
+	github.com/ovirt/go-ovirt-client v0.0.0
+	github.com/sirupsen/logrus v1.9.3
+)
+
+require (
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/ovirt/go-ovirt v0.0.0-20210809163552-d4276e35d3db // indirect
+	github.com/ovirt/go-ovirt-client-log/v2 v2.2.0 // indirect
+	golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
+)
+
+replace github.com/ovirt/go-ovirt-client => ../
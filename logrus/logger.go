@@ -0,0 +1,41 @@
+// Package ovirtclientlogrus provides a logger for go-ovirt-client writing to logrus.
+package ovirtclientlogrus
+
+import (
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	"github.com/sirupsen/logrus"
+)
+
+// New creates an ovirtclient.FieldLogger writing to the passed logrus logger or entry. The fields the client attaches
+// to its messages, such as the operation being performed, are added to the logrus entries.
+func New(logger logrus.FieldLogger) ovirtclient.FieldLogger {
+	return &logrusLogger{
+		logger: logger,
+	}
+}
+
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+func (l *logrusLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debugf(format, args...)
+}
+
+func (l *logrusLogger) Infof(format string, args ...interface{}) {
+	l.logger.Infof(format, args...)
+}
+
+func (l *logrusLogger) Warningf(format string, args ...interface{}) {
+	l.logger.Warningf(format, args...)
+}
+
+func (l *logrusLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Errorf(format, args...)
+}
+
+func (l *logrusLogger) WithFields(fields map[string]interface{}) ovirtclient.Logger {
+	return &logrusLogger{
+		logger: l.logger.WithFields(fields),
+	}
+}
@@ -0,0 +1,49 @@
+package ovirtclientlogrus_test
+
+import (
+	"testing"
+
+	ovirtclientlogrus "github.com/ovirt/go-ovirt-client/logrus"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	base, hook := test.NewNullLogger()
+	base.SetLevel(logrus.DebugLevel)
+	logger := ovirtclientlogrus.New(base)
+
+	logger.Debugf("debug %d", 1)
+	logger.Infof("info %d", 2)
+	logger.Warningf("warning %d", 3)
+	logger.WithFields(map[string]interface{}{"operation": "GetVM"}).Errorf("error %d", 4)
+
+	entries := hook.AllEntries()
+	expected := []struct {
+		level   logrus.Level
+		message string
+	}{
+		{logrus.DebugLevel, "debug 1"},
+		{logrus.InfoLevel, "info 2"},
+		{logrus.WarnLevel, "warning 3"},
+		{logrus.ErrorLevel, "error 4"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("incorrect number of log entries (expected: %d, got: %d)", len(expected), len(entries))
+	}
+	for i, e := range expected {
+		if entries[i].Level != e.level || entries[i].Message != e.message {
+			t.Fatalf(
+				"incorrect log entry %d (expected: %s %q, got: %s %q)",
+				i, e.level, e.message, entries[i].Level, entries[i].Message,
+			)
+		}
+	}
+	if operation := entries[3].Data["operation"]; operation != "GetVM" {
+		t.Fatalf("incorrect operation field (expected: GetVM, got: %v)", operation)
+	}
+	if len(entries[0].Data) != 0 {
+		t.Fatalf("fields leaked to the parent logger (%v)", entries[0].Data)
+	}
+}
@@ -1,6 +1,7 @@
 package ovirtclient
 
 import (
+	"io"
 	"math/rand"
 	"sync"
 
@@ -14,6 +15,13 @@ type MockClient interface {
 
 	// GenerateUUID generates a UUID for testing purposes.
 	GenerateUUID() string
+
+	// Save writes the resources of the mock client, such as VMs, disks and templates, to the writer as JSON. Events,
+	// jobs, VM backups and VM checkpoints are not saved.
+	Save(w io.Writer) error
+	// Load replaces the resources of the mock client with the ones previously written by Save. Events and jobs are
+	// kept.
+	Load(r io.Reader) error
 }
 
 type mockClient struct {
@@ -21,21 +29,59 @@ type mockClient struct {
 	url                               string
 	lock                              *sync.Mutex
 	nonSecureRandom                   *rand.Rand
-	vms                               map[string]*vm
+	stats                             *statsCollector
+	vms                               map[VMID]*vm
 	storageDomains                    map[string]*storageDomain
-	disks                             map[string]*diskWithData
-	clusters                          map[string]*cluster
+	storageDomainVMs                  map[string]map[VMID]*storageDomainVMWithData
+	storageDomainTemplates            map[string]map[TemplateID]*storageDomainTemplateWithData
+	disks                             map[DiskID]*diskWithData
+	clusters                          map[ClusterID]*cluster
 	hosts                             map[string]*host
+	hostNICs                          map[string]*hostNIC
+	hostNICLabels                     map[string][]string
+	hostFenceAgents                   map[string]*fenceAgent
+	hostNUMANodes                     map[string][]*hostNUMANode
+	hostErrata                        map[string][]*erratum
+	hostHugePages                     map[string][]*hostHugePages
+	hostDevices                       map[string][]*hostDevice
 	templates                         map[TemplateID]*template
-	nics                              map[string]*nic
+	nics                              map[NICID]*nic
+	nicReportedDevices                map[NICID]*reportedDevice
 	vnicProfiles                      map[string]*vnicProfile
 	networks                          map[string]*network
+	networkLabels                     map[string][]string
+	networkProviders                  map[string]*networkProvider
+	hostProviders                     map[string]*hostProvider
+	instanceTypes                     map[InstanceTypeID]*instanceType
+	operatingSystems                  map[string]*operatingSystem
+	imageProviders                    map[string]*imageProvider
+	glanceImages                      map[string]*glanceImageWithData
+	externalNetworks                  map[string]*externalNetwork
 	dataCenters                       map[string]*datacenterWithClusters
-	vmDiskAttachmentsByVM             map[string]map[string]*diskAttachment
-	vmDiskAttachmentsByDisk           map[string]*diskAttachment
+	vmDiskAttachmentsByVM             map[VMID]map[string]*diskAttachment
+	vmDiskAttachmentsByDisk           map[DiskID]*diskAttachment
+	vmNUMANodes                       map[VMID][]VMNUMANode
+	vmBackups                         map[VMID][]*vmBackup
+	vmCheckpoints                     map[VMID][]*vmCheckpoint
 	templateDiskAttachmentsByTemplate map[TemplateID][]*templateDiskAttachment
-	templateDiskAttachmentsByDisk     map[string]*templateDiskAttachment
-	tags                              map[string]*tag
+	templateDiskAttachmentsByDisk     map[DiskID]*templateDiskAttachment
+	tags                              map[TagID]*tag
+	quotas                            map[QuotaID]*quota
+	quotaClusterLimits                map[QuotaID][]*quotaClusterLimit
+	quotaStorageLimits                map[QuotaID][]*quotaStorageLimit
+	permissions                       map[string]*permission
+	users                             map[string]*user
+	groups                            map[string]*group
+	directoryDomains                  map[string]*directoryDomain
+	directoryUsers                    map[string][]*user
+	directoryGroups                   map[string][]*group
+	roles                             map[string]*role
+	events                            []*event
+	jobs                              map[string]*job
+	jobSteps                          map[string][]*jobStep
+	engineCACertificate               []byte
+	// vmNameValidator checks the names of created and renamed VMs. If nil, DefaultVMNameValidator is used.
+	vmNameValidator VMNameValidator
 }
 
 func (m *mockClient) GetURL() string {
@@ -0,0 +1,13 @@
+package ovirtclient
+
+func (m *mockClient) CleanupVMs(params CleanupParameters, retries ...RetryStrategy) ([]VMID, error) {
+	return cleanupVMs(m, params, retries)
+}
+
+func (m *mockClient) CleanupDisks(params CleanupParameters, retries ...RetryStrategy) ([]DiskID, error) {
+	return cleanupDisks(m, params, retries)
+}
+
+func (m *mockClient) CleanupTemplates(params CleanupParameters, retries ...RetryStrategy) ([]TemplateID, error) {
+	return cleanupTemplates(m, params, retries)
+}
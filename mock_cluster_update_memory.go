@@ -0,0 +1,30 @@
+package ovirtclient
+
+func (m *mockClient) UpdateClusterMemoryPolicy(
+	id ClusterID,
+	params UpdateClusterMemoryPolicyParameters,
+	_ ...RetryStrategy,
+) (Cluster, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.clusters[id]
+	if !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	updatedCluster := *item
+	if percent := params.OverCommitPercent(); percent != nil {
+		updatedCluster.memoryOverCommitPercent = *percent
+	}
+	if ballooning := params.Ballooning(); ballooning != nil {
+		updatedCluster.ballooningEnabled = *ballooning
+	}
+	if ksm := params.KSM(); ksm != nil {
+		updatedCluster.ksmEnabled = *ksm
+	}
+	if merge := params.KSMMergeAcrossNodes(); merge != nil {
+		updatedCluster.ksmMergeAcrossNodes = *merge
+	}
+	m.clusters[id] = &updatedCluster
+	return &updatedCluster, nil
+}
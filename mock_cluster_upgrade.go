@@ -0,0 +1,42 @@
+package ovirtclient
+
+func (m *mockClient) UpgradeClusterCompatibility(
+	id ClusterID,
+	major uint,
+	minor uint,
+	retries ...RetryStrategy,
+) (ClusterUpgradeResult, error) {
+	upgradedCluster, previousVersion, err := m.upgradeClusterVersion(id, major, minor)
+	if err != nil {
+		return nil, err
+	}
+	return newClusterUpgradeResult(m, m.logger, upgradedCluster, previousVersion, retries)
+}
+
+// upgradeClusterVersion sets the compatibility version of the cluster and, like the engine, marks the VMs in the
+// cluster that are not down as having a pending next run configuration.
+func (m *mockClient) upgradeClusterVersion(id ClusterID, major uint, minor uint) (Cluster, ClusterVersion, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.clusters[id]
+	if !ok {
+		return nil, nil, newError(ENotFound, "cluster with ID %s not found", id)
+	}
+	if err := validateClusterUpgrade(item, major, minor); err != nil {
+		return nil, nil, err
+	}
+	previousVersion := item.compatibilityVersion
+	if previousVersion.major == major && previousVersion.minor == minor {
+		return item, previousVersion, nil
+	}
+	upgradedCluster := *item
+	upgradedCluster.compatibilityVersion = &clusterVersion{major: major, minor: minor}
+	m.clusters[id] = &upgradedCluster
+	for _, vm := range m.vms {
+		if vm.clusterID == id && vm.status != VMStatusDown {
+			vm.nextRun = true
+		}
+	}
+	return &upgradedCluster, previousVersion, nil
+}
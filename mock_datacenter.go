@@ -3,5 +3,5 @@ package ovirtclient
 type datacenterWithClusters struct {
 	datacenter
 
-	clusters []string
+	clusters []ClusterID
 }
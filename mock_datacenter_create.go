@@ -0,0 +1,44 @@
+package ovirtclient
+
+func (m *mockClient) CreateDatacenter(
+	name string,
+	params OptionalDatacenterParameters,
+	_ ...RetryStrategy,
+) (Datacenter, error) {
+	if err := validateDatacenterCreationParameters(name, params); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, dc := range m.dataCenters {
+		if dc.name == name {
+			return nil, newError(EConflict, "datacenter name is already in use")
+		}
+	}
+
+	dc := &datacenterWithClusters{
+		datacenter: datacenter{
+			client:        m,
+			id:            m.GenerateUUID(),
+			name:          name,
+			status:        DatacenterStatusUninitialized,
+			storageFormat: StorageFormatV5,
+		},
+		clusters: []ClusterID{},
+	}
+	if params != nil {
+		if description := params.Description(); description != nil {
+			dc.description = *description
+		}
+		if local := params.Local(); local != nil {
+			dc.local = *local
+		}
+		if storageFormat := params.StorageFormat(); storageFormat != nil {
+			dc.storageFormat = *storageFormat
+		}
+	}
+	m.dataCenters[dc.id] = dc
+	return dc, nil
+}
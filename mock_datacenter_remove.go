@@ -0,0 +1,18 @@
+package ovirtclient
+
+func (m *mockClient) RemoveDatacenter(id string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	dc, ok := m.dataCenters[id]
+	if !ok {
+		return newError(ENotFound, "datacenter with ID %s not found", id)
+	}
+	if dc.status == DatacenterStatusUp {
+		return newError(EConflict, "datacenter %s still has active storage domains", id)
+	}
+
+	delete(m.dataCenters, id)
+
+	return nil
+}
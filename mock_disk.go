@@ -42,6 +42,8 @@ func (d *diskWithData) WithAlias(alias *string) *diskWithData {
 			status:           d.status,
 			totalSize:        d.totalSize,
 			sparse:           d.sparse,
+			quotaID:          d.quotaID,
+			diskProfileID:    d.diskProfileID,
 		},
 		d.lock,
 		d.data,
@@ -66,6 +68,8 @@ func (d *diskWithData) withProvisionedSize(ps uint64) (*diskWithData, error) {
 			status:           d.status,
 			totalSize:        ps,
 			sparse:           d.sparse,
+			quotaID:          d.quotaID,
+			diskProfileID:    d.diskProfileID,
 		},
 		d.lock,
 		d.data,
@@ -77,7 +81,7 @@ func (d *diskWithData) clone() *diskWithData {
 	return &diskWithData{
 		disk{
 			d.client,
-			uuid.NewString(),
+			DiskID(uuid.NewString()),
 			d.alias,
 			d.provisionedSize,
 			d.format,
@@ -85,6 +89,8 @@ func (d *diskWithData) clone() *diskWithData {
 			d.status,
 			d.totalSize,
 			d.sparse,
+			d.quotaID,
+			d.diskProfileID,
 		},
 		&sync.Mutex{},
 		d.data,
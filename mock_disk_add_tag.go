@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) AddTagToDisk(diskID DiskID, tagID TagID, _ ...RetryStrategy) error {
+	return newError(
+		EUnsupported,
+		"cannot add tag %s to disk %s, the oVirt Engine does not support tags on disks",
+		tagID,
+		diskID,
+	)
+}
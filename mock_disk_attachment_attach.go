@@ -0,0 +1,8 @@
+package ovirtclient
+
+func (m *mockClient) AttachDisks(vmID VMID, specs []DiskAttachmentSpec, retries ...RetryStrategy) (
+	[]DiskAttachment,
+	error,
+) {
+	return attachDisks(m, m.logger, vmID, specs, retries)
+}
@@ -1,12 +1,11 @@
 package ovirtclient
 
 func (m *mockClient) CreateDiskAttachment(
-	vmID string,
-	diskID string,
+	vmID VMID,
+	diskID DiskID,
 	diskInterface DiskInterface,
 	params CreateDiskAttachmentOptionalParams,
-	_ ...RetryStrategy,
-) (DiskAttachment, error) {
+	_ ...RetryStrategy) (DiskAttachment, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) GetDiskAttachment(vmID string, diskAttachmentID string, _ ...RetryStrategy) (DiskAttachment, error) {
+func (m *mockClient) GetDiskAttachment(vmID VMID, diskAttachmentID string, _ ...RetryStrategy) (DiskAttachment, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) ListDiskAttachments(vmID string, _ ...RetryStrategy) ([]DiskAttachment, error) {
+func (m *mockClient) ListDiskAttachments(vmID VMID, _ ...RetryStrategy) ([]DiskAttachment, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
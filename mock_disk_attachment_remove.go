@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) RemoveDiskAttachment(vmID string, diskAttachmentID string, _ ...RetryStrategy) error {
+func (m *mockClient) RemoveDiskAttachment(vmID VMID, diskAttachmentID string, _ ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
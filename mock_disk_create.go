@@ -35,14 +35,19 @@ func (m *mockClient) createDisk(
 	size uint64,
 	params CreateDiskOptionalParameters,
 ) (*diskWithData, error) {
-	if _, ok := m.storageDomains[storageDomainID]; !ok {
-		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	if params != nil {
+		if err := m.checkQuotaExists(params.QuotaID()); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.reserveStorage(storageDomainID, size); err != nil {
+		return nil, err
 	}
 
 	disk := &diskWithData{
 		disk: disk{
 			client:           m,
-			id:               m.GenerateUUID(),
+			id:               DiskID(m.GenerateUUID()),
 			format:           format,
 			provisionedSize:  size,
 			totalSize:        size,
@@ -60,6 +65,8 @@ func (m *mockClient) createDisk(
 		if sparse := params.Sparse(); sparse != nil {
 			disk.disk.sparse = *sparse
 		}
+		disk.disk.quotaID = params.QuotaID()
+		disk.disk.diskProfileID = params.DiskProfileID()
 	}
 
 	m.disks[disk.id] = disk
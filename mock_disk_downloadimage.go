@@ -8,14 +8,14 @@ import (
 )
 
 // Deprecated: use StartDownloadDisk instead.
-func (m *mockClient) StartImageDownload(diskID string, format ImageFormat, retries ...RetryStrategy) (
+func (m *mockClient) StartImageDownload(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (
 	ImageDownload,
 	error,
 ) {
 	return m.StartDownloadDisk(diskID, format, retries...)
 }
 
-func (m *mockClient) StartDownloadDisk(diskID string, format ImageFormat, _ ...RetryStrategy) (ImageDownload, error) {
+func (m *mockClient) StartDownloadDisk(diskID DiskID, format ImageFormat, _ ...RetryStrategy) (ImageDownload, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -43,14 +43,14 @@ func (m *mockClient) StartDownloadDisk(diskID string, format ImageFormat, _ ...R
 }
 
 // Deprecated: use DownloadImage instead.
-func (m *mockClient) DownloadImage(diskID string, format ImageFormat, retries ...RetryStrategy) (
+func (m *mockClient) DownloadImage(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (
 	ImageDownloadReader,
 	error,
 ) {
 	return m.DownloadDisk(diskID, format, retries...)
 }
 
-func (m *mockClient) DownloadDisk(diskID string, format ImageFormat, retries ...RetryStrategy) (
+func (m *mockClient) DownloadDisk(diskID DiskID, format ImageFormat, retries ...RetryStrategy) (
 	ImageDownloadReader,
 	error,
 ) {
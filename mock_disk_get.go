@@ -2,7 +2,7 @@
 
 package ovirtclient
 
-func (m *mockClient) GetDisk(id string, _ ...RetryStrategy) (Disk, error) {
+func (m *mockClient) GetDisk(id DiskID, _ ...RetryStrategy) (Disk, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.disks[id]; ok {
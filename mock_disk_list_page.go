@@ -0,0 +1,24 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListDisksPage(params PageParameters, _ ...RetryStrategy) ([]Disk, error) {
+	if err := validatePageParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ids := make([]string, 0, len(m.disks))
+	for id := range m.disks {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+	start, end := mockPage(len(ids), params)
+	result := make([]Disk, end-start)
+	for i, id := range ids[start:end] {
+		result[i] = m.disks[DiskID(id)]
+	}
+	return result, nil
+}
@@ -1,10 +1,11 @@
 package ovirtclient
 
-func (m *mockClient) RemoveDisk(diskID string, _ ...RetryStrategy) error {
+func (m *mockClient) RemoveDisk(diskID DiskID, _ ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if _, ok := m.disks[diskID]; !ok {
+	disk, ok := m.disks[diskID]
+	if !ok {
 		return newError(ENotFound, "disk with ID %s not found", diskID)
 	}
 
@@ -34,6 +35,8 @@ func (m *mockClient) RemoveDisk(diskID string, _ ...RetryStrategy) error {
 
 	delete(m.vmDiskAttachmentsByDisk, diskID)
 	delete(m.disks, diskID)
+	m.removePermissionsOf(PermissionObjectTypeDisk, string(diskID))
+	m.releaseStorage(disk.storageDomainIDs, disk.totalSize)
 
 	return nil
 }
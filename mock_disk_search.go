@@ -0,0 +1,82 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) SearchDisks(params DiskSearchParameters, _ ...RetryStrategy) ([]Disk, error) {
+	if params.Alias() == nil && params.Tag() == nil && params.Statuses() == nil &&
+		params.StorageDomainID() == nil && params.Attached() == nil {
+		return nil, newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	if statuses := params.Statuses(); statuses != nil {
+		if err := statuses.Validate(); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid value for search field statuses")
+		}
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Disk{}
+	for _, d := range m.disks {
+		if alias := params.Alias(); alias != nil && d.alias != *alias {
+			continue
+		}
+		if tag := params.Tag(); tag != nil && !m.diskAttachedToVMTagged(d.id, *tag) {
+			continue
+		}
+		if !m.diskMatchesSearchParams(d, params) {
+			continue
+		}
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Alias() < result[j].Alias()
+	})
+	return result, nil
+}
+
+// diskMatchesSearchParams returns true if the disk matches the status, storage domain and attachment filters of the
+// search parameters.
+func (m *mockClient) diskMatchesSearchParams(d *diskWithData, params DiskSearchParameters) bool {
+	if statuses := params.Statuses(); statuses != nil {
+		foundStatus := false
+		for _, status := range *statuses {
+			if d.status == status {
+				foundStatus = true
+				break
+			}
+		}
+		if !foundStatus {
+			return false
+		}
+	}
+	if storageDomainID := params.StorageDomainID(); storageDomainID != nil {
+		foundStorageDomain := false
+		for _, id := range d.storageDomainIDs {
+			if id == *storageDomainID {
+				foundStorageDomain = true
+				break
+			}
+		}
+		if !foundStorageDomain {
+			return false
+		}
+	}
+	if attached := params.Attached(); attached != nil {
+		if _, ok := m.vmDiskAttachmentsByDisk[d.id]; ok != *attached {
+			return false
+		}
+	}
+	return true
+}
+
+// diskAttachedToVMTagged returns true if the disk is attached to a VM that has a tag with the specified name. Disks
+// cannot be tagged themselves.
+func (m *mockClient) diskAttachedToVMTagged(diskID DiskID, tagName string) bool {
+	attachment, ok := m.vmDiskAttachmentsByDisk[diskID]
+	if !ok {
+		return false
+	}
+	vm, ok := m.vms[attachment.vmid]
+	return ok && m.hasTagNamed(vm.tagIDs, tagName)
+}
@@ -4,7 +4,7 @@ import (
 	"time"
 )
 
-func (m *mockClient) UpdateDisk(id string, params UpdateDiskParameters, retries ...RetryStrategy) (Disk, error) {
+func (m *mockClient) UpdateDisk(id DiskID, params UpdateDiskParameters, retries ...RetryStrategy) (Disk, error) {
 	progress, err := m.StartUpdateDisk(id, params, retries...)
 	if err != nil {
 		return progress.Disk(), err
@@ -12,7 +12,7 @@ func (m *mockClient) UpdateDisk(id string, params UpdateDiskParameters, retries
 	return progress.Wait(retries...)
 }
 
-func (m *mockClient) StartUpdateDisk(id string, params UpdateDiskParameters, _ ...RetryStrategy) (
+func (m *mockClient) StartUpdateDisk(id DiskID, params UpdateDiskParameters, _ ...RetryStrategy) (
 	DiskUpdate,
 	error,
 ) {
@@ -43,11 +43,10 @@ func (m *mockClient) UploadImage(
 }
 
 func (m *mockClient) StartUploadToDisk(
-	diskID string,
+	diskID DiskID,
 	size uint64,
 	reader readSeekCloser,
-	retries ...RetryStrategy,
-) (UploadImageProgress, error) {
+	retries ...RetryStrategy) (UploadImageProgress, error) {
 	disk, err := m.getDisk(diskID, retries...)
 	if err != nil {
 		return nil, err
@@ -97,7 +96,7 @@ func (m *mockClient) StartUploadToDisk(
 	return progress, nil
 }
 
-func (m *mockClient) UploadToDisk(diskID string, size uint64, reader readSeekCloser, retries ...RetryStrategy) error {
+func (m *mockClient) UploadToDisk(diskID DiskID, size uint64, reader readSeekCloser, retries ...RetryStrategy) error {
 	progress, err := m.StartUploadToDisk(diskID, size, reader, retries...)
 	if err != nil {
 		return err
@@ -1,11 +1,14 @@
 package ovirtclient
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // WaitForDiskOK waits for a disk to be in the OK status, then additionally queries the job that was in progress with
 // the correlation ID. This is necessary because the disk returns OK status before the job has actually finished,
 // resulting in a "disk locked" error on subsequent operations. It uses checkDiskOk as an underlying function.
-func (m *mockClient) WaitForDiskOK(diskID string, retries ...RetryStrategy) (Disk, error) {
+func (m *mockClient) WaitForDiskOK(diskID DiskID, retries ...RetryStrategy) (Disk, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	disk, ok := m.disks[diskID]
@@ -18,3 +21,33 @@ func (m *mockClient) WaitForDiskOK(diskID string, retries ...RetryStrategy) (Dis
 
 	return disk, nil
 }
+
+func (m *mockClient) WaitForDiskStatus(diskID DiskID, status DiskStatus, retries ...RetryStrategy) (
+	disk Disk,
+	err error,
+) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+	if status == DiskStatusOK {
+		// The mock does not finish disk operations on its own, so waiting for OK completes them.
+		return m.WaitForDiskOK(diskID, retries...)
+	}
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = retry(
+		fmt.Sprintf("waiting for disk %s status %s", diskID, status),
+		m.logger,
+		retries,
+		func() error {
+			disk, err = m.GetDisk(diskID, retries...)
+			if err != nil {
+				return err
+			}
+			return diskStatusError(disk, status)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return disk, nil
+}
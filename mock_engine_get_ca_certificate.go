@@ -0,0 +1,48 @@
+package ovirtclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+)
+
+func (m *mockClient) GetEngineCACertificate(_ ...RetryStrategy) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.engineCACertificate == nil {
+		// The CA is only created on first use as generating the key is slow compared to the rest of the mock setup.
+		caCertificate, err := generateMockCACertificate()
+		if err != nil {
+			return nil, err
+		}
+		m.engineCACertificate = caCertificate
+	}
+	return append([]byte{}, m.engineCACertificate...), nil
+}
+
+// generateMockCACertificate creates a self-signed, PEM encoded CA certificate resembling the one of the engine.
+func generateMockCACertificate() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to generate CA key")
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"localdomain"}, CommonName: "localhost.localdomain"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, wrap(err, EBug, "failed to create CA certificate")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), nil
+}
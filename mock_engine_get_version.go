@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) GetEngineVersion(_ ...RetryStrategy) (EngineVersion, error) {
+	return &engineVersion{
+		major:       4,
+		minor:       5,
+		build:       0,
+		fullVersion: "4.5.0",
+	}, nil
+}
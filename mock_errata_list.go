@@ -0,0 +1,26 @@
+package ovirtclient
+
+func (m *mockClient) ListVMErrata(vmID VMID, _ ...RetryStrategy) ([]Erratum, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", vmID)
+	}
+	// The mock VMs are not registered as content hosts, so no errata apply to them.
+	return []Erratum{}, nil
+}
+
+func (m *mockClient) ListHostErrata(hostID string, _ ...RetryStrategy) ([]Erratum, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]Erratum, len(m.hostErrata[hostID]))
+	for i, item := range m.hostErrata[hostID] {
+		result[i] = item
+	}
+	return result, nil
+}
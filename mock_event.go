@@ -0,0 +1,44 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+// The following event codes are used by the mock client. They match the codes the oVirt Engine uses for the same
+// actions.
+const (
+	mockEventCodeVMCreated  int64 = 34
+	mockEventCodeVMStopped  int64 = 33
+	mockEventCodeVMShutdown int64 = 73
+	mockEventCodeVMRemoved  int64 = 113
+	mockEventCodeVMStarted  int64 = 153
+)
+
+// mockExternalEventCodes are the codes the oVirt Engine assigns to external events based on their severity.
+var mockExternalEventCodes = map[EventSeverity]int64{
+	EventSeverityNormal:  12,
+	EventSeverityWarning: 13,
+	EventSeverityError:   14,
+	EventSeverityAlert:   15,
+}
+
+// mockEngineEventOrigin is the origin of the events created by the engine itself.
+const mockEngineEventOrigin = "oVirt"
+
+// addVMEvent records an event related to a VM. It must be called with the lock held.
+func (m *mockClient) addVMEvent(code int64, vm *vm, format string, args ...interface{}) {
+	m.events = append(m.events, &event{
+		client:      m,
+		id:          m.GenerateUUID(),
+		index:       int64(len(m.events) + 1),
+		code:        code,
+		description: fmt.Sprintf(format, args...),
+		severity:    EventSeverityNormal,
+		time:        time.Now(),
+		vmID:        vm.id,
+		clusterID:   vm.clusterID,
+		origin:      mockEngineEventOrigin,
+		customID:    -1,
+	})
+}
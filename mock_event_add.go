@@ -0,0 +1,54 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+func (m *mockClient) AddEvent(
+	severity EventSeverity,
+	description string,
+	customID int64,
+	params AddEventOptionalParameters,
+	_ ...RetryStrategy,
+) (Event, error) {
+	origin, err := validateAddEvent(severity, description, params)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, e := range m.events {
+		if e.origin == origin && e.customID == customID {
+			return nil, newError(EConflict, "an event with the custom ID %d already exists for origin %s", customID, origin)
+		}
+	}
+	result := &event{
+		client:      m,
+		id:          m.GenerateUUID(),
+		index:       int64(len(m.events) + 1),
+		code:        mockExternalEventCodes[severity],
+		description: description,
+		severity:    severity,
+		time:        time.Now(),
+		origin:      origin,
+		customID:    customID,
+	}
+	if params != nil {
+		if vmID := params.VMID(); vmID != nil {
+			vm, ok := m.vms[*vmID]
+			if !ok {
+				return nil, newError(ENotFound, "VM with ID %s not found", *vmID)
+			}
+			result.vmID = vm.id
+			result.clusterID = vm.clusterID
+		}
+		if hostID := params.HostID(); hostID != nil {
+			if _, ok := m.hosts[*hostID]; !ok {
+				return nil, newError(ENotFound, "host with ID %s not found", *hostID)
+			}
+			result.hostID = *hostID
+		}
+	}
+	m.events = append(m.events, result)
+	return result, nil
+}
@@ -0,0 +1,27 @@
+package ovirtclient
+
+func (m *mockClient) ListEvents(params EventListParameters, _ ...RetryStrategy) ([]Event, error) {
+	if params != nil && params.Search() != nil {
+		return nil, newError(EUnsupported, "the mock client does not support searching events")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Event{}
+	// Like the engine, apply the limit to the newest events first.
+	for i := len(m.events) - 1; i >= 0; i-- {
+		item := m.events[i]
+		if params != nil {
+			if from := params.From(); from != nil && item.index <= *from {
+				break
+			}
+			if max := params.Max(); max != nil && uint(len(result)) >= *max {
+				break
+			}
+		}
+		result = append(result, item)
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+func (m *mockClient) SubscribeEvents(
+	params EventListParameters,
+	pollInterval time.Duration,
+	retries ...RetryStrategy,
+) (EventSubscription, error) {
+	return subscribeEvents(m, m.logger, params, pollInterval, retries)
+}
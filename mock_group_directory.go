@@ -0,0 +1,17 @@
+package ovirtclient
+
+func (m *mockClient) ListDirectoryGroups(domainID string, search string, _ ...RetryStrategy) ([]GroupData, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.directoryDomains[domainID]; !ok {
+		return nil, newError(ENotFound, "directory domain with ID %s not found", domainID)
+	}
+	result := []GroupData{}
+	for _, g := range m.directoryGroups[domainID] {
+		if mockDirectorySearchMatches(search, g.name) {
+			result = append(result, g)
+		}
+	}
+	return result, nil
+}
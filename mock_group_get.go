@@ -0,0 +1,11 @@
+package ovirtclient
+
+func (m *mockClient) GetGroup(id string, _ ...RetryStrategy) (Group, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if g, ok := m.groups[id]; ok {
+		return g, nil
+	}
+	return nil, newError(ENotFound, "group with ID %s not found", id)
+}
@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListGroups(_ ...RetryStrategy) ([]Group, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]Group, 0, len(m.groups))
+	for _, g := range m.groups {
+		result = append(result, g)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+func (m *mockClient) GetGroupByName(name string, retries ...RetryStrategy) (Group, error) {
+	groups, err := m.ListGroups(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findGroupByName(groups, name)
+}
@@ -0,0 +1,16 @@
+package ovirtclient
+
+func (m *mockClient) AddTagToHost(id string, tagID TagID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	h, ok := m.hosts[id]
+	if !ok {
+		return newError(ENotFound, "host with ID %s not found", id)
+	}
+	if _, ok := m.tags[tagID]; !ok {
+		return newError(ENotFound, "tag with ID %s not found", tagID)
+	}
+	h.tagIDs = append(h.tagIDs, tagID)
+	return nil
+}
@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListHostDevices(hostID string, _ ...RetryStrategy) ([]HostDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostDevice, len(m.hostDevices[hostID]))
+	for i, item := range m.hostDevices[hostID] {
+		result[i] = item
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package ovirtclient
+
+func (m *mockClient) FenceHost(id string, fenceType FenceType, _ ...RetryStrategy) (HostPowerStatus, error) {
+	if err := fenceType.Validate(); err != nil {
+		return "", err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	existing, ok := m.hosts[id]
+	if !ok {
+		return "", newError(ENotFound, "host with ID %s not found", id)
+	}
+	hasFenceAgents := false
+	for _, agent := range m.hostFenceAgents {
+		if agent.hostID == id {
+			hasFenceAgents = true
+			break
+		}
+	}
+	if !hasFenceAgents {
+		return "", newError(EConflict, "host %s has no fence agents configured", id)
+	}
+	// Update a copy so hosts returned earlier are not changed.
+	item := *existing
+	switch fenceType {
+	case FenceTypeStop:
+		item.status = HostStatusDown
+	case FenceTypeStart, FenceTypeRestart:
+		item.status = HostStatusUp
+	}
+	m.hosts[id] = &item
+	if item.status == HostStatusDown {
+		return HostPowerStatusOff, nil
+	}
+	return HostPowerStatusOn, nil
+}
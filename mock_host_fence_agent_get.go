@@ -0,0 +1,15 @@
+package ovirtclient
+
+func (m *mockClient) GetHostFenceAgent(hostID string, agentID string, _ ...RetryStrategy) (FenceAgent, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.getHostFenceAgent(hostID, agentID)
+}
+
+// getHostFenceAgent returns the fence agent without locking. The caller must hold the lock.
+func (m *mockClient) getHostFenceAgent(hostID string, agentID string) (*fenceAgent, error) {
+	if item, ok := m.hostFenceAgents[agentID]; ok && item.hostID == hostID {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "fence agent with ID %s not found on host %s", agentID, hostID)
+}
@@ -0,0 +1,25 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListHostFenceAgents(hostID string, _ ...RetryStrategy) ([]FenceAgent, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	agents := []*fenceAgent{}
+	for _, item := range m.hostFenceAgents {
+		if item.hostID == hostID {
+			agents = append(agents, item)
+		}
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].order < agents[j].order })
+	result := make([]FenceAgent, len(agents))
+	for i, item := range agents {
+		result[i] = item
+	}
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package ovirtclient
+
+func (m *mockClient) UpdateHostFenceAgent(
+	hostID string,
+	agentID string,
+	params UpdateFenceAgentParameters,
+	_ ...RetryStrategy,
+) (FenceAgent, error) {
+	if params == nil {
+		return nil, newError(EBadArgument, "the parameters for updating a fence agent must not be nil")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	existing, err := m.getHostFenceAgent(hostID, agentID)
+	if err != nil {
+		return nil, err
+	}
+	// Update a copy so fence agents returned earlier are not changed.
+	item := *existing
+	if agentType := params.Type(); agentType != nil {
+		item.agentType = *agentType
+	}
+	if address := params.Address(); address != nil {
+		item.address = *address
+	}
+	if port := params.Port(); port != nil {
+		item.port = *port
+	}
+	if username := params.Username(); username != nil {
+		item.username = *username
+	}
+	if order := params.Order(); order != nil {
+		item.order = *order
+	}
+	if options := params.Options(); options != nil {
+		item.options = make(map[string]string, len(options))
+		for name, value := range options {
+			item.options[name] = value
+		}
+	}
+	m.hostFenceAgents[agentID] = &item
+	return &item, nil
+}
@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListHostHugePages(hostID string, _ ...RetryStrategy) ([]HostHugePages, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostHugePages, len(m.hostHugePages[hostID]))
+	for i, item := range m.hostHugePages[hostID] {
+		result[i] = item
+	}
+	return result, nil
+}
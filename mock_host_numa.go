@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListHostNUMANodes(hostID string, _ ...RetryStrategy) ([]HostNUMANode, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := make([]HostNUMANode, len(m.hostNUMANodes[hostID]))
+	for i, item := range m.hostNUMANodes[hostID] {
+		result[i] = item
+	}
+	return result, nil
+}
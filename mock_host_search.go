@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) SearchHosts(params HostSearchParameters, _ ...RetryStrategy) ([]Host, error) {
+	if params.Name() == nil && params.Tag() == nil {
+		return nil, newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Host{}
+	for _, h := range m.hosts {
+		if name := params.Name(); name != nil && h.name != *name {
+			continue
+		}
+		if tag := params.Tag(); tag != nil && !m.hasTagNamed(h.tagIDs, *tag) {
+			continue
+		}
+		result = append(result, h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
@@ -0,0 +1,15 @@
+package ovirtclient
+
+func (m *mockClient) GetHostNIC(hostID string, nicID string, _ ...RetryStrategy) (HostNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.getHostNIC(hostID, nicID)
+}
+
+// getHostNIC returns the host NIC without locking. The caller must hold the lock.
+func (m *mockClient) getHostNIC(hostID string, nicID string) (*hostNIC, error) {
+	if item, ok := m.hostNICs[nicID]; ok && item.hostID == hostID {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "NIC with ID %s not found on host %s", nicID, hostID)
+}
@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) ListHostNICLabels(hostID string, nicID string, _ ...RetryStrategy) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.getHostNIC(hostID, nicID); err != nil {
+		return nil, err
+	}
+	return listMockNetworkLabels(m.hostNICLabels, nicID), nil
+}
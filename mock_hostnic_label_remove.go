@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) RemoveHostNICLabel(hostID string, nicID string, label string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.getHostNIC(hostID, nicID); err != nil {
+		return err
+	}
+	return removeMockNetworkLabel(m.hostNICLabels, nicID, label)
+}
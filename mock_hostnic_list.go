@@ -0,0 +1,16 @@
+package ovirtclient
+
+func (m *mockClient) ListHostNICs(hostID string, _ ...RetryStrategy) ([]HostNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.hosts[hostID]; !ok {
+		return nil, newError(ENotFound, "host with ID %s not found", hostID)
+	}
+	result := []HostNIC{}
+	for _, item := range m.hostNICs {
+		if item.hostID == hostID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
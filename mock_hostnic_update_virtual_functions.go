@@ -0,0 +1,28 @@
+package ovirtclient
+
+func (m *mockClient) UpdateHostNICVirtualFunctions(
+	hostID string,
+	nicID string,
+	params UpdateHostNICVirtualFunctionsParameters,
+	_ ...RetryStrategy,
+) (HostNIC, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	nic, err := m.getHostNIC(hostID, nicID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateHostNICVirtualFunctionsUpdate(nic, params); err != nil {
+		return nil, err
+	}
+	vfConfig := *nic.vfConfig
+	if number := params.NumberOfVirtualFunctions(); number != nil {
+		vfConfig.numberOfVirtualFunctions = *number
+	}
+	if allowed := params.AllNetworksAllowed(); allowed != nil {
+		vfConfig.allNetworksAllowed = *allowed
+	}
+	nic = nic.withVirtualFunctionsConfiguration(&vfConfig)
+	m.hostNICs[nicID] = nic
+	return nic, nil
+}
@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) GetHostProvider(id string, _ ...RetryStrategy) (HostProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.hostProviders[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "host provider with ID %s not found", id)
+}
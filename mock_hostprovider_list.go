@@ -0,0 +1,13 @@
+package ovirtclient
+
+func (m *mockClient) ListHostProviders(_ ...RetryStrategy) ([]HostProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]HostProvider, len(m.hostProviders))
+	i := 0
+	for _, item := range m.hostProviders {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) GetImageProvider(id string, _ ...RetryStrategy) (ImageProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.imageProviders[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "image provider with ID %s not found", id)
+}
@@ -0,0 +1,79 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (m *mockClient) ImportGlanceImage(
+	providerID string,
+	imageID string,
+	storageDomainID string,
+	asTemplate bool,
+	_ ...RetryStrategy,
+) error {
+	if err := validateGlanceImportParameters(providerID, imageID, storageDomainID); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.imageProviders[providerID]; !ok {
+		return newError(ENotFound, "image provider with ID %s not found", providerID)
+	}
+	image, ok := m.glanceImages[imageID]
+	if !ok || image.providerID != providerID {
+		return newError(ENotFound, "image with ID %s not found on image provider %s", imageID, providerID)
+	}
+	if asTemplate {
+		if sd, ok := m.storageDomains[storageDomainID]; ok && len(sd.datacenterIDs) == 0 {
+			return newError(
+				EBadArgument,
+				"storage domain %s is not attached to a datacenter, cannot import image as template",
+				storageDomainID,
+			)
+		}
+	}
+
+	disk, err := m.createDisk(
+		storageDomainID,
+		ImageFormatCow,
+		image.size,
+		CreateDiskParams().MustWithAlias(image.name).MustWithSparse(true),
+	)
+	if err != nil {
+		return err
+	}
+	// The import is waited for, so the disk is ready right away.
+	disk.status = DiskStatusOK
+	if asTemplate {
+		m.createGlanceTemplate(disk)
+	}
+	return nil
+}
+
+// createGlanceTemplate creates a template for a disk imported from an image provider. Like the engine, it names the
+// template GlanceTemplate-<random>.
+func (m *mockClient) createGlanceTemplate(disk *diskWithData) {
+	tpl := &template{
+		client:       m,
+		id:           TemplateID(m.GenerateUUID()),
+		name:         fmt.Sprintf("GlanceTemplate-%s", generateRandomID(7, m.nonSecureRandom)),
+		status:       TemplateStatusOK,
+		creationTime: time.Now(),
+		cpu:          m.templates[DefaultBlankTemplateID].cpu.clone(),
+	}
+	attachment := &templateDiskAttachment{
+		client:        m,
+		id:            TemplateDiskAttachmentID(m.GenerateUUID()),
+		templateID:    tpl.id,
+		diskID:        disk.id,
+		diskInterface: DiskInterfaceVirtIO,
+		bootable:      true,
+		active:        true,
+	}
+	m.templates[tpl.id] = tpl
+	m.templateDiskAttachmentsByTemplate[tpl.id] = []*templateDiskAttachment{attachment}
+	m.templateDiskAttachmentsByDisk[disk.id] = attachment
+}
@@ -0,0 +1,13 @@
+package ovirtclient
+
+func (m *mockClient) ListImageProviders(_ ...RetryStrategy) ([]ImageProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]ImageProvider, len(m.imageProviders))
+	i := 0
+	for _, item := range m.imageProviders {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+// glanceImageWithData is an image on a mock image provider, together with the size of the disk it is imported as.
+type glanceImageWithData struct {
+	glanceImage
+
+	size uint64
+}
+
+func (m *mockClient) ListGlanceImages(providerID string, _ ...RetryStrategy) ([]GlanceImage, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.imageProviders[providerID]; !ok {
+		return nil, newError(ENotFound, "image provider with ID %s not found", providerID)
+	}
+	result := []GlanceImage{}
+	for _, item := range m.glanceImages {
+		if item.providerID == providerID {
+			result = append(result, item.glanceImage)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
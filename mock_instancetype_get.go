@@ -0,0 +1,23 @@
+package ovirtclient
+
+func (m *mockClient) GetInstanceType(id InstanceTypeID, _ ...RetryStrategy) (InstanceType, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if i, ok := m.instanceTypes[id]; ok {
+		return i, nil
+	}
+	return nil, newError(ENotFound, "instance type with ID %s not found", id)
+}
+
+// findInstanceType returns the instance type with the specified ID, or nil if the ID is empty. It must be called with
+// the lock held.
+func (m *mockClient) findInstanceType(id InstanceTypeID) (*instanceType, error) {
+	if id == "" {
+		return nil, nil
+	}
+	if i, ok := m.instanceTypes[id]; ok {
+		return i, nil
+	}
+	return nil, newError(ENotFound, "instance type with ID %s not found", id)
+}
@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListInstanceTypes(_ ...RetryStrategy) ([]InstanceType, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]InstanceType, 0, len(m.instanceTypes))
+	for _, i := range m.instanceTypes {
+		result = append(result, i)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+func (m *mockClient) GetInstanceTypeByName(name string, retries ...RetryStrategy) (InstanceType, error) {
+	instanceTypes, err := m.ListInstanceTypes(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findInstanceTypeByName(instanceTypes, name)
+}
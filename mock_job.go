@@ -0,0 +1,37 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// addCorrelatedJob records a finished job with a single step for an action that completed synchronously in the mock,
+// provided a correlation ID was passed along with the retries. This allows WaitForJob to work against the mock. It
+// must be called with the lock held.
+func (m *mockClient) addCorrelatedJob(retries []RetryStrategy, description string) {
+	correlationID := correlationIDFromRetries(retries)
+	if correlationID == "" {
+		return
+	}
+	now := time.Now()
+	item := &job{
+		client:        m,
+		id:            m.GenerateUUID(),
+		description:   description,
+		status:        JobStatusFinished,
+		startTime:     now,
+		endTime:       &now,
+		autoCleared:   true,
+		correlationID: correlationID,
+	}
+	m.jobs[item.id] = item
+	m.jobSteps[item.id] = []*jobStep{
+		{
+			id:          m.GenerateUUID(),
+			jobID:       item.id,
+			description: "Executing",
+			stepType:    "executing",
+			number:      0,
+			status:      JobStatusFinished,
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package ovirtclient
+
+func (m *mockClient) ListJobsByCorrelationID(correlationID string, _ ...RetryStrategy) ([]Job, error) {
+	if _, err := quoteSearchString(correlationID); err != nil {
+		return nil, wrap(err, EBadArgument, "invalid correlation ID: %s", correlationID)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Job{}
+	for _, item := range m.jobs {
+		if item.correlationID == correlationID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
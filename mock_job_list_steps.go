@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListJobSteps(jobID string, _ ...RetryStrategy) ([]JobStep, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.jobs[jobID]; !ok {
+		return nil, newError(ENotFound, "job with ID %s not found", jobID)
+	}
+	result := make([]JobStep, len(m.jobSteps[jobID]))
+	for i, step := range m.jobSteps[jobID] {
+		result[i] = step
+	}
+	return result, nil
+}
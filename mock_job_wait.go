@@ -0,0 +1,21 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (m *mockClient) WaitForJob(correlationID string, retries ...RetryStrategy) (result []Job, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = retry(
+		fmt.Sprintf("waiting for jobs with correlation ID %s", correlationID),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.ListJobsByCorrelationID(correlationID, retries...)
+			if err != nil {
+				return err
+			}
+			return checkJobsEnded(correlationID, result)
+		})
+	return
+}
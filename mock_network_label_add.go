@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) AddNetworkLabel(networkID string, label string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.networks[networkID]; !ok {
+		return newError(ENotFound, "network with ID %s not found", networkID)
+	}
+	return addMockNetworkLabel(m.networkLabels, networkID, label)
+}
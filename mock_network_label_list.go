@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) ListNetworkLabels(networkID string, _ ...RetryStrategy) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.networks[networkID]; !ok {
+		return nil, newError(ENotFound, "network with ID %s not found", networkID)
+	}
+	return listMockNetworkLabels(m.networkLabels, networkID), nil
+}
@@ -0,0 +1,20 @@
+package ovirtclient
+
+func (m *mockClient) RemoveNetwork(id string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.networks[id]; !ok {
+		return newError(ENotFound, "network with ID %s not found", id)
+	}
+	for _, profile := range m.vnicProfiles {
+		if profile.networkID == id {
+			return newError(EConflict, "network %s is still used by VNIC profile %s", id, profile.id)
+		}
+	}
+
+	delete(m.networks, id)
+	delete(m.networkLabels, id)
+
+	return nil
+}
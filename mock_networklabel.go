@@ -0,0 +1,33 @@
+package ovirtclient
+
+// addMockNetworkLabel adds a label to a label list stored in the mock. The caller must hold the lock.
+func addMockNetworkLabel(labels map[string][]string, ownerID string, label string) error {
+	if err := validateNetworkLabel(label); err != nil {
+		return err
+	}
+	for _, existingLabel := range labels[ownerID] {
+		if existingLabel == label {
+			return newError(EConflict, "label %s is already assigned", label)
+		}
+	}
+	labels[ownerID] = append(labels[ownerID], label)
+	return nil
+}
+
+// removeMockNetworkLabel removes a label from a label list stored in the mock. The caller must hold the lock.
+func removeMockNetworkLabel(labels map[string][]string, ownerID string, label string) error {
+	for i, existingLabel := range labels[ownerID] {
+		if existingLabel == label {
+			labels[ownerID] = append(labels[ownerID][:i], labels[ownerID][i+1:]...)
+			return nil
+		}
+	}
+	return newError(ENotFound, "label %s not found", label)
+}
+
+// listMockNetworkLabels returns a copy of a label list stored in the mock. The caller must hold the lock.
+func listMockNetworkLabels(labels map[string][]string, ownerID string) []string {
+	result := make([]string, len(labels[ownerID]))
+	copy(result, labels[ownerID])
+	return result
+}
@@ -0,0 +1,54 @@
+package ovirtclient
+
+func (m *mockClient) CreateProviderNetwork(
+	providerID string,
+	datacenterID string,
+	name string,
+	_ ...RetryStrategy,
+) (Network, error) {
+	if err := validateProviderNetworkParameters(providerID, datacenterID, name); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	provider, ok := m.networkProviders[providerID]
+	if !ok {
+		return nil, newError(ENotFound, "network provider with ID %s not found", providerID)
+	}
+	if provider.readOnly {
+		return nil, newError(EBadArgument, "network provider %s is read-only", providerID)
+	}
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	for _, n := range m.networks {
+		if n.name == name && n.dcID == datacenterID {
+			return nil, newError(
+				EConflict,
+				"a network with the name %s already exists in datacenter %s",
+				name,
+				datacenterID,
+			)
+		}
+	}
+
+	extNetwork := &externalNetwork{
+		client:     m,
+		id:         m.GenerateUUID(),
+		name:       name,
+		providerID: providerID,
+	}
+	m.externalNetworks[extNetwork.id] = extNetwork
+
+	result := &network{
+		client:             m,
+		id:                 m.GenerateUUID(),
+		name:               name,
+		dcID:               datacenterID,
+		externalProviderID: providerID,
+	}
+	m.networks[result.id] = result
+	return result, nil
+}
@@ -0,0 +1,10 @@
+package ovirtclient
+
+func (m *mockClient) GetNetworkProvider(id string, _ ...RetryStrategy) (NetworkProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if item, ok := m.networkProviders[id]; ok {
+		return item, nil
+	}
+	return nil, newError(ENotFound, "network provider with ID %s not found", id)
+}
@@ -0,0 +1,51 @@
+package ovirtclient
+
+func (m *mockClient) ImportNetworkFromProvider(
+	providerID string,
+	externalNetworkID string,
+	datacenterID string,
+	_ ...RetryStrategy,
+) (Network, error) {
+	if err := validateNetworkImportParameters(providerID, externalNetworkID, datacenterID); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.networkProviders[providerID]; !ok {
+		return nil, newError(ENotFound, "network provider with ID %s not found", providerID)
+	}
+	extNetwork, ok := m.externalNetworks[externalNetworkID]
+	if !ok || extNetwork.providerID != providerID {
+		return nil, newError(
+			ENotFound,
+			"external network with ID %s not found on network provider %s",
+			externalNetworkID,
+			providerID,
+		)
+	}
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	for _, n := range m.networks {
+		if n.name == extNetwork.name && n.dcID == datacenterID {
+			return nil, newError(
+				EConflict,
+				"a network with the name %s already exists in datacenter %s",
+				extNetwork.name,
+				datacenterID,
+			)
+		}
+	}
+
+	result := &network{
+		client:             m,
+		id:                 m.GenerateUUID(),
+		name:               extNetwork.name,
+		dcID:               datacenterID,
+		externalProviderID: providerID,
+	}
+	m.networks[result.id] = result
+	return result, nil
+}
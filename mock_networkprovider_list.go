@@ -0,0 +1,13 @@
+package ovirtclient
+
+func (m *mockClient) ListNetworkProviders(_ ...RetryStrategy) ([]NetworkProvider, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]NetworkProvider, len(m.networkProviders))
+	i := 0
+	for _, item := range m.networkProviders {
+		result[i] = item
+		i++
+	}
+	return result, nil
+}
@@ -0,0 +1,16 @@
+package ovirtclient
+
+func (m *mockClient) ListNetworkProviderNetworks(providerID string, _ ...RetryStrategy) ([]ExternalNetwork, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.networkProviders[providerID]; !ok {
+		return nil, newError(ENotFound, "network provider with ID %s not found", providerID)
+	}
+	result := []ExternalNetwork{}
+	for _, item := range m.externalNetworks {
+		if item.providerID == providerID {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
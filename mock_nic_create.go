@@ -1,22 +1,27 @@
 package ovirtclient
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/google/uuid"
 )
 
 func (m *mockClient) CreateNIC(
-	vmid string,
+	vmid VMID,
 	vnicProfileID string,
 	name string,
-	_ OptionalNICParameters,
-	_ ...RetryStrategy,
-) (NIC, error) {
+	params OptionalNICParameters,
+	retries ...RetryStrategy) (NIC, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if err := validateNICCreationParameters(vmid, name); err != nil {
+	if err := validateNICCreationParameters(vmid, name, params); err != nil {
 		return nil, err
 	}
+	if params == nil {
+		params = CreateNICParams()
+	}
 	if _, ok := m.vms[vmid]; !ok {
 		return nil, newError(ENotFound, "VM with ID %s not found for NIC creation", vmid)
 	}
@@ -26,7 +31,12 @@ func (m *mockClient) CreateNIC(
 		}
 	}
 
-	id := uuid.Must(uuid.NewUUID()).String()
+	mac, err := m.mockNICMAC(params.MAC())
+	if err != nil {
+		return nil, err
+	}
+
+	id := NICID(uuid.Must(uuid.NewUUID()).String())
 
 	nic := &nic{
 		client:        m,
@@ -34,8 +44,38 @@ func (m *mockClient) CreateNIC(
 		name:          name,
 		vmid:          vmid,
 		vnicProfileID: vnicProfileID,
+		mac:           mac,
+		plugged:       params.Plugged() == nil || *params.Plugged(),
+		linked:        params.Linked() == nil || *params.Linked(),
+	}
+	deviceIndex := 0
+	for _, n := range m.nics {
+		if n.vmid == vmid {
+			deviceIndex++
+		}
 	}
 	m.nics[id] = nic
+	m.nicReportedDevices[id] = m.generateMockReportedDevice(deviceIndex, mac)
+	m.addCorrelatedJob(retries, fmt.Sprintf("Adding NIC %s to VM %s", name, vmid))
 
 	return nic, nil
 }
+
+// mockNICMAC returns the requested MAC address, or generates one like the MAC pool of the engine if it is empty. It
+// returns an EConflict error if the MAC address is already in use. It must be called with the lock held.
+func (m *mockClient) mockNICMAC(mac string) (string, error) {
+	if mac == "" {
+		return m.generateMockMAC(), nil
+	}
+	hardwareAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return "", newError(EBadArgument, "invalid MAC address: %s", mac)
+	}
+	mac = hardwareAddr.String()
+	for _, n := range m.nics {
+		if n.mac == mac {
+			return "", newError(EConflict, "MAC address %s is already in use by NIC %s", mac, n.id)
+		}
+	}
+	return mac, nil
+}
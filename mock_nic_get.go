@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) GetNIC(vmid string, id string, _ ...RetryStrategy) (NIC, error) {
+func (m *mockClient) GetNIC(vmid VMID, id NICID, _ ...RetryStrategy) (NIC, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if nic, ok := m.nics[id]; ok {
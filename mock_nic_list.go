@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) ListNICs(vmid string, _ ...RetryStrategy) ([]NIC, error) {
+func (m *mockClient) ListNICs(vmid VMID, _ ...RetryStrategy) ([]NIC, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	var result []NIC
@@ -0,0 +1,53 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+)
+
+func (m *mockClient) ListNICReportedDevices(vmid VMID, nicID NICID, _ ...RetryStrategy) ([]ReportedDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	vm, ok := m.vms[vmid]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmid)
+	}
+	nic, ok := m.nics[nicID]
+	if !ok || nic.vmid != vmid {
+		return nil, newError(ENotFound, "NIC with ID %s not found on VM with ID %s", nicID, vmid)
+	}
+	// Only running VMs have a guest agent that can report devices.
+	if vm.status != VMStatusUp {
+		return []ReportedDevice{}, nil
+	}
+	device, ok := m.nicReportedDevices[nicID]
+	if !ok {
+		return []ReportedDevice{}, nil
+	}
+	return []ReportedDevice{device}, nil
+}
+
+// generateMockReportedDevice creates a fake guest-reported device for a NIC. The IP address is taken from the
+// TEST-NET-1 documentation range so it never collides with real addresses.
+func (m *mockClient) generateMockReportedDevice(deviceIndex int, mac string) *reportedDevice {
+	return &reportedDevice{
+		id:   m.GenerateUUID(),
+		name: fmt.Sprintf("eth%d", deviceIndex),
+		mac:  mac,
+		ipAddresses: []net.IP{
+			net.IPv4(192, 0, 2, byte(1+m.nonSecureRandom.Intn(254))),
+		},
+	}
+}
+
+// generateMockMAC generates a random MAC address with the 56:6f prefix the engine uses for its default MAC pool.
+func (m *mockClient) generateMockMAC() string {
+	mac := net.HardwareAddr{
+		0x56, 0x6f,
+		byte(m.nonSecureRandom.Intn(256)),
+		byte(m.nonSecureRandom.Intn(256)),
+		byte(m.nonSecureRandom.Intn(256)),
+		byte(m.nonSecureRandom.Intn(256)),
+	}
+	return mac.String()
+}
@@ -1,14 +1,18 @@
 package ovirtclient
 
-func (m *mockClient) RemoveNIC(vmid string, id string, _ ...RetryStrategy) error {
+import "fmt"
+
+func (m *mockClient) RemoveNIC(vmid VMID, id NICID, retries ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if _, ok := m.vms[vmid]; !ok {
-		return newError(ENotFound, "NIC with ID %s not found", vmid)
+		return newError(ENotFound, "VM with ID %s not found", vmid)
 	}
-	if _, ok := m.nics[id]; !ok {
+	if nic, ok := m.nics[id]; !ok || nic.vmid != vmid {
 		return newError(ENotFound, "NIC with ID %s not found on VM with ID %s", id, vmid)
 	}
 	delete(m.nics, id)
+	delete(m.nicReportedDevices, id)
+	m.addCorrelatedJob(retries, fmt.Sprintf("Removing NIC %s from VM %s", id, vmid))
 	return nil
 }
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) UpdateNIC(vmid string, nicID string, params UpdateNICParameters, retries ...RetryStrategy) (
+func (m *mockClient) UpdateNIC(vmid VMID, nicID NICID, params UpdateNICParameters, retries ...RetryStrategy) (
 	NIC,
 	error,
 ) {
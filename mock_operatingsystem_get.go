@@ -0,0 +1,11 @@
+package ovirtclient
+
+func (m *mockClient) GetOperatingSystem(id string, _ ...RetryStrategy) (OperatingSystem, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if o, ok := m.operatingSystems[id]; ok {
+		return o, nil
+	}
+	return nil, newError(ENotFound, "operating system with ID %s not found", id)
+}
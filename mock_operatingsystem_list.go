@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListOperatingSystems(_ ...RetryStrategy) ([]OperatingSystem, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]OperatingSystem, 0, len(m.operatingSystems))
+	for _, o := range m.operatingSystems {
+		result = append(result, o)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+func (m *mockClient) GetOperatingSystemByName(name string, retries ...RetryStrategy) (OperatingSystem, error) {
+	operatingSystems, err := m.ListOperatingSystems(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findOperatingSystemByName(operatingSystems, name)
+}
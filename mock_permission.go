@@ -0,0 +1,46 @@
+package ovirtclient
+
+// checkPermissionObject returns an error if the VM, template or disk does not exist. The caller must hold the lock.
+func (m *mockClient) checkPermissionObject(objectType PermissionObjectType, objectID string) error {
+	if err := objectType.Validate(); err != nil {
+		return err
+	}
+	exists := false
+	switch objectType {
+	case PermissionObjectTypeTemplate:
+		_, exists = m.templates[TemplateID(objectID)]
+	case PermissionObjectTypeDisk:
+		_, exists = m.disks[DiskID(objectID)]
+	default:
+		_, exists = m.vms[VMID(objectID)]
+	}
+	if !exists {
+		return newError(ENotFound, "%s with ID %s not found", objectType, objectID)
+	}
+	return nil
+}
+
+// checkPermissionPrincipal returns an error if the user or group is not known to the engine. The caller must hold the
+// lock.
+func (m *mockClient) checkPermissionPrincipal(principal PermissionPrincipal) error {
+	exists := false
+	if principal.Type() == PermissionPrincipalTypeUser {
+		_, exists = m.users[principal.ID()]
+	} else {
+		_, exists = m.groups[principal.ID()]
+	}
+	if !exists {
+		return newError(ENotFound, "%s with ID %s not found", principal.Type(), principal.ID())
+	}
+	return nil
+}
+
+// removePermissionsOf removes the permissions granted on an object when the object is removed, similar to the
+// engine. The caller must hold the lock.
+func (m *mockClient) removePermissionsOf(objectType PermissionObjectType, objectID string) {
+	for id, p := range m.permissions {
+		if p.objectType == objectType && p.objectID == objectID {
+			delete(m.permissions, id)
+		}
+	}
+}
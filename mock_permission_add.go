@@ -0,0 +1,81 @@
+package ovirtclient
+
+import (
+	"github.com/google/uuid"
+)
+
+func (m *mockClient) AddVMPermission(
+	vmID VMID,
+	principal PermissionPrincipal,
+	roleID string,
+	_ ...RetryStrategy,
+) (Permission, error) {
+	return m.addPermission(PermissionObjectTypeVM, string(vmID), principal, roleID)
+}
+
+func (m *mockClient) AddTemplatePermission(
+	templateID TemplateID,
+	principal PermissionPrincipal,
+	roleID string,
+	_ ...RetryStrategy,
+) (Permission, error) {
+	return m.addPermission(PermissionObjectTypeTemplate, string(templateID), principal, roleID)
+}
+
+func (m *mockClient) AddDiskPermission(
+	diskID DiskID,
+	principal PermissionPrincipal,
+	roleID string,
+	_ ...RetryStrategy,
+) (Permission, error) {
+	return m.addPermission(PermissionObjectTypeDisk, string(diskID), principal, roleID)
+}
+
+func (m *mockClient) addPermission(
+	objectType PermissionObjectType,
+	objectID string,
+	principal PermissionPrincipal,
+	roleID string,
+) (Permission, error) {
+	if err := validatePermissionParameters(principal, roleID); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.checkPermissionObject(objectType, objectID); err != nil {
+		return nil, err
+	}
+	if err := m.checkPermissionPrincipal(principal); err != nil {
+		return nil, err
+	}
+	if _, ok := m.roles[roleID]; !ok {
+		return nil, newError(ENotFound, "role with ID %s not found", roleID)
+	}
+	for _, p := range m.permissions {
+		if p.objectType == objectType && p.objectID == objectID && p.roleID == roleID &&
+			*p.principal == (permissionPrincipal{principal.Type(), principal.ID()}) {
+			return nil, newError(
+				EConflict,
+				"%s %s already has role %s on %s %s",
+				principal.Type(),
+				principal.ID(),
+				roleID,
+				objectType,
+				objectID,
+			)
+		}
+	}
+
+	result := &permission{
+		client:     m,
+		id:         uuid.Must(uuid.NewUUID()).String(),
+		roleID:     roleID,
+		principal:  &permissionPrincipal{principal.Type(), principal.ID()},
+		objectType: objectType,
+		objectID:   objectID,
+	}
+	m.permissions[result.id] = result
+	return result, nil
+}
@@ -0,0 +1,28 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListPermissions(
+	objectType PermissionObjectType,
+	objectID string,
+	_ ...RetryStrategy,
+) ([]Permission, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.checkPermissionObject(objectType, objectID); err != nil {
+		return nil, err
+	}
+	result := []Permission{}
+	for _, p := range m.permissions {
+		if p.objectType == objectType && p.objectID == objectID {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID() < result[j].ID()
+	})
+	return result, nil
+}
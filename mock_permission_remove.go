@@ -0,0 +1,21 @@
+package ovirtclient
+
+func (m *mockClient) RemovePermission(
+	objectType PermissionObjectType,
+	objectID string,
+	id string,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.checkPermissionObject(objectType, objectID); err != nil {
+		return err
+	}
+	p, ok := m.permissions[id]
+	if !ok || p.objectType != objectType || p.objectID != objectID {
+		return newError(ENotFound, "permission with ID %s not found on %s %s", id, objectType, objectID)
+	}
+	delete(m.permissions, id)
+	return nil
+}
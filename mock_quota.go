@@ -0,0 +1,57 @@
+package ovirtclient
+
+// checkQuotaExists returns an error if a non-empty quota ID does not refer to an existing quota. The caller must hold
+// the lock.
+func (m *mockClient) checkQuotaExists(id QuotaID) error {
+	if id == "" {
+		return nil
+	}
+	if _, ok := m.quotas[id]; !ok {
+		return newError(ENotFound, "quota with ID %s not found", id)
+	}
+	return nil
+}
+
+// getQuota returns the quota if it belongs to the datacenter. The caller must hold the lock.
+func (m *mockClient) getQuota(datacenterID string, id QuotaID) (*quota, error) {
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	q, ok := m.quotas[id]
+	if !ok || q.datacenterID != datacenterID {
+		return nil, newError(ENotFound, "quota with ID %s not found in datacenter %s", id, datacenterID)
+	}
+	return q, nil
+}
+
+// quotaClusterLimitWithUsage returns a copy of the limit with the vCPUs used by the VMs assigned to the quota. The
+// mock does not track the memory of VMs, so the memory usage is always zero. The caller must hold the lock.
+func (m *mockClient) quotaClusterLimitWithUsage(limit *quotaClusterLimit) *quotaClusterLimit {
+	result := *limit
+	result.vcpuUsage = 0
+	for _, v := range m.vms {
+		if v.quotaID != limit.quotaID || (limit.clusterID != "" && v.clusterID != limit.clusterID) {
+			continue
+		}
+		if v.cpu != nil && v.cpu.topo != nil {
+			result.vcpuUsage += uint64(v.cpu.topo.cores * v.cpu.topo.threads * v.cpu.topo.sockets)
+		}
+	}
+	return &result
+}
+
+// quotaStorageLimitWithUsage returns a copy of the limit with the provisioned size of the disks assigned to the
+// quota. The caller must hold the lock.
+func (m *mockClient) quotaStorageLimitWithUsage(limit *quotaStorageLimit) *quotaStorageLimit {
+	result := *limit
+	result.usage = 0
+	for _, d := range m.disks {
+		if d.quotaID != limit.quotaID {
+			continue
+		}
+		if limit.storageDomainID == "" || stringIn(limit.storageDomainID, d.storageDomainIDs) {
+			result.usage += d.provisionedSize
+		}
+	}
+	return &result
+}
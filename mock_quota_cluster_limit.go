@@ -0,0 +1,77 @@
+package ovirtclient
+
+import (
+	"github.com/google/uuid"
+)
+
+func (m *mockClient) ListQuotaClusterLimits(datacenterID string, id QuotaID, _ ...RetryStrategy) (
+	[]QuotaClusterLimit,
+	error,
+) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.getQuota(datacenterID, id); err != nil {
+		return nil, err
+	}
+	result := make([]QuotaClusterLimit, len(m.quotaClusterLimits[id]))
+	for i, limit := range m.quotaClusterLimits[id] {
+		result[i] = m.quotaClusterLimitWithUsage(limit)
+	}
+	return result, nil
+}
+
+func (m *mockClient) AddQuotaClusterLimit(
+	datacenterID string,
+	id QuotaID,
+	clusterID ClusterID,
+	vcpuLimit int64,
+	memoryLimit int64,
+	_ ...RetryStrategy,
+) (QuotaClusterLimit, error) {
+	if err := validateQuotaClusterLimit(vcpuLimit, memoryLimit); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.getQuota(datacenterID, id); err != nil {
+		return nil, err
+	}
+	if clusterID != "" {
+		if err := m.checkClusterInDatacenter(datacenterID, clusterID); err != nil {
+			return nil, err
+		}
+	}
+	for _, limit := range m.quotaClusterLimits[id] {
+		// The engine does not allow mixing a limit for all clusters with limits for specific clusters.
+		if limit.clusterID == clusterID || limit.clusterID == "" || clusterID == "" {
+			return nil, newError(EConflict, "quota %s already has a conflicting cluster limit %s", id, limit.id)
+		}
+	}
+
+	limit := &quotaClusterLimit{
+		id:          uuid.Must(uuid.NewUUID()).String(),
+		quotaID:     id,
+		clusterID:   clusterID,
+		vcpuLimit:   vcpuLimit,
+		memoryLimit: memoryLimit,
+	}
+	m.quotaClusterLimits[id] = append(m.quotaClusterLimits[id], limit)
+	return m.quotaClusterLimitWithUsage(limit), nil
+}
+
+// checkClusterInDatacenter returns an error if the cluster does not exist or is not part of the datacenter. The
+// caller must hold the lock.
+func (m *mockClient) checkClusterInDatacenter(datacenterID string, clusterID ClusterID) error {
+	if _, ok := m.clusters[clusterID]; !ok {
+		return newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	for _, id := range m.dataCenters[datacenterID].clusters {
+		if id == clusterID {
+			return nil
+		}
+	}
+	return newError(EBadArgument, "cluster %s is not part of datacenter %s", clusterID, datacenterID)
+}
@@ -0,0 +1,60 @@
+package ovirtclient
+
+import (
+	"github.com/google/uuid"
+)
+
+// The default percentages the engine uses when they are not set on quota creation.
+const (
+	mockQuotaDefaultSoftLimitPct uint = 80
+	mockQuotaDefaultHardLimitPct uint = 20
+)
+
+func (m *mockClient) CreateQuota(
+	datacenterID string,
+	name string,
+	params OptionalQuotaParameters,
+	_ ...RetryStrategy,
+) (Quota, error) {
+	if err := validateQuotaCreationParameters(datacenterID, name); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &quotaParams{}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	for _, q := range m.quotas {
+		if q.datacenterID == datacenterID && q.name == name {
+			return nil, newError(EConflict, "a quota with the name %s already exists in datacenter %s", name, datacenterID)
+		}
+	}
+
+	result := &quota{
+		client:              m,
+		id:                  QuotaID(uuid.Must(uuid.NewUUID()).String()),
+		name:                name,
+		description:         params.Description(),
+		datacenterID:        datacenterID,
+		clusterSoftLimitPct: mockQuotaPct(params.ClusterSoftLimitPct(), mockQuotaDefaultSoftLimitPct),
+		clusterHardLimitPct: mockQuotaPct(params.ClusterHardLimitPct(), mockQuotaDefaultHardLimitPct),
+		storageSoftLimitPct: mockQuotaPct(params.StorageSoftLimitPct(), mockQuotaDefaultSoftLimitPct),
+		storageHardLimitPct: mockQuotaPct(params.StorageHardLimitPct(), mockQuotaDefaultHardLimitPct),
+	}
+	m.quotas[result.id] = result
+	m.quotaClusterLimits[result.id] = []*quotaClusterLimit{}
+	m.quotaStorageLimits[result.id] = []*quotaStorageLimit{}
+	return result, nil
+}
+
+func mockQuotaPct(pct *uint, defaultPct uint) uint {
+	if pct == nil {
+		return defaultPct
+	}
+	return *pct
+}
@@ -0,0 +1,8 @@
+package ovirtclient
+
+func (m *mockClient) GetQuota(datacenterID string, id QuotaID, _ ...RetryStrategy) (Quota, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.getQuota(datacenterID, id)
+}
@@ -0,0 +1,24 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListQuotas(datacenterID string, _ ...RetryStrategy) ([]Quota, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	result := make([]Quota, 0, len(m.quotas))
+	for _, q := range m.quotas {
+		if q.datacenterID == datacenterID {
+			result = append(result, q)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package ovirtclient
+
+func (m *mockClient) RemoveQuota(datacenterID string, id QuotaID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.getQuota(datacenterID, id); err != nil {
+		return err
+	}
+	for _, v := range m.vms {
+		if v.quotaID == id {
+			return newError(EConflict, "quota %s is still assigned to VM %s", id, v.id)
+		}
+	}
+	for _, d := range m.disks {
+		if d.quotaID == id {
+			return newError(EConflict, "quota %s is still assigned to disk %s", id, d.id)
+		}
+	}
+
+	delete(m.quotas, id)
+	delete(m.quotaClusterLimits, id)
+	delete(m.quotaStorageLimits, id)
+	return nil
+}
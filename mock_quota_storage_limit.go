@@ -0,0 +1,61 @@
+package ovirtclient
+
+import (
+	"github.com/google/uuid"
+)
+
+func (m *mockClient) ListQuotaStorageLimits(datacenterID string, id QuotaID, _ ...RetryStrategy) (
+	[]QuotaStorageLimit,
+	error,
+) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.getQuota(datacenterID, id); err != nil {
+		return nil, err
+	}
+	result := make([]QuotaStorageLimit, len(m.quotaStorageLimits[id]))
+	for i, limit := range m.quotaStorageLimits[id] {
+		result[i] = m.quotaStorageLimitWithUsage(limit)
+	}
+	return result, nil
+}
+
+func (m *mockClient) AddQuotaStorageLimit(
+	datacenterID string,
+	id QuotaID,
+	storageDomainID string,
+	limit int64,
+	_ ...RetryStrategy,
+) (QuotaStorageLimit, error) {
+	if err := validateQuotaStorageLimit(limit); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, err := m.getQuota(datacenterID, id); err != nil {
+		return nil, err
+	}
+	if storageDomainID != "" {
+		if _, err := m.getAttachedStorageDomain(datacenterID, storageDomainID); err != nil {
+			return nil, err
+		}
+	}
+	for _, l := range m.quotaStorageLimits[id] {
+		// The engine does not allow mixing a limit for all storage domains with limits for specific ones.
+		if l.storageDomainID == storageDomainID || l.storageDomainID == "" || storageDomainID == "" {
+			return nil, newError(EConflict, "quota %s already has a conflicting storage limit %s", id, l.id)
+		}
+	}
+
+	result := &quotaStorageLimit{
+		id:              uuid.Must(uuid.NewUUID()).String(),
+		quotaID:         id,
+		storageDomainID: storageDomainID,
+		limit:           limit,
+	}
+	m.quotaStorageLimits[id] = append(m.quotaStorageLimits[id], result)
+	return m.quotaStorageLimitWithUsage(result), nil
+}
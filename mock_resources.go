@@ -0,0 +1,109 @@
+package ovirtclient
+
+func (m *mockClient) Disks() DiskClient {
+	return m
+}
+
+func (m *mockClient) DiskAttachments() DiskAttachmentClient {
+	return m
+}
+
+func (m *mockClient) VMs() VMClient {
+	return m
+}
+
+func (m *mockClient) NICs() NICClient {
+	return m
+}
+
+func (m *mockClient) VNICProfiles() VNICProfileClient {
+	return m
+}
+
+func (m *mockClient) Networks() NetworkClient {
+	return m
+}
+
+func (m *mockClient) NetworkProviders() NetworkProviderClient {
+	return m
+}
+
+func (m *mockClient) HostProviders() HostProviderClient {
+	return m
+}
+
+func (m *mockClient) ImageProviders() ImageProviderClient {
+	return m
+}
+
+func (m *mockClient) Datacenters() DatacenterClient {
+	return m
+}
+
+func (m *mockClient) Clusters() ClusterClient {
+	return m
+}
+
+func (m *mockClient) StorageDomains() StorageDomainClient {
+	return m
+}
+
+func (m *mockClient) Hosts() HostClient {
+	return m
+}
+
+func (m *mockClient) HostNICs() HostNICClient {
+	return m
+}
+
+func (m *mockClient) Templates() TemplateClient {
+	return m
+}
+
+func (m *mockClient) TemplateDisks() TemplateDiskClient {
+	return m
+}
+
+func (m *mockClient) Tags() TagClient {
+	return m
+}
+
+func (m *mockClient) Quotas() QuotaClient {
+	return m
+}
+
+func (m *mockClient) Permissions() PermissionClient {
+	return m
+}
+
+func (m *mockClient) Users() UserClient {
+	return m
+}
+
+func (m *mockClient) Groups() GroupClient {
+	return m
+}
+
+func (m *mockClient) Roles() RoleClient {
+	return m
+}
+
+func (m *mockClient) InstanceTypes() InstanceTypeClient {
+	return m
+}
+
+func (m *mockClient) OperatingSystems() OperatingSystemClient {
+	return m
+}
+
+func (m *mockClient) Errata() ErrataClient {
+	return m
+}
+
+func (m *mockClient) Events() EventClient {
+	return m
+}
+
+func (m *mockClient) Jobs() JobClient {
+	return m
+}
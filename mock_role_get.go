@@ -0,0 +1,11 @@
+package ovirtclient
+
+func (m *mockClient) GetRole(id string, _ ...RetryStrategy) (Role, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if r, ok := m.roles[id]; ok {
+		return r, nil
+	}
+	return nil, newError(ENotFound, "role with ID %s not found", id)
+}
@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListRoles(_ ...RetryStrategy) ([]Role, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]Role, 0, len(m.roles))
+	for _, r := range m.roles {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+func (m *mockClient) GetRoleByName(name string, retries ...RetryStrategy) (Role, error) {
+	roles, err := m.ListRoles(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findRoleByName(roles, name)
+}
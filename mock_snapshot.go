@@ -0,0 +1,1229 @@
+package ovirtclient
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// mockSnapshot is the JSON representation of the resources of the mock client.
+type mockSnapshot struct {
+	StorageDomains          []mockStorageDomainSnapshot            `json:"storage_domains"`
+	Clusters                []mockClusterSnapshot                  `json:"clusters"`
+	Hosts                   []mockHostSnapshot                     `json:"hosts"`
+	HostNICs                []mockHostNICSnapshot                  `json:"host_nics"`
+	HostNICLabels           map[string][]string                    `json:"host_nic_labels"`
+	HostFenceAgents         []mockFenceAgentSnapshot               `json:"host_fence_agents"`
+	HostNUMANodes           map[string][]mockHostNUMANodeSnapshot  `json:"host_numa_nodes"`
+	HostHugePages           map[string][]mockHostHugePagesSnapshot `json:"host_hugepages"`
+	HostDevices             []mockHostDeviceSnapshot               `json:"host_devices"`
+	HostErrata              map[string][]mockErratumSnapshot       `json:"host_errata"`
+	Datacenters             []mockDatacenterSnapshot               `json:"datacenters"`
+	Networks                []mockNetworkSnapshot                  `json:"networks"`
+	NetworkLabels           map[string][]string                    `json:"network_labels"`
+	NetworkProviders        []mockNetworkProviderSnapshot          `json:"network_providers"`
+	ExternalNetworks        []mockExternalNetworkSnapshot          `json:"external_networks"`
+	VNICProfiles            []mockVNICProfileSnapshot              `json:"vnic_profiles"`
+	Disks                   []mockDiskSnapshot                     `json:"disks"`
+	Templates               []mockTemplateSnapshot                 `json:"templates"`
+	TemplateDiskAttachments []mockTemplateDiskAttachmentSnapshot   `json:"template_disk_attachments"`
+	VMs                     []mockVMSnapshot                       `json:"vms"`
+	DiskAttachments         []mockDiskAttachmentSnapshot           `json:"disk_attachments"`
+	NICs                    []mockNICSnapshot                      `json:"nics"`
+	NICReportedDevices      map[NICID]mockReportedDeviceSnapshot   `json:"nic_reported_devices"`
+	Tags                    []mockTagSnapshot                      `json:"tags"`
+	StorageDomainVMs        []mockStorageDomainVMSnapshot          `json:"storage_domain_vms"`
+	StorageDomainTemplates  []mockStorageDomainTemplateSnapshot    `json:"storage_domain_templates"`
+	Quotas                  []mockQuotaSnapshot                    `json:"quotas"`
+	Permissions             []mockPermissionSnapshot               `json:"permissions"`
+	Users                   []mockUserSnapshot                     `json:"users"`
+	Groups                  []mockGroupSnapshot                    `json:"groups"`
+	DirectoryDomains        []mockDirectoryDomainSnapshot          `json:"directory_domains"`
+	Roles                   []mockRoleSnapshot                     `json:"roles"`
+	InstanceTypes           []mockInstanceTypeSnapshot             `json:"instance_types"`
+	OperatingSystems        []mockOperatingSystemSnapshot          `json:"operating_systems"`
+	HostProviders           []mockHostProviderSnapshot             `json:"host_providers"`
+	ImageProviders          []mockImageProviderSnapshot            `json:"image_providers"`
+	GlanceImages            []mockGlanceImageSnapshot              `json:"glance_images"`
+}
+
+type mockStorageDomainSnapshot struct {
+	ID             string                      `json:"id"`
+	Name           string                      `json:"name"`
+	Available      uint64                      `json:"available"`
+	Used           uint64                      `json:"used"`
+	Committed      uint64                      `json:"committed"`
+	StorageType    StorageDomainType           `json:"storage_type"`
+	Function       StorageDomainFunction       `json:"function"`
+	Status         StorageDomainStatus         `json:"status"`
+	ExternalStatus StorageDomainExternalStatus `json:"external_status"`
+	DatacenterIDs  []string                    `json:"datacenter_ids"`
+}
+
+type mockClusterSnapshot struct {
+	ID                        ClusterID       `json:"id"`
+	Name                      string          `json:"name"`
+	CPUArchitecture           CPUArchitecture `json:"cpu_architecture"`
+	CPUType                   string          `json:"cpu_type"`
+	CompatibilityVersionMajor uint            `json:"compatibility_version_major"`
+	CompatibilityVersionMinor uint            `json:"compatibility_version_minor"`
+	BIOSType                  BIOSType        `json:"bios_type"`
+	MemoryOverCommitPercent   uint            `json:"memory_over_commit_percent"`
+	BallooningEnabled         bool            `json:"ballooning_enabled"`
+	KSMEnabled                bool            `json:"ksm_enabled"`
+	KSMMergeAcrossNodes       bool            `json:"ksm_merge_across_nodes"`
+}
+
+type mockHostSnapshot struct {
+	ID                  string     `json:"id"`
+	ClusterID           ClusterID  `json:"cluster_id"`
+	Status              HostStatus `json:"status"`
+	Name                string     `json:"name"`
+	Address             string     `json:"address"`
+	CPUName             string     `json:"cpu_name"`
+	CPUSpeed            uint       `json:"cpu_speed"`
+	CPUType             string     `json:"cpu_type"`
+	CPUCores            uint       `json:"cpu_cores"`
+	CPUThreads          uint       `json:"cpu_threads"`
+	CPUSockets          uint       `json:"cpu_sockets"`
+	Memory              uint64     `json:"memory"`
+	MaxSchedulingMemory uint64     `json:"max_scheduling_memory"`
+	NUMASupported       bool       `json:"numa_supported"`
+	TagIDs              []TagID    `json:"tag_ids"`
+	SSHPort             uint       `json:"ssh_port"`
+	SSHFingerprint      string     `json:"ssh_fingerprint"`
+	SSHPublicKey        string     `json:"ssh_public_key"`
+	CertificateSubject  string     `json:"certificate_subject"`
+	CertificateOrg      string     `json:"certificate_organization"`
+}
+
+type mockHostNUMANodeSnapshot struct {
+	Index     uint   `json:"index"`
+	Memory    uint64 `json:"memory"`
+	CPUs      []uint `json:"cpus"`
+	Distances []uint `json:"distances"`
+}
+
+type mockHostHugePagesSnapshot struct {
+	Size VMHugePages `json:"size"`
+	Free uint64      `json:"free"`
+}
+
+type mockHostDeviceSnapshot struct {
+	ID               string               `json:"id"`
+	HostID           string               `json:"host_id"`
+	Name             string               `json:"name"`
+	Capability       HostDeviceCapability `json:"capability"`
+	VendorID         string               `json:"vendor_id"`
+	VendorName       string               `json:"vendor_name"`
+	ProductID        string               `json:"product_id"`
+	ProductName      string               `json:"product_name"`
+	ParentDeviceName string               `json:"parent_device_name"`
+	Driver           string               `json:"driver"`
+}
+
+type mockErratumSnapshot struct {
+	ID       string      `json:"id"`
+	Name     string      `json:"name"`
+	Title    string      `json:"title"`
+	Type     ErratumType `json:"type"`
+	Severity string      `json:"severity"`
+	Summary  string      `json:"summary"`
+	Solution string      `json:"solution"`
+	Issued   time.Time   `json:"issued"`
+	Packages []string    `json:"packages"`
+}
+
+type mockFenceAgentSnapshot struct {
+	ID             string            `json:"id"`
+	HostID         string            `json:"host_id"`
+	Type           string            `json:"type"`
+	Address        string            `json:"address"`
+	Port           uint              `json:"port"`
+	Username       string            `json:"username"`
+	Order          uint              `json:"order"`
+	Options        map[string]string `json:"options"`
+	EncryptOptions bool              `json:"encrypt_options"`
+}
+
+type mockHostNICSnapshot struct {
+	ID                          string `json:"id"`
+	Name                        string `json:"name"`
+	HostID                      string `json:"host_id"`
+	MAC                         string `json:"mac"`
+	HasVirtualFunctions         bool   `json:"has_virtual_functions"`
+	MaxNumberOfVirtualFunctions uint   `json:"max_number_of_virtual_functions"`
+	NumberOfVirtualFunctions    uint   `json:"number_of_virtual_functions"`
+	AllNetworksAllowed          bool   `json:"all_networks_allowed"`
+}
+
+type mockDatacenterSnapshot struct {
+	ID            string           `json:"id"`
+	Name          string           `json:"name"`
+	ClusterIDs    []ClusterID      `json:"cluster_ids"`
+	Description   string           `json:"description"`
+	Status        DatacenterStatus `json:"status"`
+	Local         bool             `json:"local"`
+	StorageFormat StorageFormat    `json:"storage_format"`
+}
+
+type mockNetworkSnapshot struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	DatacenterID       string `json:"datacenter_id"`
+	ExternalProviderID string `json:"external_provider_id"`
+}
+
+type mockNetworkProviderSnapshot struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	URL                string `json:"url"`
+	ExternalPluginType string `json:"external_plugin_type"`
+	ReadOnly           bool   `json:"read_only"`
+	AutoSync           bool   `json:"auto_sync"`
+}
+
+type mockExternalNetworkSnapshot struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ProviderID string `json:"provider_id"`
+}
+
+type mockVNICProfileSnapshot struct {
+	ID              string              `json:"id"`
+	NetworkID       string              `json:"network_id"`
+	Name            string              `json:"name"`
+	PortMirroring   bool                `json:"port_mirroring"`
+	PassThroughMode VNICPassThroughMode `json:"pass_through_mode"`
+}
+
+type mockDiskSnapshot struct {
+	ID               DiskID        `json:"id"`
+	Alias            string        `json:"alias"`
+	ProvisionedSize  uint64        `json:"provisioned_size"`
+	Format           ImageFormat   `json:"format"`
+	StorageDomainIDs []string      `json:"storage_domain_ids"`
+	Status           DiskStatus    `json:"status"`
+	TotalSize        uint64        `json:"total_size"`
+	Sparse           bool          `json:"sparse"`
+	QuotaID          QuotaID       `json:"quota_id"`
+	DiskProfileID    DiskProfileID `json:"disk_profile_id"`
+	Data             []byte        `json:"data"`
+}
+
+type mockCPUSnapshot struct {
+	Cores   uint `json:"cores"`
+	Threads uint `json:"threads"`
+	Sockets uint `json:"sockets"`
+}
+
+type mockTemplateSnapshot struct {
+	ID           TemplateID       `json:"id"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	Status       TemplateStatus   `json:"status"`
+	CreationTime time.Time        `json:"creation_time"`
+	CPU          *mockCPUSnapshot `json:"cpu"`
+	TagIDs       []TagID          `json:"tag_ids"`
+}
+
+type mockTemplateDiskAttachmentSnapshot struct {
+	ID            TemplateDiskAttachmentID `json:"id"`
+	TemplateID    TemplateID               `json:"template_id"`
+	DiskID        DiskID                   `json:"disk_id"`
+	DiskInterface DiskInterface            `json:"disk_interface"`
+	Bootable      bool                     `json:"bootable"`
+	Active        bool                     `json:"active"`
+}
+
+type mockVMSnapshot struct {
+	ID                VMID                           `json:"id"`
+	Name              string                         `json:"name"`
+	Comment           string                         `json:"comment"`
+	Description       string                         `json:"description"`
+	ClusterID         ClusterID                      `json:"cluster_id"`
+	TemplateID        TemplateID                     `json:"template_id"`
+	HostID            *string                        `json:"host_id"`
+	Status            VMStatus                       `json:"status"`
+	CreationTime      time.Time                      `json:"creation_time"`
+	CPU               *mockCPUSnapshot               `json:"cpu"`
+	TagIDs            []TagID                        `json:"tag_ids"`
+	HugePages         *VMHugePages                   `json:"huge_pages"`
+	CustomScript      string                         `json:"custom_script"`
+	Hostname          string                         `json:"hostname"`
+	QuotaID           QuotaID                        `json:"quota_id"`
+	InstanceTypeID    InstanceTypeID                 `json:"instance_type_id"`
+	NextRun           bool                           `json:"next_run"`
+	NUMATuneMode      NUMATuneMode                   `json:"numa_tune_mode"`
+	NUMANodes         []mockVMNUMANodeSnapshot       `json:"numa_nodes"`
+	NICConfigurations []mockNICConfigurationSnapshot `json:"nic_configurations"`
+}
+
+type mockNICConfigurationSnapshot struct {
+	Name         string          `json:"name"`
+	BootProtocol NICBootProtocol `json:"boot_protocol"`
+	IP           string          `json:"ip"`
+	Gateway      string          `json:"gateway"`
+}
+
+type mockVMNUMANodeSnapshot struct {
+	Index               uint   `json:"index"`
+	CPUs                []uint `json:"cpus"`
+	Memory              uint64 `json:"memory"`
+	HostNUMANodeIndexes []uint `json:"host_numa_node_indexes"`
+}
+
+type mockStorageDomainVMSnapshot struct {
+	StorageDomainID string         `json:"storage_domain_id"`
+	VM              mockVMSnapshot `json:"vm"`
+}
+
+type mockStorageDomainTemplateSnapshot struct {
+	StorageDomainID string               `json:"storage_domain_id"`
+	Template        mockTemplateSnapshot `json:"template"`
+}
+
+type mockDiskAttachmentSnapshot struct {
+	ID            string        `json:"id"`
+	VMID          VMID          `json:"vm_id"`
+	DiskID        DiskID        `json:"disk_id"`
+	DiskInterface DiskInterface `json:"disk_interface"`
+	Active        bool          `json:"active"`
+	Bootable      bool          `json:"bootable"`
+}
+
+type mockNICSnapshot struct {
+	ID            NICID  `json:"id"`
+	Name          string `json:"name"`
+	VMID          VMID   `json:"vm_id"`
+	VNICProfileID string `json:"vnic_profile_id"`
+	MAC           string `json:"mac"`
+	Plugged       bool   `json:"plugged"`
+	Linked        bool   `json:"linked"`
+}
+
+type mockReportedDeviceSnapshot struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	MAC         string   `json:"mac"`
+	IPAddresses []net.IP `json:"ip_addresses"`
+}
+
+type mockTagSnapshot struct {
+	ID          TagID  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ParentID    TagID  `json:"parent_id"`
+}
+
+type mockQuotaSnapshot struct {
+	ID                  QuotaID                         `json:"id"`
+	Name                string                          `json:"name"`
+	Description         string                          `json:"description"`
+	DatacenterID        string                          `json:"datacenter_id"`
+	ClusterSoftLimitPct uint                            `json:"cluster_soft_limit_pct"`
+	ClusterHardLimitPct uint                            `json:"cluster_hard_limit_pct"`
+	StorageSoftLimitPct uint                            `json:"storage_soft_limit_pct"`
+	StorageHardLimitPct uint                            `json:"storage_hard_limit_pct"`
+	ClusterLimits       []mockQuotaClusterLimitSnapshot `json:"cluster_limits"`
+	StorageLimits       []mockQuotaStorageLimitSnapshot `json:"storage_limits"`
+}
+
+type mockPermissionSnapshot struct {
+	ID            string                  `json:"id"`
+	RoleID        string                  `json:"role_id"`
+	PrincipalType PermissionPrincipalType `json:"principal_type"`
+	PrincipalID   string                  `json:"principal_id"`
+	ObjectType    PermissionObjectType    `json:"object_type"`
+	ObjectID      string                  `json:"object_id"`
+}
+
+type mockUserSnapshot struct {
+	ID         string `json:"id"`
+	UserName   string `json:"user_name"`
+	Principal  string `json:"principal"`
+	Name       string `json:"name"`
+	LastName   string `json:"last_name"`
+	Email      string `json:"email"`
+	DomainName string `json:"domain_name"`
+}
+
+type mockGroupSnapshot struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	DomainName string `json:"domain_name"`
+}
+
+type mockDirectoryDomainSnapshot struct {
+	ID     string              `json:"id"`
+	Name   string              `json:"name"`
+	Users  []mockUserSnapshot  `json:"users"`
+	Groups []mockGroupSnapshot `json:"groups"`
+}
+
+type mockHostProviderSnapshot struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	URL                    string `json:"url"`
+	RequiresAuthentication bool   `json:"requires_authentication"`
+	Username               string `json:"username"`
+}
+
+type mockImageProviderSnapshot struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	URL                    string `json:"url"`
+	RequiresAuthentication bool   `json:"requires_authentication"`
+	Username               string `json:"username"`
+	AuthenticationURL      string `json:"authentication_url"`
+	TenantName             string `json:"tenant_name"`
+}
+
+type mockGlanceImageSnapshot struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProviderID  string `json:"provider_id"`
+	Size        uint64 `json:"size"`
+}
+
+type mockRoleSnapshot struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Administrative bool   `json:"administrative"`
+	Mutable        bool   `json:"mutable"`
+}
+
+type mockInstanceTypeSnapshot struct {
+	ID          InstanceTypeID   `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	CPU         *mockCPUSnapshot `json:"cpu"`
+	Memory      uint64           `json:"memory"`
+	MaxMemory   uint64           `json:"max_memory"`
+}
+
+type mockOperatingSystemSnapshot struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Architecture CPUArchitecture `json:"architecture"`
+}
+
+type mockQuotaClusterLimitSnapshot struct {
+	ID          string    `json:"id"`
+	ClusterID   ClusterID `json:"cluster_id"`
+	VCPULimit   int64     `json:"vcpu_limit"`
+	MemoryLimit int64     `json:"memory_limit"`
+}
+
+type mockQuotaStorageLimitSnapshot struct {
+	ID              string `json:"id"`
+	StorageDomainID string `json:"storage_domain_id"`
+	Limit           int64  `json:"limit"`
+}
+
+func (m *mockClient) Save(w io.Writer) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	snapshot := m.snapshotInfrastructure()
+	m.snapshotWorkloads(snapshot)
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return wrap(err, EUnidentified, "failed to write mock client state")
+	}
+	return nil
+}
+
+func (m *mockClient) Load(r io.Reader) error {
+	snapshot := &mockSnapshot{}
+	if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+		return wrap(err, EBadArgument, "failed to read mock client state")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.restoreInfrastructure(snapshot)
+	m.restoreWorkloads(snapshot)
+	return nil
+}
+
+// snapshotInfrastructure creates a snapshot of the storage domains, clusters, hosts, datacenters and networks.
+func (m *mockClient) snapshotInfrastructure() *mockSnapshot {
+	snapshot := &mockSnapshot{
+		HostNICLabels: m.hostNICLabels,
+		NetworkLabels: m.networkLabels,
+	}
+	for _, sd := range m.storageDomains {
+		snapshot.StorageDomains = append(snapshot.StorageDomains, mockStorageDomainSnapshot{
+			sd.id,
+			sd.name,
+			sd.available,
+			sd.used,
+			sd.committed,
+			sd.storageType,
+			sd.function,
+			sd.status,
+			sd.externalStatus,
+			sd.datacenterIDs,
+		})
+	}
+	m.snapshotClusters(snapshot)
+	m.snapshotHosts(snapshot)
+	for _, dc := range m.dataCenters {
+		snapshot.Datacenters = append(snapshot.Datacenters, mockDatacenterSnapshot{
+			dc.id, dc.name, dc.clusters, dc.description, dc.status, dc.local, dc.storageFormat,
+		})
+	}
+	for _, n := range m.networks {
+		snapshot.Networks = append(snapshot.Networks, mockNetworkSnapshot{n.id, n.name, n.dcID, n.externalProviderID})
+	}
+	for _, p := range m.networkProviders {
+		snapshot.NetworkProviders = append(snapshot.NetworkProviders, mockNetworkProviderSnapshot{
+			p.id, p.name, p.description, p.url, p.externalPluginType, p.readOnly, p.autoSync,
+		})
+	}
+	for _, n := range m.externalNetworks {
+		snapshot.ExternalNetworks = append(snapshot.ExternalNetworks, mockExternalNetworkSnapshot{
+			n.id, n.name, n.providerID,
+		})
+	}
+	for _, p := range m.vnicProfiles {
+		snapshot.VNICProfiles = append(snapshot.VNICProfiles, mockVNICProfileSnapshot{
+			p.id, p.networkID, p.name, p.portMirroring, p.passThroughMode,
+		})
+	}
+	m.snapshotDirectory(snapshot)
+	m.snapshotExternalProviders(snapshot)
+	m.snapshotCatalogs(snapshot)
+	return snapshot
+}
+
+// snapshotExternalProviders adds the external host and image providers and the images on them to the snapshot.
+func (m *mockClient) snapshotExternalProviders(snapshot *mockSnapshot) {
+	for _, p := range m.hostProviders {
+		snapshot.HostProviders = append(snapshot.HostProviders, mockHostProviderSnapshot{
+			p.id, p.name, p.description, p.url, p.requiresAuthentication, p.username,
+		})
+	}
+	for _, p := range m.imageProviders {
+		snapshot.ImageProviders = append(snapshot.ImageProviders, mockImageProviderSnapshot{
+			p.id, p.name, p.description, p.url, p.requiresAuthentication, p.username, p.authenticationURL, p.tenantName,
+		})
+	}
+	for _, i := range m.glanceImages {
+		snapshot.GlanceImages = append(snapshot.GlanceImages, mockGlanceImageSnapshot{
+			i.id, i.name, i.description, i.providerID, i.size,
+		})
+	}
+}
+
+// snapshotDirectory adds the users, groups, directory services and roles to the snapshot.
+func (m *mockClient) snapshotDirectory(snapshot *mockSnapshot) {
+	for _, u := range m.users {
+		snapshot.Users = append(snapshot.Users, snapshotUser(u))
+	}
+	for _, g := range m.groups {
+		snapshot.Groups = append(snapshot.Groups, mockGroupSnapshot{g.id, g.name, g.domainName})
+	}
+	for _, d := range m.directoryDomains {
+		item := mockDirectoryDomainSnapshot{ID: d.id, Name: d.name}
+		for _, u := range m.directoryUsers[d.id] {
+			item.Users = append(item.Users, snapshotUser(u))
+		}
+		for _, g := range m.directoryGroups[d.id] {
+			item.Groups = append(item.Groups, mockGroupSnapshot{g.id, g.name, g.domainName})
+		}
+		snapshot.DirectoryDomains = append(snapshot.DirectoryDomains, item)
+	}
+	for _, r := range m.roles {
+		snapshot.Roles = append(snapshot.Roles, mockRoleSnapshot{
+			r.id, r.name, r.description, r.administrative, r.mutable,
+		})
+	}
+}
+
+// snapshotCatalogs adds the instance types and operating systems offered by the engine to the snapshot.
+func (m *mockClient) snapshotCatalogs(snapshot *mockSnapshot) {
+	for _, i := range m.instanceTypes {
+		snapshot.InstanceTypes = append(snapshot.InstanceTypes, mockInstanceTypeSnapshot{
+			i.id, i.name, i.description, snapshotCPU(&vmCPU{topo: i.cpu}), i.memory, i.maxMemory,
+		})
+	}
+	for _, o := range m.operatingSystems {
+		snapshot.OperatingSystems = append(snapshot.OperatingSystems, mockOperatingSystemSnapshot{
+			o.id, o.name, o.description, o.architecture,
+		})
+	}
+}
+
+func snapshotUser(u *user) mockUserSnapshot {
+	return mockUserSnapshot{u.id, u.userName, u.principal, u.name, u.lastName, u.email, u.domainName}
+}
+
+// snapshotClusters adds the clusters to the snapshot.
+func (m *mockClient) snapshotClusters(snapshot *mockSnapshot) {
+	for _, c := range m.clusters {
+		snapshot.Clusters = append(snapshot.Clusters, mockClusterSnapshot{
+			c.id,
+			c.name,
+			c.cpuArchitecture,
+			c.cpuType,
+			c.compatibilityVersion.major,
+			c.compatibilityVersion.minor,
+			c.biosType,
+			c.memoryOverCommitPercent,
+			c.ballooningEnabled,
+			c.ksmEnabled,
+			c.ksmMergeAcrossNodes,
+		})
+	}
+}
+
+// snapshotHosts adds the hosts, host NICs and fence agents to the snapshot.
+func (m *mockClient) snapshotHosts(snapshot *mockSnapshot) {
+	for _, h := range m.hosts {
+		snapshot.Hosts = append(snapshot.Hosts, mockHostSnapshot{
+			ID:                  h.id,
+			ClusterID:           h.clusterID,
+			Status:              h.status,
+			Name:                h.name,
+			Address:             h.address,
+			CPUName:             h.cpu.name,
+			CPUSpeed:            h.cpu.speed,
+			CPUType:             h.cpu.cpuType,
+			CPUCores:            h.cpu.topo.cores,
+			CPUThreads:          h.cpu.topo.threads,
+			CPUSockets:          h.cpu.topo.sockets,
+			Memory:              h.memory,
+			MaxSchedulingMemory: h.maxSchedulingMemory,
+			NUMASupported:       h.numaSupported,
+			TagIDs:              append([]TagID{}, h.tagIDs...),
+			SSHPort:             h.ssh.port,
+			SSHFingerprint:      h.ssh.fingerprint,
+			SSHPublicKey:        h.ssh.publicKey,
+			CertificateSubject:  h.certificate.subject,
+			CertificateOrg:      h.certificate.organization,
+		})
+	}
+	snapshot.HostNUMANodes = make(map[string][]mockHostNUMANodeSnapshot, len(m.hostNUMANodes))
+	for hostID, nodes := range m.hostNUMANodes {
+		for _, n := range nodes {
+			snapshot.HostNUMANodes[hostID] = append(snapshot.HostNUMANodes[hostID], mockHostNUMANodeSnapshot{
+				n.index, n.memory, n.cpus, n.distances,
+			})
+		}
+	}
+	snapshot.HostHugePages = make(map[string][]mockHostHugePagesSnapshot, len(m.hostHugePages))
+	for hostID, hugePages := range m.hostHugePages {
+		for _, h := range hugePages {
+			snapshot.HostHugePages[hostID] = append(snapshot.HostHugePages[hostID], mockHostHugePagesSnapshot{h.size, h.free})
+		}
+	}
+	m.snapshotHostDevices(snapshot)
+	m.snapshotHostErrata(snapshot)
+	for _, a := range m.hostFenceAgents {
+		snapshot.HostFenceAgents = append(snapshot.HostFenceAgents, mockFenceAgentSnapshot{
+			a.id, a.hostID, a.agentType, a.address, a.port, a.username, a.order, a.options, a.encryptOptions,
+		})
+	}
+	for _, n := range m.hostNICs {
+		item := mockHostNICSnapshot{ID: n.id, Name: n.name, HostID: n.hostID, MAC: n.mac}
+		if n.vfConfig != nil {
+			item.HasVirtualFunctions = true
+			item.MaxNumberOfVirtualFunctions = n.vfConfig.maxNumberOfVirtualFunctions
+			item.NumberOfVirtualFunctions = n.vfConfig.numberOfVirtualFunctions
+			item.AllNetworksAllowed = n.vfConfig.allNetworksAllowed
+		}
+		snapshot.HostNICs = append(snapshot.HostNICs, item)
+	}
+}
+
+// snapshotHostDevices adds the host devices to the snapshot.
+func (m *mockClient) snapshotHostDevices(snapshot *mockSnapshot) {
+	for _, devices := range m.hostDevices {
+		for _, d := range devices {
+			snapshot.HostDevices = append(snapshot.HostDevices, mockHostDeviceSnapshot{
+				d.id, d.hostID, d.name, d.capability, d.vendorID, d.vendorName, d.productID, d.productName,
+				d.parentDeviceName, d.driver,
+			})
+		}
+	}
+}
+
+// snapshotHostErrata adds the errata of the hosts to the snapshot.
+func (m *mockClient) snapshotHostErrata(snapshot *mockSnapshot) {
+	snapshot.HostErrata = make(map[string][]mockErratumSnapshot, len(m.hostErrata))
+	for hostID, errata := range m.hostErrata {
+		for _, e := range errata {
+			snapshot.HostErrata[hostID] = append(snapshot.HostErrata[hostID], mockErratumSnapshot{
+				e.id, e.name, e.title, e.erratumType, e.severity, e.summary, e.solution, e.issued,
+				append([]string{}, e.packages...),
+			})
+		}
+	}
+}
+
+// snapshotWorkloads adds the disks, templates, VMs, NICs and tags to the snapshot.
+func (m *mockClient) snapshotWorkloads(snapshot *mockSnapshot) {
+	for _, d := range m.disks {
+		snapshot.Disks = append(snapshot.Disks, mockDiskSnapshot{
+			d.id, d.alias, d.provisionedSize, d.format, d.storageDomainIDs, d.status, d.totalSize, d.sparse, d.quotaID,
+			d.diskProfileID, d.data,
+		})
+	}
+	for _, t := range m.templates {
+		snapshot.Templates = append(snapshot.Templates, snapshotTemplate(t))
+		for _, a := range m.templateDiskAttachmentsByTemplate[t.id] {
+			snapshot.TemplateDiskAttachments = append(
+				snapshot.TemplateDiskAttachments,
+				mockTemplateDiskAttachmentSnapshot{a.id, a.templateID, a.diskID, a.diskInterface, a.bootable, a.active},
+			)
+		}
+	}
+	for _, v := range m.vms {
+		item := snapshotVM(v)
+		for _, node := range m.vmNUMANodes[v.id] {
+			item.NUMANodes = append(item.NUMANodes, mockVMNUMANodeSnapshot{
+				node.Index(), append([]uint{}, node.CPUs()...), node.Memory(), append([]uint{}, node.HostNUMANodeIndexes()...),
+			})
+		}
+		snapshot.VMs = append(snapshot.VMs, item)
+		for _, a := range m.vmDiskAttachmentsByVM[v.id] {
+			snapshot.DiskAttachments = append(snapshot.DiskAttachments, mockDiskAttachmentSnapshot{
+				a.id, a.vmid, a.diskID, a.diskInterface, a.active, a.bootable,
+			})
+		}
+	}
+	for _, n := range m.nics {
+		snapshot.NICs = append(snapshot.NICs, mockNICSnapshot{
+			n.id, n.name, n.vmid, n.vnicProfileID, n.mac, n.plugged, n.linked,
+		})
+	}
+	snapshot.NICReportedDevices = make(map[NICID]mockReportedDeviceSnapshot, len(m.nicReportedDevices))
+	for nicID, d := range m.nicReportedDevices {
+		snapshot.NICReportedDevices[nicID] = mockReportedDeviceSnapshot{d.id, d.name, d.mac, d.ipAddresses}
+	}
+	for _, t := range m.tags {
+		snapshot.Tags = append(snapshot.Tags, mockTagSnapshot{t.id, t.name, t.description, t.parentID})
+	}
+	m.snapshotStorageDomainEntities(snapshot)
+	m.snapshotQuotas(snapshot)
+	for _, p := range m.permissions {
+		snapshot.Permissions = append(snapshot.Permissions, mockPermissionSnapshot{
+			p.id, p.roleID, p.principal.principalType, p.principal.id, p.objectType, p.objectID,
+		})
+	}
+}
+
+// snapshotQuotas adds the quotas and their limits to the snapshot.
+func (m *mockClient) snapshotQuotas(snapshot *mockSnapshot) {
+	for _, q := range m.quotas {
+		item := mockQuotaSnapshot{
+			q.id, q.name, q.description, q.datacenterID, q.clusterSoftLimitPct, q.clusterHardLimitPct,
+			q.storageSoftLimitPct, q.storageHardLimitPct, nil, nil,
+		}
+		for _, l := range m.quotaClusterLimits[q.id] {
+			item.ClusterLimits = append(item.ClusterLimits, mockQuotaClusterLimitSnapshot{
+				l.id, l.clusterID, l.vcpuLimit, l.memoryLimit,
+			})
+		}
+		for _, l := range m.quotaStorageLimits[q.id] {
+			item.StorageLimits = append(
+				item.StorageLimits,
+				mockQuotaStorageLimitSnapshot{l.id, l.storageDomainID, l.limit},
+			)
+		}
+		snapshot.Quotas = append(snapshot.Quotas, item)
+	}
+}
+
+// snapshotStorageDomainEntities adds the VMs and templates stored on storage domains to the snapshot.
+func (m *mockClient) snapshotStorageDomainEntities(snapshot *mockSnapshot) {
+	for storageDomainID, vms := range m.storageDomainVMs {
+		for _, v := range vms {
+			snapshot.StorageDomainVMs = append(
+				snapshot.StorageDomainVMs,
+				mockStorageDomainVMSnapshot{storageDomainID, snapshotVM(&v.vm)},
+			)
+		}
+	}
+	for storageDomainID, templates := range m.storageDomainTemplates {
+		for _, t := range templates {
+			snapshot.StorageDomainTemplates = append(
+				snapshot.StorageDomainTemplates,
+				mockStorageDomainTemplateSnapshot{storageDomainID, snapshotTemplate(&t.template)},
+			)
+		}
+	}
+}
+
+func snapshotVM(v *vm) mockVMSnapshot {
+	item := mockVMSnapshot{
+		ID: v.id, Name: v.name, Comment: v.comment, Description: v.description, ClusterID: v.clusterID,
+		TemplateID: v.templateID, HostID: v.hostID, Status: v.status, CreationTime: v.creationTime,
+		CPU: snapshotCPU(v.cpu), TagIDs: v.tagIDs, HugePages: v.hugePages, QuotaID: v.quotaID,
+		InstanceTypeID: v.instanceTypeID, NextRun: v.nextRun, NUMATuneMode: v.numaTuneMode,
+	}
+	if v.initialization != nil {
+		item.CustomScript = v.initialization.CustomScript()
+		item.Hostname = v.initialization.HostName()
+		item.NICConfigurations = snapshotNICConfigurations(v.initialization.NICConfigurations())
+	}
+	return item
+}
+
+func snapshotNICConfigurations(nicConfigurations []NICConfiguration) []mockNICConfigurationSnapshot {
+	var result []mockNICConfigurationSnapshot
+	for _, nicConfiguration := range nicConfigurations {
+		item := mockNICConfigurationSnapshot{Name: nicConfiguration.Name(), BootProtocol: nicConfiguration.BootProtocol()}
+		if ip := nicConfiguration.IP(); ip != nil {
+			item.IP = ip.String()
+		}
+		if gateway := nicConfiguration.Gateway(); gateway != nil {
+			item.Gateway = gateway.String()
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func restoreNICConfigurations(items []mockNICConfigurationSnapshot) []NICConfiguration {
+	var result []NICConfiguration
+	for _, item := range items {
+		nicConfiguration := &nicConfiguration{name: item.Name, bootProtocol: item.BootProtocol}
+		if ip, ipNet, err := net.ParseCIDR(item.IP); err == nil {
+			nicConfiguration.ip = &net.IPNet{IP: ip, Mask: ipNet.Mask}
+		}
+		nicConfiguration.gateway = net.ParseIP(item.Gateway)
+		result = append(result, nicConfiguration)
+	}
+	return result
+}
+
+func snapshotTemplate(t *template) mockTemplateSnapshot {
+	return mockTemplateSnapshot{
+		t.id, t.name, t.description, t.status, t.creationTime, snapshotCPU(t.cpu), append([]TagID{}, t.tagIDs...),
+	}
+}
+
+func snapshotCPU(cpu *vmCPU) *mockCPUSnapshot {
+	if cpu == nil || cpu.topo == nil {
+		return nil
+	}
+	return &mockCPUSnapshot{cpu.topo.cores, cpu.topo.threads, cpu.topo.sockets}
+}
+
+func restoreCPU(cpu *mockCPUSnapshot) *vmCPU {
+	if cpu == nil {
+		return nil
+	}
+	return &vmCPU{
+		topo: &vmCPUTopo{
+			cores:   cpu.Cores,
+			threads: cpu.Threads,
+			sockets: cpu.Sockets,
+		},
+	}
+}
+
+// restoreInfrastructure replaces the storage domains, clusters, hosts, datacenters and networks with the ones in
+// the snapshot.
+func (m *mockClient) restoreInfrastructure(snapshot *mockSnapshot) {
+	m.storageDomains = make(map[string]*storageDomain, len(snapshot.StorageDomains))
+	for _, sd := range snapshot.StorageDomains {
+		m.storageDomains[sd.ID] = &storageDomain{
+			m,
+			sd.ID,
+			sd.Name,
+			sd.Available,
+			sd.Used,
+			sd.Committed,
+			sd.StorageType,
+			sd.Function,
+			sd.Status,
+			sd.ExternalStatus,
+			sd.DatacenterIDs,
+		}
+	}
+	m.restoreClusters(snapshot)
+	m.restoreHosts(snapshot)
+	m.dataCenters = make(map[string]*datacenterWithClusters, len(snapshot.Datacenters))
+	for _, dc := range snapshot.Datacenters {
+		m.dataCenters[dc.ID] = &datacenterWithClusters{
+			datacenter{m, dc.ID, dc.Name, dc.Description, dc.Status, dc.Local, dc.StorageFormat},
+			dc.ClusterIDs,
+		}
+	}
+	m.networks = make(map[string]*network, len(snapshot.Networks))
+	for _, n := range snapshot.Networks {
+		m.networks[n.ID] = &network{m, n.ID, n.Name, n.DatacenterID, n.ExternalProviderID}
+	}
+	m.networkLabels = restoreLabels(snapshot.NetworkLabels)
+	m.networkProviders = make(map[string]*networkProvider, len(snapshot.NetworkProviders))
+	for _, p := range snapshot.NetworkProviders {
+		m.networkProviders[p.ID] = &networkProvider{
+			m, p.ID, p.Name, p.Description, p.URL, p.ExternalPluginType, p.ReadOnly, p.AutoSync,
+		}
+	}
+	m.externalNetworks = make(map[string]*externalNetwork, len(snapshot.ExternalNetworks))
+	for _, n := range snapshot.ExternalNetworks {
+		m.externalNetworks[n.ID] = &externalNetwork{m, n.ID, n.Name, n.ProviderID}
+	}
+	m.vnicProfiles = make(map[string]*vnicProfile, len(snapshot.VNICProfiles))
+	for _, p := range snapshot.VNICProfiles {
+		m.vnicProfiles[p.ID] = &vnicProfile{m, p.ID, p.NetworkID, p.Name, p.PortMirroring, p.PassThroughMode}
+	}
+	m.restoreDirectory(snapshot)
+	m.restoreExternalProviders(snapshot)
+	m.restoreCatalogs(snapshot)
+}
+
+// restoreExternalProviders replaces the external host and image providers and their images with the ones in the
+// snapshot.
+func (m *mockClient) restoreExternalProviders(snapshot *mockSnapshot) {
+	m.hostProviders = make(map[string]*hostProvider, len(snapshot.HostProviders))
+	for _, p := range snapshot.HostProviders {
+		m.hostProviders[p.ID] = &hostProvider{p.ID, p.Name, p.Description, p.URL, p.RequiresAuthentication, p.Username}
+	}
+	m.imageProviders = make(map[string]*imageProvider, len(snapshot.ImageProviders))
+	for _, p := range snapshot.ImageProviders {
+		m.imageProviders[p.ID] = &imageProvider{
+			m, p.ID, p.Name, p.Description, p.URL, p.RequiresAuthentication, p.Username, p.AuthenticationURL, p.TenantName,
+		}
+	}
+	m.glanceImages = make(map[string]*glanceImageWithData, len(snapshot.GlanceImages))
+	for _, i := range snapshot.GlanceImages {
+		m.glanceImages[i.ID] = &glanceImageWithData{glanceImage{m, i.ID, i.Name, i.Description, i.ProviderID}, i.Size}
+	}
+}
+
+// restoreDirectory replaces the users, groups, directory services and roles with the ones in the snapshot.
+func (m *mockClient) restoreDirectory(snapshot *mockSnapshot) {
+	m.users = make(map[string]*user, len(snapshot.Users))
+	for _, u := range snapshot.Users {
+		m.users[u.ID] = m.restoreUser(u)
+	}
+	m.groups = make(map[string]*group, len(snapshot.Groups))
+	for _, g := range snapshot.Groups {
+		m.groups[g.ID] = &group{m, g.ID, g.Name, g.DomainName}
+	}
+	m.directoryDomains = make(map[string]*directoryDomain, len(snapshot.DirectoryDomains))
+	m.directoryUsers = make(map[string][]*user, len(snapshot.DirectoryDomains))
+	m.directoryGroups = make(map[string][]*group, len(snapshot.DirectoryDomains))
+	for _, d := range snapshot.DirectoryDomains {
+		m.directoryDomains[d.ID] = &directoryDomain{d.ID, d.Name}
+		m.directoryUsers[d.ID] = []*user{}
+		for _, u := range d.Users {
+			m.directoryUsers[d.ID] = append(m.directoryUsers[d.ID], m.restoreUser(u))
+		}
+		m.directoryGroups[d.ID] = []*group{}
+		for _, g := range d.Groups {
+			m.directoryGroups[d.ID] = append(m.directoryGroups[d.ID], &group{m, g.ID, g.Name, g.DomainName})
+		}
+	}
+	m.roles = make(map[string]*role, len(snapshot.Roles))
+	for _, r := range snapshot.Roles {
+		m.roles[r.ID] = &role{r.ID, r.Name, r.Description, r.Administrative, r.Mutable}
+	}
+}
+
+// restoreCatalogs replaces the instance types and operating systems with the ones in the snapshot.
+func (m *mockClient) restoreCatalogs(snapshot *mockSnapshot) {
+	m.instanceTypes = make(map[InstanceTypeID]*instanceType, len(snapshot.InstanceTypes))
+	for _, i := range snapshot.InstanceTypes {
+		m.instanceTypes[i.ID] = &instanceType{
+			i.ID, i.Name, i.Description, restoreCPU(i.CPU).topo, i.Memory, i.MaxMemory,
+		}
+	}
+	m.operatingSystems = make(map[string]*operatingSystem, len(snapshot.OperatingSystems))
+	for _, o := range snapshot.OperatingSystems {
+		m.operatingSystems[o.ID] = &operatingSystem{o.ID, o.Name, o.Description, o.Architecture}
+	}
+}
+
+func (m *mockClient) restoreUser(u mockUserSnapshot) *user {
+	return &user{m, u.ID, u.UserName, u.Principal, u.Name, u.LastName, u.Email, u.DomainName}
+}
+
+// restoreClusters replaces the clusters with the ones in the snapshot.
+func (m *mockClient) restoreClusters(snapshot *mockSnapshot) {
+	m.clusters = make(map[ClusterID]*cluster, len(snapshot.Clusters))
+	for _, c := range snapshot.Clusters {
+		m.clusters[c.ID] = &cluster{
+			m,
+			c.ID,
+			c.Name,
+			c.CPUArchitecture,
+			c.CPUType,
+			&clusterVersion{c.CompatibilityVersionMajor, c.CompatibilityVersionMinor},
+			c.BIOSType,
+			c.MemoryOverCommitPercent,
+			c.BallooningEnabled,
+			c.KSMEnabled,
+			c.KSMMergeAcrossNodes,
+		}
+	}
+}
+
+// restoreHosts replaces the hosts, host NICs and fence agents with the ones in the snapshot.
+func (m *mockClient) restoreHosts(snapshot *mockSnapshot) {
+	m.hosts = make(map[string]*host, len(snapshot.Hosts))
+	for _, h := range snapshot.Hosts {
+		m.hosts[h.ID] = &host{
+			client:    m,
+			id:        h.ID,
+			clusterID: h.ClusterID,
+			status:    h.Status,
+			name:      h.Name,
+			address:   h.Address,
+			cpu: &hostCPU{
+				name:    h.CPUName,
+				speed:   h.CPUSpeed,
+				cpuType: h.CPUType,
+				topo:    &vmCPUTopo{cores: h.CPUCores, threads: h.CPUThreads, sockets: h.CPUSockets},
+			},
+			memory:              h.Memory,
+			maxSchedulingMemory: h.MaxSchedulingMemory,
+			numaSupported:       h.NUMASupported,
+			tagIDs:              append([]TagID{}, h.TagIDs...),
+			ssh:                 &hostSSH{h.SSHPort, h.SSHFingerprint, h.SSHPublicKey},
+			certificate:         &hostCertificate{h.CertificateSubject, h.CertificateOrg},
+		}
+	}
+	m.hostNUMANodes = make(map[string][]*hostNUMANode, len(snapshot.HostNUMANodes))
+	for hostID, nodes := range snapshot.HostNUMANodes {
+		for _, n := range nodes {
+			m.hostNUMANodes[hostID] = append(m.hostNUMANodes[hostID], &hostNUMANode{
+				n.Index, n.Memory, append([]uint{}, n.CPUs...), append([]uint{}, n.Distances...),
+			})
+		}
+	}
+	m.hostHugePages = make(map[string][]*hostHugePages, len(snapshot.HostHugePages))
+	for hostID, hugePages := range snapshot.HostHugePages {
+		for _, h := range hugePages {
+			m.hostHugePages[hostID] = append(m.hostHugePages[hostID], &hostHugePages{h.Size, h.Free})
+		}
+	}
+	m.restoreHostDevices(snapshot)
+	m.restoreHostErrata(snapshot)
+	m.hostNICs = make(map[string]*hostNIC, len(snapshot.HostNICs))
+	for _, n := range snapshot.HostNICs {
+		item := &hostNIC{client: m, id: n.ID, name: n.Name, hostID: n.HostID, mac: n.MAC}
+		if n.HasVirtualFunctions {
+			item.vfConfig = &hostNICVirtualFunctionsConfiguration{
+				n.MaxNumberOfVirtualFunctions, n.NumberOfVirtualFunctions, n.AllNetworksAllowed,
+			}
+		}
+		m.hostNICs[n.ID] = item
+	}
+	m.hostNICLabels = restoreLabels(snapshot.HostNICLabels)
+	m.hostFenceAgents = make(map[string]*fenceAgent, len(snapshot.HostFenceAgents))
+	for _, a := range snapshot.HostFenceAgents {
+		options := make(map[string]string, len(a.Options))
+		for name, value := range a.Options {
+			options[name] = value
+		}
+		m.hostFenceAgents[a.ID] = &fenceAgent{
+			m, a.ID, a.HostID, a.Type, a.Address, a.Port, a.Username, a.Order, options, a.EncryptOptions,
+		}
+	}
+}
+
+// restoreHostDevices replaces the host devices with the ones in the snapshot.
+func (m *mockClient) restoreHostDevices(snapshot *mockSnapshot) {
+	m.hostDevices = make(map[string][]*hostDevice, len(m.hosts))
+	for _, d := range snapshot.HostDevices {
+		m.hostDevices[d.HostID] = append(m.hostDevices[d.HostID], &hostDevice{
+			d.ID, d.HostID, d.Name, d.Capability, d.VendorID, d.VendorName, d.ProductID, d.ProductName,
+			d.ParentDeviceName, d.Driver,
+		})
+	}
+}
+
+// restoreHostErrata replaces the errata of the hosts with the ones in the snapshot.
+func (m *mockClient) restoreHostErrata(snapshot *mockSnapshot) {
+	m.hostErrata = make(map[string][]*erratum, len(snapshot.HostErrata))
+	for hostID, errata := range snapshot.HostErrata {
+		for _, e := range errata {
+			m.hostErrata[hostID] = append(m.hostErrata[hostID], &erratum{
+				e.ID, e.Name, e.Title, e.Type, e.Severity, e.Summary, e.Solution, e.Issued,
+				append([]string{}, e.Packages...),
+			})
+		}
+	}
+}
+
+// restoreWorkloads replaces the disks, templates, VMs and tags with the ones in the snapshot.
+func (m *mockClient) restoreWorkloads(snapshot *mockSnapshot) {
+	m.disks = make(map[DiskID]*diskWithData, len(snapshot.Disks))
+	for _, d := range snapshot.Disks {
+		m.disks[d.ID] = &diskWithData{
+			disk{
+				m, d.ID, d.Alias, d.ProvisionedSize, d.Format, d.StorageDomainIDs, d.Status, d.TotalSize, d.Sparse,
+				d.QuotaID, d.DiskProfileID,
+			},
+			&sync.Mutex{},
+			d.Data,
+		}
+	}
+	m.templates = make(map[TemplateID]*template, len(snapshot.Templates))
+	m.templateDiskAttachmentsByTemplate = make(map[TemplateID][]*templateDiskAttachment, len(snapshot.Templates))
+	m.templateDiskAttachmentsByDisk = make(map[DiskID]*templateDiskAttachment, len(snapshot.TemplateDiskAttachments))
+	for _, t := range snapshot.Templates {
+		m.templates[t.ID] = m.restoreTemplate(t)
+		m.templateDiskAttachmentsByTemplate[t.ID] = []*templateDiskAttachment{}
+	}
+	for _, a := range snapshot.TemplateDiskAttachments {
+		attachment := &templateDiskAttachment{m, a.ID, a.TemplateID, a.DiskID, a.DiskInterface, a.Bootable, a.Active}
+		m.templateDiskAttachmentsByTemplate[a.TemplateID] = append(
+			m.templateDiskAttachmentsByTemplate[a.TemplateID],
+			attachment,
+		)
+		m.templateDiskAttachmentsByDisk[a.DiskID] = attachment
+	}
+	m.restoreVMs(snapshot)
+	m.tags = make(map[TagID]*tag, len(snapshot.Tags))
+	for _, t := range snapshot.Tags {
+		m.tags[t.ID] = &tag{m, t.ID, t.Name, t.Description, t.ParentID}
+	}
+	m.restoreStorageDomainEntities(snapshot)
+	m.restoreQuotas(snapshot)
+	m.permissions = make(map[string]*permission, len(snapshot.Permissions))
+	for _, p := range snapshot.Permissions {
+		m.permissions[p.ID] = &permission{
+			m, p.ID, p.RoleID, &permissionPrincipal{p.PrincipalType, p.PrincipalID}, p.ObjectType, p.ObjectID,
+		}
+	}
+}
+
+// restoreQuotas replaces the quotas and their limits with the ones in the snapshot.
+func (m *mockClient) restoreQuotas(snapshot *mockSnapshot) {
+	m.quotas = make(map[QuotaID]*quota, len(snapshot.Quotas))
+	m.quotaClusterLimits = make(map[QuotaID][]*quotaClusterLimit, len(snapshot.Quotas))
+	m.quotaStorageLimits = make(map[QuotaID][]*quotaStorageLimit, len(snapshot.Quotas))
+	for _, q := range snapshot.Quotas {
+		m.quotas[q.ID] = &quota{
+			m, q.ID, q.Name, q.Description, q.DatacenterID, q.ClusterSoftLimitPct, q.ClusterHardLimitPct,
+			q.StorageSoftLimitPct, q.StorageHardLimitPct,
+		}
+		m.quotaClusterLimits[q.ID] = []*quotaClusterLimit{}
+		for _, l := range q.ClusterLimits {
+			m.quotaClusterLimits[q.ID] = append(
+				m.quotaClusterLimits[q.ID],
+				&quotaClusterLimit{l.ID, q.ID, l.ClusterID, l.VCPULimit, 0, l.MemoryLimit, 0},
+			)
+		}
+		m.quotaStorageLimits[q.ID] = []*quotaStorageLimit{}
+		for _, l := range q.StorageLimits {
+			m.quotaStorageLimits[q.ID] = append(
+				m.quotaStorageLimits[q.ID],
+				&quotaStorageLimit{l.ID, q.ID, l.StorageDomainID, l.Limit, 0},
+			)
+		}
+	}
+}
+
+// restoreStorageDomainEntities replaces the VMs and templates stored on storage domains with the ones in the
+// snapshot.
+func (m *mockClient) restoreStorageDomainEntities(snapshot *mockSnapshot) {
+	m.storageDomainVMs = map[string]map[VMID]*storageDomainVMWithData{}
+	for _, item := range snapshot.StorageDomainVMs {
+		if _, ok := m.storageDomainVMs[item.StorageDomainID]; !ok {
+			m.storageDomainVMs[item.StorageDomainID] = map[VMID]*storageDomainVMWithData{}
+		}
+		m.storageDomainVMs[item.StorageDomainID][item.VM.ID] = &storageDomainVMWithData{
+			storageDomainVM{m, item.VM.ID, item.VM.Name, item.StorageDomainID},
+			*m.restoreVM(item.VM),
+		}
+	}
+	m.storageDomainTemplates = map[string]map[TemplateID]*storageDomainTemplateWithData{}
+	for _, item := range snapshot.StorageDomainTemplates {
+		if _, ok := m.storageDomainTemplates[item.StorageDomainID]; !ok {
+			m.storageDomainTemplates[item.StorageDomainID] = map[TemplateID]*storageDomainTemplateWithData{}
+		}
+		m.storageDomainTemplates[item.StorageDomainID][item.Template.ID] = &storageDomainTemplateWithData{
+			storageDomainTemplate{m, item.Template.ID, item.Template.Name, item.StorageDomainID},
+			*m.restoreTemplate(item.Template),
+		}
+	}
+}
+
+func (m *mockClient) restoreTemplate(t mockTemplateSnapshot) *template {
+	return &template{
+		m, t.ID, t.Name, t.Description, t.Status, t.CreationTime, restoreCPU(t.CPU), append([]TagID{}, t.TagIDs...),
+	}
+}
+
+// restoreVMs replaces the VMs and their disk attachments and NICs with the ones in the snapshot.
+func (m *mockClient) restoreVMs(snapshot *mockSnapshot) {
+	m.vms = make(map[VMID]*vm, len(snapshot.VMs))
+	m.vmDiskAttachmentsByVM = make(map[VMID]map[string]*diskAttachment, len(snapshot.VMs))
+	m.vmDiskAttachmentsByDisk = make(map[DiskID]*diskAttachment, len(snapshot.DiskAttachments))
+	m.vmNUMANodes = make(map[VMID][]VMNUMANode, len(snapshot.VMs))
+	m.vmBackups = make(map[VMID][]*vmBackup, len(snapshot.VMs))
+	m.vmCheckpoints = make(map[VMID][]*vmCheckpoint, len(snapshot.VMs))
+	for _, v := range snapshot.VMs {
+		m.vms[v.ID] = m.restoreVM(v)
+		m.vmDiskAttachmentsByVM[v.ID] = map[string]*diskAttachment{}
+		m.vmNUMANodes[v.ID] = []VMNUMANode{}
+		for _, n := range v.NUMANodes {
+			m.vmNUMANodes[v.ID] = append(m.vmNUMANodes[v.ID], &vmNUMANode{n.Index, n.CPUs, n.Memory, n.HostNUMANodeIndexes})
+		}
+	}
+	for _, a := range snapshot.DiskAttachments {
+		attachment := &diskAttachment{m, a.ID, a.VMID, a.DiskID, a.DiskInterface, a.Active, a.Bootable}
+		if _, ok := m.vmDiskAttachmentsByVM[a.VMID]; !ok {
+			m.vmDiskAttachmentsByVM[a.VMID] = map[string]*diskAttachment{}
+		}
+		m.vmDiskAttachmentsByVM[a.VMID][a.ID] = attachment
+		m.vmDiskAttachmentsByDisk[a.DiskID] = attachment
+	}
+	m.nics = make(map[NICID]*nic, len(snapshot.NICs))
+	for _, n := range snapshot.NICs {
+		m.nics[n.ID] = &nic{m, n.ID, n.Name, n.VMID, n.VNICProfileID, n.MAC, n.Plugged, n.Linked}
+	}
+	m.nicReportedDevices = make(map[NICID]*reportedDevice, len(snapshot.NICReportedDevices))
+	for nicID, d := range snapshot.NICReportedDevices {
+		m.nicReportedDevices[nicID] = &reportedDevice{d.ID, d.Name, d.MAC, d.IPAddresses}
+	}
+}
+
+func (m *mockClient) restoreVM(v mockVMSnapshot) *vm {
+	return &vm{
+		client:       m,
+		id:           v.ID,
+		name:         v.Name,
+		comment:      v.Comment,
+		description:  v.Description,
+		clusterID:    v.ClusterID,
+		templateID:   v.TemplateID,
+		hostID:       v.HostID,
+		status:       v.Status,
+		creationTime: v.CreationTime,
+		cpu:          restoreCPU(v.CPU),
+		tagIDs:       v.TagIDs,
+		hugePages:    v.HugePages,
+		initialization: &initialization{
+			customScript:      v.CustomScript,
+			hostname:          v.Hostname,
+			nicConfigurations: restoreNICConfigurations(v.NICConfigurations),
+		},
+		quotaID:        v.QuotaID,
+		instanceTypeID: v.InstanceTypeID,
+		nextRun:        v.NextRun,
+		numaTuneMode:   v.NUMATuneMode,
+	}
+}
+
+func restoreLabels(labels map[string][]string) map[string][]string {
+	if labels == nil {
+		return map[string][]string{}
+	}
+	return labels
+}
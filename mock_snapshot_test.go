@@ -0,0 +1,86 @@
+package ovirtclient_test
+
+import (
+	"bytes"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestMockSaveLoad(t *testing.T) {
+	t.Parallel()
+	source := ovirtclient.NewMock()
+	clusters, err := source.ListClusters()
+	if err != nil {
+		t.Fatalf("failed to list clusters (%v)", err)
+	}
+	storageDomain, err := source.SelectStorageDomain(nil)
+	if err != nil {
+		t.Fatalf("failed to select storage domain (%v)", err)
+	}
+	vm, err := source.CreateVM(
+		clusters[0].ID(),
+		ovirtclient.DefaultBlankTemplateID,
+		"snapshot-test",
+		ovirtclient.CreateVMParams().MustWithComment("saved by the snapshot test"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create VM (%v)", err)
+	}
+	disk, err := source.CreateDisk(storageDomain.ID(), ovirtclient.ImageFormatRaw, 512, nil)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+	if _, err := source.CreateDiskAttachment(vm.ID(), disk.ID(), ovirtclient.DiskInterfaceVirtIO, nil); err != nil {
+		t.Fatalf("failed to attach disk (%v)", err)
+	}
+
+	state := &bytes.Buffer{}
+	if err := source.Save(state); err != nil {
+		t.Fatalf("failed to save mock client state (%v)", err)
+	}
+	target := ovirtclient.NewMock()
+	if err := target.Load(state); err != nil {
+		t.Fatalf("failed to load mock client state (%v)", err)
+	}
+
+	loadedVM, err := target.GetVM(vm.ID())
+	if err != nil {
+		t.Fatalf("the saved VM was not loaded (%v)", err)
+	}
+	if loadedVM.Name() != vm.Name() || loadedVM.Comment() != vm.Comment() {
+		t.Fatalf("the loaded VM does not match the saved VM")
+	}
+	if loadedVM.ClusterID() != clusters[0].ID() {
+		t.Fatalf("the loaded VM has an incorrect cluster ID (%s)", loadedVM.ClusterID())
+	}
+	loadedCluster, err := target.GetCluster(clusters[0].ID())
+	if err != nil || loadedCluster.CompatibilityVersion().String() != clusters[0].CompatibilityVersion().String() {
+		t.Fatalf("the saved cluster was not loaded correctly (%v)", err)
+	}
+	attachments, err := target.ListDiskAttachments(vm.ID())
+	if err != nil {
+		t.Fatalf("failed to list disk attachments of the loaded VM (%v)", err)
+	}
+	if len(attachments) != 1 || attachments[0].DiskID() != disk.ID() {
+		t.Fatalf("the disk attachment of the saved VM was not loaded")
+	}
+	if _, err := target.GetDisk(disk.ID()); err != nil {
+		t.Fatalf("the saved disk was not loaded (%v)", err)
+	}
+}
+
+func TestMockLoadInvalidState(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	err := client.Load(bytes.NewBufferString("not json"))
+	if err == nil {
+		t.Fatalf("loading an invalid state did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("loading an invalid state returned an unexpected error (%v)", err)
+	}
+	if _, err := client.ListVMs(); err != nil {
+		t.Fatalf("the mock client is unusable after loading an invalid state (%v)", err)
+	}
+}
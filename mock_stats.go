@@ -0,0 +1,5 @@
+package ovirtclient
+
+func (m *mockClient) Stats() ClientStats {
+	return m.stats.snapshot()
+}
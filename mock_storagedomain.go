@@ -0,0 +1,106 @@
+package ovirtclient
+
+// reserveStorage reduces the available space of the storage domain by size bytes and adds them to the used and
+// committed space. The mock does not distinguish sparse disks, so the used and committed space are always the same.
+// It returns an error if the storage domain does not have enough space left.
+func (m *mockClient) reserveStorage(storageDomainID string, size uint64) error {
+	sd, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if sd.function != StorageDomainFunctionData {
+		return newError(EBadArgument, "cannot create disks on %s storage domain %s", sd.function, storageDomainID)
+	}
+	if sd.available < size {
+		return newError(
+			EConflict,
+			"not enough space on storage domain %s (requested: %d bytes, available: %d bytes)",
+			storageDomainID,
+			size,
+			sd.available,
+		)
+	}
+	sd.available -= size
+	sd.used += size
+	sd.committed += size
+	return nil
+}
+
+// useStorage reduces the available space of the storage domains by size bytes for disks that are created as a side
+// effect of another call, such as cloning the disks of a template. The available space never drops below zero.
+func (m *mockClient) useStorage(storageDomainIDs []string, size uint64) {
+	for _, storageDomainID := range storageDomainIDs {
+		if sd, ok := m.storageDomains[storageDomainID]; ok {
+			if sd.available < size {
+				sd.available = 0
+			} else {
+				sd.available -= size
+			}
+			sd.used += size
+			sd.committed += size
+		}
+	}
+}
+
+// releaseStorage returns size bytes to the available space of the storage domains when a disk is removed.
+func (m *mockClient) releaseStorage(storageDomainIDs []string, size uint64) {
+	for _, storageDomainID := range storageDomainIDs {
+		if sd, ok := m.storageDomains[storageDomainID]; ok {
+			sd.available += size
+			sd.used -= minUint64(sd.used, size)
+			sd.committed -= minUint64(sd.committed, size)
+		}
+	}
+}
+
+func minUint64(a uint64, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// getAttachedStorageDomain returns a copy of the storage domain if it is attached to the datacenter. The copy can be
+// modified and stored in place of the original.
+func (m *mockClient) getAttachedStorageDomain(datacenterID string, id string) (*storageDomain, error) {
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return nil, newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	for _, attachedDatacenterID := range sd.datacenterIDs {
+		if attachedDatacenterID == datacenterID {
+			sdCopy := *sd
+			return &sdCopy, nil
+		}
+	}
+	return nil, newError(ENotFound, "storage domain %s is not attached to datacenter %s", id, datacenterID)
+}
+
+// updateDatacenterStatus sets the status of the datacenter based on the storage domains attached to it, similar to
+// the engine. The datacenter is up if it has an active storage domain, in maintenance if all of its storage domains
+// are in maintenance, and uninitialized if it has no storage domains.
+func (m *mockClient) updateDatacenterStatus(datacenterID string) {
+	dc, ok := m.dataCenters[datacenterID]
+	if !ok {
+		return
+	}
+	status := DatacenterStatusUninitialized
+	for _, sd := range m.storageDomains {
+		for _, attachedDatacenterID := range sd.datacenterIDs {
+			if attachedDatacenterID != datacenterID {
+				continue
+			}
+			if sd.status == StorageDomainStatusActive {
+				status = DatacenterStatusUp
+			} else if status == DatacenterStatusUninitialized {
+				status = DatacenterStatusMaintenance
+			}
+		}
+	}
+	dcCopy := *dc
+	dcCopy.status = status
+	m.dataCenters[datacenterID] = &dcCopy
+}
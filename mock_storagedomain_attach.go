@@ -0,0 +1,25 @@
+package ovirtclient
+
+func (m *mockClient) AttachStorageDomain(datacenterID string, id string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.dataCenters[datacenterID]; !ok {
+		return newError(ENotFound, "datacenter with ID %s not found", datacenterID)
+	}
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	if len(sd.datacenterIDs) > 0 {
+		return newError(EConflict, "storage domain %s is already attached to datacenter %s", id, sd.datacenterIDs[0])
+	}
+
+	sdCopy := *sd
+	sdCopy.datacenterIDs = []string{datacenterID}
+	sdCopy.status = StorageDomainStatusActive
+	m.storageDomains[id] = &sdCopy
+	m.updateDatacenterStatus(datacenterID)
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package ovirtclient
+
+func (m *mockClient) DeactivateStorageDomain(datacenterID string, id string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	sd, err := m.getAttachedStorageDomain(datacenterID, id)
+	if err != nil {
+		return err
+	}
+	if sd.status != StorageDomainStatusActive {
+		return newError(EConflict, "storage domain %s cannot be deactivated in status %s", id, sd.status)
+	}
+
+	sd.status = StorageDomainStatusMaintenance
+	m.storageDomains[id] = sd
+	m.updateDatacenterStatus(datacenterID)
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package ovirtclient
+
+func (m *mockClient) DetachStorageDomain(datacenterID string, id string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	sd, err := m.getAttachedStorageDomain(datacenterID, id)
+	if err != nil {
+		return err
+	}
+	if sd.status != StorageDomainStatusMaintenance {
+		return newError(EConflict, "storage domain %s must be in maintenance to detach it (status: %s)", id, sd.status)
+	}
+
+	sd.datacenterIDs = []string{}
+	sd.status = StorageDomainStatusUnattached
+	m.storageDomains[id] = sd
+	m.updateDatacenterStatus(datacenterID)
+
+	return nil
+}
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) GetDiskFromStorageDomain(id string, diskID string, _ ...RetryStrategy) (Disk, error) {
+func (m *mockClient) GetDiskFromStorageDomain(id string, diskID DiskID, _ ...RetryStrategy) (Disk, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if disk, ok := m.disks[diskID]; ok {
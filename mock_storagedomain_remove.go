@@ -0,0 +1,40 @@
+package ovirtclient
+
+func (m *mockClient) RemoveStorageDomain(
+	id string,
+	params RemoveStorageDomainParameters,
+	_ ...RetryStrategy,
+) error {
+	if err := validateStorageDomainRemovalParameters(params); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	sd, ok := m.storageDomains[id]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	if len(sd.datacenterIDs) > 0 {
+		return newError(EConflict, "storage domain %s is still attached to datacenter %s", id, sd.datacenterIDs[0])
+	}
+	if hostID := params.HostID(); hostID != "" {
+		if _, ok := m.hosts[hostID]; !ok {
+			return newError(ENotFound, "host with ID %s not found", hostID)
+		}
+	}
+	for _, d := range m.disks {
+		for _, storageDomainID := range d.storageDomainIDs {
+			if storageDomainID == id {
+				return newError(EConflict, "storage domain %s still has disk %s", id, d.id)
+			}
+		}
+	}
+
+	delete(m.storageDomains, id)
+	delete(m.storageDomainVMs, id)
+	delete(m.storageDomainTemplates, id)
+
+	return nil
+}
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) RemoveDiskFromStorageDomain(id string, diskID string, _ ...RetryStrategy) error {
+func (m *mockClient) RemoveDiskFromStorageDomain(id string, diskID DiskID, _ ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -12,6 +12,7 @@ func (m *mockClient) RemoveDiskFromStorageDomain(id string, diskID string, _ ...
 
 	// if there is only 1 domain just delete the disk
 	if len(domains) == 1 {
+		m.releaseStorage(domains, m.disks[diskID].totalSize)
 		delete(m.disks, diskID)
 		return nil
 	}
@@ -19,6 +20,7 @@ func (m *mockClient) RemoveDiskFromStorageDomain(id string, diskID string, _ ...
 	// remove the storagedomain from the disk slice
 	for i, sdomain := range domains {
 		if sdomain == id {
+			m.releaseStorage([]string{id}, m.disks[diskID].totalSize)
 			// gocritic will complain on the following line due to appendAssign, but that's legit here
 			m.disks[diskID].storageDomainIDs = append(domains[:i], domains[i+1:]...) //nolint:gocritic
 			return nil
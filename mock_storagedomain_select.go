@@ -0,0 +1,8 @@
+package ovirtclient
+
+func (m *mockClient) SelectStorageDomain(
+	params StorageDomainSelectionParameters,
+	retries ...RetryStrategy,
+) (StorageDomain, error) {
+	return selectStorageDomain(m, params, retries...)
+}
@@ -0,0 +1,100 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// storageDomainTemplateWithData is a template on a storage domain in the mock, together with the configuration it is
+// imported with. The mock does not export the disks of the template.
+type storageDomainTemplateWithData struct {
+	storageDomainTemplate
+
+	template template
+}
+
+func (m *mockClient) ListStorageDomainTemplates(id string, _ ...RetryStrategy) ([]StorageDomainTemplate, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.storageDomains[id]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	result := make([]StorageDomainTemplate, 0, len(m.storageDomainTemplates[id]))
+	for _, item := range m.storageDomainTemplates[id] {
+		result = append(result, &item.storageDomainTemplate)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ImportTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.bringTemplateIntoEngine(storageDomainID, StorageDomainFunctionExport, templateID, clusterID, params)
+}
+
+func (m *mockClient) RegisterTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.bringTemplateIntoEngine(
+		storageDomainID,
+		StorageDomainFunctionData,
+		templateID,
+		clusterID,
+		nil,
+	); err != nil {
+		return err
+	}
+	// Registered templates are no longer listed as unregistered on the data domain.
+	delete(m.storageDomainTemplates[storageDomainID], templateID)
+	return nil
+}
+
+// bringTemplateIntoEngine implements importing and registering templates, see bringVMIntoEngine.
+func (m *mockClient) bringTemplateIntoEngine(
+	storageDomainID string,
+	function StorageDomainFunction,
+	templateID TemplateID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+) error {
+	item, err := m.checkStorageDomainImport(storageDomainID, function, clusterID, params)
+	if err != nil {
+		return err
+	}
+	sdTemplate, ok := m.storageDomainTemplates[storageDomainID][templateID]
+	if !ok {
+		return newError(ENotFound, "template %s not found on storage domain %s", templateID, storageDomainID)
+	}
+	newTemplate := sdTemplate.template
+	newTemplate.client = m
+	newTemplate.status = TemplateStatusOK
+	newTemplate.creationTime = time.Now()
+	newTemplate.cpu = sdTemplate.template.cpu.clone()
+	if item.clone {
+		newTemplate.id = TemplateID(m.GenerateUUID())
+	}
+	if item.name != "" {
+		newTemplate.name = item.name
+	}
+	for _, existingTemplate := range m.templates {
+		if existingTemplate.id == newTemplate.id {
+			return newError(EConflict, "template %s already exists, import it as a clone instead", newTemplate.id)
+		}
+		if existingTemplate.name == newTemplate.name {
+			return newError(EConflict, "a template with the name %s already exists", newTemplate.name)
+		}
+	}
+	m.templates[newTemplate.id] = &newTemplate
+	m.templateDiskAttachmentsByTemplate[newTemplate.id] = []*templateDiskAttachment{}
+	return nil
+}
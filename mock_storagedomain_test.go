@@ -0,0 +1,65 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+)
+
+func TestMockStorageDomainCapacity(t *testing.T) {
+	t.Parallel()
+	client := ovirtclient.NewMock()
+	storageDomain, err := client.SelectStorageDomain(nil)
+	if err != nil {
+		t.Fatalf("failed to select storage domain (%v)", err)
+	}
+	storageDomainID := storageDomain.ID()
+	initialSpace := storageDomain.Available()
+
+	if _, err := client.CreateDisk(
+		storageDomainID,
+		ovirtclient.ImageFormatRaw,
+		initialSpace+1,
+		nil,
+	); !ovirtclient.HasErrorCode(err, ovirtclient.EConflict) {
+		t.Fatalf("creating a disk larger than the storage domain did not return a conflict error (%v)", err)
+	}
+
+	disk, err := client.CreateDisk(storageDomainID, ovirtclient.ImageFormatRaw, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("failed to create disk (%v)", err)
+	}
+	assertStorageDomainAvailable(t, client, storageDomainID, initialSpace-1024*1024)
+
+	if err := disk.Remove(); err != nil {
+		t.Fatalf("failed to remove disk (%v)", err)
+	}
+	assertStorageDomainAvailable(t, client, storageDomainID, initialSpace)
+}
+
+func assertStorageDomainAvailable(
+	t *testing.T,
+	client ovirtclient.Client,
+	storageDomainID string,
+	expected uint64,
+) {
+	storageDomain, err := client.GetStorageDomain(storageDomainID)
+	if err != nil {
+		t.Fatalf("failed to get storage domain %s (%v)", storageDomainID, err)
+	}
+	if storageDomain.Available() != expected {
+		t.Fatalf(
+			"incorrect available space on storage domain %s (expected: %d, got: %d)",
+			storageDomainID,
+			expected,
+			storageDomain.Available(),
+		)
+	}
+	// The mock starts with empty storage domains, so the space used by disks is the difference to the initial space.
+	if storageDomain.Used()+storageDomain.Available() != 10*1024*1024*1024 {
+		t.Fatalf("incorrect used space on storage domain %s (%d)", storageDomainID, storageDomain.Used())
+	}
+	if storageDomain.Committed() != storageDomain.Used() {
+		t.Fatalf("incorrect committed space on storage domain %s (%d)", storageDomainID, storageDomain.Committed())
+	}
+}
@@ -0,0 +1,145 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+// storageDomainVMWithData is a VM on a storage domain in the mock, together with the configuration it is imported
+// with. The mock does not export the disks of the VM.
+type storageDomainVMWithData struct {
+	storageDomainVM
+
+	vm vm
+}
+
+func (m *mockClient) ListStorageDomainVMs(id string, _ ...RetryStrategy) ([]StorageDomainVM, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.storageDomains[id]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", id)
+	}
+	result := make([]StorageDomainVM, 0, len(m.storageDomainVMs[id]))
+	for _, item := range m.storageDomainVMs[id] {
+		result = append(result, &item.storageDomainVM)
+	}
+	return result, nil
+}
+
+func (m *mockClient) ImportVMFromStorageDomain(
+	storageDomainID string,
+	vmID VMID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.bringVMIntoEngine(storageDomainID, StorageDomainFunctionExport, vmID, clusterID, params)
+}
+
+func (m *mockClient) RegisterVMFromStorageDomain(
+	storageDomainID string,
+	vmID VMID,
+	clusterID ClusterID,
+	_ ...RetryStrategy,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err := m.bringVMIntoEngine(storageDomainID, StorageDomainFunctionData, vmID, clusterID, nil); err != nil {
+		return err
+	}
+	// Registered VMs are no longer listed as unregistered on the data domain.
+	delete(m.storageDomainVMs[storageDomainID], vmID)
+	return nil
+}
+
+// bringVMIntoEngine implements importing and registering VMs, which only differ in the function of the storage
+// domain they work on.
+func (m *mockClient) bringVMIntoEngine(
+	storageDomainID string,
+	function StorageDomainFunction,
+	vmID VMID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+) error {
+	item, err := m.checkStorageDomainImport(storageDomainID, function, clusterID, params)
+	if err != nil {
+		return err
+	}
+	sdVM, ok := m.storageDomainVMs[storageDomainID][vmID]
+	if !ok {
+		return newError(ENotFound, "VM %s not found on storage domain %s", vmID, storageDomainID)
+	}
+	newVM := sdVM.vm
+	newVM.client = m
+	newVM.clusterID = clusterID
+	newVM.status = VMStatusDown
+	newVM.creationTime = time.Now()
+	newVM.cpu = sdVM.vm.cpu.clone()
+	if item.clone {
+		newVM.id = VMID(m.GenerateUUID())
+	}
+	if item.name != "" {
+		newVM.name = item.name
+	}
+	for _, existingVM := range m.vms {
+		if existingVM.id == newVM.id {
+			return newError(EConflict, "VM %s already exists, import it as a clone instead", newVM.id)
+		}
+		if existingVM.name == newVM.name {
+			return newError(EConflict, "a VM with the name %s already exists", newVM.name)
+		}
+	}
+	m.vms[newVM.id] = &newVM
+	m.vmDiskAttachmentsByVM[newVM.id] = map[string]*diskAttachment{}
+	m.addVMEvent(mockEventCodeVMCreated, &newVM, "VM %s was imported.", newVM.name)
+	return nil
+}
+
+// storageDomainEntityImport describes how a VM or template is imported.
+type storageDomainEntityImport struct {
+	name  string
+	clone bool
+}
+
+// checkStorageDomainImport checks the storage domain, cluster and params of an import or registration, and returns
+// the settings for the new VM or template.
+func (m *mockClient) checkStorageDomainImport(
+	storageDomainID string,
+	function StorageDomainFunction,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+) (*storageDomainEntityImport, error) {
+	sd, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if sd.function != function {
+		return nil, newError(
+			EBadArgument,
+			"storage domain %s is a %s domain, expected a %s domain",
+			storageDomainID,
+			sd.function,
+			function,
+		)
+	}
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	result := &storageDomainEntityImport{}
+	if params == nil {
+		return result, nil
+	}
+	if name := params.Name(); name != nil {
+		result.name = *name
+	}
+	if clone := params.CloneVMs(); clone != nil {
+		result.clone = *clone
+	}
+	if targetID := params.TargetStorageDomainID(); targetID != nil {
+		if target, ok := m.storageDomains[*targetID]; !ok || target.function != StorageDomainFunctionData {
+			return nil, newError(ENotFound, "data storage domain with ID %s not found", *targetID)
+		}
+	}
+	return result, nil
+}
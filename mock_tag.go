@@ -0,0 +1,11 @@
+package ovirtclient
+
+// hasTagNamed returns true if one of the tags with the specified IDs has the specified name.
+func (m *mockClient) hasTagNamed(tagIDs []TagID, name string) bool {
+	for _, tagID := range tagIDs {
+		if t, ok := m.tags[tagID]; ok && t.name == name {
+			return true
+		}
+	}
+	return false
+}
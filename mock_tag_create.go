@@ -2,18 +2,46 @@ package ovirtclient
 
 import "github.com/google/uuid"
 
-func (m *mockClient) CreateTag(name string, description string, _ ...RetryStrategy) (result Tag, err error) {
+func (m *mockClient) CreateTag(
+	name string,
+	description string,
+	parentID TagID,
+	_ ...RetryStrategy,
+) (result Tag, err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	id := uuid.Must(uuid.NewUUID()).String()
+	if err := m.checkTagName("", name); err != nil {
+		return nil, err
+	}
+	if parentID != "" {
+		if _, ok := m.tags[parentID]; !ok {
+			return nil, newError(ENotFound, "parent tag with ID %s not found", parentID)
+		}
+	}
+	id := TagID(uuid.Must(uuid.NewUUID()).String())
 	tag := &tag{
 		client:      m,
 		id:          id,
 		name:        name,
 		description: description,
+		parentID:    parentID,
 	}
 	m.tags[id] = tag
 
 	result = tag
 	return
 }
+
+// checkTagName returns an error if the name is empty or already used by a tag other than the one with the ID
+// tagID.
+func (m *mockClient) checkTagName(tagID TagID, name string) error {
+	if name == "" {
+		return newError(EBadArgument, "tag name cannot be empty")
+	}
+	for _, t := range m.tags {
+		if t.name == name && t.id != tagID {
+			return newError(EConflict, "a tag with the name %s already exists", name)
+		}
+	}
+	return nil
+}
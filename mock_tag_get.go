@@ -2,7 +2,7 @@
 
 package ovirtclient
 
-func (m *mockClient) GetTag(id string, _ ...RetryStrategy) (Tag, error) {
+func (m *mockClient) GetTag(id TagID, _ ...RetryStrategy) (Tag, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.tags[id]; ok {
@@ -0,0 +1,5 @@
+package ovirtclient
+
+func (m *mockClient) GetTags(ids []TagID, retries ...RetryStrategy) ([]Tag, error) {
+	return getTags(m, ids, retries)
+}
@@ -0,0 +1,23 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListChildTags(tagID TagID, _ ...RetryStrategy) ([]Tag, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.tags[tagID]; !ok {
+		return nil, newError(ENotFound, "tag with ID %s not found", tagID)
+	}
+	result := []Tag{}
+	for _, item := range m.tags {
+		if item.parentID == tagID {
+			result = append(result, item)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) RemoveTag(id string, _ ...RetryStrategy) (err error) {
+func (m *mockClient) RemoveTag(id TagID, _ ...RetryStrategy) (err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -8,6 +8,21 @@ func (m *mockClient) RemoveTag(id string, _ ...RetryStrategy) (err error) {
 		return newError(ENotFound, "Tag with ID %s not found", id)
 	}
 
+	// The engine removes the tags below the removed tag too, so we collect them before removing any.
+	var removedTagIDs []TagID
+	for tagID := range m.tags {
+		if m.isTagBelow(tagID, id) {
+			removedTagIDs = append(removedTagIDs, tagID)
+		}
+	}
+	for _, tagID := range removedTagIDs {
+		m.removeTag(tagID)
+	}
+
+	return nil
+}
+
+func (m *mockClient) removeTag(id TagID) {
 	// remove the tag from all the VMs.
 	for _, vm := range m.vms {
 		for i, tagID := range vm.tagIDs {
@@ -19,7 +34,22 @@ func (m *mockClient) RemoveTag(id string, _ ...RetryStrategy) (err error) {
 		}
 	}
 
+	for _, tpl := range m.templates {
+		tpl.tagIDs = removeTagID(tpl.tagIDs, id)
+	}
+	for _, h := range m.hosts {
+		h.tagIDs = removeTagID(h.tagIDs, id)
+	}
+
 	delete(m.tags, id)
+}
 
-	return nil
+func removeTagID(tagIDs []TagID, id TagID) []TagID {
+	result := make([]TagID, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if tagID != id {
+			result = append(result, tagID)
+		}
+	}
+	return result
 }
@@ -0,0 +1,47 @@
+package ovirtclient
+
+func (m *mockClient) UpdateTag(id TagID, params UpdateTagParameters, _ ...RetryStrategy) (Tag, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	t, ok := m.tags[id]
+	if !ok {
+		return nil, newError(ENotFound, "Tag with ID %s not found", id)
+	}
+	updatedTag := *t
+	if name := params.Name(); name != nil {
+		if err := m.checkTagName(id, *name); err != nil {
+			return nil, err
+		}
+		updatedTag.name = *name
+	}
+	if description := params.Description(); description != nil {
+		updatedTag.description = *description
+	}
+	if parentID := params.ParentID(); parentID != nil {
+		if _, ok := m.tags[*parentID]; !ok {
+			return nil, newError(ENotFound, "parent tag with ID %s not found", *parentID)
+		}
+		if m.isTagBelow(*parentID, id) {
+			return nil, newError(EBadArgument, "cannot move tag %s below itself or one of its children", id)
+		}
+		updatedTag.parentID = *parentID
+	}
+	m.tags[id] = &updatedTag
+	return &updatedTag, nil
+}
+
+// isTagBelow returns true if the tag with the ID tagID is the tag with the ID ancestorID or one of its descendants.
+func (m *mockClient) isTagBelow(tagID TagID, ancestorID TagID) bool {
+	for tagID != "" {
+		if tagID == ancestorID {
+			return true
+		}
+		t, ok := m.tags[tagID]
+		if !ok {
+			return false
+		}
+		tagID = t.parentID
+	}
+	return false
+}
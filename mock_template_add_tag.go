@@ -0,0 +1,16 @@
+package ovirtclient
+
+func (m *mockClient) AddTagToTemplate(id TemplateID, tagID TagID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tpl, ok := m.templates[id]
+	if !ok {
+		return newError(ENotFound, "template with ID %s not found", id)
+	}
+	if _, ok := m.tags[tagID]; !ok {
+		return newError(ENotFound, "tag with ID %s not found", tagID)
+	}
+	tpl.tagIDs = append(tpl.tagIDs, tagID)
+	return nil
+}
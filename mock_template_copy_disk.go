@@ -3,7 +3,7 @@ package ovirtclient
 import "time"
 
 func (m *mockClient) CopyTemplateDiskToStorageDomain(
-	diskID string,
+	diskID DiskID,
 	storageDomainID string,
 	retries ...RetryStrategy) (result Disk, err error) {
 	m.lock.Lock()
@@ -50,6 +50,7 @@ func (c *mockDiskCopy) do() {
 	// Sleep to trigger potential race conditions / improper status handling.
 	time.Sleep(time.Second)
 	c.client.disks[c.disk.ID()] = c.disk
+	c.client.useStorage([]string{c.storageDomainID}, c.disk.totalSize)
 	c.client.disks[c.disk.ID()].storageDomainIDs = append(c.client.disks[c.disk.ID()].storageDomainIDs, c.storageDomainID)
 	close(c.done)
 }
@@ -6,11 +6,10 @@ import (
 )
 
 func (m *mockClient) CreateTemplate(
-	vmID string,
+	vmID VMID,
 	name string,
 	params OptionalTemplateCreateParameters,
-	_ ...RetryStrategy,
-) (Template, error) {
+	_ ...RetryStrategy) (Template, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -34,12 +33,13 @@ func (m *mockClient) CreateTemplate(
 		description = *desc
 	}
 	tpl := &template{
-		client:      m,
-		id:          TemplateID(m.GenerateUUID()),
-		name:        name,
-		description: description,
-		status:      TemplateStatusLocked,
-		cpu:         vm.cpu.clone(),
+		client:       m,
+		id:           TemplateID(m.GenerateUUID()),
+		name:         name,
+		description:  description,
+		status:       TemplateStatusLocked,
+		creationTime: time.Now(),
+		cpu:          vm.cpu.clone(),
 	}
 	m.templates[tpl.ID()] = tpl
 	m.templateDiskAttachmentsByTemplate[tpl.ID()] = make(
@@ -68,7 +68,7 @@ func (m *mockClient) handlePostTemplateCreation(tpl *template) {
 	}()
 }
 
-func (m *mockClient) attachTemplateDisks(vmID string, tpl *template) {
+func (m *mockClient) attachTemplateDisks(vmID VMID, tpl *template) {
 	i := 0
 	for _, attachment := range m.vmDiskAttachmentsByVM[vmID] {
 		disk := m.disks[attachment.diskID]
@@ -76,6 +76,7 @@ func (m *mockClient) attachTemplateDisks(vmID string, tpl *template) {
 		_ = newDisk.Lock()
 		newDisk.alias = fmt.Sprintf("disk-%s", generateRandomID(5, m.nonSecureRandom))
 		m.disks[newDisk.ID()] = newDisk
+		m.useStorage(newDisk.storageDomainIDs, newDisk.totalSize)
 
 		tplAttachment := &templateDiskAttachment{
 			client:        m,
@@ -0,0 +1,27 @@
+package ovirtclient
+
+func (m *mockClient) ExportTemplate(templateID TemplateID, storageDomainID string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tpl, ok := m.templates[templateID]
+	if !ok {
+		return newError(ENotFound, "template with ID %s not found", templateID)
+	}
+	if err := m.checkExportStorageDomain(storageDomainID); err != nil {
+		return err
+	}
+	if _, ok := m.storageDomainTemplates[storageDomainID][templateID]; ok {
+		return newError(EConflict, "template %s already exists on storage domain %s", templateID, storageDomainID)
+	}
+
+	if _, ok := m.storageDomainTemplates[storageDomainID]; !ok {
+		m.storageDomainTemplates[storageDomainID] = map[TemplateID]*storageDomainTemplateWithData{}
+	}
+	m.storageDomainTemplates[storageDomainID][templateID] = &storageDomainTemplateWithData{
+		storageDomainTemplate{m, templateID, tpl.name, storageDomainID},
+		*tpl,
+	}
+
+	return nil
+}
@@ -34,6 +34,7 @@ func (m *mockClient) RemoveTemplate(id TemplateID, retries ...RetryStrategy) (er
 			}
 
 			delete(m.templates, id)
+			m.removePermissionsOf(PermissionObjectTypeTemplate, string(id))
 			return nil
 		})
 	return err
@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) SearchTemplates(params TemplateSearchParameters, _ ...RetryStrategy) ([]Template, error) {
+	if params.Name() == nil && params.Tag() == nil {
+		return nil, newError(EBadArgument, "at least one search parameter must be specified")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := []Template{}
+	for _, tpl := range m.templates {
+		if name := params.Name(); name != nil && tpl.name != *name {
+			continue
+		}
+		if tag := params.Tag(); tag != nil && !m.hasTagNamed(tpl.tagIDs, *tag) {
+			continue
+		}
+		result = append(result, tpl)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
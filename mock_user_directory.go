@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+func (m *mockClient) ListDirectoryDomains(_ ...RetryStrategy) ([]DirectoryDomain, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]DirectoryDomain, 0, len(m.directoryDomains))
+	for _, d := range m.directoryDomains {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+func (m *mockClient) ListDirectoryUsers(domainID string, search string, _ ...RetryStrategy) ([]UserData, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.directoryDomains[domainID]; !ok {
+		return nil, newError(ENotFound, "directory domain with ID %s not found", domainID)
+	}
+	result := []UserData{}
+	for _, u := range m.directoryUsers[domainID] {
+		if mockDirectorySearchMatches(search, u.principal) {
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+// mockDirectorySearchMatches implements a simplified version of the directory search. It only supports searches in
+// the form of "field=pattern", where the pattern may contain * wildcards, and always matches the pattern against the
+// name of the user or group.
+func mockDirectorySearchMatches(search string, name string) bool {
+	if search == "" {
+		return true
+	}
+	pattern := search
+	if i := strings.Index(search, "="); i >= 0 {
+		pattern = search[i+1:]
+	}
+	matched, err := path.Match(pattern, name)
+	return err == nil && matched
+}
@@ -0,0 +1,11 @@
+package ovirtclient
+
+func (m *mockClient) GetUser(id string, _ ...RetryStrategy) (User, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if u, ok := m.users[id]; ok {
+		return u, nil
+	}
+	return nil, newError(ENotFound, "user with ID %s not found", id)
+}
@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListUsers(_ ...RetryStrategy) ([]User, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	result := make([]User, 0, len(m.users))
+	for _, u := range m.users {
+		result = append(result, u)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UserName() < result[j].UserName()
+	})
+	return result, nil
+}
+
+func (m *mockClient) GetUserByName(name string, retries ...RetryStrategy) (User, error) {
+	users, err := m.ListUsers(retries...)
+	if err != nil {
+		return nil, err
+	}
+	return findUserByName(users, name)
+}
@@ -1,6 +1,6 @@
 package ovirtclient
 
-func (m *mockClient) AddTagToVM(id string, tagID string, retries ...RetryStrategy) (err error) {
+func (m *mockClient) AddTagToVM(id VMID, tagID TagID, retries ...RetryStrategy) (err error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -0,0 +1,69 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (m *mockClient) FinalizeVMBackup(vmID VMID, id VMBackupID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	waitRetries := defaultRetries(retries, defaultLongTimeouts())
+	if err := m.finalizeVMBackup(vmID, id, retries); err != nil {
+		return withCorrelationID(err, correlationIDFromRetries(retries))
+	}
+	_, err := m.WaitForVMBackupPhase(vmID, id, VMBackupPhaseSucceeded, waitRetries...)
+	return err
+}
+
+func (m *mockClient) finalizeVMBackup(vmID VMID, id VMBackupID, retries []RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[vmID]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, backup := range m.vmBackups[vmID] {
+		if backup.id != id {
+			continue
+		}
+		if backup.phase != VMBackupPhaseReady {
+			return newError(
+				EConflict,
+				"backup %s of VM %s is in phase %s, not %s",
+				id,
+				vmID,
+				backup.phase,
+				VMBackupPhaseReady,
+			)
+		}
+		backup.phase = VMBackupPhaseFinalizing
+		m.addCorrelatedJob(retries, fmt.Sprintf("Finalizing backup of VM %s", item.name))
+		go func(backup *vmBackup) {
+			time.Sleep(time.Second)
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			backup.phase = VMBackupPhaseSucceeded
+			m.addVMCheckpoint(backup)
+		}(backup)
+		return nil
+	}
+	return newError(ENotFound, "backup %s of VM %s not found", id, vmID)
+}
+
+// addVMCheckpoint adds the checkpoint created by a successful backup to the end of the backup chain of the VM. It must
+// be called with the lock held.
+func (m *mockClient) addVMCheckpoint(backup *vmBackup) {
+	checkpoint := &vmCheckpoint{
+		client:       m,
+		id:           *backup.toCheckpointID,
+		vmID:         backup.vmID,
+		state:        VMCheckpointStateCreated,
+		diskIDs:      append([]DiskID{}, backup.diskIDs...),
+		creationDate: backup.creationDate,
+	}
+	if checkpoints := m.vmCheckpoints[backup.vmID]; len(checkpoints) > 0 {
+		parentID := checkpoints[len(checkpoints)-1].id
+		checkpoint.parentID = &parentID
+	}
+	m.vmCheckpoints[backup.vmID] = append(m.vmCheckpoints[backup.vmID], checkpoint)
+}
@@ -0,0 +1,15 @@
+package ovirtclient
+
+func (m *mockClient) GetVMBackup(vmID VMID, id VMBackupID, _ ...RetryStrategy) (VMBackup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, backup := range m.vmBackups[vmID] {
+		if backup.id == id {
+			return backup.clone(), nil
+		}
+	}
+	return nil, newError(ENotFound, "backup %s of VM %s not found", id, vmID)
+}
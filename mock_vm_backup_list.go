@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListVMBackups(vmID VMID, _ ...RetryStrategy) ([]VMBackup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := make([]VMBackup, len(m.vmBackups[vmID]))
+	for i, backup := range m.vmBackups[vmID] {
+		result[i] = backup.clone()
+	}
+	return result, nil
+}
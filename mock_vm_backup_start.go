@@ -0,0 +1,86 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"time"
+)
+
+func (m *mockClient) StartVMBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	params StartVMBackupOptionalParameters,
+	retries ...RetryStrategy,
+) (VMBackup, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	waitRetries := defaultRetries(retries, defaultLongTimeouts())
+	if err := validateStartVMBackup(diskIDs, params); err != nil {
+		return nil, err
+	}
+	backup, err := m.createVMBackup(vmID, diskIDs, params, retries)
+	if err != nil {
+		return nil, withCorrelationID(err, correlationIDFromRetries(retries))
+	}
+	return m.WaitForVMBackupPhase(vmID, backup.ID(), VMBackupPhaseReady, waitRetries...)
+}
+
+func (m *mockClient) createVMBackup(
+	vmID VMID,
+	diskIDs []DiskID,
+	params StartVMBackupOptionalParameters,
+	retries []RetryStrategy,
+) (VMBackup, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[vmID]
+	if !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	for _, diskID := range diskIDs {
+		if attachment, ok := m.vmDiskAttachmentsByDisk[diskID]; !ok || attachment.vmid != vmID {
+			return nil, newError(EBadArgument, "disk %s is not attached to VM %s", diskID, vmID)
+		}
+	}
+	for _, backup := range m.vmBackups[vmID] {
+		if !backup.phase.Ended() {
+			return nil, newError(EConflict, "VM %s already has a backup in progress (%s)", vmID, backup.id)
+		}
+	}
+	backup := &vmBackup{
+		client:       m,
+		id:           VMBackupID(m.GenerateUUID()),
+		vmID:         vmID,
+		phase:        VMBackupPhaseStarting,
+		diskIDs:      append([]DiskID{}, diskIDs...),
+		creationDate: time.Now(),
+	}
+	if params != nil {
+		if checkpointID := params.FromCheckpointID(); checkpointID != nil {
+			if !m.vmCheckpointUsable(vmID, *checkpointID) {
+				return nil, newError(ENotFound, "checkpoint %s of VM %s not found", *checkpointID, vmID)
+			}
+			backup.fromCheckpointID = checkpointID
+		}
+	}
+	toCheckpointID := VMCheckpointID(m.GenerateUUID())
+	backup.toCheckpointID = &toCheckpointID
+	m.vmBackups[vmID] = append(m.vmBackups[vmID], backup)
+	m.addCorrelatedJob(retries, fmt.Sprintf("Backing up VM %s", item.name))
+	go func() {
+		time.Sleep(time.Second)
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		backup.phase = VMBackupPhaseReady
+	}()
+	return backup.clone(), nil
+}
+
+// vmCheckpointUsable returns true if the VM has the checkpoint and an incremental backup can be started from it. It
+// must be called with the lock held.
+func (m *mockClient) vmCheckpointUsable(vmID VMID, checkpointID VMCheckpointID) bool {
+	for _, checkpoint := range m.vmCheckpoints[vmID] {
+		if checkpoint.id == checkpointID {
+			return checkpoint.state == VMCheckpointStateCreated
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (m *mockClient) WaitForVMBackupPhase(
+	vmID VMID,
+	id VMBackupID,
+	phase VMBackupPhase,
+	retries ...RetryStrategy,
+) (result VMBackup, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	if err := phase.Validate(); err != nil {
+		return nil, err
+	}
+	err = retry(
+		fmt.Sprintf("waiting for backup %s of VM %s to reach phase %s", id, vmID, phase),
+		m.logger,
+		retries,
+		func() error {
+			result, err = m.GetVMBackup(vmID, id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMBackupPhase(result, phase)
+		})
+	return
+}
@@ -0,0 +1,52 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (m *mockClient) StartVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return m.StartVM(id, retries...)
+	})
+}
+
+func (m *mockClient) StopVMs(
+	ids []VMID,
+	force bool,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return m.StopVM(id, force, retries...)
+	})
+}
+
+func (m *mockClient) RemoveVMs(ids []VMID, params BulkOptionalParameters, retries ...RetryStrategy) map[VMID]error {
+	return runBulk(ids, params, func(id VMID) error {
+		return m.RemoveVM(id, retries...)
+	})
+}
+
+func (m *mockClient) AssignTagToVMs(
+	tagID TagID,
+	ids []VMID,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) error {
+	results := runBulk(ids, params, func(id VMID) error {
+		return m.AddTagToVM(id, tagID, retries...)
+	})
+	return bulkError(fmt.Sprintf("assign tag %s to", tagID), results)
+}
+
+func (m *mockClient) UnassignTagFromVMs(
+	tagID TagID,
+	ids []VMID,
+	params BulkOptionalParameters,
+	retries ...RetryStrategy,
+) error {
+	results := runBulk(ids, params, func(id VMID) error {
+		return m.RemoveTagFromVM(id, tagID, retries...)
+	})
+	return bulkError(fmt.Sprintf("remove tag %s from", tagID), results)
+}
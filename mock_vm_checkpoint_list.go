@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ListVMCheckpoints(vmID VMID, _ ...RetryStrategy) ([]VMCheckpoint, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.vms[vmID]; !ok {
+		return nil, newError(ENotFound, "VM with ID %s not found", vmID)
+	}
+	result := make([]VMCheckpoint, len(m.vmCheckpoints[vmID]))
+	for i, checkpoint := range m.vmCheckpoints[vmID] {
+		result[i] = checkpoint.clone()
+	}
+	return result, nil
+}
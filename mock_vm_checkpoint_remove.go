@@ -0,0 +1,40 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (m *mockClient) RemoveVMCheckpoint(vmID VMID, id VMCheckpointID, retries ...RetryStrategy) error {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[vmID]
+	if !ok {
+		return withCorrelationID(
+			newError(ENotFound, "VM with ID %s not found", vmID),
+			correlationIDFromRetries(retries),
+		)
+	}
+	checkpoints := m.vmCheckpoints[vmID]
+	for i, checkpoint := range checkpoints {
+		if checkpoint.id != id {
+			continue
+		}
+		if i != 0 {
+			return withCorrelationID(
+				newError(EConflict, "only the oldest checkpoint of VM %s can be removed, not %s", vmID, id),
+				correlationIDFromRetries(retries),
+			)
+		}
+		m.vmCheckpoints[vmID] = checkpoints[1:]
+		if len(m.vmCheckpoints[vmID]) > 0 {
+			m.vmCheckpoints[vmID][0].parentID = nil
+		}
+		m.addCorrelatedJob(retries, fmt.Sprintf("Removing checkpoint of VM %s", item.name))
+		return nil
+	}
+	return withCorrelationID(
+		newError(ENotFound, "checkpoint %s of VM %s not found", id, vmID),
+		correlationIDFromRetries(retries),
+	)
+}
@@ -8,23 +8,35 @@ import (
 )
 
 func (m *mockClient) CreateVM(
-	clusterID string,
+	clusterID ClusterID,
 	templateID TemplateID,
 	name string,
 	params OptionalVMParameters,
-	retries ...RetryStrategy,
-) (result VM, err error) {
+	retries ...RetryStrategy) (VM, error) {
 	retries = defaultRetries(retries, defaultWriteTimeouts())
+	result, err := m.createVMWithoutDisks(clusterID, templateID, name, params, retries)
+	if err != nil {
+		return result, err
+	}
+	return createVMDisks(m, m.logger, result, params, retries)
+}
+
+func (m *mockClient) createVMWithoutDisks(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries []RetryStrategy) (result VM, err error) {
 
 	if err := validateVMCreationParameters(clusterID, templateID, name, params); err != nil {
 		return nil, err
 	}
+	if err := validateVMName(m.vmNameValidator, name); err != nil {
+		return nil, err
+	}
 	if params == nil {
 		params = &vmParams{}
 	}
-	if name == "" {
-		return nil, newError(EBadArgument, "The name parameter is required for VM creation.")
-	}
 	err = retry(
 		fmt.Sprintf("creating VM %s", name),
 		m.logger,
@@ -42,35 +54,54 @@ func (m *mockClient) CreateVM(
 			if tpl.status != TemplateStatusOK {
 				return newError(EConflict, "template in status \"%s\"", tpl.status)
 			}
-
-			for _, vm := range m.vms {
-				if vm.name == name {
-					return newError(EConflict, "A VM with the name \"%s\" already exists.", name)
-				}
+			if err := m.checkQuotaExists(params.QuotaID()); err != nil {
+				return err
+			}
+			vmInstanceType, err := m.findInstanceType(params.InstanceTypeID())
+			if err != nil {
+				return err
+			}
+			if err := m.checkVMNameFree(name); err != nil {
+				return err
 			}
 
-			cpu := m.createVMCPU(params, tpl)
+			cpu := m.createVMCPU(params, tpl, vmInstanceType)
+			if err := checkVMNUMANodeCPUs(cpu, params.NUMANodes()); err != nil {
+				return err
+			}
 
 			vm := m.createVM(name, params, clusterID, templateID, cpu)
 
 			m.attachVMDisksFromTemplate(tpl, vm)
+			m.addCorrelatedJob(retries, fmt.Sprintf("Creating VM %s", name))
 
 			result = vm
 			return nil
 		},
 	)
 
-	return result, err
+	return result, withCorrelationID(err, correlationIDFromRetries(retries))
+}
+
+// checkVMNameFree returns an EConflict error if a VM with the specified name already exists. It must be called with
+// the lock held.
+func (m *mockClient) checkVMNameFree(name string) error {
+	for _, vm := range m.vms {
+		if vm.name == name {
+			return newError(EConflict, "A VM with the name \"%s\" already exists.", name)
+		}
+	}
+	return nil
 }
 
 func (m *mockClient) createVM(
 	name string,
 	params OptionalVMParameters,
-	clusterID string,
+	clusterID ClusterID,
 	templateID TemplateID,
 	cpu *vmCPU,
 ) *vm {
-	id := uuid.Must(uuid.NewUUID()).String()
+	id := VMID(uuid.Must(uuid.NewUUID()).String())
 	init := params.Initialization()
 	if init == nil {
 		init = &initialization{}
@@ -80,14 +111,21 @@ func (m *mockClient) createVM(
 		id:             id,
 		name:           name,
 		comment:        params.Comment(),
+		description:    params.Description(),
 		clusterID:      clusterID,
 		templateID:     templateID,
 		status:         VMStatusDown,
+		creationTime:   time.Now(),
 		cpu:            cpu,
 		hugePages:      params.HugePages(),
 		initialization: init,
+		quotaID:        params.QuotaID(),
+		instanceTypeID: params.InstanceTypeID(),
+		numaTuneMode:   params.NUMATuneMode(),
 	}
 	m.vms[id] = vm
+	m.vmNUMANodes[id] = append([]VMNUMANode{}, params.NUMANodes()...)
+	m.addVMEvent(mockEventCodeVMCreated, vm, "VM %s was created.", vm.name)
 	return vm
 }
 
@@ -102,6 +140,7 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm) {
 		_ = newDisk.Lock()
 		newDisk.alias = fmt.Sprintf("disk-%s", generateRandomID(5, m.nonSecureRandom))
 		m.disks[newDisk.ID()] = newDisk
+		m.useStorage(newDisk.storageDomainIDs, newDisk.totalSize)
 
 		go func() {
 			time.Sleep(time.Second)
@@ -122,7 +161,7 @@ func (m *mockClient) attachVMDisksFromTemplate(tpl *template, vm *vm) {
 	}
 }
 
-func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vmCPU {
+func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template, vmInstanceType *instanceType) *vmCPU {
 	var cpu *vmCPU
 	cpuParams := params.CPU()
 	switch {
@@ -134,6 +173,10 @@ func (m *mockClient) createVMCPU(params OptionalVMParameters, tpl *template) *vm
 				threads: cpuParams.Threads(),
 			},
 		}
+	case vmInstanceType != nil:
+		cpu = &vmCPU{
+			topo: vmInstanceType.cpu.clone(),
+		}
 	case tpl.cpu != nil:
 		cpu = tpl.cpu.clone()
 	default:
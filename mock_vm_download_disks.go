@@ -0,0 +1,15 @@
+package ovirtclient
+
+func (m *mockClient) StartDownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) (
+	VMDisksDownloadProgress,
+	error,
+) {
+	return startDownloadVMDisks(m, m.logger, id, targetDirectory, retries)
+}
+
+func (m *mockClient) DownloadVMDisks(id VMID, targetDirectory string, retries ...RetryStrategy) (
+	[]VMDiskFile,
+	error,
+) {
+	return downloadVMDisks(m, m.logger, id, targetDirectory, retries)
+}
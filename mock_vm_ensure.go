@@ -0,0 +1,11 @@
+package ovirtclient
+
+func (m *mockClient) EnsureVM(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	return ensureVM(m, clusterID, templateID, name, params, retries)
+}
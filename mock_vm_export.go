@@ -0,0 +1,45 @@
+package ovirtclient
+
+func (m *mockClient) ExportVM(id VMID, storageDomainID string, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	vm, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	if vm.status != VMStatusDown {
+		return newError(EConflict, "VM %s must be down to export it (status: %s)", id, vm.status)
+	}
+	if err := m.checkExportStorageDomain(storageDomainID); err != nil {
+		return err
+	}
+	if _, ok := m.storageDomainVMs[storageDomainID][id]; ok {
+		return newError(EConflict, "VM %s already exists on storage domain %s", id, storageDomainID)
+	}
+
+	if _, ok := m.storageDomainVMs[storageDomainID]; !ok {
+		m.storageDomainVMs[storageDomainID] = map[VMID]*storageDomainVMWithData{}
+	}
+	m.storageDomainVMs[storageDomainID][id] = &storageDomainVMWithData{
+		storageDomainVM{m, id, vm.name, storageDomainID},
+		*vm,
+	}
+
+	return nil
+}
+
+// checkExportStorageDomain returns an error if the storage domain is not an active export domain.
+func (m *mockClient) checkExportStorageDomain(storageDomainID string) error {
+	sd, ok := m.storageDomains[storageDomainID]
+	if !ok {
+		return newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if sd.function != StorageDomainFunctionExport {
+		return newError(EBadArgument, "storage domain %s is a %s domain, not an export domain", storageDomainID, sd.function)
+	}
+	if sd.status != StorageDomainStatusActive {
+		return newError(EConflict, "export domain %s is not active (status: %s)", storageDomainID, sd.status)
+	}
+	return nil
+}
@@ -2,7 +2,7 @@
 
 package ovirtclient
 
-func (m *mockClient) GetVM(id string, _ ...RetryStrategy) (VM, error) {
+func (m *mockClient) GetVM(id VMID, _ ...RetryStrategy) (VM, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
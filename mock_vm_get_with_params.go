@@ -0,0 +1,50 @@
+package ovirtclient
+
+func (m *mockClient) GetVMWithParams(id VMID, params VMGetParameters, _ ...RetryStrategy) (VM, error) {
+	follow, err := vmFollowList(params)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	return m.withEmbeddedVMResources(item, follow), nil
+}
+
+// withEmbeddedVMResources returns a copy of the VM with the sub-resources specified in follow embedded. It must be
+// called with the lock held.
+func (m *mockClient) withEmbeddedVMResources(item *vm, follow VMFollowList) *vm {
+	if len(follow) == 0 {
+		return item
+	}
+	result := *item
+	if follow.Contains(VMFollowNICs) {
+		result.embeddedNICs = []NIC{}
+		for _, nic := range m.nics {
+			if nic.vmid == item.id {
+				result.embeddedNICs = append(result.embeddedNICs, nic)
+			}
+		}
+	}
+	if follow.Contains(VMFollowDiskAttachments) {
+		result.embeddedDiskAttachments = []DiskAttachment{}
+		for _, attachment := range m.vmDiskAttachmentsByVM[item.id] {
+			result.embeddedDiskAttachments = append(result.embeddedDiskAttachments, attachment)
+		}
+	}
+	if follow.Contains(VMFollowReportedDevices) {
+		result.embeddedReportedDevices = []ReportedDevice{}
+		// Only running VMs have a guest agent that can report devices.
+		if item.status == VMStatusUp {
+			for _, nic := range m.nics {
+				if device, ok := m.nicReportedDevices[nic.id]; ok && nic.vmid == item.id {
+					result.embeddedReportedDevices = append(result.embeddedReportedDevices, device)
+				}
+			}
+		}
+	}
+	return &result
+}
@@ -0,0 +1,58 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// ImportExternalVM creates a VM without disks in the mock, as the mock has no external hypervisors to import from.
+func (m *mockClient) ImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	retries = defaultRetries(retries, defaultWriteTimeouts())
+	name, err := validateImportExternalVM(provider, url, sourceVMName, clusterID, storageDomainID, params)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result, err := m.importExternalVM(name, clusterID, storageDomainID, params)
+	if err != nil {
+		return nil, withCorrelationID(err, correlationIDFromRetries(retries))
+	}
+	m.addCorrelatedJob(retries, fmt.Sprintf("Importing VM %s from %s", sourceVMName, provider))
+	return result, nil
+}
+
+// importExternalVM creates the imported VM. It must be called with the lock held.
+func (m *mockClient) importExternalVM(
+	name string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+) (*vm, error) {
+	if _, ok := m.clusters[clusterID]; !ok {
+		return nil, newError(ENotFound, "cluster with ID %s not found", clusterID)
+	}
+	if _, ok := m.storageDomains[storageDomainID]; !ok {
+		return nil, newError(ENotFound, "storage domain with ID %s not found", storageDomainID)
+	}
+	if params != nil && params.ProxyHostID() != nil {
+		if _, ok := m.hosts[*params.ProxyHostID()]; !ok {
+			return nil, newError(ENotFound, "host with ID %s not found", *params.ProxyHostID())
+		}
+	}
+	if err := m.checkVMNameFree(name); err != nil {
+		return nil, err
+	}
+	vmParams := &vmParams{}
+	cpu := m.createVMCPU(vmParams, m.templates[DefaultBlankTemplateID], nil)
+	result := m.createVM(name, vmParams, clusterID, DefaultBlankTemplateID, cpu)
+	m.vmDiskAttachmentsByVM[result.id] = map[string]*diskAttachment{}
+	return result, nil
+}
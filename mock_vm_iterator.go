@@ -0,0 +1,9 @@
+package ovirtclient
+
+func (m *mockClient) ListVMsIterator(pageSize uint, retries ...RetryStrategy) VMIterator {
+	return newVMIterator(m, pageSize, retries)
+}
+
+func (m *mockClient) ForEachVM(pageSize uint, callback func(vm VM) error, retries ...RetryStrategy) error {
+	return forEachVM(m, pageSize, callback, retries)
+}
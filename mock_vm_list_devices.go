@@ -0,0 +1,42 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListVMDevices(id VMID, _ ...RetryStrategy) ([]VMDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[id]; !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	var disks []vmDevice
+	for _, attachment := range m.vmDiskAttachmentsByVM[id] {
+		disks = append(disks, vmDevice{
+			id:         attachment.id,
+			vmid:       id,
+			deviceType: VMDeviceTypeDisk,
+			model:      string(attachment.diskInterface),
+		})
+	}
+	sort.Slice(disks, func(i, j int) bool {
+		return disks[i].id < disks[j].id
+	})
+	var nics []vmDevice
+	for _, n := range m.nics {
+		if n.vmid == id {
+			nics = append(nics, vmDevice{id: string(n.id), vmid: id, deviceType: VMDeviceTypeNIC, name: n.name})
+		}
+	}
+	sort.Slice(nics, func(i, j int) bool {
+		return nics[i].name < nics[j].name
+	})
+	result := []VMDevice{}
+	for _, device := range append(disks, nics...) {
+		result = append(result, device)
+	}
+	// The engine enables the memory balloon on new VMs by default.
+	result = append(result, vmDevice{vmid: id, deviceType: VMDeviceTypeBalloon, model: "virtio"})
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package ovirtclient
+
+func (m *mockClient) ListVMNUMANodes(id VMID, _ ...RetryStrategy) ([]VMNUMANode, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.vms[id]; !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	return append([]VMNUMANode{}, m.vmNUMANodes[id]...), nil
+}
+
+// checkVMNUMANodeCPUs returns an error if a virtual NUMA node references a CPU the VM does not have, like the engine
+// does when adding the node.
+func checkVMNUMANodeCPUs(cpu *vmCPU, nodes []VMNUMANode) error {
+	if err := validateVMNUMANodes(nodes); err != nil {
+		return err
+	}
+	if cpu == nil || cpu.topo == nil {
+		return nil
+	}
+	cpuCount := cpu.topo.Cores() * cpu.topo.Threads() * cpu.topo.Sockets()
+	for _, node := range nodes {
+		for _, index := range node.CPUs() {
+			if index >= cpuCount {
+				return newError(
+					EBadArgument,
+					"virtual NUMA node %d references CPU %d, but the VM only has %d CPUs",
+					node.Index(),
+					index,
+					cpuCount,
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package ovirtclient
+
+import (
+	"sort"
+)
+
+func (m *mockClient) ListVMsPage(params PageParameters, _ ...RetryStrategy) ([]VM, error) {
+	if err := validatePageParameters(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ids := make([]string, 0, len(m.vms))
+	for id := range m.vms {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+	start, end := mockPage(len(ids), params)
+	result := make([]VM, end-start)
+	for i, id := range ids[start:end] {
+		result[i] = m.vms[VMID(id)]
+	}
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package ovirtclient
+
+func (m *mockClient) ListVMSummaries(_ ...RetryStrategy) ([]VMSummary, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]VMSummary, len(m.vms))
+	i := 0
+	for _, item := range m.vms {
+		result[i] = vmSummary{id: item.id, name: item.name, status: item.status}
+		i++
+	}
+	return result, nil
+}
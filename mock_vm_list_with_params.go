@@ -0,0 +1,17 @@
+package ovirtclient
+
+func (m *mockClient) ListVMsWithParams(params VMGetParameters, _ ...RetryStrategy) ([]VM, error) {
+	follow, err := vmFollowList(params)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]VM, len(m.vms))
+	i := 0
+	for _, item := range m.vms {
+		result[i] = m.withEmbeddedVMResources(item, follow)
+		i++
+	}
+	return result, nil
+}
@@ -0,0 +1,24 @@
+package ovirtclient
+
+// mockVMMemory is the memory the mock reports for all VMs, since the mock does not track the memory of VMs.
+const mockVMMemory uint64 = 1024 * 1024 * 1024
+
+func (m *mockClient) GetVMMemoryStatistics(id VMID, _ ...RetryStrategy) (VMMemoryStatistics, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	item, ok := m.vms[id]
+	if !ok {
+		return nil, newError(ENotFound, "vm with ID %s not found", id)
+	}
+	result := &vmMemoryStatistics{installed: mockVMMemory}
+	// Like the engine, the mock only reports the memory usage inside the guest for running VMs.
+	if item.status == VMStatusUp {
+		result.used = mockVMMemory / 4
+		result.buffered = mockVMMemory / 16
+		result.cached = mockVMMemory / 8
+		result.free = mockVMMemory - result.used
+		result.unused = result.free - result.buffered - result.cached
+	}
+	return result, nil
+}
@@ -0,0 +1,5 @@
+package ovirtclient
+
+func (m *mockClient) ProvisionVM(blueprint VMBlueprint, retries ...RetryStrategy) (VM, error) {
+	return provisionVM(m, m.logger, blueprint, retries)
+}
@@ -2,11 +2,17 @@ package ovirtclient
 
 import "fmt"
 
-func (m *mockClient) RemoveVM(id string, retries ...RetryStrategy) error {
+func (m *mockClient) RemoveVM(id VMID, retries ...RetryStrategy) error {
+	return m.RemoveVMWithParams(id, nil, retries...)
+}
 
+func (m *mockClient) RemoveVMWithParams(id VMID, params RemoveVMOptionalParameters, retries ...RetryStrategy) error {
+	if params == nil {
+		params = RemoveVMParams()
+	}
 	retries = defaultRetries(retries, defaultWriteTimeouts())
 
-	return retry(
+	err := retry(
 		fmt.Sprintf("removing VM %s", id),
 		m.logger,
 		retries,
@@ -14,25 +20,51 @@ func (m *mockClient) RemoveVM(id string, retries ...RetryStrategy) error {
 			m.lock.Lock()
 			defer m.lock.Unlock()
 
-			if _, ok := m.vms[id]; !ok {
+			item, ok := m.vms[id]
+			if !ok {
 				return newError(ENotFound, "VM with ID %s not found", id)
 			}
 
-			for _, diskAttachment := range m.vmDiskAttachmentsByVM[id] {
-				if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked {
-					return newError(EConflict, "Cannot delete VM, disk %s is locked.", diskAttachment.DiskID())
-				}
-				delete(m.disks, diskAttachment.DiskID())
-				delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+			if err := m.removeVMDisks(id, params.DetachOnly()); err != nil {
+				return err
 			}
 			for nicID, nic := range m.nics {
 				if nic.VMID() == id {
 					delete(m.nics, nicID)
+					delete(m.nicReportedDevices, nicID)
 				}
 			}
 			delete(m.vmDiskAttachmentsByVM, id)
+			delete(m.vmNUMANodes, id)
+			delete(m.vmBackups, id)
+			delete(m.vmCheckpoints, id)
 			delete(m.vms, id)
+			m.removePermissionsOf(PermissionObjectTypeVM, string(id))
+			m.addVMEvent(mockEventCodeVMRemoved, item, "VM %s was removed.", item.name)
+			m.addCorrelatedJob(retries, fmt.Sprintf("Removing VM %s", item.name))
 
 			return nil
 		})
+	return withCorrelationID(err, correlationIDFromRetries(retries))
+}
+
+// removeVMDisks detaches the disks of a VM that is being removed and, unless detachOnly is set, removes them. It must
+// be called with the lock held.
+func (m *mockClient) removeVMDisks(id VMID, detachOnly bool) error {
+	for _, diskAttachment := range m.vmDiskAttachmentsByVM[id] {
+		if m.disks[diskAttachment.DiskID()].status == DiskStatusLocked {
+			return newError(EConflict, "Cannot delete VM, disk %s is locked.", diskAttachment.DiskID())
+		}
+	}
+	for _, diskAttachment := range m.vmDiskAttachmentsByVM[id] {
+		delete(m.vmDiskAttachmentsByDisk, diskAttachment.DiskID())
+		if detachOnly {
+			continue
+		}
+		disk := m.disks[diskAttachment.DiskID()]
+		m.releaseStorage(disk.storageDomainIDs, disk.totalSize)
+		delete(m.disks, diskAttachment.DiskID())
+		m.removePermissionsOf(PermissionObjectTypeDisk, string(diskAttachment.DiskID()))
+	}
+	return nil
 }
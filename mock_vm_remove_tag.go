@@ -0,0 +1,17 @@
+package ovirtclient
+
+func (m *mockClient) RemoveTagFromVM(id VMID, tagID TagID, _ ...RetryStrategy) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	vm, ok := m.vms[id]
+	if !ok {
+		return newError(ENotFound, "VM with ID %s not found", id)
+	}
+	tagIDs := removeTagID(vm.tagIDs, tagID)
+	if len(tagIDs) == len(vm.tagIDs) {
+		return newError(ENotFound, "tag with ID %s is not assigned to VM %s", tagID, id)
+	}
+	vm.tagIDs = tagIDs
+	return nil
+}
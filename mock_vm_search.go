@@ -1,13 +1,30 @@
 package ovirtclient
 
+import (
+	"sort"
+	"strings"
+)
+
 func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([]VM, error) {
+	if err := validatePageParameters(params); err != nil {
+		return nil, err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	// We disable the "prealloc" linter here because it recommends preallocating result, which will lead
 	// to inefficient memory usage.
 	var result []VM //nolint:prealloc
-	for _, vm := range m.vms {
-		if name := params.Name(); name != nil && vm.name != *name {
+	ids := make([]string, 0, len(m.vms))
+	for id := range m.vms {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		vm := m.vms[VMID(id)]
+		if tag := params.Tag(); tag != nil && !m.hasTagNamed(vm.tagIDs, *tag) {
+			continue
+		}
+		if !mockVMMatchesFields(vm, params) {
 			continue
 		}
 		if statuses := params.Statuses(); statuses != nil {
@@ -34,7 +51,67 @@ func (m *mockClient) SearchVMs(params VMSearchParameters, _ ...RetryStrategy) ([
 				continue
 			}
 		}
+		if rawQuery := params.RawQuery(); rawQuery != nil {
+			matches, err := mockVMMatchesRawQuery(vm, *rawQuery)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+		}
 		result = append(result, vm)
 	}
-	return result, nil
+	start, end := mockPage(len(result), params)
+	return result[start:end], nil
+}
+
+// mockVMMatchesFields returns true if the VM matches the name, cluster, template, host and description filters of
+// the search parameters.
+func mockVMMatchesFields(vm *vm, params VMSearchParameters) bool {
+	if name := params.Name(); name != nil && vm.name != *name {
+		return false
+	}
+	if clusterID := params.ClusterID(); clusterID != nil && vm.clusterID != *clusterID {
+		return false
+	}
+	if templateID := params.TemplateID(); templateID != nil && vm.templateID != *templateID {
+		return false
+	}
+	if hostID := params.HostID(); hostID != nil && (vm.hostID == nil || *vm.hostID != *hostID) {
+		return false
+	}
+	if description := params.Description(); description != nil && vm.description != *description {
+		return false
+	}
+	return true
+}
+
+// mockVMMatchesRawQuery implements a small subset of the engine search syntax for the mock. It supports name and
+// status terms in the form of key=value, joined by "and".
+func mockVMMatchesRawQuery(vm *vm, query string) (bool, error) {
+	if strings.TrimSpace(query) == "" {
+		return false, newError(EBadArgument, "the raw search query must not be empty")
+	}
+	for _, term := range strings.Split(strings.ReplaceAll(query, " AND ", " and "), " and ") {
+		parts := strings.SplitN(term, "=", 2)
+		if len(parts) != 2 {
+			return false, newError(EUnsupported, "the mock client does not support the search term: %s", term)
+		}
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.Trim(strings.TrimSpace(parts[1]), "\"")
+		switch key {
+		case "name":
+			if vm.name != value {
+				return false, nil
+			}
+		case "status":
+			if !strings.EqualFold(string(vm.Status()), value) {
+				return false, nil
+			}
+		default:
+			return false, newError(EUnsupported, "the mock client does not support searching by %s", key)
+		}
+	}
+	return true, nil
 }
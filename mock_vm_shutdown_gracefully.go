@@ -0,0 +1,13 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+func (m *mockClient) ShutdownVMGracefully(id VMID, timeout time.Duration, retries ...RetryStrategy) (
+	VM,
+	VMShutdownMethod,
+	error,
+) {
+	return shutdownVMGracefully(m, m.logger, id, timeout, retries)
+}
@@ -1,15 +1,26 @@
 package ovirtclient
 
 import (
+	"fmt"
+	"sort"
 	"time"
 )
 
-func (m *mockClient) StartVM(id string, _ ...RetryStrategy) error {
+func (m *mockClient) StartVM(id VMID, retries ...RetryStrategy) error {
+	return m.StartVMWithParams(id, nil, retries...)
+}
+
+func (m *mockClient) StartVMWithParams(id VMID, params StartVMOptionalParameters, retries ...RetryStrategy) error {
+	if _, err := validateStartVMParams(params); err != nil {
+		return err
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
 		if item.Status() != VMStatusUp {
 			item.status = VMStatusWaitForLaunch
+			item.hostID = m.scheduleVM(item)
+			m.addVMEvent(mockEventCodeVMStarted, item, "VM %s was started.", item.name)
 			go func() {
 				time.Sleep(2 * time.Second)
 				m.lock.Lock()
@@ -21,7 +32,27 @@ func (m *mockClient) StartVM(id string, _ ...RetryStrategy) error {
 				item.status = VMStatusUp
 			}()
 		}
+		m.addCorrelatedJob(retries, fmt.Sprintf("Starting VM %s", item.name))
+		return nil
+	}
+	return withCorrelationID(
+		newError(ENotFound, "vm with ID %s not found", id),
+		correlationIDFromRetries(retries),
+	)
+}
+
+// scheduleVM returns the ID of the host the mock runs the VM on, which is the first host in the cluster of the VM
+// that is up, or nil if there is no such host. It must be called with the lock held.
+func (m *mockClient) scheduleVM(item *vm) *string {
+	ids := make([]string, 0, len(m.hosts))
+	for id, h := range m.hosts {
+		if h.clusterID == item.clusterID && h.status == HostStatusUp {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
 		return nil
 	}
-	return newError(ENotFound, "vm with ID %s not found", id)
+	sort.Strings(ids)
+	return &ids[0]
 }
@@ -1,26 +1,38 @@
 package ovirtclient
 
 import (
+	"fmt"
 	"time"
 )
 
-func (m *mockClient) StopVM(id string, force bool, _ ...RetryStrategy) error {
+func (m *mockClient) StopVM(id VMID, force bool, retries ...RetryStrategy) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if item, ok := m.vms[id]; ok {
 		if (item.status == VMStatusSavingState || item.status == VMStatusRestoringState) && !force {
-			return newError(EConflict, "VM is currently backing up or restoring.")
+			return withCorrelationID(
+				newError(EConflict, "VM is currently backing up or restoring."),
+				correlationIDFromRetries(retries),
+			)
 		}
 		if item.status != VMStatusDown {
 			item.status = VMStatusPoweringDown
+			m.addVMEvent(mockEventCodeVMStopped, item, "VM %s is down.", item.name)
 			go func() {
 				time.Sleep(2 * time.Second)
 				m.lock.Lock()
 				defer m.lock.Unlock()
 				item.status = VMStatusDown
+				item.hostID = nil
+				// Pending configuration changes are applied when the VM is started again.
+				item.nextRun = false
 			}()
 		}
+		m.addCorrelatedJob(retries, fmt.Sprintf("Stopping VM %s", item.name))
 		return nil
 	}
-	return newError(ENotFound, "vm with ID %s not found", id)
+	return withCorrelationID(
+		newError(ENotFound, "vm with ID %s not found", id),
+		correlationIDFromRetries(retries),
+	)
 }
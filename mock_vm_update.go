@@ -1,18 +1,29 @@
 package ovirtclient
 
-func (m *mockClient) UpdateVM(id string, params UpdateVMParameters, _ ...RetryStrategy) (VM, error) {
+import "fmt"
+
+func (m *mockClient) UpdateVM(id VMID, params UpdateVMParameters, retries ...RetryStrategy) (VM, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
 	if _, ok := m.vms[id]; !ok {
-		return nil, newError(ENotFound, "VM with ID %s not found", id)
+		return nil, withCorrelationID(
+			newError(ENotFound, "VM with ID %s not found", id),
+			correlationIDFromRetries(retries),
+		)
 	}
 
 	vm := m.vms[id]
 	if name := params.Name(); name != nil {
+		if err := validateVMName(m.vmNameValidator, *name); err != nil {
+			return nil, withCorrelationID(err, correlationIDFromRetries(retries))
+		}
 		for _, otherVM := range m.vms {
 			if otherVM.name == *name && otherVM.ID() != vm.ID() {
-				return nil, newError(EConflict, "A VM with the name \"%s\" already exists.", *name)
+				return nil, withCorrelationID(
+					newError(EConflict, "A VM with the name \"%s\" already exists.", *name),
+					correlationIDFromRetries(retries),
+				)
 			}
 		}
 		vm = vm.withName(*name)
@@ -20,7 +31,22 @@ func (m *mockClient) UpdateVM(id string, params UpdateVMParameters, _ ...RetrySt
 	if comment := params.Comment(); comment != nil {
 		vm = vm.withComment(*comment)
 	}
+	if mode := params.NUMATuneMode(); mode != nil {
+		if err := mode.Validate(); err != nil {
+			return nil, withCorrelationID(err, correlationIDFromRetries(retries))
+		}
+		updatedVM := *vm
+		updatedVM.numaTuneMode = *mode
+		vm = &updatedVM
+	}
+	if nodes := params.NUMANodes(); nodes != nil {
+		if err := checkVMNUMANodeCPUs(vm.cpu, nodes); err != nil {
+			return nil, withCorrelationID(err, correlationIDFromRetries(retries))
+		}
+		m.vmNUMANodes[id] = append([]VMNUMANode{}, nodes...)
+	}
 	m.vms[id] = vm
+	m.addCorrelatedJob(retries, fmt.Sprintf("Updating VM %s", vm.name))
 
 	return vm, nil
 }
@@ -0,0 +1,14 @@
+package ovirtclient
+
+func (m *mockClient) ValidateVMCreation(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries ...RetryStrategy,
+) error {
+	if err := validateVMName(m.vmNameValidator, name); err != nil {
+		return err
+	}
+	return validateVMCreation(m, clusterID, templateID, name, params, defaultRetries(retries, defaultReadTimeouts()))
+}
@@ -0,0 +1,21 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+func (m *mockClient) WaitForVM(id VMID, condition VMCondition, retries ...RetryStrategy) (vm VM, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = retry(
+		fmt.Sprintf("waiting for VM %s to meet the condition", id),
+		m.logger,
+		retries,
+		func() error {
+			vm, err = m.GetVM(id, retries...)
+			if err != nil {
+				return err
+			}
+			return checkVMCondition(vm, condition)
+		})
+	return
+}
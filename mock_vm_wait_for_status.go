@@ -4,7 +4,7 @@ import (
 	"fmt"
 )
 
-func (m *mockClient) WaitForVMStatus(id string, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
+func (m *mockClient) WaitForVMStatus(id VMID, status VMStatus, retries ...RetryStrategy) (vm VM, err error) {
 	retries = defaultRetries(retries, defaultLongTimeouts())
 	err = retry(
 		fmt.Sprintf("waiting for VM %s status %s", id, status),
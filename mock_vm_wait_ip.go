@@ -0,0 +1,27 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"net"
+)
+
+func (m *mockClient) WaitForVMIPAddresses(
+	id VMID,
+	filter ReportedDeviceFilter,
+	retries ...RetryStrategy,
+) (result map[string][]net.IP, err error) {
+	retries = defaultRetries(retries, defaultLongTimeouts())
+	err = retry(
+		fmt.Sprintf("waiting for IP addresses on VM %s", id),
+		m.logger,
+		retries,
+		func() error {
+			vm, err := m.GetVMWithParams(id, vmIPAddressesGetParams, retries...)
+			if err != nil {
+				return err
+			}
+			result, err = findVMIPAddresses(vm, filter)
+			return err
+		})
+	return
+}
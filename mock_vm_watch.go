@@ -0,0 +1,17 @@
+package ovirtclient
+
+import (
+	"time"
+)
+
+func (m *mockClient) WatchVM(id VMID, pollInterval time.Duration, retries ...RetryStrategy) (VMWatch, error) {
+	return watchVM(m, m.logger, id, pollInterval, retries)
+}
+
+func (m *mockClient) WatchVMs(
+	params VMSearchParameters,
+	pollInterval time.Duration,
+	retries ...RetryStrategy,
+) (VMWatch, error) {
+	return watchVMs(m, m.logger, params, pollInterval, retries)
+}
@@ -30,6 +30,16 @@ func (m *mockClient) CreateVNICProfile(
 		id:        id,
 		networkID: networkID,
 		name:      name,
+
+		passThroughMode: VNICPassThroughModeDisabled,
+	}
+	if params != nil {
+		if portMirroring := params.PortMirroring(); portMirroring != nil {
+			m.vnicProfiles[id].portMirroring = *portMirroring
+		}
+		if passThroughMode := params.PassThroughMode(); passThroughMode != nil {
+			m.vnicProfiles[id].passThroughMode = *passThroughMode
+		}
 	}
 
 	return m.vnicProfiles[id], nil
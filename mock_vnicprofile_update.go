@@ -0,0 +1,28 @@
+package ovirtclient
+
+func (m *mockClient) UpdateVNICProfile(
+	id string,
+	params UpdateVNICProfileParameters,
+	_ ...RetryStrategy,
+) (VNICProfile, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	profile, ok := m.vnicProfiles[id]
+	if !ok {
+		return nil, newError(ENotFound, "VNIC profile with ID %s not found", id)
+	}
+	if name := params.Name(); name != nil {
+		for _, otherProfile := range m.vnicProfiles {
+			if otherProfile.id != id && otherProfile.name == *name {
+				return nil, newError(EConflict, "VNIC profile name is already in use")
+			}
+		}
+		profile = profile.withName(*name)
+	}
+	if portMirroring := params.PortMirroring(); portMirroring != nil {
+		profile = profile.withPortMirroring(*portMirroring)
+	}
+	m.vnicProfiles[id] = profile
+
+	return profile, nil
+}
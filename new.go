@@ -1,6 +1,7 @@
 package ovirtclient
 
 import (
+	"crypto/tls"
 	"math/rand"
 	"net/http"
 	"strings"
@@ -12,7 +13,8 @@ import (
 // ExtraSettings are the optional settings for the oVirt connection.
 //
 // For future development, an interface named ExtraSettingsV2, V3, etc. will be added that incorporate this interface.
-// This is done for backwards compatibility.
+// This is done for backwards compatibility. Instead of implementing these interfaces, use NewExtraSettings to obtain a
+// builder implementing the newest version.
 type ExtraSettings interface {
 	// ExtraHeaders adds headers to the request.
 	ExtraHeaders() map[string]string
@@ -20,6 +22,118 @@ type ExtraSettings interface {
 	Compression() bool
 }
 
+// ExtraSettingsV2 extends ExtraSettings with a default correlation ID for the client.
+type ExtraSettingsV2 interface {
+	ExtraSettings
+
+	// CorrelationID returns the correlation ID attached to all create, update and delete calls of the client, unless
+	// a different one is passed to the call using the CorrelationID option. An empty string disables this feature.
+	CorrelationID() string
+}
+
+// ExtraSettingsV3 extends ExtraSettingsV2 with a metrics collector for the client.
+type ExtraSettingsV3 interface {
+	ExtraSettingsV2
+
+	// MetricsCollector returns the collector that receives measurements about the API calls of the client. If nil, no
+	// metrics are collected.
+	MetricsCollector() MetricsCollector
+}
+
+// ExtraSettingsV4 extends ExtraSettingsV3 with a tracer for the client.
+type ExtraSettingsV4 interface {
+	ExtraSettingsV3
+
+	// Tracer returns the tracer that creates spans around the API calls of the client. If nil, no spans are created.
+	Tracer() Tracer
+}
+
+// ExtraSettingsV5 extends ExtraSettingsV4 with a client-side rate limit.
+type ExtraSettingsV5 interface {
+	ExtraSettingsV4
+
+	// RateLimit returns the maximum average number of API calls per second the client makes. Calls exceeding the limit
+	// wait until they are allowed. Zero disables the rate limit.
+	RateLimit() float64
+	// RateLimitBurst returns the number of API calls that can be made at once before the rate limit applies. Zero
+	// means one call.
+	RateLimitBurst() uint
+}
+
+// ExtraSettingsV6 extends ExtraSettingsV5 with tuning options for the HTTP connections.
+type ExtraSettingsV6 interface {
+	ExtraSettingsV5
+
+	// HTTPTransport returns the tuning options for the HTTP connections of the client. Use HTTPTransportParams to
+	// obtain a builder. If nil, the defaults are used.
+	HTTPTransport() HTTPTransportParameters
+}
+
+// ExtraSettingsV7 extends ExtraSettingsV6 with proxy settings.
+type ExtraSettingsV7 interface {
+	ExtraSettingsV6
+
+	// Proxy returns the proxy settings of the client. Use ProxyParams to obtain a builder. If nil, no proxy is used.
+	Proxy() ProxyParameters
+}
+
+// ExtraSettingsV8 extends ExtraSettingsV7 with a dry-run mode.
+type ExtraSettingsV8 interface {
+	ExtraSettingsV7
+
+	// DryRun returns true if the client should only validate and log create, update and delete calls instead of
+	// sending them to the oVirt Engine. The requests these calls would send are logged including their body, and
+	// the calls return an EDryRun error.
+	DryRun() bool
+}
+
+// ExtraSettingsV9 extends ExtraSettingsV8 with a read-only mode.
+type ExtraSettingsV9 interface {
+	ExtraSettingsV8
+
+	// ReadOnly returns true if the client should reject all create, update and delete calls with an EReadOnly error
+	// instead of sending them to the oVirt Engine. This takes precedence over DryRun.
+	ReadOnly() bool
+}
+
+// ExtraSettingsV10 extends ExtraSettingsV9 with failover URLs for environments with multiple engines.
+type ExtraSettingsV10 interface {
+	ExtraSettingsV9
+
+	// FailoverURLs returns the URLs of standby oVirt Engines. If the engine at the current URL cannot be reached, the
+	// client switches to the next URL in order and repeats the call. After the last URL, the client starts over with
+	// the URL passed to New. If empty, the client does not fail over.
+	FailoverURLs() []string
+}
+
+// ExtraSettingsV11 extends ExtraSettingsV10 with a custom User-Agent, so the requests of downstream products can be
+// identified in the engine logs.
+type ExtraSettingsV11 interface {
+	ExtraSettingsV10
+
+	// UserAgentSuffix returns the text appended to the User-Agent header sent with each request, for example
+	// myproduct/1.2.3. If empty, the default User-Agent of the oVirt SDK is used.
+	UserAgentSuffix() string
+}
+
+// ExtraSettingsV12 extends ExtraSettingsV11 with an audit sink recording the calls that change the oVirt Engine.
+type ExtraSettingsV12 interface {
+	ExtraSettingsV11
+
+	// AuditSink returns the sink that records each create, update and delete call of the client. If nil, no calls are
+	// recorded.
+	AuditSink() AuditSink
+}
+
+// ExtraSettingsV13 extends ExtraSettingsV12 with a custom validator for VM names.
+type ExtraSettingsV13 interface {
+	ExtraSettingsV12
+
+	// VMNameValidator returns the validator checking the names of created and renamed VMs before the request is sent.
+	// If nil, DefaultVMNameValidator is used.
+	VMNameValidator() VMNameValidator
+}
+
 // New creates a new copy of the enhanced oVirt client. It accepts the following options:
 //
 //   url
@@ -46,7 +160,8 @@ type ExtraSettings interface {
 //   extraSettings
 //
 // This is an implementation of the ExtraSettings interface, allowing for customization of headers and turning on
-// compression.
+// compression. If it also implements a later version of the interface, such as ExtraSettingsV2, the additional
+// settings of that version are applied as well.
 //
 // TLS
 //
@@ -82,7 +197,12 @@ type ExtraSettings interface {
 //
 // This library also supports customizing the connection settings. In order to stay backwards compatible the
 // extraSettings parameter must implement the ovirtclient.ExtraSettings interface. Future versions of this library will
-// add new interfaces (e.g. ExtraSettingsV2) to add new features without breaking compatibility.
+// add new interfaces (e.g. ExtraSettingsV2) to add new features without breaking compatibility. The easiest way to
+// pass extra settings is the builder returned from NewExtraSettings, which implements the newest interface:
+//
+//    extraSettings := ovirtclient.NewExtraSettings().
+//        WithCompression().
+//        WithUserAgentSuffix("myproduct/1.2.3")
 func New(
 	url string,
 	username string,
@@ -105,40 +225,54 @@ func NewWithVerify(
 	extraSettings ExtraSettings,
 	verify func(connection Client) error,
 ) (ClientWithLegacySupport, error) {
-	if err := validateURL(url); err != nil {
-		return nil, wrap(err, EBadArgument, "invalid URL: %s", url)
-	}
 	if err := validateUsername(username); err != nil {
 		return nil, wrap(err, "invalid username: %s", username)
 	}
+	return newClient(url, credentials{username: username, password: password}, tls, logger, extraSettings, verify)
+}
+
+// credentials are the credentials the client logs in with. Either the username and password or the token source are
+// set.
+type credentials struct {
+	username string
+	password string
+	tokens   *tokenSource
+}
+
+// newClient implements NewWithVerify and NewWithTokenProvider.
+func newClient(
+	url string,
+	creds credentials,
+	tls TLSProvider,
+	logger Logger,
+	extraSettings ExtraSettings,
+	verify func(connection Client) error,
+) (ClientWithLegacySupport, error) {
+	urls, err := engineURLs(url, extraSettings)
+	if err != nil {
+		return nil, err
+	}
 	tlsConfig, err := tls.CreateTLSConfig()
 	if err != nil {
 		return nil, wrap(err, ETLSError, "failed to create TLS configuration")
 	}
 
-	connBuilder := ovirtsdk4.NewConnectionBuilder().
-		URL(url).
-		Username(username).
-		Password(password).
-		TLSConfig(tlsConfig)
-	if extraSettings != nil {
-		if len(extraSettings.ExtraHeaders()) > 0 {
-			connBuilder.Headers(extraSettings.ExtraHeaders())
-		}
-		if extraSettings.Compression() {
-			connBuilder.Compress(true)
-		}
+	transportParams, proxy := transportSettings(extraSettings)
+	compress := extraSettings != nil && extraSettings.Compression()
+	apiClient := newAPIHTTPClient(tlsConfig, transportParams, proxy, compress)
+	if extraSettingsV8, ok := extraSettings.(ExtraSettingsV8); ok && extraSettingsV8.DryRun() {
+		apiClient.Transport = &dryRunTransport{base: apiClient.Transport, logger: logger}
 	}
-
-	conn, err := connBuilder.Build()
+	if creds.tokens != nil {
+		apiClient.Transport = &tokenTransport{base: apiClient.Transport, tokens: creds.tokens}
+	}
+	conn, err := buildConnection(url, creds, tlsConfig, extraSettings, apiClient)
 	if err != nil {
 		return nil, wrap(err, EUnidentified, "failed to create underlying oVirt connection")
 	}
 
 	httpClient := http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-		},
+		Transport: newHeaderTransport(newHTTPTransport(tlsConfig, transportParams, proxy), requestHeaders(extraSettings)),
 	}
 
 	client := &oVirtClient{
@@ -146,18 +280,124 @@ func NewWithVerify(
 		httpClient:      httpClient,
 		logger:          logger,
 		url:             url,
+		urls:            urls,
+		username:        creds.username,
+		stats:           newStatsCollector(),
 		nonSecureRandom: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
 	}
+	client.connect = func() (*ovirtsdk4.Connection, error) {
+		return buildConnection(client.url, creds, tlsConfig, extraSettings, apiClient)
+	}
+	applyExtendedSettings(client, extraSettings)
 
 	if verify != nil {
 		if err := verify(client); err != nil {
 			return nil, err
 		}
 	}
+	client.setTokenUser(creds)
 
 	return client, nil
 }
 
+// engineURLs returns the URL followed by the failover URLs from ExtraSettingsV10, if any. It returns an error if any of
+// the URLs is invalid.
+func engineURLs(url string, extraSettings ExtraSettings) ([]string, error) {
+	urls := []string{url}
+	if extraSettingsV10, ok := extraSettings.(ExtraSettingsV10); ok {
+		urls = append(urls, extraSettingsV10.FailoverURLs()...)
+	}
+	for _, u := range urls {
+		if err := validateURL(u); err != nil {
+			return nil, wrap(err, EBadArgument, "invalid URL: %s", u)
+		}
+	}
+	return urls, nil
+}
+
+// transportSettings returns the HTTP transport settings from ExtraSettingsV6 and the proxy settings from
+// ExtraSettingsV7. Both are nil if the extra settings do not provide them.
+func transportSettings(extraSettings ExtraSettings) (HTTPTransportParameters, ProxyParameters) {
+	var transportParams HTTPTransportParameters
+	if extraSettingsV6, ok := extraSettings.(ExtraSettingsV6); ok {
+		transportParams = extraSettingsV6.HTTPTransport()
+	}
+	var proxy ProxyParameters
+	if extraSettingsV7, ok := extraSettings.(ExtraSettingsV7); ok {
+		proxy = extraSettingsV7.Proxy()
+	}
+	return transportParams, proxy
+}
+
+// buildConnection creates the underlying SDK connection sending its requests using apiClient. If the credentials
+// contain a token source, apiClient adds its tokens to the requests, so only a token is obtained here to fail early if
+// the token provider does not work.
+func buildConnection(
+	url string,
+	creds credentials,
+	tlsConfig *tls.Config,
+	extraSettings ExtraSettings,
+	apiClient *http.Client,
+) (*ovirtsdk4.Connection, error) {
+	username, password := creds.username, creds.password
+	if creds.tokens != nil {
+		if _, err := creds.tokens.get(); err != nil {
+			return nil, err
+		}
+		// The SDK refuses to build a connection without a username and password even if it never logs in.
+		username, password = tokenPlaceholderCredential, tokenPlaceholderCredential
+	}
+	connBuilder := ovirtsdk4.NewConnectionBuilder().
+		URL(url).
+		Username(username).
+		Password(password).
+		TLSConfig(tlsConfig)
+	if headers := requestHeaders(extraSettings); len(headers) > 0 {
+		connBuilder.Headers(headers)
+	}
+	if extraSettings != nil {
+		if extraSettings.Compression() {
+			connBuilder.Compress(true)
+		}
+	}
+	conn, err := connBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := setConnectionField(conn, "client", apiClient); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// applyExtendedSettings applies the settings of ExtraSettingsV2 and later to the client.
+func applyExtendedSettings(client *oVirtClient, extraSettings ExtraSettings) {
+	if extraSettingsV2, ok := extraSettings.(ExtraSettingsV2); ok {
+		client.correlationID = extraSettingsV2.CorrelationID()
+	}
+	if extraSettingsV3, ok := extraSettings.(ExtraSettingsV3); ok {
+		client.metrics = extraSettingsV3.MetricsCollector()
+	}
+	if extraSettingsV4, ok := extraSettings.(ExtraSettingsV4); ok {
+		client.tracer = extraSettingsV4.Tracer()
+	}
+	if extraSettingsV5, ok := extraSettings.(ExtraSettingsV5); ok {
+		client.rateLimiter = newRateLimiter(extraSettingsV5.RateLimit(), extraSettingsV5.RateLimitBurst())
+	}
+	if extraSettingsV8, ok := extraSettings.(ExtraSettingsV8); ok {
+		client.dryRun = extraSettingsV8.DryRun()
+	}
+	if extraSettingsV9, ok := extraSettings.(ExtraSettingsV9); ok {
+		client.readOnly = extraSettingsV9.ReadOnly()
+	}
+	if extraSettingsV12, ok := extraSettings.(ExtraSettingsV12); ok {
+		client.auditSink = extraSettingsV12.AuditSink()
+	}
+	if extraSettingsV13, ok := extraSettings.(ExtraSettingsV13); ok {
+		client.vmNameValidator = extraSettingsV13.VMNameValidator()
+	}
+}
+
 func testConnection(conn Client) error {
 	return conn.Test()
 }
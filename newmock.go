@@ -1,6 +1,12 @@
 package ovirtclient
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -15,111 +21,321 @@ func NewMock() MockClient {
 	return NewMockWithLogger(&noopLogger{})
 }
 
+// NewMockWithExtraSettings is identical to NewMockWithLogger, but accepts the extra settings passed to New. Only the
+// settings affecting the behavior of the mock are applied, currently the VMNameValidator of ExtraSettingsV13. The
+// extraSettings may be nil.
+func NewMockWithExtraSettings(logger Logger, extraSettings ExtraSettings) MockClient {
+	client := NewMockWithLogger(logger).(*mockClient)
+	if extraSettingsV13, ok := extraSettings.(ExtraSettingsV13); ok {
+		client.vmNameValidator = extraSettingsV13.VMNameValidator()
+	}
+	return client
+}
+
 // NewMockWithLogger is identical to NewMock, but accepts a logger.
 func NewMockWithLogger(logger Logger) MockClient {
-	testCluster := generateTestCluster()
+	testCluster := generateTestCluster("Test cluster")
+	secondaryCluster := generateTestCluster("Secondary test cluster")
 	testHost := generateTestHost(testCluster)
-	testStorageDomain := generateTestStorageDomain()
-	secondaryStorageDomain := generateTestStorageDomain()
-	testDatacenter := generateTestDatacenter(testCluster)
+	secondaryHost := generateTestHost(secondaryCluster)
+	testHostNIC := generateTestHostNIC(testHost)
+	testFenceAgent := generateTestFenceAgent(testHost)
+	testDatacenter := generateTestDatacenter(testCluster, secondaryCluster)
+	testStorageDomain := generateTestStorageDomain(testDatacenter)
+	secondaryStorageDomain := generateTestStorageDomain(testDatacenter)
+	testExportStorageDomain := generateTestExportStorageDomain(testDatacenter)
 	testNetwork := generateTestNetwork(testDatacenter)
 	testVNICProfile := generateTestVNICProfile(testNetwork)
-	blankTemplate := &template{
-		nil,
-		DefaultBlankTemplateID,
-		"Blank",
-		"Blank template",
-		TemplateStatusOK,
-		&vmCPU{
-			&vmCPUTopo{
-				cores:   1,
-				threads: 1,
-				sockets: 1,
-			},
-		},
-	}
+	testNetworkProvider := generateTestNetworkProvider()
+	testExternalNetwork := generateTestExternalNetwork(testNetworkProvider)
+	blankTemplate := generateBlankTemplate()
 
 	client := getClient(
 		logger,
-		testStorageDomain,
-		secondaryStorageDomain,
-		testCluster,
-		testHost,
+		[]*storageDomain{testStorageDomain, secondaryStorageDomain, testExportStorageDomain},
+		[]*cluster{testCluster, secondaryCluster},
+		[]*host{testHost, secondaryHost},
+		testHostNIC,
+		testFenceAgent,
 		blankTemplate,
 		testVNICProfile,
 		testNetwork,
 		testDatacenter,
+		testNetworkProvider,
+		testExternalNetwork,
 	)
 
 	testCluster.client = client
+	secondaryCluster.client = client
 	testHost.client = client
+	secondaryHost.client = client
+	testHostNIC.client = client
+	testFenceAgent.client = client
 	blankTemplate.client = client
 	testStorageDomain.client = client
 	secondaryStorageDomain.client = client
+	testExportStorageDomain.client = client
 	testDatacenter.client = client
 	testNetwork.client = client
 	testVNICProfile.client = client
+	testNetworkProvider.client = client
+	testExternalNetwork.client = client
 
 	return client
 }
 
 func getClient(
 	logger Logger,
-	testStorageDomain *storageDomain,
-	secondaryStorageDomain *storageDomain,
-	testCluster *cluster,
-	testHost *host,
+	testStorageDomains []*storageDomain,
+	testClusters []*cluster,
+	testHosts []*host,
+	testHostNIC *hostNIC,
+	testFenceAgent *fenceAgent,
 	blankTemplate *template,
 	testVNICProfile *vnicProfile,
 	testNetwork *network,
 	testDatacenter *datacenterWithClusters,
+	testNetworkProvider *networkProvider,
+	testExternalNetwork *externalNetwork,
 ) *mockClient {
 	client := &mockClient{
 		logger:          logger,
 		url:             "https://localhost/ovirt-engine/api",
 		lock:            &sync.Mutex{},
-		vms:             map[string]*vm{},
-		tags:            map[string]*tag{},
 		nonSecureRandom: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
-		storageDomains: map[string]*storageDomain{
-			testStorageDomain.ID():      testStorageDomain,
-			secondaryStorageDomain.ID(): secondaryStorageDomain,
-		},
-		disks: map[string]*diskWithData{},
-		clusters: map[string]*cluster{
-			testCluster.ID(): testCluster,
-		},
-		hosts: map[string]*host{
-			testHost.ID(): testHost,
+		stats:           newStatsCollector(),
+		storageDomains:  make(map[string]*storageDomain, len(testStorageDomains)),
+		clusters:        make(map[ClusterID]*cluster, len(testClusters)),
+		hostNICs: map[string]*hostNIC{
+			testHostNIC.ID(): testHostNIC,
 		},
-		templates: map[TemplateID]*template{
-			blankTemplate.ID(): blankTemplate,
+		hostNICLabels: map[string][]string{},
+		hostFenceAgents: map[string]*fenceAgent{
+			testFenceAgent.ID(): testFenceAgent,
 		},
-		nics: map[string]*nic{},
 		vnicProfiles: map[string]*vnicProfile{
 			testVNICProfile.ID(): testVNICProfile,
 		},
 		networks: map[string]*network{
 			testNetwork.ID(): testNetwork,
 		},
+		networkLabels: map[string][]string{},
+		networkProviders: map[string]*networkProvider{
+			testNetworkProvider.ID(): testNetworkProvider,
+		},
+		externalNetworks: map[string]*externalNetwork{
+			testExternalNetwork.ID(): testExternalNetwork,
+		},
 		dataCenters: map[string]*datacenterWithClusters{
 			testDatacenter.ID(): testDatacenter,
 		},
-		vmDiskAttachmentsByVM:   map[string]map[string]*diskAttachment{},
-		vmDiskAttachmentsByDisk: map[string]*diskAttachment{},
-		templateDiskAttachmentsByTemplate: map[TemplateID][]*templateDiskAttachment{
-			blankTemplate.ID(): {},
-		},
-		templateDiskAttachmentsByDisk: map[string]*templateDiskAttachment{},
 	}
+	for _, sd := range testStorageDomains {
+		client.storageDomains[sd.ID()] = sd
+	}
+	initMockWorkloads(client, blankTemplate)
+	for _, c := range testClusters {
+		client.clusters[c.ID()] = c
+	}
+	initMockHosts(client, testHosts)
+	initMockDirectory(client)
+	initMockRoles(client)
+	initMockInstanceTypes(client)
+	initMockOperatingSystems(client)
+	initMockExternalProviders(client)
 	return client
 }
 
+// initMockExternalProviders adds a Foreman host provider and the public ovirt-image-repository Glance provider the
+// engine comes with, offering a small CirrOS image.
+func initMockExternalProviders(client *mockClient) {
+	testHostProvider := &hostProvider{
+		id:                     uuid.NewString(),
+		name:                   "foreman",
+		description:            "Test Foreman host provider",
+		url:                    "https://localhost:443",
+		requiresAuthentication: true,
+		username:               "admin",
+	}
+	client.hostProviders = map[string]*hostProvider{testHostProvider.ID(): testHostProvider}
+	testImageProvider := &imageProvider{
+		client:      client,
+		id:          uuid.NewString(),
+		name:        "ovirt-image-repository",
+		description: "Public Glance repository for oVirt",
+		url:         "http://glance.ovirt.org:9292",
+	}
+	client.imageProviders = map[string]*imageProvider{testImageProvider.ID(): testImageProvider}
+	testImage := &glanceImageWithData{
+		glanceImage{
+			client:      client,
+			id:          uuid.NewString(),
+			name:        "CirrOS 0.5.2 for x86_64",
+			description: "CirrOS test image",
+			providerID:  testImageProvider.ID(),
+		},
+		117440512,
+	}
+	client.glanceImages = map[string]*glanceImageWithData{testImage.id: testImage}
+}
+
+// initMockRoles adds a subset of the built-in roles of the engine. The role IDs are generated since they should be
+// looked up by name.
+func initMockRoles(client *mockClient) {
+	administrativeRoles := []string{"SuperUser", "ClusterAdmin", "DataCenterAdmin", "TemplateAdmin"}
+	userRoles := []string{
+		"UserRole", "PowerUserRole", "UserVmManager", "UserTemplateBasedVm", "TemplateOwner", "DiskOperator",
+		"DiskCreator", "VmCreator", "TemplateCreator",
+	}
+	client.roles = make(map[string]*role, len(administrativeRoles)+len(userRoles))
+	for _, name := range administrativeRoles {
+		r := &role{id: uuid.NewString(), name: name, administrative: true}
+		client.roles[r.id] = r
+	}
+	for _, name := range userRoles {
+		r := &role{id: uuid.NewString(), name: name}
+		client.roles[r.id] = r
+	}
+}
+
+// initMockInstanceTypes adds the instance types the engine comes with. Like the roles, their IDs are generated since
+// they should be looked up by name.
+func initMockInstanceTypes(client *mockClient) {
+	const gib = 1024 * 1024 * 1024
+	instanceTypes := []*instanceType{
+		{name: "Tiny", description: "Tiny instance type", cpu: &vmCPUTopo{1, 1, 1}, memory: gib / 2},
+		{name: "Small", description: "Small instance type", cpu: &vmCPUTopo{1, 1, 1}, memory: 2 * gib},
+		{name: "Medium", description: "Medium instance type", cpu: &vmCPUTopo{1, 1, 2}, memory: 4 * gib},
+		{name: "Large", description: "Large instance type", cpu: &vmCPUTopo{1, 1, 2}, memory: 8 * gib},
+		{name: "XLarge", description: "Extra Large instance type", cpu: &vmCPUTopo{1, 1, 4}, memory: 16 * gib},
+	}
+	client.instanceTypes = make(map[InstanceTypeID]*instanceType, len(instanceTypes))
+	for _, i := range instanceTypes {
+		i.id = InstanceTypeID(uuid.NewString())
+		// The engine allows hot-plugging memory up to four times the initial memory by default.
+		i.maxMemory = 4 * i.memory
+		client.instanceTypes[i.id] = i
+	}
+}
+
+// initMockOperatingSystems adds a subset of the operating systems the engine supports, using the same IDs.
+func initMockOperatingSystems(client *mockClient) {
+	operatingSystems := []*operatingSystem{
+		{"0", "other", "Other OS", CPUArchitectureX86_64},
+		{"5", "other_linux", "Linux", CPUArchitectureX86_64},
+		{"1001", "other_ppc64", "Other OS", CPUArchitecturePPC64},
+		{"1002", "other_linux_ppc64", "Linux", CPUArchitecturePPC64},
+		{"2001", "other_s390x", "Other OS", CPUArchitectureS390X},
+		{"2002", "other_linux_s390x", "Linux", CPUArchitectureS390X},
+	}
+	client.operatingSystems = make(map[string]*operatingSystem, len(operatingSystems))
+	for _, o := range operatingSystems {
+		client.operatingSystems[o.id] = o
+	}
+}
+
+// The name of the built-in authorization domain of the engine and the ID of the built-in Everyone group.
+const (
+	mockInternalDomainName = "internal-authz"
+	mockEveryoneGroupID    = "eee00000-0000-0000-0000-123456789eee"
+)
+
+// initMockDirectory sets up the built-in internal directory service with the admin user, similar to a freshly
+// installed engine. The admin user and the built-in Everyone group are known to the engine.
+func initMockDirectory(client *mockClient) {
+	internalDomain := &directoryDomain{uuid.NewString(), mockInternalDomainName}
+	directoryAdmin := &user{
+		client:     client,
+		id:         uuid.NewString(),
+		userName:   "admin@" + mockInternalDomainName,
+		principal:  "admin",
+		name:       "admin",
+		domainName: mockInternalDomainName,
+	}
+	admin := *directoryAdmin
+	admin.id = uuid.NewString()
+
+	client.directoryDomains = map[string]*directoryDomain{internalDomain.ID(): internalDomain}
+	client.directoryUsers = map[string][]*user{internalDomain.ID(): {directoryAdmin}}
+	client.directoryGroups = map[string][]*group{internalDomain.ID(): {}}
+	client.users = map[string]*user{admin.ID(): &admin}
+	client.groups = map[string]*group{
+		mockEveryoneGroupID: {client: client, id: mockEveryoneGroupID, name: "Everyone"},
+	}
+}
+
+// initMockHosts adds the hosts to the client together with their NUMA nodes, hugepages, devices and errata.
+func initMockHosts(client *mockClient, testHosts []*host) {
+	client.hosts = make(map[string]*host, len(testHosts))
+	client.hostNUMANodes = make(map[string][]*hostNUMANode, len(testHosts))
+	client.hostHugePages = make(map[string][]*hostHugePages, len(testHosts))
+	client.hostDevices = make(map[string][]*hostDevice, len(testHosts))
+	client.hostErrata = make(map[string][]*erratum, len(testHosts))
+	for _, h := range testHosts {
+		client.hosts[h.ID()] = h
+		client.hostNUMANodes[h.ID()] = generateTestNUMANodes(h)
+		client.hostHugePages[h.ID()] = generateTestHugePages()
+		client.hostDevices[h.ID()] = generateTestHostDevices(h)
+		client.hostErrata[h.ID()] = generateTestHostErrata()
+	}
+}
+
+// initMockWorkloads initializes the client with no disks, VMs, tags, quotas, permissions, events, jobs and exported
+// VMs and templates, and only the blank template.
+func initMockWorkloads(client *mockClient, blankTemplate *template) {
+	client.vms = map[VMID]*vm{}
+	client.tags = map[TagID]*tag{}
+	client.quotas = map[QuotaID]*quota{}
+	client.quotaClusterLimits = map[QuotaID][]*quotaClusterLimit{}
+	client.quotaStorageLimits = map[QuotaID][]*quotaStorageLimit{}
+	client.permissions = map[string]*permission{}
+	client.events = []*event{}
+	client.jobs = map[string]*job{}
+	client.jobSteps = map[string][]*jobStep{}
+	client.disks = map[DiskID]*diskWithData{}
+	client.templates = map[TemplateID]*template{
+		blankTemplate.ID(): blankTemplate,
+	}
+	client.nics = map[NICID]*nic{}
+	client.nicReportedDevices = map[NICID]*reportedDevice{}
+	client.vmDiskAttachmentsByVM = map[VMID]map[string]*diskAttachment{}
+	client.vmDiskAttachmentsByDisk = map[DiskID]*diskAttachment{}
+	client.vmNUMANodes = map[VMID][]VMNUMANode{}
+	client.vmBackups = map[VMID][]*vmBackup{}
+	client.vmCheckpoints = map[VMID][]*vmCheckpoint{}
+	client.templateDiskAttachmentsByTemplate = map[TemplateID][]*templateDiskAttachment{
+		blankTemplate.ID(): {},
+	}
+	client.templateDiskAttachmentsByDisk = map[DiskID]*templateDiskAttachment{}
+	client.storageDomainVMs = map[string]map[VMID]*storageDomainVMWithData{}
+	client.storageDomainTemplates = map[string]map[TemplateID]*storageDomainTemplateWithData{}
+}
+
+func generateBlankTemplate() *template {
+	return &template{
+		nil,
+		DefaultBlankTemplateID,
+		"Blank",
+		"Blank template",
+		TemplateStatusOK,
+		time.Now(),
+		&vmCPU{
+			&vmCPUTopo{
+				cores:   1,
+				threads: 1,
+				sockets: 1,
+			},
+		},
+		nil,
+	}
+}
+
 func generateTestVNICProfile(testNetwork *network) *vnicProfile {
 	return &vnicProfile{
-		id:        uuid.NewString(),
-		name:      "test",
-		networkID: testNetwork.ID(),
+		id:              uuid.NewString(),
+		name:            "test",
+		networkID:       testNetwork.ID(),
+		passThroughMode: VNICPassThroughModeDisabled,
 	}
 }
 
@@ -131,39 +347,237 @@ func generateTestNetwork(testDatacenter *datacenterWithClusters) *network {
 	}
 }
 
-func generateTestDatacenter(testCluster *cluster) *datacenterWithClusters {
+func generateTestNetworkProvider() *networkProvider {
+	return &networkProvider{
+		id:                 uuid.NewString(),
+		name:               "ovirt-provider-ovn",
+		description:        "Test OVN network provider",
+		url:                "https://localhost:9696",
+		externalPluginType: "ovirt-provider-ovn",
+	}
+}
+
+func generateTestExternalNetwork(testNetworkProvider *networkProvider) *externalNetwork {
+	return &externalNetwork{
+		id:         uuid.NewString(),
+		name:       "test-external",
+		providerID: testNetworkProvider.ID(),
+	}
+}
+
+func generateTestDatacenter(testClusters ...*cluster) *datacenterWithClusters {
+	clusterIDs := make([]ClusterID, len(testClusters))
+	for i, c := range testClusters {
+		clusterIDs[i] = c.ID()
+	}
 	return &datacenterWithClusters{
 		datacenter: datacenter{
-			id:   uuid.NewString(),
-			name: "test",
-		},
-		clusters: []string{
-			testCluster.ID(),
+			id:            uuid.NewString(),
+			name:          "test",
+			status:        DatacenterStatusUp,
+			storageFormat: StorageFormatV5,
 		},
+		clusters: clusterIDs,
 	}
 }
 
-func generateTestStorageDomain() *storageDomain {
+func generateTestStorageDomain(testDatacenter *datacenterWithClusters) *storageDomain {
 	return &storageDomain{
 		id:             uuid.NewString(),
 		name:           "Test storage domain",
 		available:      10 * 1024 * 1024 * 1024,
+		storageType:    StorageDomainTypeNFS,
+		function:       StorageDomainFunctionData,
 		status:         StorageDomainStatusActive,
 		externalStatus: StorageDomainExternalStatusNA,
+		datacenterIDs:  []string{testDatacenter.ID()},
 	}
 }
 
-func generateTestCluster() *cluster {
+func generateTestExportStorageDomain(testDatacenter *datacenterWithClusters) *storageDomain {
+	sd := generateTestStorageDomain(testDatacenter)
+	sd.name = "Test export domain"
+	sd.function = StorageDomainFunctionExport
+	return sd
+}
+
+func generateTestCluster(name string) *cluster {
 	return &cluster{
-		id:   uuid.NewString(),
-		name: "Test cluster",
+		id:                   ClusterID(uuid.NewString()),
+		name:                 name,
+		cpuArchitecture:      CPUArchitectureX86_64,
+		cpuType:              "Intel Cascadelake Server Family",
+		compatibilityVersion: &clusterVersion{major: 4, minor: 6},
+		biosType:             BIOSTypeQ35SeaBIOS,
+
+		memoryOverCommitPercent: 100,
+		ballooningEnabled:       true,
+		ksmEnabled:              true,
+		ksmMergeAcrossNodes:     true,
 	}
 }
 
 func generateTestHost(c *cluster) *host {
+	id := uuid.NewString()
 	return &host{
-		id:        uuid.NewString(),
+		id:        id,
 		clusterID: c.ID(),
 		status:    HostStatusUp,
+		name:      fmt.Sprintf("%s host", c.name),
+		address:   fmt.Sprintf("host-%s.localdomain", id[:8]),
+		cpu: &hostCPU{
+			name:    "Test CPU",
+			speed:   2400,
+			cpuType: "Intel Cascadelake Server Family",
+			topo:    &vmCPUTopo{cores: 4, threads: 2, sockets: 2},
+		},
+		memory:              64 * 1024 * 1024 * 1024,
+		maxSchedulingMemory: 60 * 1024 * 1024 * 1024,
+		numaSupported:       true,
+		ssh:                 generateTestHostSSH(),
+		certificate: &hostCertificate{
+			subject:      fmt.Sprintf("O=localdomain,CN=host-%s.localdomain", id[:8]),
+			organization: "localdomain",
+		},
+	}
+}
+
+// generateTestHostSSH creates the SSH details of a host with a random Ed25519 host key. The fingerprint is calculated
+// the same way as ssh-keygen -l does.
+func generateTestHostSSH() *hostSSH {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to generate SSH host key (%w)", err))
+	}
+	keyType := "ssh-ed25519"
+	wireFormat := &bytes.Buffer{}
+	for _, field := range [][]byte{[]byte(keyType), publicKey} {
+		_ = binary.Write(wireFormat, binary.BigEndian, uint32(len(field)))
+		_, _ = wireFormat.Write(field)
+	}
+	fingerprint := sha256.Sum256(wireFormat.Bytes())
+	return &hostSSH{
+		port:        22,
+		fingerprint: "SHA256:" + base64.RawStdEncoding.EncodeToString(fingerprint[:]),
+		publicKey:   keyType + " " + base64.StdEncoding.EncodeToString(wireFormat.Bytes()),
+	}
+}
+
+// generateTestNUMANodes creates one NUMA node for each socket of the host, splitting the CPUs and memory evenly.
+func generateTestNUMANodes(h *host) []*hostNUMANode {
+	sockets := h.cpu.topo.sockets
+	cpusPerNode := h.cpu.topo.cores * h.cpu.topo.threads
+	nodes := make([]*hostNUMANode, sockets)
+	for i := uint(0); i < sockets; i++ {
+		node := &hostNUMANode{
+			index:     i,
+			memory:    h.memory / uint64(sockets),
+			cpus:      make([]uint, cpusPerNode),
+			distances: make([]uint, sockets),
+		}
+		for j := uint(0); j < cpusPerNode; j++ {
+			node.cpus[j] = i*cpusPerNode + j
+		}
+		for j := uint(0); j < sockets; j++ {
+			node.distances[j] = 21
+		}
+		node.distances[i] = 10
+		nodes[i] = node
+	}
+	return nodes
+}
+
+func generateTestHugePages() []*hostHugePages {
+	return []*hostHugePages{
+		{size: VMHugePages2M, free: 1024},
+		{size: VMHugePages1G, free: 8},
+	}
+}
+
+// generateTestHostErrata creates a security and a bug fix erratum as if the host was registered as a content host in
+// Katello.
+func generateTestHostErrata() []*erratum {
+	return []*erratum{
+		{
+			id:          uuid.NewString(),
+			name:        "RHSA-2021:3058",
+			title:       "Important: glib2 security update",
+			erratumType: ErratumTypeSecurity,
+			severity:    "Important",
+			summary:     "An update for glib2 is now available.",
+			solution:    "Update the affected packages and restart the services using them.",
+			issued:      time.Date(2021, 8, 10, 0, 0, 0, 0, time.UTC),
+			packages:    []string{"glib2-2.56.4-10.el8_4.1.x86_64"},
+		},
+		{
+			id:          uuid.NewString(),
+			name:        "RHBA-2021:3080",
+			title:       "vdsm bug fix update",
+			erratumType: ErratumTypeBugfix,
+			summary:     "An update for vdsm is now available.",
+			solution:    "Update the affected packages.",
+			issued:      time.Date(2021, 8, 12, 0, 0, 0, 0, time.UTC),
+			packages:    []string{"vdsm-4.40.80.5-1.el8ev.x86_64", "vdsm-common-4.40.80.5-1.el8ev.noarch"},
+		},
+	}
+}
+
+// generateTestHostDevices creates a small device tree with a PCI network card, a USB device and a SCSI disk.
+func generateTestHostDevices(h *host) []*hostDevice {
+	newDevice := func(
+		name string,
+		capability HostDeviceCapability,
+		parentDeviceName string,
+		driver string,
+	) *hostDevice {
+		return &hostDevice{
+			id:               uuid.NewString(),
+			hostID:           h.ID(),
+			name:             name,
+			capability:       capability,
+			parentDeviceName: parentDeviceName,
+			driver:           driver,
+		}
+	}
+	nic := newDevice("pci_0000_3b_00_0", HostDeviceCapabilityPCI, "computer", "i40e")
+	nic.vendorID, nic.vendorName = "0x8086", "Intel Corporation"
+	nic.productID, nic.productName = "0x1572", "Ethernet Controller X710 for 10GbE SFP+"
+	usbDevice := newDevice("usb_1_4", HostDeviceCapabilityUSBDevice, "usb_usb1", "usbhid")
+	usbDevice.vendorID, usbDevice.vendorName = "0x0624", "Avocent Corp."
+	usbDevice.productID, usbDevice.productName = "0x0249", "Virtual Keyboard/Mouse"
+	return []*hostDevice{
+		newDevice("computer", HostDeviceCapabilitySystem, "", ""),
+		nic,
+		newDevice("usb_usb1", HostDeviceCapabilityUSB, "computer", "xhci_hcd"),
+		usbDevice,
+		newDevice("scsi_host0", HostDeviceCapabilitySCSIHost, "computer", ""),
+		newDevice("scsi_0_0_0_0", HostDeviceCapabilitySCSI, "scsi_host0", "sd"),
+	}
+}
+
+func generateTestFenceAgent(h *host) *fenceAgent {
+	return &fenceAgent{
+		id:        uuid.NewString(),
+		hostID:    h.ID(),
+		agentType: "ipmilan",
+		address:   fmt.Sprintf("ipmi-%s", h.Address()),
+		username:  "admin",
+		order:     1,
+		options: map[string]string{
+			"lanplus": "1",
+		},
+	}
+}
+
+func generateTestHostNIC(h *host) *hostNIC {
+	return &hostNIC{
+		id:     uuid.NewString(),
+		name:   "eth0",
+		hostID: h.ID(),
+		mac:    "56:6f:00:00:00:01",
+		vfConfig: &hostNICVirtualFunctionsConfiguration{
+			maxNumberOfVirtualFunctions: 8,
+			allNetworksAllowed:          true,
+		},
 	}
 }
@@ -0,0 +1,87 @@
+// Package ovirtclientotel provides a Tracer for go-ovirt-client creating OpenTelemetry spans around the API calls of
+// the client.
+package ovirtclientotel
+
+import (
+	"context"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the OpenTelemetry tracer the spans are created with.
+const InstrumentationName = "github.com/ovirt/go-ovirt-client"
+
+// New creates an ovirtclient.Tracer creating spans using the passed OpenTelemetry tracer provider, for example
+// otel.GetTracerProvider(). The spans are named after the operation, for example RemoveVM, and carry the following
+// attributes:
+//
+//	ovirt.operation
+//
+// The name of the client function making the call.
+//
+//	ovirt.action
+//
+// A human-readable description of the call including the IDs of the objects involved.
+//
+//	ovirt.resource.type
+//
+// The type of the object the call works on, for example vm.
+//
+//	ovirt.resource.id
+//
+// The ID of the object the call works on. Calls listing or creating top-level objects, such as ListVMs, do not set
+// this attribute.
+//
+//	ovirt.error_code
+//
+// The error code of the call if it failed. Failed calls also record the error on the span and set its status to
+// error.
+func New(provider trace.TracerProvider) ovirtclient.Tracer {
+	return &tracer{
+		tracer: provider.Tracer(InstrumentationName),
+	}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) StartSpan(
+	ctx context.Context,
+	operation string,
+	action string,
+	resourceType ovirtclient.ResourceType,
+	resourceID string,
+) ovirtclient.TraceSpan {
+	attributes := []attribute.KeyValue{
+		attribute.String("ovirt.operation", operation),
+		attribute.String("ovirt.action", action),
+		attribute.String("ovirt.resource.type", string(resourceType)),
+	}
+	if resourceID != "" {
+		attributes = append(attributes, attribute.String("ovirt.resource.id", resourceID))
+	}
+	_, s := t.tracer.Start(
+		ctx,
+		operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	return &span{span: s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetAttributes(attribute.String("ovirt.error_code", string(ovirtclient.Classify(err))))
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,71 @@
+package ovirtclientotel_test
+
+import (
+	"context"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientotel "github.com/ovirt/go-ovirt-client/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := ovirtclientotel.New(provider)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	tracer.StartSpan(ctx, "GetVM", "getting VM 1234", ovirtclient.ResourceTypeVM, "1234").End(nil)
+	tracer.StartSpan(ctx, "RemoveVM", "removing VM 1234", ovirtclient.ResourceTypeVM, "1234").End(
+		ovirtclient.NewMock().RemoveVM("1234"),
+	)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("incorrect number of spans (expected: 3, got: %d)", len(spans))
+	}
+	for _, span := range spans[:2] {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Fatalf("span %s is not a child of the parent span", span.Name())
+		}
+	}
+
+	getSpan := spans[0]
+	if getSpan.Name() != "GetVM" {
+		t.Fatalf("incorrect span name (expected: GetVM, got: %s)", getSpan.Name())
+	}
+	if getSpan.Status().Code != codes.Unset {
+		t.Fatalf("incorrect status for successful call (%v)", getSpan.Status().Code)
+	}
+	assertAttribute(t, getSpan.Attributes(), "ovirt.operation", "GetVM")
+	assertAttribute(t, getSpan.Attributes(), "ovirt.action", "getting VM 1234")
+	assertAttribute(t, getSpan.Attributes(), "ovirt.resource.type", "vm")
+	assertAttribute(t, getSpan.Attributes(), "ovirt.resource.id", "1234")
+
+	removeSpan := spans[1]
+	if removeSpan.Status().Code != codes.Error {
+		t.Fatalf("incorrect status for failed call (%v)", removeSpan.Status().Code)
+	}
+	if len(removeSpan.Events()) != 1 || removeSpan.Events()[0].Name != "exception" {
+		t.Fatalf("the error was not recorded on the span")
+	}
+	assertAttribute(t, removeSpan.Attributes(), "ovirt.error_code", string(ovirtclient.ENotFound))
+}
+
+func assertAttribute(t *testing.T, attributes []attribute.KeyValue, key string, value string) {
+	t.Helper()
+	for _, attr := range attributes {
+		if string(attr.Key) == key {
+			if attr.Value.AsString() != value {
+				t.Fatalf("incorrect value for attribute %s (expected: %s, got: %s)", key, value, attr.Value.AsString())
+			}
+			return
+		}
+	}
+	t.Fatalf("attribute %s not found", key)
+}
@@ -0,0 +1,73 @@
+// Package ovirtclientprometheus provides a MetricsCollector for go-ovirt-client exporting the API calls of the client
+// as Prometheus metrics. It is a separate module, so the client itself does not depend on the Prometheus libraries.
+package ovirtclientprometheus
+
+import (
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is an ovirtclient.MetricsCollector that records the API calls of the client as Prometheus metrics. It is
+// also a prometheus.Collector, so it can be registered with a Prometheus registry. It exports the following metrics:
+//
+//	ovirt_api_calls_total
+//
+// A counter of the API calls, including retried attempts, with the labels operation and code. The operation is the
+// name of the client function that made the call, for example RemoveVM. The code is the error code of the call, or
+// empty if the call was successful.
+//
+//	ovirt_api_call_duration_seconds
+//
+// A histogram of the duration of the API calls with the label operation.
+type Collector interface {
+	ovirtclient.MetricsCollector
+	prometheus.Collector
+}
+
+// New creates a new Collector. The namespace is prepended to the metric names, for example a namespace of myapp
+// results in myapp_ovirt_api_calls_total. An empty namespace leaves the names as they are.
+func New(namespace string) Collector {
+	return &collector{
+		calls: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "ovirt",
+				Name:      "api_calls_total",
+				Help:      "Number of API calls made to the oVirt Engine, including retried attempts.",
+			},
+			[]string{"operation", "code"},
+		),
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "ovirt",
+				Name:      "api_call_duration_seconds",
+				Help:      "Duration of the API calls made to the oVirt Engine.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"operation"},
+		),
+	}
+}
+
+type collector struct {
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func (c *collector) ObserveAPICall(operation string, duration time.Duration, code ovirtclient.ErrorCode) {
+	c.calls.WithLabelValues(operation, string(code)).Inc()
+	c.duration.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+func (c *collector) Describe(descs chan<- *prometheus.Desc) {
+	c.calls.Describe(descs)
+	c.duration.Describe(descs)
+}
+
+func (c *collector) Collect(metrics chan<- prometheus.Metric) {
+	c.calls.Collect(metrics)
+	c.duration.Collect(metrics)
+}
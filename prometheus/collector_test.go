@@ -0,0 +1,43 @@
+package ovirtclientprometheus_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientprometheus "github.com/ovirt/go-ovirt-client/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	collector := ovirtclientprometheus.New("test")
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatalf("failed to register collector (%v)", err)
+	}
+
+	collector.ObserveAPICall("GetVM", 10*time.Millisecond, "")
+	collector.ObserveAPICall("GetVM", 20*time.Millisecond, ovirtclient.ENotFound)
+	collector.ObserveAPICall("RemoveVM", 30*time.Millisecond, "")
+
+	expected := `
+# HELP test_ovirt_api_calls_total Number of API calls made to the oVirt Engine, including retried attempts.
+# TYPE test_ovirt_api_calls_total counter
+test_ovirt_api_calls_total{code="",operation="GetVM"} 1
+test_ovirt_api_calls_total{code="",operation="RemoveVM"} 1
+test_ovirt_api_calls_total{code="not_found",operation="GetVM"} 1
+`
+	if err := testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"test_ovirt_api_calls_total",
+	); err != nil {
+		t.Fatalf("incorrect call counts (%v)", err)
+	}
+	if count := testutil.CollectAndCount(collector, "test_ovirt_api_call_duration_seconds"); count != 2 {
+		t.Fatalf("incorrect number of duration histograms (expected: 2, got: %d)", count)
+	}
+}
@@ -0,0 +1,171 @@
+package ovirtclient
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ProxyParameters contains the proxy settings of the client. They can be passed to the client using ExtraSettingsV7.
+// Unlike the HTTP_PROXY and NO_PROXY environment variables they apply to a single client only, so clients talking to
+// different engines can use different proxies.
+//
+// The proxy applies to the API calls as well as to the HTTP client used for image transfers, which is also returned
+// from GetHTTPClient.
+type ProxyParameters interface {
+	// URL returns the URL of the proxy, for example http://proxy.example.com:3128 or socks5://proxy.example.com:1080.
+	// An empty string disables the proxy.
+	URL() string
+	// Username returns the username for authenticating with the proxy. An empty string disables authentication.
+	Username() string
+	// Password returns the password for authenticating with the proxy.
+	Password() string
+	// NoProxy returns the hosts that are reached directly. An entry matches the host itself and all its subdomains,
+	// an entry of "*" matches all hosts.
+	NoProxy() []string
+}
+
+// BuildableProxyParameters is a buildable version of ProxyParameters.
+type BuildableProxyParameters interface {
+	ProxyParameters
+
+	// WithURL sets the URL of the proxy. The scheme must be http, https or socks5.
+	WithURL(proxyURL string) (BuildableProxyParameters, error)
+	// MustWithURL is identical to WithURL, but panics instead of returning an error.
+	MustWithURL(proxyURL string) BuildableProxyParameters
+
+	// WithCredentials sets the username and password for authenticating with the proxy.
+	WithCredentials(username string, password string) (BuildableProxyParameters, error)
+	// MustWithCredentials is identical to WithCredentials, but panics instead of returning an error.
+	MustWithCredentials(username string, password string) BuildableProxyParameters
+
+	// WithNoProxy sets the hosts that are reached directly.
+	WithNoProxy(hosts ...string) (BuildableProxyParameters, error)
+	// MustWithNoProxy is identical to WithNoProxy, but panics instead of returning an error.
+	MustWithNoProxy(hosts ...string) BuildableProxyParameters
+}
+
+// ProxyParams creates a buildable set of proxy settings.
+func ProxyParams() BuildableProxyParameters {
+	return &proxyParams{}
+}
+
+type proxyParams struct {
+	url      string
+	username string
+	password string
+	noProxy  []string
+}
+
+func (p *proxyParams) URL() string {
+	return p.url
+}
+
+func (p *proxyParams) Username() string {
+	return p.username
+}
+
+func (p *proxyParams) Password() string {
+	return p.password
+}
+
+func (p *proxyParams) NoProxy() []string {
+	return p.noProxy
+}
+
+func (p *proxyParams) WithURL(proxyURL string) (BuildableProxyParameters, error) {
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid proxy URL: %s", proxyURL)
+	}
+	switch parsedURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, newError(EBadArgument, "the proxy URL must start with http://, https:// or socks5:// (%s)", proxyURL)
+	}
+	if parsedURL.Host == "" {
+		return nil, newError(EBadArgument, "the proxy URL must contain a host (%s)", proxyURL)
+	}
+	p.url = proxyURL
+	return p, nil
+}
+
+func (p *proxyParams) MustWithURL(proxyURL string) BuildableProxyParameters {
+	builder, err := p.WithURL(proxyURL)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (p *proxyParams) WithCredentials(username string, password string) (BuildableProxyParameters, error) {
+	if username == "" && password != "" {
+		return nil, newError(EBadArgument, "a proxy password was set without a username")
+	}
+	p.username = username
+	p.password = password
+	return p, nil
+}
+
+func (p *proxyParams) MustWithCredentials(username string, password string) BuildableProxyParameters {
+	builder, err := p.WithCredentials(username, password)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+func (p *proxyParams) WithNoProxy(hosts ...string) (BuildableProxyParameters, error) {
+	for _, host := range hosts {
+		if strings.TrimPrefix(host, ".") == "" {
+			return nil, newError(EBadArgument, "empty host in the proxy exclusion list")
+		}
+	}
+	p.noProxy = hosts
+	return p, nil
+}
+
+func (p *proxyParams) MustWithNoProxy(hosts ...string) BuildableProxyParameters {
+	builder, err := p.WithNoProxy(hosts...)
+	if err != nil {
+		panic(err)
+	}
+	return builder
+}
+
+// newProxyFunc creates the proxy function for an HTTP transport from the params. If no proxy is configured, it returns
+// nil, which disables the proxy.
+func newProxyFunc(params ProxyParameters) func(*http.Request) (*url.URL, error) {
+	if params == nil || params.URL() == "" {
+		return nil
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if isProxyExcluded(req.URL.Hostname(), params.NoProxy()) {
+			return nil, nil
+		}
+		proxyURL, err := url.Parse(params.URL())
+		if err != nil {
+			return nil, wrap(err, EBadArgument, "invalid proxy URL: %s", params.URL())
+		}
+		if params.Username() != "" {
+			proxyURL.User = url.UserPassword(params.Username(), params.Password())
+		}
+		return proxyURL, nil
+	}
+}
+
+// isProxyExcluded returns true if the host matches an entry of the noProxy list.
+func isProxyExcluded(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimPrefix(entry, "."))
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		if entry == "*" || host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
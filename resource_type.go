@@ -0,0 +1,103 @@
+package ovirtclient
+
+import (
+	"fmt"
+)
+
+// ResourceType is the type of the object an API call works on. It is passed to the Tracer and recorded in the
+// AuditRecord of each call.
+type ResourceType string
+
+const (
+	// ResourceTypeCluster is the type of clusters.
+	ResourceTypeCluster ResourceType = "cluster"
+	// ResourceTypeDatacenter is the type of datacenters.
+	ResourceTypeDatacenter ResourceType = "datacenter"
+	// ResourceTypeDirectoryDomain is the type of the directory domains users and groups are looked up in.
+	ResourceTypeDirectoryDomain ResourceType = "directory_domain"
+	// ResourceTypeDisk is the type of disks.
+	ResourceTypeDisk ResourceType = "disk"
+	// ResourceTypeDiskAttachment is the type of disk attachments of VMs.
+	ResourceTypeDiskAttachment ResourceType = "disk_attachment"
+	// ResourceTypeEngine is the type of the calls concerning the oVirt Engine itself, such as querying its version.
+	ResourceTypeEngine ResourceType = "engine"
+	// ResourceTypeEvent is the type of events.
+	ResourceTypeEvent ResourceType = "event"
+	// ResourceTypeFenceAgent is the type of the fence agents of hosts.
+	ResourceTypeFenceAgent ResourceType = "fence_agent"
+	// ResourceTypeGroup is the type of groups.
+	ResourceTypeGroup ResourceType = "group"
+	// ResourceTypeHost is the type of hosts.
+	ResourceTypeHost ResourceType = "host"
+	// ResourceTypeHostNIC is the type of the NICs of hosts.
+	ResourceTypeHostNIC ResourceType = "host_nic"
+	// ResourceTypeHostProvider is the type of external host providers.
+	ResourceTypeHostProvider ResourceType = "host_provider"
+	// ResourceTypeImageProvider is the type of external image providers.
+	ResourceTypeImageProvider ResourceType = "image_provider"
+	// ResourceTypeInstanceType is the type of instance types.
+	ResourceTypeInstanceType ResourceType = "instance_type"
+	// ResourceTypeJob is the type of jobs.
+	ResourceTypeJob ResourceType = "job"
+	// ResourceTypeNetwork is the type of networks.
+	ResourceTypeNetwork ResourceType = "network"
+	// ResourceTypeNetworkProvider is the type of external network providers.
+	ResourceTypeNetworkProvider ResourceType = "network_provider"
+	// ResourceTypeNIC is the type of the NICs of VMs.
+	ResourceTypeNIC ResourceType = "nic"
+	// ResourceTypeOperatingSystem is the type of operating systems.
+	ResourceTypeOperatingSystem ResourceType = "operating_system"
+	// ResourceTypePermission is the type of permissions.
+	ResourceTypePermission ResourceType = "permission"
+	// ResourceTypeQuota is the type of quotas.
+	ResourceTypeQuota ResourceType = "quota"
+	// ResourceTypeRole is the type of roles.
+	ResourceTypeRole ResourceType = "role"
+	// ResourceTypeStorageDomain is the type of storage domains.
+	ResourceTypeStorageDomain ResourceType = "storage_domain"
+	// ResourceTypeTag is the type of tags.
+	ResourceTypeTag ResourceType = "tag"
+	// ResourceTypeTemplate is the type of templates.
+	ResourceTypeTemplate ResourceType = "template"
+	// ResourceTypeUser is the type of users.
+	ResourceTypeUser ResourceType = "user"
+	// ResourceTypeVM is the type of VMs.
+	ResourceTypeVM ResourceType = "vm"
+	// ResourceTypeVMBackup is the type of the backups of VMs.
+	ResourceTypeVMBackup ResourceType = "vm_backup"
+	// ResourceTypeVMCheckpoint is the type of the checkpoints of VMs.
+	ResourceTypeVMCheckpoint ResourceType = "vm_checkpoint"
+	// ResourceTypeVNICProfile is the type of VNIC profiles.
+	ResourceTypeVNICProfile ResourceType = "vnic_profile"
+)
+
+// callResource identifies the object an API call works on, together with the other arguments of the call. For calls
+// working on a single object, such as RemoveVM, this is the object itself. For calls listing or creating objects
+// below another object, such as the NICs of a VM, this is the parent object. For calls listing or creating top-level
+// objects, such as ListVMs, the ID is empty.
+type callResource struct {
+	resourceType ResourceType
+	id           string
+	parameters   map[string]string
+}
+
+// resourceOf creates the callResource for the object of the specified type and ID.
+func resourceOf(resourceType ResourceType, id string) callResource {
+	return callResource{
+		resourceType: resourceType,
+		id:           id,
+	}
+}
+
+// with returns a copy of the resource with an additional argument of the call, such as the name of a created object
+// or the ID of a tag assigned to a VM. The parameters are recorded in the audit records, passwords and other secrets
+// are redacted when the record is created.
+func (r callResource) with(name string, value interface{}) callResource {
+	parameters := make(map[string]string, len(r.parameters)+1)
+	for k, v := range r.parameters {
+		parameters[k] = v
+	}
+	parameters[name] = fmt.Sprint(value)
+	r.parameters = parameters
+	return r
+}
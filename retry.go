@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
 	"time"
 
@@ -33,52 +34,220 @@ func retry(
 	if logger == nil {
 		logger = &noopLogger{}
 	}
+	contexts := retryContexts(retries)
 	logger.Debugf("%s%s...", strings.ToUpper(action[:1]), action[1:])
-	for {
-		err := what()
+	for attempt := 1; ; attempt++ {
+		err, canceled := callWithContexts(contexts, what)
+		if canceled {
+			logger.Debugf("Canceled %s after %d attempt(s) (%v)", action, attempt, err)
+			return wrap(err, ETimeout, "timeout while %s", action)
+		}
 		if err == nil {
-			logger.Debugf("Completed %s.", action)
+			logger.Debugf("Completed %s after %d attempt(s).", action, attempt)
 			return nil
 		}
 		for _, r := range retries {
 			if err := r.Continue(err, action); err != nil {
-				logger.Debugf("Giving up %s (%v)", action, err)
+				logger.Debugf("Giving up %s after %d attempt(s) (%v)", action, attempt, err)
 				return err
 			}
 		}
 
-		logRetry(action, logger, err)
-		// Here we create a select statement with a dynamic number of cases. We use this because a) select{} only
-		// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
-		// time.After() returns <-chan time.Time. Go doesn't support type assertions, so we have to result to
-		// the reflection library to do this.
-		var chans []reflect.SelectCase
-		for _, r := range retries {
-			c := r.Wait(err)
-			if c != nil {
-				chans = append(chans, reflect.SelectCase{
-					Dir:  reflect.SelectRecv,
-					Chan: reflect.ValueOf(c),
-					Send: reflect.Value{},
-				})
-			}
+		logRetry(action, attempt, logger, err)
+		if err := waitForRetry(action, logger, retries, err); err != nil {
+			return err
+		}
+		logger.Debugf("Retrying %s (attempt %d)...", action, attempt+1)
+	}
+}
+
+// waitForRetry waits until the first of the retry strategies signals that the next attempt can be made. It returns
+// an error if the retries should be aborted instead.
+func waitForRetry(action string, logger ovirtclientlog.Logger, retries []RetryInstance, err error) error {
+	// Here we create a select statement with a dynamic number of cases. We use this because a) select{} only
+	// supports fixed cases and b) the channel types are different. Context returns a <-chan struct{}, while
+	// time.After() returns <-chan time.Time. Go doesn't support type assertions, so we have to result to
+	// the reflection library to do this.
+	var chans []reflect.SelectCase
+	var waitingRetries []RetryInstance
+	for _, r := range retries {
+		c := r.Wait(err)
+		if c != nil {
+			chans = append(chans, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(c),
+				Send: reflect.Value{},
+			})
+			waitingRetries = append(waitingRetries, r)
+		}
+	}
+	if len(chans) == 0 {
+		logger.Errorf(
+			"No retry strategies with waiting function specified for %s.",
+			action,
+		)
+		return newError(EBug, "no retry strategies with waiting function specified for %s", action)
+	}
+	logger.Debugf("Waiting before retrying %s...", action)
+	chosen, _, _ := reflect.Select(chans)
+	if err := waitingRetries[chosen].OnWaitExpired(err, action); err != nil {
+		logger.Debugf("Giving up %s (%v)", action, err)
+		return err
+	}
+	return nil
+}
+
+// retry calls the retry function with the logger of the client, creating a trace span for the call and reporting
+// each attempt to the metrics collector if they are configured. The resource identifies the object the call works on
+// in the trace span. See runRetry for the steps each attempt goes through.
+func (o *oVirtClient) retry(resource callResource, action string, retries []RetryStrategy, what func() error) error {
+	return o.runRetry(resource, action, retries, what)
+}
+
+// runRetry implements oVirtClient.retry and oVirtClient.mutate. It must be called directly from these functions so
+// callerOperation can determine the name of the operation.
+//
+// The trace span covers the whole call including all retries. Each attempt goes through the following steps, from
+// the outside in:
+//
+// - The rate limiter delays the attempt until it is allowed.
+// - The metrics collector and the statistics of the client record the attempt.
+// - If the engine cannot be reached, the client fails over to the next engine URL and repeats the attempt.
+// - If the session has expired, the client logs in again and repeats the attempt.
+func (o *oVirtClient) runRetry(
+	resource callResource,
+	action string,
+	retries []RetryStrategy,
+	what func() error,
+) (err error) {
+	operation := callerOperation()
+	what = o.reauthenticating(what)
+	what = o.failingOver(what)
+	what = o.observeAttempts(operation, what)
+	what = o.rateLimited(strategyContexts(retries), what)
+	if o.tracer != nil {
+		span := o.tracer.StartSpan(retryContext(retries), operation, action, resource.resourceType, resource.id)
+		defer func() {
+			span.End(err)
+		}()
+	}
+	return retry(action, o.operationLogger(operation, action), retries, what)
+}
+
+// operationLogger returns the logger of the client. If the logger is a FieldLogger, the operation and action are
+// attached to each log message as fields.
+func (o *oVirtClient) operationLogger(operation string, action string) ovirtclientlog.Logger {
+	fieldLogger, ok := o.logger.(FieldLogger)
+	if !ok {
+		return o.logger
+	}
+	return fieldLogger.WithFields(map[string]interface{}{
+		"operation": operation,
+		"action":    action,
+	})
+}
+
+// observeAttempts wraps the what function to report each call to the metrics collector and the statistics. If
+// neither is configured the function is returned as is.
+func (o *oVirtClient) observeAttempts(operation string, what func() error) func() error {
+	if o.metrics == nil && o.stats == nil {
+		return what
+	}
+	return func() error {
+		start := time.Now()
+		err := what()
+		var code ErrorCode
+		if err != nil {
+			code = wrap(err, EUnidentified, "").Code()
 		}
-		if len(chans) == 0 {
-			logger.Errorf(
-				"No retry strategies with waiting function specified for %s.",
-				action,
-			)
-			return newError(EBug, "no retry strategies with waiting function specified for %s", action)
+		duration := time.Since(start)
+		if o.metrics != nil {
+			o.metrics.ObserveAPICall(operation, duration, code)
 		}
-		chosen, _, _ := reflect.Select(chans)
-		if err := retries[chosen].OnWaitExpired(err, action); err != nil {
-			logger.Debugf("Giving up %s (%v)", action, err)
+		o.stats.ObserveAPICall(operation, duration, code)
+		return err
+	}
+}
+
+// callerOperation returns the name of the function that called oVirtClient.retry or oVirtClient.mutate, stripped of
+// the package and receiver names.
+func callerOperation() string {
+	pc, _, _, ok := runtime.Caller(3)
+	if !ok {
+		return "unknown"
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	// Strip the package path and receiver, for example github.com/ovirt/go-ovirt-client.(*oVirtClient).RemoveVM.
+	if i := strings.LastIndex(name, ")."); i >= 0 {
+		name = name[i+2:]
+	} else if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+		name = name[strings.Index(name, ".")+1:]
+	}
+	// Strip the suffix of closures, for example RemoveVM.func1.
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// contextRetryInstance is implemented by retry instances that carry a context. If any of the retry instances passed
+// to a call carries a context, no further attempts are made once the context is canceled. An API call that is already
+// in flight is not canceled.
+type contextRetryInstance interface {
+	Context() context.Context
+}
+
+func retryContexts(retries []RetryInstance) []context.Context {
+	var contexts []context.Context
+	for _, r := range retries {
+		if c, ok := r.(contextRetryInstance); ok {
+			contexts = append(contexts, c.Context())
+		}
+	}
+	return contexts
+}
+
+// strategyContexts returns the contexts of the retry strategies that carry one.
+func strategyContexts(retries []RetryStrategy) []context.Context {
+	instances := make([]RetryInstance, len(retries))
+	for i, r := range retries {
+		instances[i] = r.Get()
+	}
+	return retryContexts(instances)
+}
+
+// contextsErr returns the error of the first context that has ended, or nil if none has.
+func contextsErr(contexts []context.Context) error {
+	for _, ctx := range contexts {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
 	}
+	return nil
+}
+
+// callWithContexts calls the what function and returns its error. If any of the passed contexts has ended before the
+// call, the function is not called and the context error is returned with the canceled flag set.
+//
+// The oVirt SDK does not accept a context, so a call that is already in flight cannot be canceled. The call is
+// therefore never abandoned, otherwise it could still change the engine or write the results of the caller after the
+// caller has been told that it timed out. If a context ends during the call and the call fails, its error is returned
+// with the canceled flag set so no further attempts are made. If the call succeeds, it is reported as such. The
+// duration of a single call can be bounded using HTTPTransportParameters.RequestTimeout.
+func callWithContexts(contexts []context.Context, what func() error) (error, bool) { //nolint:revive
+	if err := contextsErr(contexts); err != nil {
+		return err, true
+	}
+	err := what()
+	return err, err != nil && contextsErr(contexts) != nil
 }
 
-func logRetry(action string, logger ovirtclientlog.Logger, err error) {
+func logRetry(action string, attempt int, logger ovirtclientlog.Logger, err error) {
 	var e EngineError
 	isPending := false
 	isConflict := false
@@ -87,9 +256,9 @@ func logRetry(action string, logger ovirtclientlog.Logger, err error) {
 		isConflict = e.HasCode(EConflict)
 	}
 	if isPending || isConflict {
-		logger.Debugf("Still %s, retrying... (%s)", action, err.Error())
+		logger.Debugf("Still %s after %d attempt(s), retrying... (%s)", action, attempt, err.Error())
 	} else {
-		logger.Debugf("Failed %s, retrying... (%s)", action, err.Error())
+		logger.Debugf("Failed %s on attempt %d, retrying... (%s)", action, attempt, err.Error())
 	}
 }
 
@@ -160,8 +329,10 @@ type RetryInstance interface {
 	OnWaitExpired(err error, action string) error
 }
 
-// ContextStrategy provides a timeout based on a context in the ctx parameter. If the context is canceled the
-// retry loop is aborted.
+// ContextStrategy stops the retry loop when the context in the ctx parameter is canceled, so no further attempts are
+// made. It does not cancel API calls: the oVirt SDK does not accept a context, so an API call that is already in
+// flight is finished first, and the client call can return up to one API call duration after the context has ended.
+// Use HTTPTransportParameters.RequestTimeout to bound the duration of a single API call.
 func ContextStrategy(ctx context.Context) RetryStrategy {
 	return &retryStrategyContainer{
 		func() RetryInstance {
@@ -183,6 +354,10 @@ func (c *contextStrategy) Name() string {
 	return "context strategy"
 }
 
+func (c *contextStrategy) Context() context.Context {
+	return c.ctx
+}
+
 func (c *contextStrategy) Continue(_ error, _ string) error {
 	return nil
 }
@@ -258,36 +433,15 @@ func (a *autoRetryStrategy) Name() string {
 }
 
 func (a *autoRetryStrategy) Continue(err error, action string) error {
-	var engineErr EngineError
-	if errors.As(err, &engineErr) {
-		if !engineErr.CanAutoRetry() {
-			return wrap(
-				err,
-				EUnidentified,
-				"non-retryable error encountered while %s, giving up",
-				action,
-			)
-		}
+	if IsRetryable(err) {
 		return nil
 	}
-	identifiedError := realIdentify(err)
-	if identifiedError == nil {
-		return wrap(
-			err,
-			EUnidentified,
-			"non-retryable error encountered while %s, giving up",
-			action,
-		)
-	}
-	if !identifiedError.CanAutoRetry() {
-		return wrap(
-			err,
-			EUnidentified,
-			"non-retryable error encountered while %s, giving up",
-			action,
-		)
-	}
-	return nil
+	return wrap(
+		err,
+		EUnidentified,
+		"non-retryable error encountered while %s, giving up",
+		action,
+	)
 }
 
 func (a *autoRetryStrategy) Wait(_ error) interface{} {
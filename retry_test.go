@@ -93,3 +93,71 @@ func TestContextStrategy(t *testing.T) {
 		t.Fatalf("retry didn't run for enough time")
 	}
 }
+
+func TestContextStrategyWaitsForInFlightCall(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	err := retry(
+		"test",
+		nil,
+		[]RetryStrategy{
+			ContextStrategy(ctx),
+		},
+		func() error {
+			calls++
+			<-ctx.Done()
+			time.Sleep(100 * time.Millisecond)
+			return newError(EConflict, "still in progress")
+		},
+	)
+	if !HasErrorCode(err, ETimeout) {
+		t.Fatalf("retry on a call outliving the context did not return a timeout error (%v)", err)
+	}
+	if calls != 1 {
+		t.Fatalf("retry made further calls after the context was canceled (%d calls)", calls)
+	}
+
+	err = retry(
+		"test",
+		nil,
+		[]RetryStrategy{
+			ContextStrategy(ctx),
+		},
+		func() error {
+			calls++
+			return nil
+		},
+	)
+	if !HasErrorCode(err, ETimeout) {
+		t.Fatalf("retry with a canceled context did not return a timeout error (%v)", err)
+	}
+	if calls != 1 {
+		t.Fatalf("retry made a call with an already canceled context")
+	}
+}
+
+func TestContextStrategyReportsCompletedInFlightCall(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := retry(
+		"test",
+		nil,
+		[]RetryStrategy{
+			ContextStrategy(ctx),
+		},
+		func() error {
+			cancel()
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("a call that completed after the context was canceled was not reported as successful (%v)", err)
+	}
+}
@@ -0,0 +1,75 @@
+package ovirtclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+	"unsafe"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// sdkConnectionLayout mirrors the fields of the SDK connection of the oVirt SDK version pinned in go.mod. The SDK
+// offers no way to pass its HTTP client, so setConnectionField sets the unexported field. The mirror is only used to
+// check the size of the SDK connection at build time below.
+//
+//nolint:unused,structcheck
+type sdkConnectionLayout struct {
+	url          *url.URL
+	username     string
+	password     string
+	token        string
+	insecure     bool
+	tlsConfig    *tls.Config
+	certPool     *x509.CertPool
+	caFile       string
+	caCert       []byte
+	headers      map[string]string
+	logFunc      ovirtsdk4.LogFunc
+	kerberos     bool
+	timeout      time.Duration
+	compress     bool
+	client       *http.Client
+	ssoToken     string
+	ssoTokenName string
+}
+
+// These declarations fail to compile if the size of the SDK connection differs from sdkConnectionLayout, which
+// happens when an SDK upgrade adds, removes or changes fields. In this case setConnectionField and
+// sdkConnectionLayout must be checked against the new SDK version. Renamed fields are detected by
+// TestSDKConnectionFields.
+var (
+	_ [unsafe.Sizeof(ovirtsdk4.Connection{}) - unsafe.Sizeof(sdkConnectionLayout{})]struct{}
+	_ [unsafe.Sizeof(sdkConnectionLayout{}) - unsafe.Sizeof(ovirtsdk4.Connection{})]struct{}
+)
+
+// sdkConnectionFields lists the unexported fields of the SDK connection set by setConnectionField, together with their
+// types.
+var sdkConnectionFields = map[string]reflect.Type{
+	"client": reflect.TypeOf(&http.Client{}),
+}
+
+// setConnectionField sets an unexported field of the SDK connection. The SDK offers no way to pass its HTTP client, so
+// the field is set using reflection. It must only be called on a connection that was just built and has not been
+// used or shared with other goroutines yet, otherwise it races with the requests sent using the connection. An EBug
+// error is returned if the field does not exist or has a different type, which means the SDK version is not supported.
+func setConnectionField(conn *ovirtsdk4.Connection, name string, value interface{}) error {
+	if expectedType, ok := sdkConnectionFields[name]; !ok || expectedType != reflect.TypeOf(value) {
+		return newError(EBug, "setting the %s field of type %T of the oVirt SDK connection is not supported", name, value)
+	}
+	field := reflect.ValueOf(conn).Elem().FieldByName(name)
+	if !field.IsValid() || field.Type() != reflect.TypeOf(value) {
+		return newError(
+			EBug,
+			"the oVirt SDK connection has no %s field of type %T, the SDK version is not supported",
+			name,
+			value,
+		)
+	}
+	//nolint:gosec
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(value))
+	return nil
+}
@@ -0,0 +1,51 @@
+// This file contains tests for setting the unexported fields of the SDK connection. It is therefore excluded from the
+// testpackage check.
+
+package ovirtclient // nolint:testpackage
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	ovirtsdk4 "github.com/ovirt/go-ovirt"
+)
+
+// TestSDKConnectionFields tests that the SDK connection has all fields setConnectionField sets, with the expected
+// types. If this test fails after an SDK upgrade, setConnectionField must be adapted to the new SDK version.
+func TestSDKConnectionFields(t *testing.T) {
+	t.Parallel()
+	connType := reflect.TypeOf(ovirtsdk4.Connection{})
+	for name, expectedType := range sdkConnectionFields {
+		field, ok := connType.FieldByName(name)
+		if !ok {
+			t.Fatalf("the oVirt SDK connection has no %s field", name)
+		}
+		if field.Type != expectedType {
+			t.Fatalf("the %s field of the oVirt SDK connection has the type %s instead of %s", name, field.Type, expectedType)
+		}
+	}
+}
+
+func TestSetConnectionField(t *testing.T) {
+	t.Parallel()
+	conn, err := ovirtsdk4.NewConnectionBuilder().
+		URL("https://engine.invalid/ovirt-engine/api").
+		Username("admin@internal").
+		Password("password").
+		Insecure(true).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to create connection (%v)", err)
+	}
+	client := &http.Client{}
+	if err := setConnectionField(conn, "client", client); err != nil {
+		t.Fatalf("failed to set the HTTP client of the connection (%v)", err)
+	}
+	if reflect.ValueOf(conn).Elem().FieldByName("client").Pointer() != reflect.ValueOf(client).Pointer() {
+		t.Fatalf("the HTTP client of the connection was not set")
+	}
+	if err := setConnectionField(conn, "username", "other"); !HasErrorCode(err, EBug) {
+		t.Fatalf("setting an unsupported field did not return an EBug error (%v)", err)
+	}
+}
@@ -9,12 +9,12 @@ func (o *oVirtClient) ListTemplateDiskAttachments(
 	retries ...RetryStrategy,
 ) (result []TemplateDiskAttachment, err error) {
 	retries = defaultRetries(retries, defaultReadTimeouts())
-	err = retry(
+	err = o.retry(
+		resourceOf(ResourceTypeTemplate, string(templateID)),
 		fmt.Sprintf("listing disk attachments for template %s", templateID),
-		o.logger,
 		retries,
 		func() error {
-			res, err := o.conn.
+			res, err := o.connection().
 				SystemService().
 				TemplatesService().
 				TemplateService(string(templateID)).
@@ -18,7 +18,7 @@ type TestHelper interface {
 	GetClient() Client
 
 	// GetClusterID returns the ID for the cluster.
-	GetClusterID() string
+	GetClusterID() ClusterID
 
 	// GetBlankTemplateID returns the ID of the blank template that can be used for creating dummy VMs.
 	GetBlankTemplateID() TemplateID
@@ -30,6 +30,10 @@ type TestHelper interface {
 	// storage domain is available, the test will be skipped.
 	GetSecondaryStorageDomainID(t *testing.T) string
 
+	// GetSecondaryClusterID returns the ID of a second cluster with a working host, which is not identical to the
+	// cluster returned by GetClusterID. If no secondary cluster is available, the test will be skipped.
+	GetSecondaryClusterID(t *testing.T) ClusterID
+
 	// GenerateRandomID generates a random ID for testing.
 	GenerateRandomID(length uint) string
 
@@ -38,6 +42,12 @@ type TestHelper interface {
 
 	// GetTLS returns the TLS provider used for this test helper.
 	GetTLS() TLSProvider
+
+	// CheckResourceLeaks registers a cleanup function on the test that checks if the VMs, disks and templates created
+	// using the client of this helper still exist when the test ends. Since cleanup functions run in reverse order,
+	// this should be called before creating any resources. If autoCleanup is false, leftover resources fail the test,
+	// protecting shared engines from leaking resources. If it is true, they are removed instead.
+	CheckResourceLeaks(t *testing.T, autoCleanup bool)
 }
 
 // MustNewTestHelper is identical to NewTestHelper, but panics instead of returning an error.
@@ -83,6 +93,8 @@ func NewTestHelper(
 	if err != nil {
 		return nil, err
 	}
+	tracker := newResourceTracker()
+	client = newTrackingClient(client, tracker)
 
 	if params == nil {
 		params = &testHelperParameters{}
@@ -98,7 +110,9 @@ func NewTestHelper(
 		return nil, err
 	}
 
-	secondaryStorageDomainID, err := setupSecondaryStorageDomainID(params.StorageDomainID(), storageDomainID, client)
+	secondaryClusterID, secondaryStorageDomainID, err := setupSecondaryResources(
+		params, clusterID, storageDomainID, client,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -115,10 +129,12 @@ func NewTestHelper(
 
 	return &testHelper{
 		client:                   client,
+		tracker:                  tracker,
 		tls:                      tlsProvider,
 		clusterID:                clusterID,
 		storageDomainID:          storageDomainID,
 		secondaryStorageDomainID: secondaryStorageDomainID,
+		secondaryClusterID:       secondaryClusterID,
 		blankTemplateID:          blankTemplateID,
 		vnicProfileID:            vnicProfileID,
 		// We are suppressing gosec linting here since rand is not used in a security-relevant context,
@@ -136,13 +152,22 @@ func TestHelperParams() BuildableTestHelperParameters {
 type TestHelperParameters interface {
 	// ClusterID returns the cluster ID used for testing. It can return an empty string if no
 	// test cluster is designated, in which case a cluster is selected.
-	ClusterID() string
+	ClusterID() ClusterID
+
+	// SecondaryClusterID returns a second cluster ID used for testing cross-cluster operations. It can return an
+	// empty string if no secondary cluster is designated, in which case a cluster is selected if available.
+	SecondaryClusterID() ClusterID
 
 	// StorageDomainID returns the storage domain ID usable for testing. It can return an empty
 	// string if no test storage domain is designated for testing, in which case a working
 	// storage domain is selected.
 	StorageDomainID() string
 
+	// SecondaryStorageDomainID returns a second storage domain ID used for testing disk copies and moves. It can
+	// return an empty string if no secondary storage domain is designated, in which case a storage domain is selected
+	// if available.
+	SecondaryStorageDomainID() string
+
 	// BlankTemplateID returns an ID to a template that is blank and can be used as a basis
 	// for testing. It may return an empty string if no template is provided.
 	BlankTemplateID() TemplateID
@@ -157,7 +182,10 @@ type BuildableTestHelperParameters interface {
 	TestHelperParameters
 
 	// WithClusterID sets the cluster ID usable for testing.
-	WithClusterID(string) BuildableTestHelperParameters
+	WithClusterID(ClusterID) BuildableTestHelperParameters
+	// WithSecondaryClusterID sets the cluster ID usable for testing cross-cluster operations, which is not identical
+	// to the primary cluster ID.
+	WithSecondaryClusterID(ClusterID) BuildableTestHelperParameters
 	// WithStorageDomainID sets the storage domain that can be used for testing.
 	WithStorageDomainID(string) BuildableTestHelperParameters
 	// WithSecondaryStorageDomainID sets the storage domain that can be used for testing, which is not identical to
@@ -170,7 +198,8 @@ type BuildableTestHelperParameters interface {
 }
 
 type testHelperParameters struct {
-	clusterID                string
+	clusterID                ClusterID
+	secondaryClusterID       ClusterID
 	storageDomainID          string
 	secondaryStorageDomainID string
 	blankTemplateID          TemplateID
@@ -182,14 +211,22 @@ func (t *testHelperParameters) WithSecondaryStorageDomainID(s string) BuildableT
 	return t
 }
 
-func (t *testHelperParameters) ClusterID() string {
+func (t *testHelperParameters) ClusterID() ClusterID {
 	return t.clusterID
 }
 
+func (t *testHelperParameters) SecondaryClusterID() ClusterID {
+	return t.secondaryClusterID
+}
+
 func (t *testHelperParameters) StorageDomainID() string {
 	return t.storageDomainID
 }
 
+func (t *testHelperParameters) SecondaryStorageDomainID() string {
+	return t.secondaryStorageDomainID
+}
+
 func (t *testHelperParameters) BlankTemplateID() TemplateID {
 	return t.blankTemplateID
 }
@@ -198,11 +235,16 @@ func (t *testHelperParameters) VNICProfileID() string {
 	return t.vnicProfileID
 }
 
-func (t *testHelperParameters) WithClusterID(s string) BuildableTestHelperParameters {
+func (t *testHelperParameters) WithClusterID(s ClusterID) BuildableTestHelperParameters {
 	t.clusterID = s
 	return t
 }
 
+func (t *testHelperParameters) WithSecondaryClusterID(s ClusterID) BuildableTestHelperParameters {
+	t.secondaryClusterID = s
+	return t
+}
+
 func (t *testHelperParameters) WithStorageDomainID(s string) BuildableTestHelperParameters {
 	t.storageDomainID = s
 	return t
@@ -218,7 +260,7 @@ func (t *testHelperParameters) WithVNICProfileID(s string) BuildableTestHelperPa
 	return t
 }
 
-func setupVNICProfileID(vnicProfileID string, clusterID string, client Client) (string, error) {
+func setupVNICProfileID(vnicProfileID string, clusterID ClusterID, client Client) (string, error) {
 	if vnicProfileID != "" {
 		_, err := client.GetVNICProfile(vnicProfileID)
 		if err != nil {
@@ -274,6 +316,29 @@ func setupTestStorageDomainID(storageDomainID string, client Client) (id string,
 	return storageDomainID, nil
 }
 
+// setupSecondaryResources returns the IDs of the secondary cluster and storage domain. The IDs are empty if no
+// secondary cluster or storage domain is available.
+func setupSecondaryResources(
+	params TestHelperParameters,
+	clusterID ClusterID,
+	storageDomainID string,
+	client Client,
+) (secondaryClusterID ClusterID, secondaryStorageDomainID string, err error) {
+	secondaryClusterID, err = setupSecondaryClusterID(params.SecondaryClusterID(), clusterID, client)
+	if err != nil {
+		return "", "", err
+	}
+	secondaryStorageDomainID, err = setupSecondaryStorageDomainID(
+		params.SecondaryStorageDomainID(),
+		storageDomainID,
+		client,
+	)
+	if err != nil {
+		return "", "", err
+	}
+	return secondaryClusterID, secondaryStorageDomainID, nil
+}
+
 func setupSecondaryStorageDomainID(
 	storageDomainID string,
 	skipStorageDomainID string,
@@ -290,9 +355,9 @@ func setupSecondaryStorageDomainID(
 	return storageDomainID, nil
 }
 
-func setupTestClusterID(clusterID string, client Client) (id string, err error) {
+func setupTestClusterID(clusterID ClusterID, client Client) (id ClusterID, err error) {
 	if clusterID == "" {
-		clusterID, err = findTestClusterID(client)
+		clusterID, err = findTestClusterID("", client)
 		if err != nil {
 			return "", fmt.Errorf("failed to find a cluster to test on (%w)", err)
 		}
@@ -302,6 +367,22 @@ func setupTestClusterID(clusterID string, client Client) (id string, err error)
 	return clusterID, nil
 }
 
+func setupSecondaryClusterID(
+	clusterID ClusterID,
+	skipClusterID ClusterID,
+	client Client,
+) (id ClusterID, err error) {
+	if clusterID == "" {
+		clusterID, err = findTestClusterID(skipClusterID, client)
+		if err != nil && !errors.Is(err, errNoTestClusterFound) {
+			return "", fmt.Errorf("failed to find a secondary cluster to test on (%w)", err)
+		}
+	} else if err := verifyTestClusterID(client, clusterID); err != nil {
+		return "", fmt.Errorf("failed to verify cluster ID %s (%w)", clusterID, err)
+	}
+	return clusterID, nil
+}
+
 func createTestClient(
 	url string,
 	username string,
@@ -343,7 +424,9 @@ func verifyBlankTemplateID(client Client, templateID TemplateID) error {
 	return err
 }
 
-func findTestClusterID(client Client) (string, error) {
+var errNoTestClusterFound = fmt.Errorf("failed to find cluster suitable for testing")
+
+func findTestClusterID(skipID ClusterID, client Client) (ClusterID, error) {
 	clusters, err := client.ListClusters()
 	if err != nil {
 		return "", err
@@ -353,16 +436,19 @@ func findTestClusterID(client Client) (string, error) {
 		return "", err
 	}
 	for _, cluster := range clusters {
+		if cluster.ID() == skipID {
+			continue
+		}
 		for _, host := range hosts {
 			if host.Status() == HostStatusUp && host.ClusterID() == cluster.ID() {
 				return cluster.ID(), nil
 			}
 		}
 	}
-	return "", fmt.Errorf("failed to find cluster suitable for testing")
+	return "", errNoTestClusterFound
 }
 
-func verifyTestClusterID(client Client, clusterID string) error {
+func verifyTestClusterID(client Client, clusterID ClusterID) error {
 	_, err := client.GetCluster(clusterID)
 	return err
 }
@@ -375,7 +461,7 @@ func findTestStorageDomainID(skipID string, client Client) (string, error) {
 		return "", err
 	}
 	for _, storageDomain := range storageDomains {
-		if storageDomain.ID() == skipID {
+		if storageDomain.ID() == skipID || storageDomain.Function() != StorageDomainFunctionData {
 			continue
 		}
 		// Assume 2GB will be enough for testing
@@ -398,13 +484,22 @@ func verifyTestStorageDomainID(client Client, storageDomainID string) error {
 
 type testHelper struct {
 	client                   Client
+	tracker                  *resourceTracker
 	tls                      TLSProvider
 	rand                     *rand.Rand
-	clusterID                string
+	clusterID                ClusterID
 	storageDomainID          string
 	blankTemplateID          TemplateID
 	vnicProfileID            string
 	secondaryStorageDomainID string
+	secondaryClusterID       ClusterID
+}
+
+func (t *testHelper) GetSecondaryClusterID(te *testing.T) ClusterID {
+	if t.secondaryClusterID == "" {
+		te.Skipf("No secondary cluster available, skipping test.")
+	}
+	return t.secondaryClusterID
 }
 
 func (t *testHelper) GetSecondaryStorageDomainID(te *testing.T) string {
@@ -426,7 +521,7 @@ func (t *testHelper) GetClient() Client {
 	return t.client
 }
 
-func (t *testHelper) GetClusterID() string {
+func (t *testHelper) GetClusterID() ClusterID {
 	return t.clusterID
 }
 
@@ -473,6 +568,11 @@ func (t *testHelper) GenerateRandomID(length uint) string {
 //
 // The cluster to use for testing. Will be automatically chosen if not provided.
 //
+//   OVIRT_SECONDARY_CLUSTER_ID
+//
+// A second cluster to use for cross-cluster tests. Will be automatically chosen if not provided. Tests needing it are
+// skipped if no second cluster with a working host is available.
+//
 //   OVIRT_BLANK_TEMPLATE_ID
 //
 // ID of the blank template. Will be automatically chosen if not provided.
@@ -481,6 +581,11 @@ func (t *testHelper) GenerateRandomID(length uint) string {
 //
 // Storage domain to use for testing. Will be automatically chosen if not provided.
 //
+//   OVIRT_SECONDARY_STORAGE_DOMAIN_ID
+//
+// A second storage domain to use for disk copy and move tests. Will be automatically chosen if not provided. Tests
+// needing it are skipped if no second storage domain is available.
+//
 //   OVIRT_VNIC_PROFILE_ID
 //
 // VNIC profile to use for testing. Will be automatically chosen if not provided.
@@ -540,6 +645,11 @@ func getMockHelper(logger ovirtclientlog.Logger) TestHelper {
 //
 // The cluster to use for testing. Will be automatically chosen if not provided.
 //
+//   OVIRT_SECONDARY_CLUSTER_ID
+//
+// A second cluster to use for cross-cluster tests. Will be automatically chosen if not provided. Tests needing it are
+// skipped if no second cluster with a working host is available.
+//
 //   OVIRT_BLANK_TEMPLATE_ID
 //
 // ID of the blank template. Will be automatically chosen if not provided.
@@ -548,6 +658,11 @@ func getMockHelper(logger ovirtclientlog.Logger) TestHelper {
 //
 // Storage domain to use for testing. Will be automatically chosen if not provided.
 //
+//   OVIRT_SECONDARY_STORAGE_DOMAIN_ID
+//
+// A second storage domain to use for disk copy and move tests. Will be automatically chosen if not provided. Tests
+// needing it are skipped if no second storage domain is available.
+//
 //   OVIRT_VNIC_PROFILE_ID
 //
 // VNIC profile to use for testing. Will be automatically chosen if not provided.
@@ -559,22 +674,15 @@ func NewLiveTestHelperFromEnv(logger ovirtclientlog.Logger) (TestHelper, error)
 	}
 	user := os.Getenv("OVIRT_USERNAME")
 	if user == "" {
-		return nil, fmt.Errorf("the OVIRT_USER environment variable must not be empty")
+		return nil, fmt.Errorf("the OVIRT_USERNAME environment variable must not be empty")
 	}
 	password := os.Getenv("OVIRT_PASSWORD")
 
-	params := TestHelperParams()
-	params.WithClusterID(os.Getenv("OVIRT_CLUSTER_ID"))
-	params.WithBlankTemplateID(TemplateID(os.Getenv("OVIRT_BLANK_TEMPLATE_ID")))
-	params.WithStorageDomainID(os.Getenv("OVIRT_STORAGE_DOMAIN_ID"))
-	params.WithSecondaryStorageDomainID(os.Getenv("OVIRT_SECONDARY_STORAGE_DOMAIN_ID"))
-	params.WithVNICProfileID(os.Getenv("OVIRT_VNIC_PROFILE_ID"))
-
 	helper, err := NewTestHelper(
 		url,
 		user,
 		password,
-		params,
+		testHelperParamsFromEnv(),
 		tls,
 		false,
 		logger,
@@ -585,6 +693,18 @@ func NewLiveTestHelperFromEnv(logger ovirtclientlog.Logger) (TestHelper, error)
 	return helper, nil
 }
 
+// testHelperParamsFromEnv returns the test helper parameters set in the environment variables.
+func testHelperParamsFromEnv() TestHelperParameters {
+	params := TestHelperParams()
+	params.WithClusterID(ClusterID(os.Getenv("OVIRT_CLUSTER_ID")))
+	params.WithSecondaryClusterID(ClusterID(os.Getenv("OVIRT_SECONDARY_CLUSTER_ID")))
+	params.WithBlankTemplateID(TemplateID(os.Getenv("OVIRT_BLANK_TEMPLATE_ID")))
+	params.WithStorageDomainID(os.Getenv("OVIRT_STORAGE_DOMAIN_ID"))
+	params.WithSecondaryStorageDomainID(os.Getenv("OVIRT_SECONDARY_STORAGE_DOMAIN_ID"))
+	params.WithVNICProfileID(os.Getenv("OVIRT_VNIC_PROFILE_ID"))
+	return params
+}
+
 func getConnectionParametersForLiveTesting() (string, TLSProvider, error) {
 	// Note: if this function changes please update the documentation above, NewTestHelperFromEnv, and also doc.go.
 	url := os.Getenv("OVIRT_URL")
@@ -0,0 +1,286 @@
+package ovirtclient
+
+import (
+	"io"
+	"sync"
+	"testing"
+)
+
+// resourceTracker records the IDs of the VMs, disks and templates created using the client of a test helper. The test
+// helper uses it to detect resources that were left behind by a test.
+type resourceTracker struct {
+	lock      *sync.Mutex
+	vms       []VMID
+	disks     []DiskID
+	templates []TemplateID
+}
+
+func newResourceTracker() *resourceTracker {
+	return &resourceTracker{
+		lock: &sync.Mutex{},
+	}
+}
+
+func (r *resourceTracker) recordVM(id VMID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.vms = append(r.vms, id)
+}
+
+func (r *resourceTracker) recordDisk(id DiskID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.disks = append(r.disks, id)
+}
+
+func (r *resourceTracker) recordTemplate(id TemplateID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.templates = append(r.templates, id)
+}
+
+// get returns a copy of the recorded IDs.
+func (r *resourceTracker) get() (vms []VMID, disks []DiskID, templates []TemplateID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]VMID{}, r.vms...), append([]DiskID{}, r.disks...), append([]TemplateID{}, r.templates...)
+}
+
+// newTrackingClient wraps the client of a test helper so the VMs, disks and templates created using it are recorded
+// in the tracker. If the client is a MockClient, so is the returned client.
+func newTrackingClient(client Client, tracker *resourceTracker) Client {
+	result := &trackingClient{
+		Client:  client,
+		tracker: tracker,
+	}
+	if mock, ok := client.(MockClient); ok {
+		return &trackingMockClient{
+			trackingClient: result,
+			mock:           mock,
+		}
+	}
+	return result
+}
+
+// trackingClient records the IDs of the VMs, disks and templates created through it. Objects created in other ways,
+// such as by the asynchronous uploads, by cloning imports or by importing Glance images, are not recorded.
+type trackingClient struct {
+	Client
+
+	tracker *resourceTracker
+}
+
+func (c *trackingClient) CreateVM(
+	clusterID ClusterID,
+	templateID TemplateID,
+	name string,
+	params OptionalVMParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	vm, err := c.Client.CreateVM(clusterID, templateID, name, params, retries...)
+	if vm != nil {
+		c.tracker.recordVM(vm.ID())
+	}
+	return vm, err
+}
+
+func (c *trackingClient) ImportExternalVM(
+	provider ExternalVMProviderType,
+	url string,
+	sourceVMName string,
+	clusterID ClusterID,
+	storageDomainID string,
+	params ImportExternalVMOptionalParameters,
+	retries ...RetryStrategy,
+) (VM, error) {
+	vm, err := c.Client.ImportExternalVM(provider, url, sourceVMName, clusterID, storageDomainID, params, retries...)
+	if vm != nil {
+		c.tracker.recordVM(vm.ID())
+	}
+	return vm, err
+}
+
+func (c *trackingClient) ImportVMFromStorageDomain(
+	storageDomainID string,
+	vmID VMID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) error {
+	err := c.Client.ImportVMFromStorageDomain(storageDomainID, vmID, clusterID, params, retries...)
+	if err == nil && !importClonesVMs(params) {
+		c.tracker.recordVM(vmID)
+	}
+	return err
+}
+
+func (c *trackingClient) StartCreateDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	params CreateDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (DiskCreation, error) {
+	creation, err := c.Client.StartCreateDisk(storageDomainID, format, size, params, retries...)
+	if creation != nil {
+		c.tracker.recordDisk(creation.Disk().ID())
+	}
+	return creation, err
+}
+
+func (c *trackingClient) CreateDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	params CreateDiskOptionalParameters,
+	retries ...RetryStrategy,
+) (Disk, error) {
+	disk, err := c.Client.CreateDisk(storageDomainID, format, size, params, retries...)
+	if disk != nil {
+		c.tracker.recordDisk(disk.ID())
+	}
+	return disk, err
+}
+
+func (c *trackingClient) UploadImage(
+	alias string,
+	storageDomainID string,
+	sparse bool,
+	size uint64,
+	reader readSeekCloser,
+	retries ...RetryStrategy,
+) (UploadImageResult, error) {
+	result, err := c.Client.UploadImage(alias, storageDomainID, sparse, size, reader, retries...)
+	if result != nil && result.Disk() != nil {
+		c.tracker.recordDisk(result.Disk().ID())
+	}
+	return result, err
+}
+
+func (c *trackingClient) UploadToNewDisk(
+	storageDomainID string,
+	format ImageFormat,
+	size uint64,
+	params CreateDiskOptionalParameters,
+	reader readSeekCloser,
+	retries ...RetryStrategy,
+) (UploadImageResult, error) {
+	result, err := c.Client.UploadToNewDisk(storageDomainID, format, size, params, reader, retries...)
+	if result != nil && result.Disk() != nil {
+		c.tracker.recordDisk(result.Disk().ID())
+	}
+	return result, err
+}
+
+func (c *trackingClient) CreateTemplate(
+	vmID VMID,
+	name string,
+	params OptionalTemplateCreateParameters,
+	retries ...RetryStrategy,
+) (Template, error) {
+	template, err := c.Client.CreateTemplate(vmID, name, params, retries...)
+	if template != nil {
+		c.tracker.recordTemplate(template.ID())
+	}
+	return template, err
+}
+
+func (c *trackingClient) ImportTemplateFromStorageDomain(
+	storageDomainID string,
+	templateID TemplateID,
+	clusterID ClusterID,
+	params OptionalStorageDomainImportParameters,
+	retries ...RetryStrategy,
+) error {
+	err := c.Client.ImportTemplateFromStorageDomain(storageDomainID, templateID, clusterID, params, retries...)
+	if err == nil && !importClonesVMs(params) {
+		c.tracker.recordTemplate(templateID)
+	}
+	return err
+}
+
+// importClonesVMs returns true if an import from a storage domain assigns new IDs, which are not known to the caller.
+func importClonesVMs(params OptionalStorageDomainImportParameters) bool {
+	if params == nil {
+		return false
+	}
+	clone := params.CloneVMs()
+	return clone != nil && *clone
+}
+
+// trackingMockClient is a trackingClient for a MockClient, so tests can still detect that they are running against
+// the mock.
+type trackingMockClient struct {
+	*trackingClient
+
+	mock MockClient
+}
+
+func (c *trackingMockClient) GenerateUUID() string {
+	return c.mock.GenerateUUID()
+}
+
+func (c *trackingMockClient) Save(w io.Writer) error {
+	return c.mock.Save(w)
+}
+
+func (c *trackingMockClient) Load(r io.Reader) error {
+	return c.mock.Load(r)
+}
+
+func (t *testHelper) CheckResourceLeaks(te *testing.T, autoCleanup bool) {
+	te.Cleanup(func() {
+		vms, disks, templates := t.tracker.get()
+		for _, id := range vms {
+			t.handleLeak(te, autoCleanup, "VM", string(id), func() error {
+				_, err := t.client.GetVM(id)
+				return err
+			}, func() error {
+				return t.client.RemoveVM(id)
+			})
+		}
+		for _, id := range templates {
+			t.handleLeak(te, autoCleanup, "template", string(id), func() error {
+				_, err := t.client.GetTemplate(id)
+				return err
+			}, func() error {
+				return t.client.RemoveTemplate(id)
+			})
+		}
+		for _, id := range disks {
+			t.handleLeak(te, autoCleanup, "disk", string(id), func() error {
+				_, err := t.client.GetDisk(id)
+				return err
+			}, func() error {
+				return t.client.RemoveDisk(id)
+			})
+		}
+	})
+}
+
+// handleLeak checks if a resource still exists using the get function and either fails the test or removes the
+// resource using the remove function.
+func (t *testHelper) handleLeak(
+	te *testing.T,
+	autoCleanup bool,
+	kind string,
+	id string,
+	get func() error,
+	remove func() error,
+) {
+	err := get()
+	if err != nil {
+		if !HasErrorCode(err, ENotFound) {
+			te.Logf("⚠ Failed to check if %s %s was left behind by the test (%v)", kind, id, err)
+		}
+		return
+	}
+	if !autoCleanup {
+		te.Errorf("The test left behind %s %s.", kind, id)
+		return
+	}
+	te.Logf("⚠ The test left behind %s %s, removing it...", kind, id)
+	if err := remove(); err != nil && !HasErrorCode(err, ENotFound) {
+		te.Errorf("Failed to remove %s %s left behind by the test (%v)", kind, id, err)
+	}
+}
@@ -0,0 +1,40 @@
+package ovirtclient_test
+
+import (
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestHelperSecondaryResources(t *testing.T) {
+	t.Parallel()
+	helper := getHelper(t)
+
+	if helper.GetSecondaryClusterID(t) == helper.GetClusterID() {
+		t.Fatalf("the secondary cluster is identical to the primary cluster")
+	}
+	if helper.GetSecondaryStorageDomainID(t) == helper.GetStorageDomainID() {
+		t.Fatalf("the secondary storage domain is identical to the primary storage domain")
+	}
+}
+
+func TestHelperResourceLeakCleanup(t *testing.T) {
+	t.Parallel()
+	helper := ovirtclient.NewTestHelperFromEnv(ovirtclientlog.NewTestLogger(t))
+	client := helper.GetClient()
+
+	var diskID ovirtclient.DiskID
+	t.Run("leak", func(t *testing.T) {
+		helper.CheckResourceLeaks(t, true)
+		disk, err := client.CreateDisk(helper.GetStorageDomainID(), ovirtclient.ImageFormatRaw, 512, nil)
+		if err != nil {
+			t.Fatalf("failed to create disk (%v)", err)
+		}
+		diskID = disk.ID()
+	})
+
+	if _, err := client.GetDisk(diskID); !ovirtclient.HasErrorCode(err, ovirtclient.ENotFound) {
+		t.Fatalf("the leaked disk %s was not removed (%v)", diskID, err)
+	}
+}
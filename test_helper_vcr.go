@@ -0,0 +1,120 @@
+package ovirtclient
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+// defaultVCRDir is the directory the VCR fixtures are stored in if OVIRT_VCR_DIR is not set.
+const defaultVCRDir = "testdata/vcr"
+
+// NewVCRTestHelperFromEnv creates a test helper for the test t that records or replays the API calls of the test
+// using a VCR, depending on the following environment variables:
+//
+//   OVIRT_VCR_MODE
+//
+// Set to "record" to run the test against the live engine configured as described in NewLiveTestHelperFromEnv and
+// record the interactions, or to "replay" to run the test offline against the recorded interactions. Tests without a
+// recording are skipped when replaying. If empty, this function is identical to NewTestHelperFromEnv.
+//
+//   OVIRT_VCR_DIR
+//
+// The directory the recordings are stored in, one file per test. Defaults to testdata/vcr.
+//
+// When recording or replaying, the random IDs generated by the helper are seeded with the name of the test, so the
+// test sends the same requests on every run. See VCR for the limitations of recordings.
+func NewVCRTestHelperFromEnv(t *testing.T, logger ovirtclientlog.Logger) TestHelper {
+	mode := VCRMode(os.Getenv("OVIRT_VCR_MODE"))
+	if mode == "" {
+		return NewTestHelperFromEnv(logger)
+	}
+	if err := mode.Validate(); err != nil {
+		t.Fatalf("invalid OVIRT_VCR_MODE environment variable (%v)", err)
+	}
+	dir := os.Getenv("OVIRT_VCR_DIR")
+	if dir == "" {
+		dir = defaultVCRDir
+	}
+	fixtureFile := filepath.Join(dir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+
+	var helper TestHelper
+	var err error
+	if mode == VCRModeRecord {
+		helper, err = newRecordingTestHelper(t, fixtureFile, logger)
+	} else {
+		helper, err = newReplayingTestHelper(t, fixtureFile, logger)
+	}
+	if err != nil {
+		t.Fatalf("failed to create test helper in VCR %s mode (%v)", mode, err)
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(t.Name()))
+	// We are suppressing gosec linting here since rand is not used in a security-relevant context, only to generate
+	// the same random IDs on every run.
+	helper.(*testHelper).rand = rand.New(rand.NewSource(int64(hash.Sum64()))) //nolint:gosec
+	return helper
+}
+
+func newRecordingTestHelper(t *testing.T, fixtureFile string, logger ovirtclientlog.Logger) (TestHelper, error) {
+	url, tls, err := getConnectionParametersForLiveTesting()
+	if err != nil {
+		return nil, err
+	}
+	user := os.Getenv("OVIRT_USERNAME")
+	if user == "" {
+		return nil, fmt.Errorf("the OVIRT_USERNAME environment variable must not be empty")
+	}
+	vcr, err := NewVCR(VCRModeRecord, fixtureFile, url, tls, logger)
+	if err != nil {
+		return nil, err
+	}
+	// The VCR is closed after the cleanup functions of the test helper, so their calls are recorded too.
+	t.Cleanup(func() {
+		if err := vcr.Close(); err != nil {
+			t.Errorf("failed to write the VCR recording (%v)", err)
+		}
+	})
+	return NewTestHelper(
+		vcr.URL(),
+		user,
+		os.Getenv("OVIRT_PASSWORD"),
+		testHelperParamsFromEnv(),
+		TLS().Insecure(),
+		false,
+		logger,
+	)
+}
+
+func newReplayingTestHelper(t *testing.T, fixtureFile string, logger ovirtclientlog.Logger) (TestHelper, error) {
+	if _, err := os.Stat(fixtureFile); err != nil {
+		t.Skipf("no VCR recording found for the test at %s (%v)", fixtureFile, err)
+	}
+	// Only the path of the engine URL is used for replaying, which must match the recording.
+	url := os.Getenv("OVIRT_URL")
+	if url == "" {
+		url = "https://localhost/ovirt-engine/api"
+	}
+	vcr, err := NewVCR(VCRModeReplay, fixtureFile, url, nil, logger)
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() {
+		_ = vcr.Close()
+	})
+	return NewTestHelper(
+		vcr.URL(),
+		"admin@internal",
+		"vcr",
+		testHelperParamsFromEnv(),
+		TLS().Insecure(),
+		false,
+		logger,
+	)
+}
@@ -45,6 +45,15 @@ type BuildableTLSProvider interface {
 	// CACertsFromCertPool sets a certificate pool to use as a source for certificates. This is incompatible with  the
 	// CACertsFromSystem call as both create a certificate pool. This function must not be called twice.
 	CACertsFromCertPool(*x509.CertPool) BuildableTLSProvider
+
+	// ClientCertFromMemory sets a client certificate and the matching private key from in-memory byte slices containing
+	// PEM-encoded data. The client certificate is presented to the server for mutual TLS. This can be used to pass
+	// credentials obtained from a secrets manager without writing them to disk.
+	ClientCertFromMemory(cert []byte, key []byte) BuildableTLSProvider
+
+	// ClientCertFromFile sets a client certificate and the matching private key from PEM-encoded files. The client
+	// certificate is presented to the server for mutual TLS.
+	ClientCertFromFile(certFile string, keyFile string) BuildableTLSProvider
 }
 
 // TLS creates a BuildableTLSProvider that can be used to easily add trusted CA certificates and generally follows best
@@ -64,6 +73,10 @@ type standardTLSProvider struct {
 	certPool    *x509.CertPool
 	system      bool
 	configured  bool
+	clientCert  []byte
+	clientKey   []byte
+	certFile    string
+	keyFile     string
 }
 
 type standardTLSProviderDirectory struct {
@@ -125,6 +138,26 @@ func (s *standardTLSProvider) CACertsFromSystem() BuildableTLSProvider {
 	return s
 }
 
+func (s *standardTLSProvider) ClientCertFromMemory(cert []byte, key []byte) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clientCert = cert
+	s.clientKey = key
+	s.certFile = ""
+	s.keyFile = ""
+	return s
+}
+
+func (s *standardTLSProvider) ClientCertFromFile(certFile string, keyFile string) BuildableTLSProvider {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.certFile = certFile
+	s.keyFile = keyFile
+	s.clientCert = nil
+	s.clientKey = nil
+	return s
+}
+
 func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -134,9 +167,14 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 			"TLS not configured (Did you forget to call certificate configuration options on the TLS provider?)",
 		)
 	}
+	clientCerts, err := s.loadClientCert()
+	if err != nil {
+		return nil, err
+	}
 	if s.insecure {
 		return &tls.Config{
 			InsecureSkipVerify: true, //nolint:gosec
+			Certificates:       clientCerts,
 		}, nil
 	}
 	tlsConfig := &tls.Config{
@@ -155,11 +193,11 @@ func (s *standardTLSProvider) CreateTLSConfig() (*tls.Config, error) {
 			tls.CurveP256, tls.CurveP384,
 		},
 		InsecureSkipVerify: false,
+		Certificates:       clientCerts,
 	}
 
 	certPool := s.certPool
 	if certPool == nil {
-		var err error
 		if certPool, err = s.createCertPool(); err != nil {
 			return nil, err
 		}
@@ -226,6 +264,32 @@ func (s *standardTLSProvider) addCertsFromDir(certPool *x509.CertPool) error {
 	return nil
 }
 
+// loadClientCert loads the client certificate for mutual TLS, if any.
+func (s *standardTLSProvider) loadClientCert() ([]tls.Certificate, error) {
+	switch {
+	case s.certFile != "":
+		cert, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+		if err != nil {
+			return nil, wrap(
+				err,
+				ETLSError,
+				"failed to load client certificate from %s and key from %s",
+				s.certFile,
+				s.keyFile,
+			)
+		}
+		return []tls.Certificate{cert}, nil
+	case s.clientCert != nil:
+		cert, err := tls.X509KeyPair(s.clientCert, s.clientKey)
+		if err != nil {
+			return nil, wrap(err, ETLSError, "the provided client certificate or key is not valid")
+		}
+		return []tls.Certificate{cert}, nil
+	default:
+		return nil, nil
+	}
+}
+
 func (s *standardTLSProvider) addCertsFromFile(certPool *x509.CertPool) error {
 	for _, file := range s.files {
 		pemData, err := ioutil.ReadFile(file) //nolint:gosec
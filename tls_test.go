@@ -1,9 +1,17 @@
 package ovirtclient_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"regexp"
+	"testing"
+	"time"
 
 	ovirtclient "github.com/ovirt/go-ovirt-client"
 )
@@ -61,3 +69,61 @@ func ExampleBuildableTLSProvider_CACertsFromCertPool() {
 	}
 	// Output: Certificate verification is enabled.
 }
+
+func TestTLSClientCertFromMemory(t *testing.T) {
+	t.Parallel()
+	cert, key := generateTestClientCert(t)
+
+	tlsConfig, err := ovirtclient.TLS().
+		CACertsFromCertPool(x509.NewCertPool()).
+		ClientCertFromMemory(cert, key).
+		CreateTLSConfig()
+	if err != nil {
+		t.Fatalf("failed to create TLS config with client certificate (%v)", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("incorrect number of client certificates (expected: 1, got: %d)", len(tlsConfig.Certificates))
+	}
+}
+
+func TestTLSInvalidClientCert(t *testing.T) {
+	t.Parallel()
+	cert, _ := generateTestClientCert(t)
+
+	_, err := ovirtclient.TLS().
+		CACertsFromCertPool(x509.NewCertPool()).
+		ClientCertFromMemory(cert, []byte("not a key")).
+		CreateTLSConfig()
+	if err == nil {
+		t.Fatalf("creating a TLS config with an invalid client key did not result in an error")
+	}
+	if !ovirtclient.HasErrorCode(err, ovirtclient.ETLSError) {
+		t.Fatalf("creating a TLS config with an invalid client key returned an unexpected error (%v)", err)
+	}
+}
+
+// generateTestClientCert generates a self-signed client certificate and the matching private key in PEM format.
+func generateTestClientCert(t *testing.T) ([]byte, []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate private key (%v)", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-ovirt-client-test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate (%v)", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal private key (%v)", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
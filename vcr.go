@@ -0,0 +1,296 @@
+package ovirtclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// VCRMode is the mode of a VCR.
+type VCRMode string
+
+const (
+	// VCRModeRecord forwards all requests to a live oVirt Engine and records the interactions to a fixture file.
+	VCRModeRecord VCRMode = "record"
+	// VCRModeReplay answers all requests from the interactions recorded in a fixture file without contacting an
+	// oVirt Engine.
+	VCRModeReplay VCRMode = "replay"
+)
+
+// Validate returns an error if the VCR mode is not known.
+func (m VCRMode) Validate() error {
+	for _, mode := range VCRModeValues() {
+		if mode == m {
+			return nil
+		}
+	}
+	return newError(
+		EBadArgument,
+		"invalid VCR mode: %s must be one of: %s",
+		m,
+		strings.Join(VCRModeValues().Strings(), ", "),
+	)
+}
+
+// VCRModeList is a list of VCRMode values.
+type VCRModeList []VCRMode
+
+// Strings creates a string list of the values.
+func (l VCRModeList) Strings() []string {
+	result := make([]string, len(l))
+	for i, mode := range l {
+		result[i] = string(mode)
+	}
+	return result
+}
+
+// VCRModeValues returns all possible VCRMode values.
+func VCRModeValues() VCRModeList {
+	return []VCRMode{
+		VCRModeRecord,
+		VCRModeReplay,
+	}
+}
+
+// VCR is a local HTTP server standing in for the oVirt Engine. It either records the interactions with a live engine
+// to a fixture file, or replays them from the fixture file, which allows running tests offline. Pass the URL of the
+// VCR to New instead of the engine URL. Since the VCR is reached using plain HTTP, the TLS settings of the client are
+// not used.
+//
+// During replay, requests are matched by their method, path and query. If a request is sent multiple times, the
+// recorded responses are returned in order, and the last response is repeated once all have been used. Request
+// bodies are neither recorded nor matched, so tests must send the same requests in the same order on every run,
+// for example by using a fixed seed for random names. Image transfers connect to the imageio service directly and
+// are therefore not supported.
+//
+// The fixtures do not contain request bodies, and the access tokens returned by the SSO service are replaced, so the
+// credentials of the engine are not stored. The responses of the engine are stored as is.
+type VCR interface {
+	// URL returns the engine URL to pass to New. It points to the VCR, but has the same path as the engine URL.
+	URL() string
+	// Close stops the VCR. In record mode, it writes the recorded interactions to the fixture file.
+	Close() error
+}
+
+// NewVCR creates a VCR in the specified mode and starts it on a random local port. In record mode, the requests are
+// forwarded to the engine at engineURL using the TLS settings in tls and recorded to fixtureFile when the VCR is
+// closed. In replay mode, the interactions are read from fixtureFile and engineURL is only used for its path. tls may
+// be nil in replay mode.
+func NewVCR(mode VCRMode, fixtureFile string, engineURL string, tls TLSProvider, logger Logger) (VCR, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	target, err := url.Parse(engineURL)
+	if err != nil {
+		return nil, wrap(err, EBadArgument, "invalid engine URL: %s", engineURL)
+	}
+	v := &vcr{
+		mode:        mode,
+		fixtureFile: fixtureFile,
+		target:      target,
+		logger:      logger,
+		lock:        &sync.Mutex{},
+		positions:   map[string]int{},
+	}
+	switch mode {
+	case VCRModeRecord:
+		if err := v.setupRecording(tls); err != nil {
+			return nil, err
+		}
+	case VCRModeReplay:
+		if err := v.loadFixture(); err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, wrap(err, EUnidentified, "failed to listen for VCR connections")
+	}
+	v.listener = listener
+	v.server = &http.Server{Handler: v}
+	go func() {
+		_ = v.server.Serve(listener)
+	}()
+	return v, nil
+}
+
+// vcrSSOTokenPath is the path of the SSO service issuing the access tokens. Its responses are replaced when
+// recording to avoid storing valid tokens.
+const vcrSSOTokenPath = "/sso/oauth/token"
+
+// vcrInteraction is a single recorded request and response.
+type vcrInteraction struct {
+	Method      string `json:"method"`
+	URI         string `json:"uri"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        string `json:"body"`
+}
+
+func (i vcrInteraction) key() string {
+	return i.Method + " " + i.URI
+}
+
+type vcrFixture struct {
+	Interactions []vcrInteraction `json:"interactions"`
+}
+
+type vcr struct {
+	mode        VCRMode
+	fixtureFile string
+	target      *url.URL
+	logger      Logger
+	httpClient  *http.Client
+	listener    net.Listener
+	server      *http.Server
+
+	lock         *sync.Mutex
+	interactions []vcrInteraction
+	// positions holds the index of the next response to replay for each request key.
+	positions map[string]int
+	// recorded holds the recorded responses for each request key in replay mode.
+	recorded map[string][]vcrInteraction
+}
+
+func (v *vcr) URL() string {
+	return fmt.Sprintf("http://%s%s", v.listener.Addr().String(), v.target.Path)
+}
+
+func (v *vcr) Close() error {
+	if err := v.server.Close(); err != nil {
+		return wrap(err, EUnidentified, "failed to stop VCR")
+	}
+	if v.mode != VCRModeRecord {
+		return nil
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	data, err := json.MarshalIndent(&vcrFixture{Interactions: v.interactions}, "", "  ")
+	if err != nil {
+		return wrap(err, EBug, "failed to encode VCR fixture")
+	}
+	if err := os.MkdirAll(filepath.Dir(v.fixtureFile), 0o750); err != nil {
+		return wrap(err, EUnidentified, "failed to create directory for VCR fixture %s", v.fixtureFile)
+	}
+	if err := ioutil.WriteFile(v.fixtureFile, data, 0o600); err != nil {
+		return wrap(err, EUnidentified, "failed to write VCR fixture %s", v.fixtureFile)
+	}
+	return nil
+}
+
+func (v *vcr) setupRecording(tls TLSProvider) error {
+	if v.target.Scheme != "http" && v.target.Scheme != "https" {
+		return newError(EBadArgument, "the engine URL must start with http:// or https:// for recording")
+	}
+	if tls == nil {
+		return newError(EBadArgument, "a TLS provider is required for recording")
+	}
+	tlsConfig, err := tls.CreateTLSConfig()
+	if err != nil {
+		return wrap(err, ETLSError, "failed to create TLS configuration")
+	}
+	v.httpClient = &http.Client{
+		Transport: newHTTPTransport(tlsConfig, nil, nil),
+	}
+	return nil
+}
+
+func (v *vcr) loadFixture() error {
+	data, err := ioutil.ReadFile(v.fixtureFile)
+	if err != nil {
+		return wrap(err, ENotFound, "failed to read VCR fixture %s", v.fixtureFile)
+	}
+	fixture := &vcrFixture{}
+	if err := json.Unmarshal(data, fixture); err != nil {
+		return wrap(err, EBadArgument, "failed to decode VCR fixture %s", v.fixtureFile)
+	}
+	v.recorded = map[string][]vcrInteraction{}
+	for _, interaction := range fixture.Interactions {
+		v.recorded[interaction.key()] = append(v.recorded[interaction.key()], interaction)
+	}
+	return nil
+}
+
+func (v *vcr) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var interaction vcrInteraction
+	var err error
+	if v.mode == VCRModeRecord {
+		interaction, err = v.record(r)
+	} else {
+		interaction, err = v.replay(r)
+	}
+	if err != nil {
+		v.logger.Errorf("VCR failed to handle %s %s (%v)", r.Method, r.URL.RequestURI(), err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	if interaction.ContentType != "" {
+		w.Header().Set("Content-Type", interaction.ContentType)
+	}
+	w.WriteHeader(interaction.Status)
+	_, _ = w.Write([]byte(interaction.Body))
+}
+
+func (v *vcr) record(r *http.Request) (vcrInteraction, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return vcrInteraction{}, wrap(err, EUnidentified, "failed to read request body")
+	}
+	targetURL := fmt.Sprintf("%s://%s%s", v.target.Scheme, v.target.Host, r.URL.RequestURI())
+	req, err := http.NewRequest(r.Method, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return vcrInteraction{}, wrap(err, EBug, "failed to create request to the engine")
+	}
+	req.Header = r.Header.Clone()
+	// The HTTP client only decompresses the response transparently if it requested compression itself.
+	req.Header.Del("Accept-Encoding")
+	response, err := v.httpClient.Do(req)
+	if err != nil {
+		return vcrInteraction{}, wrap(err, EUnidentified, "failed to forward request to the engine")
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return vcrInteraction{}, wrap(err, EUnidentified, "failed to read response from the engine")
+	}
+	interaction := vcrInteraction{
+		Method:      r.Method,
+		URI:         r.URL.RequestURI(),
+		Status:      response.StatusCode,
+		ContentType: response.Header.Get("Content-Type"),
+		Body:        string(responseBody),
+	}
+	recordedInteraction := interaction
+	if strings.HasSuffix(r.URL.Path, vcrSSOTokenPath) && response.StatusCode == http.StatusOK {
+		recordedInteraction.Body = `{"access_token":"vcr","token_type":"bearer"}`
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.interactions = append(v.interactions, recordedInteraction)
+	return interaction, nil
+}
+
+func (v *vcr) replay(r *http.Request) (vcrInteraction, error) {
+	key := r.Method + " " + r.URL.RequestURI()
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	interactions := v.recorded[key]
+	if len(interactions) == 0 {
+		return vcrInteraction{}, newError(ENotFound, "no recorded interaction for %s", key)
+	}
+	position := v.positions[key]
+	if position < len(interactions)-1 {
+		v.positions[key] = position + 1
+	}
+	return interactions[position], nil
+}
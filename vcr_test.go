@@ -0,0 +1,88 @@
+package ovirtclient_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	ovirtclientlog "github.com/ovirt/go-ovirt-client-log/v2"
+)
+
+func TestVCRRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	logger := ovirtclientlog.NewTestLogger(t)
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory (%v)", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	fixtureFile := filepath.Join(dir, "fixtures", "fixture.json")
+	engine := httptest.NewServer(http.HandlerFunc(serveStandbyEngine))
+	engineURL := engine.URL + "/ovirt-engine/api"
+
+	recorder, err := ovirtclient.NewVCR(
+		ovirtclient.VCRModeRecord,
+		fixtureFile,
+		engineURL,
+		ovirtclient.TLS().Insecure(),
+		logger,
+	)
+	if err != nil {
+		t.Fatalf("failed to create recording VCR (%v)", err)
+	}
+	assertVCRClientWorks(t, recorder.URL(), logger)
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("failed to write recording (%v)", err)
+	}
+	engine.Close()
+
+	data, err := ioutil.ReadFile(fixtureFile)
+	if err != nil {
+		t.Fatalf("failed to read recording (%v)", err)
+	}
+	if strings.Contains(string(data), `"access_token":"token"`) || strings.Contains(string(data), "password") {
+		t.Fatalf("the recording contains credentials:\n%s", data)
+	}
+
+	replayer, err := ovirtclient.NewVCR(ovirtclient.VCRModeReplay, fixtureFile, engineURL, nil, logger)
+	if err != nil {
+		t.Fatalf("failed to create replaying VCR (%v)", err)
+	}
+	defer func() {
+		_ = replayer.Close()
+	}()
+	assertVCRClientWorks(t, replayer.URL(), logger)
+}
+
+func TestVCRInvalidMode(t *testing.T) {
+	t.Parallel()
+	_, err := ovirtclient.NewVCR("rewind", "fixture.json", "https://localhost/ovirt-engine/api", nil, nil)
+	if !ovirtclient.HasErrorCode(err, ovirtclient.EBadArgument) {
+		t.Fatalf("an invalid VCR mode did not result in an EBadArgument error (%v)", err)
+	}
+}
+
+func assertVCRClientWorks(t *testing.T, url string, logger ovirtclient.Logger) {
+	client, err := ovirtclient.NewWithVerify(
+		url,
+		"admin@internal",
+		"password",
+		ovirtclient.TLS().Insecure(),
+		logger,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create client (%v)", err)
+	}
+	if err := client.Test(ovirtclient.MaxTries(1)); err != nil {
+		t.Fatalf("failed to call the engine through the VCR (%v)", err)
+	}
+}
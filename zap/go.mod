@@ -0,0 +1,17 @@
+module github.com/ovirt/go-ovirt-client/zap
+
+go 1.20
+
+require (
+	github.com/ovirt/go-ovirt-client v0.0.0
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/ovirt/go-ovirt v0.0.0-20210809163552-d4276e35d3db // indirect
+	github.com/ovirt/go-ovirt-client-log/v2 v2.2.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+)
+
+replace github.com/ovirt/go-ovirt-client => ../
@@ -0,0 +1,45 @@
+// Package ovirtclientzap provides a logger for go-ovirt-client writing to zap.
+package ovirtclientzap
+
+import (
+	ovirtclient "github.com/ovirt/go-ovirt-client"
+	"go.uber.org/zap"
+)
+
+// New creates an ovirtclient.FieldLogger writing to the passed zap logger. The fields the client attaches to its
+// messages, such as the operation being performed, are added to the zap log entries.
+func New(logger *zap.Logger) ovirtclient.FieldLogger {
+	return &zapLogger{
+		logger: logger.WithOptions(zap.AddCallerSkip(1)).Sugar(),
+	}
+}
+
+type zapLogger struct {
+	logger *zap.SugaredLogger
+}
+
+func (z *zapLogger) Debugf(format string, args ...interface{}) {
+	z.logger.Debugf(format, args...)
+}
+
+func (z *zapLogger) Infof(format string, args ...interface{}) {
+	z.logger.Infof(format, args...)
+}
+
+func (z *zapLogger) Warningf(format string, args ...interface{}) {
+	z.logger.Warnf(format, args...)
+}
+
+func (z *zapLogger) Errorf(format string, args ...interface{}) {
+	z.logger.Errorf(format, args...)
+}
+
+func (z *zapLogger) WithFields(fields map[string]interface{}) ovirtclient.Logger {
+	args := make([]interface{}, 0, 2*len(fields))
+	for key, value := range fields {
+		args = append(args, key, value)
+	}
+	return &zapLogger{
+		logger: z.logger.With(args...),
+	}
+}
@@ -0,0 +1,49 @@
+package ovirtclientzap_test
+
+import (
+	"testing"
+
+	ovirtclientzap "github.com/ovirt/go-ovirt-client/zap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := ovirtclientzap.New(zap.New(core))
+
+	logger.Debugf("debug %d", 1)
+	logger.Infof("info %d", 2)
+	logger.Warningf("warning %d", 3)
+	logger.WithFields(map[string]interface{}{"operation": "GetVM"}).Errorf("error %d", 4)
+
+	entries := logs.AllUntimed()
+	expected := []struct {
+		level   zapcore.Level
+		message string
+	}{
+		{zapcore.DebugLevel, "debug 1"},
+		{zapcore.InfoLevel, "info 2"},
+		{zapcore.WarnLevel, "warning 3"},
+		{zapcore.ErrorLevel, "error 4"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("incorrect number of log entries (expected: %d, got: %d)", len(expected), len(entries))
+	}
+	for i, e := range expected {
+		if entries[i].Level != e.level || entries[i].Message != e.message {
+			t.Fatalf(
+				"incorrect log entry %d (expected: %s %q, got: %s %q)",
+				i, e.level, e.message, entries[i].Level, entries[i].Message,
+			)
+		}
+	}
+	if operation := entries[3].ContextMap()["operation"]; operation != "GetVM" {
+		t.Fatalf("incorrect operation field (expected: GetVM, got: %v)", operation)
+	}
+	if len(entries[0].Context) != 0 {
+		t.Fatalf("fields leaked to the parent logger (%v)", entries[0].Context)
+	}
+}